@@ -13,6 +13,7 @@ import (
 	"Open_IM/internal/api/organization"
 	apiThird "Open_IM/internal/api/third"
 	"Open_IM/internal/api/user"
+	"Open_IM/pkg/common/audit"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/log"
 	"Open_IM/pkg/grpc-etcdv3/getcdv3"
@@ -64,14 +65,16 @@ func main() {
 	{
 		userRouterGroup.POST("/update_user_info", user.UpdateUserInfo) //1
 		userRouterGroup.POST("/set_global_msg_recv_opt", user.SetGlobalRecvMessageOpt)
+		userRouterGroup.POST("/set_do_not_disturb", user.SetDoNotDisturb)
+		userRouterGroup.POST("/get_do_not_disturb", user.GetDoNotDisturb)
 		userRouterGroup.POST("/get_users_info", user.GetUsersPublicInfo)            //1
 		userRouterGroup.POST("/get_self_user_info", user.GetSelfUserInfo)           //1
 		userRouterGroup.POST("/get_users_online_status", user.GetUsersOnlineStatus) //1
 		userRouterGroup.POST("/get_users_info_from_cache", user.GetUsersInfoFromCache)
 		userRouterGroup.POST("/get_user_friend_from_cache", user.GetFriendIDListFromCache)
 		userRouterGroup.POST("/get_black_list_from_cache", user.GetBlackIDListFromCache)
-		userRouterGroup.POST("/get_all_users_uid", manage.GetAllUsersUid) //1
-		userRouterGroup.POST("/account_check", manage.AccountCheck)       //1
+		userRouterGroup.POST("/get_all_users_uid", audit.Middleware(), manage.GetAllUsersUid)            //1
+		userRouterGroup.POST("/account_check", audit.Middleware("checkUserIDList"), manage.AccountCheck) //1
 		//	userRouterGroup.POST("/get_users_online_status", manage.GetUsersOnlineStatus) //1
 		userRouterGroup.POST("/get_users", user.GetUsers)
 	}
@@ -93,6 +96,13 @@ func main() {
 
 		friendRouterGroup.POST("/import_friend", friend.ImportFriend) //1
 		friendRouterGroup.POST("/is_friend", friend.IsFriend)         //1
+		friendRouterGroup.POST("/set_category", friend.SetFriendCategory)
+		friendRouterGroup.POST("/delete_category", friend.DeleteFriendCategory)
+		friendRouterGroup.POST("/set_friend_categories", friend.SetFriendCategories)
+		friendRouterGroup.POST("/set_friend_starred", friend.SetFriendStarred)
+		friendRouterGroup.POST("/get_categories", friend.GetFriendCategories)
+		friendRouterGroup.POST("/get_recommendations", friend.GetFriendRecommendations)
+		friendRouterGroup.POST("/set_recommend_opt", friend.SetFriendRecommendOpt)
 	}
 	//group related routing group
 	groupRouterGroup := r.Group("/group")
@@ -114,14 +124,26 @@ func main() {
 		//only for supergroup
 		groupRouterGroup.POST("/invite_user_to_groups", group.InviteUserToGroups)
 		groupRouterGroup.POST("/get_joined_group_list", group.GetJoinedGroupList)
-		groupRouterGroup.POST("/dismiss_group", group.DismissGroup) //
-		groupRouterGroup.POST("/mute_group_member", group.MuteGroupMember)
-		groupRouterGroup.POST("/cancel_mute_group_member", group.CancelMuteGroupMember) //MuteGroup
-		groupRouterGroup.POST("/mute_group", group.MuteGroup)
-		groupRouterGroup.POST("/cancel_mute_group", group.CancelMuteGroup)
+		groupRouterGroup.POST("/dismiss_group", audit.Middleware("groupID"), group.DismissGroup) //
+		groupRouterGroup.POST("/mute_group_member", audit.Middleware("groupID", "userID"), group.MuteGroupMember)
+		groupRouterGroup.POST("/cancel_mute_group_member", audit.Middleware("groupID", "userID"), group.CancelMuteGroupMember) //MuteGroup
+		groupRouterGroup.POST("/mute_group", audit.Middleware("groupID"), group.MuteGroup)
+		groupRouterGroup.POST("/cancel_mute_group", audit.Middleware("groupID"), group.CancelMuteGroup)
 		groupRouterGroup.POST("/set_group_member_nickname", group.SetGroupMemberNickname)
 		groupRouterGroup.POST("/set_group_member_info", group.SetGroupMemberInfo)
 		groupRouterGroup.POST("/get_group_abstract_info", group.GetGroupAbstractInfo)
+		groupRouterGroup.POST("/create_invite_link", group.CreateGroupInviteLink)
+		groupRouterGroup.POST("/get_invite_links", group.GetGroupInviteLinks)
+		groupRouterGroup.POST("/revoke_invite_link", audit.Middleware("groupID", "token"), group.RevokeGroupInviteLink)
+		groupRouterGroup.POST("/set_group_role", audit.Middleware("groupID", "roleID"), group.SetGroupRole)
+		groupRouterGroup.POST("/delete_group_role", audit.Middleware("groupID", "roleID"), group.DeleteGroupRole)
+		groupRouterGroup.POST("/get_group_roles", group.GetGroupRoles)
+		groupRouterGroup.POST("/set_group_member_role", audit.Middleware("groupID", "userIDList", "roleID"), group.SetGroupMemberRole)
+		groupRouterGroup.POST("/set_join_questionnaire", audit.Middleware("groupID"), group.SetGroupJoinQuestionnaire)
+		groupRouterGroup.POST("/get_join_questionnaire", group.GetGroupJoinQuestionnaire)
+		groupRouterGroup.POST("/query_group_members", group.QueryGroupMembers)
+		groupRouterGroup.POST("/import_groups", audit.Middleware("idempotencyKey"), group.ImportGroups)
+		groupRouterGroup.POST("/get_import_job", group.GetGroupImportJob)
 		//groupRouterGroup.POST("/get_group_all_member_list_by_split", group.GetGroupAllMemberListBySplit)
 	}
 	superGroupRouterGroup := r.Group("/super_group")
@@ -130,12 +152,20 @@ func main() {
 		superGroupRouterGroup.POST("/get_groups_info", group.GetSuperGroupsInfo)
 	}
 	//certificate
+	r.GET("/.well-known/jwks.json", apiAuth.GetJWKS)
 	authRouterGroup := r.Group("/auth")
 	{
-		authRouterGroup.POST("/user_register", apiAuth.UserRegister) //1
-		authRouterGroup.POST("/user_token", apiAuth.UserToken)       //1
-		authRouterGroup.POST("/parse_token", apiAuth.ParseToken)     //1
-		authRouterGroup.POST("/force_logout", apiAuth.ForceLogout)   //1
+		authRouterGroup.POST("/user_register", apiAuth.UserRegister)                               //1
+		authRouterGroup.POST("/user_token", apiAuth.UserToken)                                     //1
+		authRouterGroup.POST("/refresh_token", apiAuth.RefreshToken)                               //1
+		authRouterGroup.POST("/parse_token", apiAuth.ParseToken)                                   //1
+		authRouterGroup.POST("/force_logout", audit.Middleware("fromUserID"), apiAuth.ForceLogout) //1
+		authRouterGroup.POST("/get_user_sessions", apiAuth.GetUserSessions)
+		authRouterGroup.POST("/revoke_user_session", audit.Middleware("userID", "sessionID"), apiAuth.RevokeUserSession)
+		authRouterGroup.GET("/oidc/login", apiAuth.OIDCLogin)
+		authRouterGroup.POST("/oidc/authorize", apiAuth.OIDCAuthorize)
+		authRouterGroup.GET("/oidc/callback", apiAuth.OIDCCallback)
+		authRouterGroup.POST("/oidc/token", apiAuth.OIDCToken)
 	}
 	//Third service
 	thirdGroup := r.Group("/third")
@@ -144,6 +174,11 @@ func main() {
 		thirdGroup.POST("/ali_oss_credential", apiThird.AliOSSCredential)
 		thirdGroup.POST("/minio_storage_credential", apiThird.MinioStorageCredential)
 		thirdGroup.POST("/minio_upload", apiThird.MinioUploadFile)
+		thirdGroup.POST("/minio_multipart/initiate", apiThird.MinioInitiateMultipartUpload)
+		thirdGroup.POST("/minio_multipart/upload_part", apiThird.MinioUploadPart)
+		thirdGroup.POST("/minio_multipart/list_parts", apiThird.MinioListParts)
+		thirdGroup.POST("/minio_multipart/complete", apiThird.MinioCompleteMultipartUpload)
+		thirdGroup.POST("/minio_multipart/abort", apiThird.MinioAbortMultipartUpload)
 		thirdGroup.POST("/upload_update_app", apiThird.UploadUpdateApp)
 		thirdGroup.POST("/get_download_url", apiThird.GetDownloadURL)
 		thirdGroup.POST("/get_rtc_invitation_info", apiThird.GetRTCInvitationInfo)
@@ -161,8 +196,8 @@ func main() {
 		chatGroup.POST("/del_msg", apiChat.DelMsg)
 		chatGroup.POST("/del_super_group_msg", apiChat.DelSuperGroupMsg)
 		chatGroup.POST("/clear_msg", apiChat.ClearMsg)
-		chatGroup.POST("/manage_send_msg", manage.ManagementSendMsg)
-		chatGroup.POST("/batch_send_msg", manage.ManagementBatchSendMsg)
+		chatGroup.POST("/manage_send_msg", audit.Middleware("sendID", "recvID", "groupID"), manage.ManagementSendMsg)
+		chatGroup.POST("/batch_send_msg", audit.Middleware("sendID", "recvIDList"), manage.ManagementBatchSendMsg)
 		chatGroup.POST("/check_msg_is_send_success", manage.CheckMsgIsSendSuccess)
 		chatGroup.POST("/set_msg_min_seq", apiChat.SetMsgMinSeq)
 
@@ -2,6 +2,7 @@ package main
 
 import (
 	"Open_IM/internal/cms_api"
+	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/constant"
 	"Open_IM/pkg/utils"
 	"flag"
@@ -16,6 +17,8 @@ import (
 func main() {
 	gin.SetMode(gin.ReleaseMode)
 	router := cms_api.NewGinRouter()
+	// the cms is the one service draining the callback queue, redeliveries are queued from here
+	callback.StartDeliveryWorker()
 	router.Use(utils.CorsHandler())
 	defaultPorts := config.Config.CmsApi.GinPort
 	ginPort := flag.Int("port", defaultPorts[0], "get ginServerPort from cmd,default 10006 as port")
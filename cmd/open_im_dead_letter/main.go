@@ -0,0 +1,44 @@
+package main
+
+import (
+	"Open_IM/pkg/common/kafka"
+	"Open_IM/pkg/utils"
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	var action = flag.String("action", "list", "list: show dead letters, redrive: send a dead letter back to its source topic")
+	var partition = flag.Int("partition", 0, "partition of the dead letter topic")
+	var offset = flag.Int64("offset", 0, "offset of the dead letter to start listing from or to redrive")
+	var showNumber = flag.Int("showNumber", 20, "max number of dead letters to list")
+	var force = flag.Bool("force", false, "redrive even if the dead letter reached max attempt")
+	flag.Parse()
+	operationID := utils.OperationIDGenerator()
+	switch *action {
+	case "list":
+		records, nextOffset, err := kafka.GetDeadLetters(int32(*partition), *offset, *showNumber)
+		if err != nil {
+			fmt.Println("get dead letters failed", err.Error())
+			os.Exit(1)
+		}
+		for _, v := range records {
+			fmt.Printf("partition: %d offset: %d topic: %s srcPartition: %d srcOffset: %d key: %s handler: %s attempt: %d createTime: %d err: %s\n",
+				v.Partition, v.Offset, v.DeadLetter.Topic, v.DeadLetter.Partition, v.DeadLetter.Offset, string(v.DeadLetter.Key),
+				v.DeadLetter.Handler, v.DeadLetter.Attempt, v.DeadLetter.CreateTime, v.DeadLetter.ErrMsg)
+		}
+		fmt.Println("total:", len(records), "next offset of partition:", nextOffset)
+	case "redrive":
+		deadLetter, err := kafka.RedriveDeadLetter(int32(*partition), *offset, *force, operationID)
+		if err != nil {
+			fmt.Println("redrive dead letter failed", *partition, *offset, err.Error())
+			os.Exit(1)
+		}
+		fmt.Println("redrive dead letter success", *partition, *offset, "to", deadLetter.Topic)
+	default:
+		fmt.Println("unknown action", *action)
+		flag.Usage()
+		os.Exit(2)
+	}
+}
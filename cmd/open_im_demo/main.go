@@ -26,6 +26,7 @@ func main() {
 	r := gin.Default()
 	r.Use(utils.CorsHandler())
 	if config.Config.Prometheus.Enable {
+		promePkg.NewLoginLockCheckFailedCounter()
 		r.GET("/metrics", promePkg.PrometheusHandler())
 	}
 	authRouterGroup := r.Group("/demo")
@@ -36,6 +37,13 @@ func main() {
 		authRouterGroup.POST("/login", register.Login)
 		authRouterGroup.POST("/reset_password", register.ResetPassword)
 		authRouterGroup.POST("/check_login", register.CheckLoginLimit)
+		authRouterGroup.POST("/two_factor/verify", register.VerifyTwoFactor)
+		authRouterGroup.POST("/two_factor/enroll", register.EnrollTwoFactor)
+		authRouterGroup.POST("/two_factor/activate", register.ActivateTwoFactor)
+		authRouterGroup.POST("/two_factor/disable", register.DisableTwoFactor)
+		authRouterGroup.POST("/two_factor/regenerate_recovery_codes", register.RegenerateRecoveryCodes)
+		authRouterGroup.POST("/two_factor/get_trusted_devices", register.GetTrustedDevices)
+		authRouterGroup.POST("/two_factor/remove_trusted_device", register.RemoveTrustedDevice)
 	}
 	demoRouterGroup := r.Group("/auth")
 	{
@@ -45,6 +53,13 @@ func main() {
 		demoRouterGroup.POST("/login", register.Login)
 		demoRouterGroup.POST("/reset_password", register.ResetPassword)
 		demoRouterGroup.POST("/check_login", register.CheckLoginLimit)
+		demoRouterGroup.POST("/two_factor/verify", register.VerifyTwoFactor)
+		demoRouterGroup.POST("/two_factor/enroll", register.EnrollTwoFactor)
+		demoRouterGroup.POST("/two_factor/activate", register.ActivateTwoFactor)
+		demoRouterGroup.POST("/two_factor/disable", register.DisableTwoFactor)
+		demoRouterGroup.POST("/two_factor/regenerate_recovery_codes", register.RegenerateRecoveryCodes)
+		demoRouterGroup.POST("/two_factor/get_trusted_devices", register.GetTrustedDevices)
+		demoRouterGroup.POST("/two_factor/remove_trusted_device", register.RemoveTrustedDevice)
 	}
 
 	//deprecated
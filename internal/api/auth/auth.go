@@ -86,7 +86,7 @@ func UserRegister(c *gin.Context) {
 		return
 	}
 	resp := api.UserRegisterResp{CommResp: api.CommResp{ErrCode: replyToken.CommonResp.ErrCode, ErrMsg: replyToken.CommonResp.ErrMsg},
-		UserToken: api.UserTokenInfo{UserID: req.UserInfo.UserID, Token: replyToken.Token, ExpiredTime: replyToken.ExpiredTime}}
+		UserToken: api.UserTokenInfo{UserID: req.UserInfo.UserID, Token: replyToken.Token, ExpiredTime: replyToken.ExpiredTime, RefreshToken: replyToken.RefreshToken, RefreshExpiredTime: replyToken.RefreshExpiredTime}}
 	log.NewInfo(req.OperationID, "UserRegister return ", resp)
 	c.JSON(http.StatusOK, resp)
 
@@ -136,11 +136,53 @@ func UserToken(c *gin.Context) {
 		return
 	}
 	resp := api.UserTokenResp{CommResp: api.CommResp{ErrCode: reply.CommonResp.ErrCode, ErrMsg: reply.CommonResp.ErrMsg},
-		UserToken: api.UserTokenInfo{UserID: req.FromUserID, Token: reply.Token, ExpiredTime: reply.ExpiredTime}}
+		UserToken: api.UserTokenInfo{UserID: req.FromUserID, Token: reply.Token, ExpiredTime: reply.ExpiredTime, RefreshToken: reply.RefreshToken, RefreshExpiredTime: reply.RefreshExpiredTime}}
 	log.NewInfo(req.OperationID, "UserToken return ", resp)
 	c.JSON(http.StatusOK, resp)
 }
 
+// @Summary 刷新token
+// @Description 使用refreshToken换取新的token和refreshToken, 旧refreshToken失效, 重复使用会吊销该登录的所有token
+// @Tags 鉴权认证
+// @ID RefreshToken
+// @Accept json
+// @Param req body api.RefreshTokenReq true "platform为平台ID <br> refreshToken为登录或上次刷新返回的refreshToken"
+// @Produce json
+// @Success 0 {object} api.RefreshTokenResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/refresh_token [post]
+func RefreshToken(c *gin.Context) {
+	params := api.RefreshTokenReq{}
+	if err := c.BindJSON(&params); err != nil {
+		errMsg := " BindJSON failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	req := &rpc.RefreshTokenReq{Platform: params.Platform, FromUserID: params.UserID, RefreshToken: params.RefreshToken, OperationID: params.OperationID}
+	log.NewInfo(req.OperationID, "RefreshToken args ", req.Platform, req.FromUserID)
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImAuthName, req.OperationID)
+	if etcdConn == nil {
+		errMsg := req.OperationID + " getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	client := rpc.NewAuthClient(etcdConn)
+	reply, err := client.RefreshToken(context.Background(), req)
+	if err != nil {
+		errMsg := req.OperationID + " RefreshToken failed " + err.Error()
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	resp := api.RefreshTokenResp{CommResp: api.CommResp{ErrCode: reply.CommonResp.ErrCode, ErrMsg: reply.CommonResp.ErrMsg},
+		UserToken: api.UserTokenInfo{UserID: req.FromUserID, Token: reply.Token, ExpiredTime: reply.ExpiredTime, RefreshToken: reply.RefreshToken, RefreshExpiredTime: reply.RefreshExpiredTime}}
+	log.NewInfo(req.OperationID, "RefreshToken return ", resp.ErrCode, resp.UserToken.ExpiredTime)
+	c.JSON(http.StatusOK, resp)
+}
+
 // @Summary 解析当前用户token
 // @Description 解析当前用户token(token在请求头中传入)
 // @Tags 鉴权认证
@@ -232,3 +274,135 @@ func ForceLogout(c *gin.Context) {
 	log.NewInfo(params.OperationID, utils.GetSelfFuncName(), " return ", resp)
 	c.JSON(http.StatusOK, resp)
 }
+
+// @Summary 获取登录会话
+// @Description 获取用户当前在线的登录会话(平台、IP、登录时间), 不传userID时为自己, 管理员可获取其他用户
+// @Tags 鉴权认证
+// @ID GetUserSessions
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetUserSessionsReq true "userID为要获取会话的用户ID"
+// @Produce json
+// @Success 0 {object} api.GetUserSessionsResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/get_user_sessions [post]
+func GetUserSessions(c *gin.Context) {
+	params := api.GetUserSessionsReq{}
+	if err := c.BindJSON(&params); err != nil {
+		errMsg := " BindJSON failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	req := &rpc.GetUserSessionsReq{UserID: params.UserID, OperationID: params.OperationID, Token: c.Request.Header.Get("token")}
+	var ok bool
+	var errInfo string
+	ok, req.OpUserID, errInfo = token_verify.GetUserIDFromToken(req.Token, req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + req.Token
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	if req.UserID == "" {
+		req.UserID = req.OpUserID
+	}
+	log.NewInfo(req.OperationID, "GetUserSessions args ", req.UserID, req.OpUserID)
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImAuthName, req.OperationID)
+	if etcdConn == nil {
+		errMsg := req.OperationID + " getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	client := rpc.NewAuthClient(etcdConn)
+	reply, err := client.GetUserSessions(context.Background(), req)
+	if err != nil {
+		errMsg := req.OperationID + " GetUserSessions failed " + err.Error() + req.UserID
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	resp := api.GetUserSessionsResp{CommResp: api.CommResp{ErrCode: reply.CommonResp.ErrCode, ErrMsg: reply.CommonResp.ErrMsg}, Sessions: []*api.UserSession{}}
+	for _, v := range reply.Sessions {
+		resp.Sessions = append(resp.Sessions, &api.UserSession{SessionID: v.SessionID, PlatformID: v.PlatformID, Platform: constant.PlatformIDToName(int(v.PlatformID)),
+			LoginIP: v.LoginIP, LoginTime: v.LoginTime, Current: v.Current})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " return ", len(resp.Sessions))
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 下线登录会话
+// @Description 下线指定的登录会话, 该会话的token失效并断开长连接, 不传userID时为自己, 管理员可下线其他用户
+// @Tags 鉴权认证
+// @ID RevokeUserSession
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.RevokeUserSessionReq true "sessionID为get_user_sessions返回的会话ID"
+// @Produce json
+// @Success 0 {object} api.RevokeUserSessionResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/revoke_user_session [post]
+func RevokeUserSession(c *gin.Context) {
+	params := api.RevokeUserSessionReq{}
+	if err := c.BindJSON(&params); err != nil {
+		errMsg := " BindJSON failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	req := &rpc.RevokeUserSessionReq{UserID: params.UserID, SessionID: params.SessionID, OperationID: params.OperationID}
+	var ok bool
+	var errInfo string
+	ok, req.OpUserID, errInfo = token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	if req.UserID == "" {
+		req.UserID = req.OpUserID
+	}
+	log.NewInfo(req.OperationID, "RevokeUserSession args ", req.String())
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImAuthName, req.OperationID)
+	if etcdConn == nil {
+		errMsg := req.OperationID + " getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	client := rpc.NewAuthClient(etcdConn)
+	reply, err := client.RevokeUserSession(context.Background(), req)
+	if err != nil {
+		errMsg := req.OperationID + " RevokeUserSession failed " + err.Error() + req.String()
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	resp := api.RevokeUserSessionResp{CommResp: api.CommResp{ErrCode: reply.CommonResp.ErrCode, ErrMsg: reply.CommonResp.ErrMsg}}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 获取token校验公钥
+// @Description 以JWKS格式返回当前可用于校验token的公钥, 签名算法为HS256时keys为空
+// @Tags 鉴权认证
+// @ID GetJWKS
+// @Produce json
+// @Success 200 {array} token_verify.JSONWebKey "返回{"keys": [...]}"
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Router /.well-known/jwks.json [get]
+func GetJWKS(c *gin.Context) {
+	keys, err := token_verify.GetJWKS()
+	if err != nil {
+		log.NewError("", "GetJWKS failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	// verifiers may cache the key set, upcoming keys are published before they become active
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
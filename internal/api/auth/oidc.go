@@ -0,0 +1,330 @@
+package apiAuth
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/oidc"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	rpc "Open_IM/pkg/proto/auth"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func newOIDCLogin(params *api.OIDCAuthorizeReq) (authURL, state string, errCode int32, errMsg string) {
+	if !config.Config.OIDC.Enable {
+		return "", "", constant.ErrAccess.ErrCode, "oidc login is not enabled"
+	}
+	if !utils.IsContain(params.RedirectURI, config.Config.OIDC.ClientRedirectURIs) {
+		return "", "", constant.ErrArgs.ErrCode, "redirectURI is not registered"
+	}
+	provider, err := oidc.GetProvider(params.Provider)
+	if err != nil {
+		return "", "", constant.ErrArgs.ErrCode, err.Error()
+	}
+	loginState := &db.OIDCLoginState{Provider: params.Provider, Platform: params.Platform, RedirectURI: params.RedirectURI}
+	if state, err = oidc.RandomString(24); err == nil {
+		if loginState.Nonce, err = oidc.RandomString(24); err == nil {
+			loginState.CodeVerifier, err = oidc.RandomString(32)
+		}
+	}
+	if err != nil {
+		log.NewError(params.OperationID, "RandomString failed ", err.Error())
+		return "", "", constant.ErrInternal.ErrCode, err.Error()
+	}
+	authURL, err = provider.AuthCodeURL(state, loginState.Nonce, loginState.CodeVerifier)
+	if err != nil {
+		log.NewError(params.OperationID, "AuthCodeURL failed ", params.Provider, err.Error())
+		return "", "", constant.ErrInternal.ErrCode, err.Error()
+	}
+	ttl := config.Config.OIDC.StateTTL
+	if ttl <= 0 {
+		ttl = 600
+	}
+	if err := db.DB.SetOIDCLoginState(state, loginState, ttl); err != nil {
+		log.NewError(params.OperationID, "SetOIDCLoginState failed ", err.Error())
+		return "", "", constant.ErrDB.ErrCode, err.Error()
+	}
+	return authURL, state, 0, ""
+}
+
+// @Summary OIDC单点登录
+// @Description 跳转到身份提供方登录页面, 登录完成后身份提供方回调/auth/oidc/callback
+// @Tags 鉴权认证
+// @ID OIDCLogin
+// @Param provider query string true "配置中的身份提供方名称"
+// @Param platform query int true "平台ID"
+// @Param redirectURI query string true "登录完成后跳转的客户端地址, 须在配置oidc.clientRedirectURIs中"
+// @Param operationID query string false "操作ID"
+// @Success 302
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/oidc/login [get]
+func OIDCLogin(c *gin.Context) {
+	params := api.OIDCAuthorizeReq{}
+	if err := c.BindQuery(&params); err != nil {
+		errMsg := " BindQuery failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	if params.OperationID == "" {
+		params.OperationID = utils.OperationIDGenerator()
+	}
+	authURL, _, errCode, errMsg := newOIDCLogin(&params)
+	if errCode != 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": errCode, "errMsg": errMsg})
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// @Summary 获取OIDC登录地址
+// @Description 客户端自行打开authURL登录, 适用于无法跟随跳转的客户端
+// @Tags 鉴权认证
+// @ID OIDCAuthorize
+// @Accept json
+// @Param req body api.OIDCAuthorizeReq true "provider为配置中的身份提供方名称 <br> platform为平台ID <br> redirectURI为登录完成后跳转的客户端地址"
+// @Produce json
+// @Success 0 {object} api.OIDCAuthorizeResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/oidc/authorize [post]
+func OIDCAuthorize(c *gin.Context) {
+	params := api.OIDCAuthorizeReq{}
+	if err := c.BindJSON(&params); err != nil {
+		errMsg := " BindJSON failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	resp := api.OIDCAuthorizeResp{}
+	resp.Data.AuthURL, resp.Data.State, resp.ErrCode, resp.ErrMsg = newOIDCLogin(&params)
+	log.NewInfo(params.OperationID, "OIDCAuthorize return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// provisionOIDCUser returns the user linked to the identity, on first sign in it registers the user and links it.
+// Users the provider did not create are never signed in, whatever the mapped user ID is.
+func provisionOIDCUser(client rpc.AuthClient, providerName string, profile *oidc.Profile, operationID string) (string, error) {
+	identity, err := imdb.GetOIDCIdentity(providerName, profile.Subject)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", err
+		}
+		if _, err := imdb.GetUserByUserID(profile.UserID); err == nil {
+			return "", errors.New("user exists and is not linked to the provider")
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", err
+		}
+		if err := imdb.InsertOIDCIdentity(&db.OIDCIdentity{Provider: providerName, Subject: profile.Subject, UserID: profile.UserID}); err != nil {
+			return "", err
+		}
+		if identity, err = imdb.GetOIDCIdentity(providerName, profile.Subject); err != nil {
+			return "", err
+		}
+	}
+	// the link is written before the user, a sign in that failed to register retries here
+	_, err = imdb.GetUserByUserID(identity.UserID)
+	if err == nil {
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", err
+	}
+	req := &rpc.UserRegisterReq{OperationID: operationID, UserInfo: &open_im_sdk.UserInfo{
+		UserID:   identity.UserID,
+		Nickname: profile.Nickname,
+		FaceURL:  profile.FaceURL,
+		Email:    profile.Email,
+	}}
+	if profile.Department != "" {
+		req.UserInfo.Ex = utils.StructToJsonString(map[string]string{"department": profile.Department})
+	}
+	log.NewInfo(operationID, "oidc provision user ", req.String())
+	reply, err := client.UserRegister(context.Background(), req)
+	if err != nil {
+		return "", err
+	}
+	if reply.CommonResp.ErrCode != 0 {
+		return "", errors.New(reply.CommonResp.ErrMsg)
+	}
+	return identity.UserID, nil
+}
+
+// redirectOIDCClient sends the browser back to the client, query carries the code or the error in oauth style
+func redirectOIDCClient(c *gin.Context, redirectURI string, query url.Values) {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	q := u.Query()
+	for k, v := range query {
+		q[k] = v
+	}
+	u.RawQuery = q.Encode()
+	c.Redirect(http.StatusFound, u.String())
+}
+
+// signInOIDCUser verifies the callback with the identity provider and returns the IM user it signs in
+func signInOIDCUser(params *api.OIDCCallbackReq, loginState *db.OIDCLoginState, operationID string) (string, error) {
+	provider, err := oidc.GetProvider(loginState.Provider)
+	if err != nil {
+		return "", utils.Wrap(err, "GetProvider failed")
+	}
+	token, err := provider.Exchange(params.Code, loginState.CodeVerifier)
+	if err != nil {
+		return "", utils.Wrap(err, "Exchange failed")
+	}
+	claims, err := provider.VerifyIDToken(token.IDToken, loginState.Nonce)
+	if err != nil {
+		return "", utils.Wrap(err, "VerifyIDToken failed")
+	}
+	if token.AccessToken != "" {
+		subject, _ := claims["sub"].(string)
+		userInfo, err := provider.UserInfo(token.AccessToken, subject)
+		if err != nil {
+			log.NewWarn(operationID, "UserInfo failed, use id token claims ", loginState.Provider, err.Error())
+		}
+		for k, v := range userInfo {
+			if _, ok := claims[k]; !ok {
+				claims[k] = v
+			}
+		}
+	}
+	profile, err := provider.MapClaims(claims)
+	if err != nil {
+		return "", utils.Wrap(err, "MapClaims failed")
+	}
+	client, err := getAuthClient(operationID)
+	if err != nil {
+		return "", err
+	}
+	userID, err := provisionOIDCUser(client, loginState.Provider, profile, operationID)
+	if err != nil {
+		return "", utils.Wrap(err, "provision user failed "+profile.UserID)
+	}
+	return userID, nil
+}
+
+func getAuthClient(operationID string) (rpc.AuthClient, error) {
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImAuthName, operationID)
+	if etcdConn == nil {
+		return nil, errors.New("getcdv3.GetDefaultConn == nil")
+	}
+	return rpc.NewAuthClient(etcdConn), nil
+}
+
+// @Summary OIDC登录回调
+// @Description 身份提供方登录完成后回调, 校验id_token, 首次登录自动注册用户, 跳转到客户端redirectURI并带上一次性code和state, 失败时带上error和error_description
+// @Tags 鉴权认证
+// @ID OIDCCallback
+// @Param code query string true "授权码"
+// @Param state query string true "登录状态"
+// @Success 302
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/oidc/callback [get]
+func OIDCCallback(c *gin.Context) {
+	params := api.OIDCCallbackReq{}
+	operationID := utils.OperationIDGenerator()
+	if err := c.BindQuery(&params); err != nil {
+		errMsg := " BindQuery failed " + err.Error()
+		log.NewError(operationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	loginState, err := db.DB.TakeOIDCLoginState(params.State)
+	if err != nil {
+		log.NewError(operationID, "TakeOIDCLoginState failed ", params.State, err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "login state is invalid or expired"})
+		return
+	}
+	if params.Error != "" || params.Code == "" {
+		log.NewInfo(operationID, "oidc login denied ", loginState.Provider, params.Error, params.ErrorDescription)
+		redirectOIDCClient(c, loginState.RedirectURI, url.Values{"error": {"access_denied"}, "error_description": {params.Error + " " + params.ErrorDescription}, "state": {params.State}})
+		return
+	}
+	userID, err := signInOIDCUser(&params, loginState, operationID)
+	if err != nil {
+		log.NewError(operationID, "signInOIDCUser failed ", loginState.Provider, err.Error())
+		redirectOIDCClient(c, loginState.RedirectURI, url.Values{"error": {"access_denied"}, "error_description": {err.Error()}, "state": {params.State}})
+		return
+	}
+	code, err := oidc.RandomString(32)
+	if err == nil {
+		ttl := config.Config.OIDC.CodeTTL
+		if ttl <= 0 {
+			ttl = 60
+		}
+		err = db.DB.SetOIDCLoginCode(code, &db.OIDCLoginCode{UserID: userID, Platform: loginState.Platform, LoginIP: c.ClientIP(), RedirectURI: loginState.RedirectURI}, ttl)
+	}
+	if err != nil {
+		log.NewError(operationID, "SetOIDCLoginCode failed ", userID, err.Error())
+		redirectOIDCClient(c, loginState.RedirectURI, url.Values{"error": {"server_error"}, "state": {params.State}})
+		return
+	}
+	log.NewInfo(operationID, "OIDCCallback redirect ", userID, loginState.RedirectURI)
+	redirectOIDCClient(c, loginState.RedirectURI, url.Values{"code": {code}, "state": {params.State}})
+}
+
+// @Summary OIDC换取token
+// @Description 用回调跳转带回的一次性code换取IM token, code只能使用一次
+// @Tags 鉴权认证
+// @ID OIDCToken
+// @Accept json
+// @Param req body api.OIDCTokenReq true "code为回调跳转带回的code <br> redirectURI须与登录时一致"
+// @Produce json
+// @Success 0 {object} api.OIDCTokenResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /auth/oidc/token [post]
+func OIDCToken(c *gin.Context) {
+	params := api.OIDCTokenReq{}
+	if err := c.BindJSON(&params); err != nil {
+		errMsg := " BindJSON failed " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	loginCode, err := db.DB.TakeOIDCLoginCode(params.Code)
+	if err != nil {
+		log.NewError(params.OperationID, "TakeOIDCLoginCode failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "code is invalid or expired"})
+		return
+	}
+	if loginCode.RedirectURI != params.RedirectURI {
+		log.NewError(params.OperationID, "redirectURI mismatch ", loginCode.UserID, params.RedirectURI)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "code is invalid or expired"})
+		return
+	}
+	client, err := getAuthClient(params.OperationID)
+	if err != nil {
+		errMsg := params.OperationID + " " + err.Error()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	req := &rpc.UserTokenReq{Platform: loginCode.Platform, FromUserID: loginCode.UserID, OperationID: params.OperationID, LoginIp: loginCode.LoginIP}
+	reply, err := client.UserToken(context.Background(), req)
+	if err != nil {
+		errMsg := params.OperationID + " UserToken failed " + err.Error() + " req: " + req.String()
+		log.NewError(params.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	resp := api.OIDCTokenResp{CommResp: api.CommResp{ErrCode: reply.CommonResp.ErrCode, ErrMsg: reply.CommonResp.ErrMsg},
+		UserToken: api.UserTokenInfo{UserID: req.FromUserID, Token: reply.Token, ExpiredTime: reply.ExpiredTime, RefreshToken: reply.RefreshToken, RefreshExpiredTime: reply.RefreshExpiredTime}}
+	log.NewInfo(params.OperationID, "OIDCToken return ", resp.ErrCode, resp.UserToken.UserID)
+	c.JSON(http.StatusOK, resp)
+}
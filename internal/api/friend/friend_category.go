@@ -0,0 +1,275 @@
+package friend
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	rpc "Open_IM/pkg/proto/friend"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func friendClient(operationID string) rpc.FriendClient {
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImFriendName, operationID)
+	if etcdConn == nil {
+		return nil
+	}
+	return rpc.NewFriendClient(etcdConn)
+}
+
+// opUserIDFromToken returns the user of the token, the error response is written when it fails
+func opUserIDFromToken(c *gin.Context, operationID string) (string, bool) {
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), operationID)
+	if !ok {
+		errMsg := operationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(operationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return "", false
+	}
+	return opUserID, true
+}
+
+func friendCategoryPbCopyApi(category *rpc.FriendCategory) *api.FriendCategory {
+	if category == nil {
+		return nil
+	}
+	apiCategory := &api.FriendCategory{CategoryID: category.CategoryID, Name: category.Name, FriendUserIDList: category.FriendUserIDList,
+		CreateTime: category.CreateTime, UpdateTime: category.UpdateTime}
+	if apiCategory.FriendUserIDList == nil {
+		apiCategory.FriendUserIDList = []string{}
+	}
+	return apiCategory
+}
+
+// @Summary 创建或重命名好友分组
+// @Description 创建好友分组，categoryID不为空时重命名该分组，变更同步到用户的其他设备
+// @Tags 好友相关
+// @ID SetFriendCategory
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetFriendCategoryReq true "fromUserID为分组所属用户<br>categoryID为空时创建分组<br>name为分组名，最长64个字符，不能重名"
+// @Produce json
+// @Success 0 {object} api.SetFriendCategoryResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/set_category [post]
+func SetFriendCategory(c *gin.Context) {
+	params := api.SetFriendCategoryReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.SetFriendCategoryReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID}}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	req.CategoryID, req.Name = params.CategoryID, params.Name
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetFriendCategory(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetFriendCategoryResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}, Category: friendCategoryPbCopyApi(respPb.Category)}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 删除好友分组
+// @Description 删除好友分组，分组中的好友不会被删除
+// @Tags 好友相关
+// @ID DeleteFriendCategory
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.DeleteFriendCategoryReq true "fromUserID为分组所属用户"
+// @Produce json
+// @Success 0 {object} api.DeleteFriendCategoryResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/delete_category [post]
+func DeleteFriendCategory(c *gin.Context) {
+	params := api.DeleteFriendCategoryReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.DeleteFriendCategoryReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID}}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	req.CategoryID = params.CategoryID
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.DeleteFriendCategory(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.DeleteFriendCategoryResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 设置好友所在分组
+// @Description 用categoryIDList替换好友所在的分组，一个好友可以在多个分组中
+// @Tags 好友相关
+// @ID SetFriendCategories
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetFriendCategoriesReq true "fromUserID为设置的用户<br>toUserID为好友<br>categoryIDList为空时把好友移出所有分组"
+// @Produce json
+// @Success 0 {object} api.SetFriendCategoriesResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/set_friend_categories [post]
+func SetFriendCategories(c *gin.Context) {
+	params := api.SetFriendCategoriesReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.SetFriendCategoriesReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID, ToUserID: params.ToUserID}}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	req.CategoryIDList = params.CategoryIDList
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetFriendCategories(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetFriendCategoriesResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 设置星标好友
+// @Description 设置或取消星标好友
+// @Tags 好友相关
+// @ID SetFriendStarred
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetFriendStarredReq true "fromUserID为设置的用户<br>toUserID为好友<br>starred为true时设为星标"
+// @Produce json
+// @Success 0 {object} api.SetFriendStarredResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/set_friend_starred [post]
+func SetFriendStarred(c *gin.Context) {
+	params := api.SetFriendStarredReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.SetFriendStarredReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID, ToUserID: params.ToUserID}}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	req.Starred = params.Starred
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetFriendStarred(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetFriendStarredResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 获取好友分组和星标好友
+// @Description 获取用户的所有好友分组及其中的好友，以及星标好友列表，用于多端同步
+// @Tags 好友相关
+// @ID GetFriendCategories
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetFriendCategoriesReq true "fromUserID为要获取的用户"
+// @Produce json
+// @Success 0 {object} api.GetFriendCategoriesResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/get_categories [post]
+func GetFriendCategories(c *gin.Context) {
+	params := api.GetFriendCategoriesReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.GetFriendCategoriesReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID}}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.GetFriendCategories(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.GetFriendCategoriesResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	resp.Data.Categories = []*api.FriendCategory{}
+	for _, v := range respPb.Categories {
+		resp.Data.Categories = append(resp.Data.Categories, friendCategoryPbCopyApi(v))
+	}
+	resp.Data.StarredFriendUserIDList = respPb.StarredFriendUserIDList
+	if resp.Data.StarredFriendUserIDList == nil {
+		resp.Data.StarredFriendUserIDList = []string{}
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", len(resp.Data.Categories), len(resp.Data.StarredFriendUserIDList))
+	c.JSON(http.StatusOK, resp)
+}
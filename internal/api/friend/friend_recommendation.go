@@ -0,0 +1,106 @@
+package friend
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/log"
+	rpc "Open_IM/pkg/proto/friend"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 获取好友推荐
+// @Description 按共同好友数、共同群数和是否同部门推荐可能认识的人，不包含好友、黑名单、有未处理好友申请以及关闭了被推荐的用户，结果由后台定期计算，首次获取时可能为空
+// @Tags 好友相关
+// @ID GetFriendRecommendations
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetFriendRecommendationsReq true "fromUserID为获取推荐的用户<br>count为返回的最大人数，0返回全部"
+// @Produce json
+// @Success 0 {object} api.GetFriendRecommendationsResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/get_recommendations [post]
+func GetFriendRecommendations(c *gin.Context) {
+	params := api.GetFriendRecommendationsReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.GetFriendRecommendationsReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID}, Count: params.Count}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", params)
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.GetFriendRecommendations(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.GetFriendRecommendationsResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}, Data: []*api.FriendRecommendation{}}
+	for _, v := range respPb.RecommendationList {
+		recommendation := &api.FriendRecommendation{UserID: v.UserID, MutualFriendNum: v.MutualFriendNum, SharedGroupNum: v.SharedGroupNum,
+			SameDepartment: v.SameDepartment, Score: v.Score}
+		if v.PublicUserInfo != nil {
+			recommendation.Nickname, recommendation.FaceURL, recommendation.Gender = v.PublicUserInfo.Nickname, v.PublicUserInfo.FaceURL, v.PublicUserInfo.Gender
+		}
+		resp.Data = append(resp.Data, recommendation)
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp.ErrCode, len(resp.Data))
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 设置是否被推荐
+// @Description 设置自己是否出现在其他用户的好友推荐中
+// @Tags 好友相关
+// @ID SetFriendRecommendOpt
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetFriendRecommendOptReq true "recommendOpt 0允许被推荐 1不被推荐"
+// @Produce json
+// @Success 0 {object} api.SetFriendRecommendOptResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /friend/set_recommend_opt [post]
+func SetFriendRecommendOpt(c *gin.Context) {
+	params := api.SetFriendRecommendOptReq{}
+	if err := c.BindJSON(&params); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	req := &rpc.SetFriendRecommendOptReq{CommID: &rpc.CommID{OperationID: params.OperationID, FromUserID: params.FromUserID}, RecommendOpt: *params.RecommendOpt}
+	var ok bool
+	if req.CommID.OpUserID, ok = opUserIDFromToken(c, req.CommID.OperationID); !ok {
+		return
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api args ", req.String())
+	client := friendClient(req.CommID.OperationID)
+	if client == nil {
+		errMsg := req.CommID.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.CommID.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetFriendRecommendOpt(context.Background(), req)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetFriendRecommendOptResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
@@ -718,7 +718,7 @@ func ApplicationGroupResponse(c *gin.Context) {
 // @ID JoinGroup
 // @Accept json
 // @Param token header string true "im token"
-// @Param req body api.JoinGroupReq true "reqMessage为申请进群信息<br>groupID为申请的群ID"
+// @Param req body api.JoinGroupReq true "reqMessage为申请进群信息<br>groupID为申请的群ID<br>inviteToken为群邀请链接token，携带时groupID可为空<br>joinAnswers为入群问题的回答，群设置了问题且需要验证时必须回答全部问题"
 // @Produce json
 // @Success 0 {object} api.JoinGroupResp
 // @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
@@ -824,7 +824,7 @@ func QuitGroup(c *gin.Context) {
 // @ID SetGroupInfo
 // @Accept json
 // @Param token header string true "im token"
-// @Param req body api.SetGroupInfoReq true "groupID为要修改的群ID<br>groupName为新的群名称<br>notification为群介绍 <br> introduction为群公告 <br> needVerification为加群验证 0为申请需要同意 邀请直接进 1为所有人进群需要验证，除了群主管理员邀请进群 2为直接进群"
+// @Param req body api.SetGroupInfoReq true "groupID为要修改的群ID<br>groupName为新的群名称<br>notification为群介绍 <br> introduction为群公告 <br> needVerification为加群验证 0为申请需要同意 邀请直接进 1为所有人进群需要验证，除了群主管理员邀请进群 2为直接进群 <br> slowModeSeconds为慢速模式间隔秒数 0为关闭 <br> dailyMsgQuota为每个成员每日发言条数 0为不限制"
 // @Produce json
 // @Success 0 {object} api.SetGroupInfoResp
 // @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
@@ -884,6 +884,14 @@ func argsHandle(params *api.SetGroupInfoReq, req *rpc.SetGroupInfoReq) {
 		req.GroupInfoForSet.ApplyMemberFriend = &wrappers.Int32Value{Value: *params.ApplyMemberFriend}
 		log.NewInfo(req.OperationID, "ApplyMemberFriend ", req.GroupInfoForSet.ApplyMemberFriend)
 	}
+	if params.SlowModeSeconds != nil {
+		req.GroupInfoForSet.SlowModeSeconds = &wrappers.Int32Value{Value: *params.SlowModeSeconds}
+		log.NewInfo(req.OperationID, "SlowModeSeconds ", req.GroupInfoForSet.SlowModeSeconds)
+	}
+	if params.DailyMsgQuota != nil {
+		req.GroupInfoForSet.DailyMsgQuota = &wrappers.Int32Value{Value: *params.DailyMsgQuota}
+		log.NewInfo(req.OperationID, "DailyMsgQuota ", req.GroupInfoForSet.DailyMsgQuota)
+	}
 }
 
 // @Summary 转让群主
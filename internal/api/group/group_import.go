@@ -0,0 +1,144 @@
+package group
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	rpc "Open_IM/pkg/proto/group"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func groupImportJobPbCopyApi(job *rpc.GroupImportJob) *api.GroupImportJob {
+	if job == nil {
+		return nil
+	}
+	apiJob := &api.GroupImportJob{JobID: job.JobID, IdempotencyKey: job.IdempotencyKey, Status: job.Status, Silent: job.Silent, Total: job.Total,
+		Succeeded: job.Succeeded, Failed: job.Failed, Results: []*api.ImportGroupResult{}, OpUserID: job.OpUserID, CreateTime: job.CreateTime, UpdateTime: job.UpdateTime}
+	for _, v := range job.Results {
+		apiJob.Results = append(apiJob.Results, &api.ImportGroupResult{GroupID: v.GroupID, ErrCode: v.ErrCode, ErrMsg: v.ErrMsg})
+	}
+	return apiJob
+}
+
+// @Summary 批量导入群
+// @Description 管理员批量创建群及其成员和角色，每个群在一个事务中创建；不超过20个群时同步完成，否则后台执行，通过get_import_job查询进度
+// @Tags 群组相关
+// @ID ImportGroups
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.ImportGroupsReq true "groupList最多1000个群，每个群最多10000个成员，所有群的成员合计最多100000个，只能导入普通群和工作群<br>groupID为空时自动生成<br>memberList中roleLevel 1普通成员 3管理员，roleID为roles中的自定义角色<br>silent为true时不给成员发送通知<br>idempotencyKey相同的重试直接返回第一次的任务"
+// @Produce json
+// @Success 0 {object} api.ImportGroupsResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/import_groups [post]
+func ImportGroups(c *gin.Context) {
+	var req api.ImportGroupsReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req.IdempotencyKey, req.Silent, len(req.GroupList))
+	memberNum := 0
+	for _, v := range req.GroupList {
+		memberNum += len(v.MemberList)
+	}
+	if len(req.GroupList) > constant.GroupImportMaxGroups || memberNum > constant.GroupImportMaxTotalMembers {
+		errMsg := req.OperationID + " too many groups or members in one import"
+		log.NewError(req.OperationID, errMsg, len(req.GroupList), memberNum)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": errMsg})
+		return
+	}
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	reqPb := &rpc.ImportGroupsReq{Silent: req.Silent, IdempotencyKey: req.IdempotencyKey, OpUserID: opUserID, OperationID: req.OperationID}
+	for _, v := range req.GroupList {
+		group := &rpc.ImportGroup{GroupInfo: &open_im_sdk.GroupInfo{}, OwnerUserID: v.OwnerUserID}
+		utils.CopyStructFields(group.GroupInfo, v)
+		for _, member := range v.MemberList {
+			group.Members = append(group.Members, &rpc.ImportGroupMember{UserID: member.UserID, RoleLevel: member.RoleLevel, RoleID: member.RoleID})
+		}
+		for _, role := range v.Roles {
+			group.Roles = append(group.Roles, &rpc.GroupRole{GroupID: v.GroupID, RoleID: role.RoleID, Name: role.Name, Capabilities: role.Capabilities})
+		}
+		reqPb.Groups = append(reqPb.Groups, group)
+	}
+	respPb, err := client.ImportGroups(context.Background(), reqPb)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.ImportGroupsResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}, Job: groupImportJobPbCopyApi(respPb.Job)}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp.ErrCode, resp.ErrMsg)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 查询批量导入群任务
+// @Description 按jobID或idempotencyKey查询批量导入群任务的进度和每个群的结果
+// @Tags 群组相关
+// @ID GetGroupImportJob
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetGroupImportJobReq true "jobID为空时按idempotencyKey查询"
+// @Produce json
+// @Success 0 {object} api.GetGroupImportJobResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/get_import_job [post]
+func GetGroupImportJob(c *gin.Context) {
+	var req api.GetGroupImportJobReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	if req.JobID == "" && req.IdempotencyKey == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "jobID or idempotencyKey is required"})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	reqPb := &rpc.GetGroupImportJobReq{JobID: req.JobID, IdempotencyKey: req.IdempotencyKey, OpUserID: opUserID, OperationID: req.OperationID}
+	respPb, err := client.GetGroupImportJob(context.Background(), reqPb)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.GetGroupImportJobResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}, Job: groupImportJobPbCopyApi(respPb.Job)}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp.ErrCode, resp.ErrMsg)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,211 @@
+package group
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	rpc "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 设置群角色
+// @Description 群主创建自定义角色或修改角色的权限，admin和member为内置角色，owner拥有全部权限不可修改
+// @Tags 群组相关
+// @ID SetGroupRole
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetGroupRoleReq true "capabilities为权限位：1发消息 2发图片语音视频文件 4@所有人 8邀请 16置顶群公告 32修改群资料 64踢人 128禁言"
+// @Produce json
+// @Success 0 {object} api.SetGroupRoleResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/set_group_role [post]
+func SetGroupRole(c *gin.Context) {
+	var req api.SetGroupRoleReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetGroupRole(context.Background(), &rpc.SetGroupRoleReq{GroupID: req.GroupID, RoleID: req.RoleID, Name: req.Name,
+		Capabilities: req.Capabilities, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetGroupRoleResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 删除群角色
+// @Description 群主删除自定义角色，该角色的成员恢复为普通成员；删除admin或member则恢复默认权限
+// @Tags 群组相关
+// @ID DeleteGroupRole
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.DeleteGroupRoleReq true "roleID为要删除的角色ID"
+// @Produce json
+// @Success 0 {object} api.DeleteGroupRoleResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/delete_group_role [post]
+func DeleteGroupRole(c *gin.Context) {
+	var req api.DeleteGroupRoleReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.DeleteGroupRole(context.Background(), &rpc.DeleteGroupRoleReq{GroupID: req.GroupID, RoleID: req.RoleID,
+		OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.DeleteGroupRoleResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 获取群角色
+// @Description 群成员获取群的内置角色和自定义角色及其当前权限
+// @Tags 群组相关
+// @ID GetGroupRoles
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetGroupRolesReq true "groupID为群ID"
+// @Produce json
+// @Success 0 {object} api.GetGroupRolesResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/get_group_roles [post]
+func GetGroupRoles(c *gin.Context) {
+	var (
+		req  api.GetGroupRolesReq
+		resp api.GetGroupRolesResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.GetGroupRoles(context.Background(), &rpc.GetGroupRolesReq{GroupID: req.GroupID, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.ErrCode, resp.ErrMsg = respPb.CommonResp.ErrCode, respPb.CommonResp.ErrMsg
+	resp.Roles = []*api.GroupRole{}
+	for _, role := range respPb.Roles {
+		resp.Roles = append(resp.Roles, &api.GroupRole{GroupID: role.GroupID, RoleID: role.RoleID, Name: role.Name,
+			Capabilities: role.Capabilities, Builtin: role.Builtin, UpdateTime: role.UpdateTime})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", len(resp.Roles))
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 设置群成员角色
+// @Description 群主为普通成员分配自定义角色，roleID为空或member时恢复为普通成员
+// @Tags 群组相关
+// @ID SetGroupMemberRole
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetGroupMemberRoleReq true "userIDList为成员ID列表<br>roleID为自定义角色ID"
+// @Produce json
+// @Success 0 {object} api.SetGroupMemberRoleResp "result为0表示成功，-1表示失败"
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/set_group_member_role [post]
+func SetGroupMemberRole(c *gin.Context) {
+	var (
+		req  api.SetGroupMemberRoleReq
+		resp api.SetGroupMemberRoleResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.SetGroupMemberRole(context.Background(), &rpc.SetGroupMemberRoleReq{GroupID: req.GroupID, UserIDList: req.UserIDList,
+		RoleID: req.RoleID, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.ErrCode, resp.ErrMsg = respPb.CommonResp.ErrCode, respPb.CommonResp.ErrMsg
+	resp.UserIDResultList = []*api.UserIDResult{}
+	for _, v := range respPb.Id2ResultList {
+		resp.UserIDResultList = append(resp.UserIDResultList, &api.UserIDResult{UserID: v.UserID, Result: v.Result})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,179 @@
+package group
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	rpc "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toInviteLink(link *rpc.GroupInviteLink) *api.GroupInviteLink {
+	return &api.GroupInviteLink{Token: link.Token, GroupID: link.GroupID, CreatorUserID: link.CreatorUserID, ExpireTime: link.ExpireTime,
+		MaxUses: link.MaxUses, UseCount: link.UseCount, AutoApprove: link.AutoApprove, Status: link.Status, CreateTime: link.CreateTime}
+}
+
+func groupClient(operationID string) rpc.GroupClient {
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImGroupName, operationID)
+	if etcdConn == nil {
+		return nil
+	}
+	return rpc.NewGroupClient(etcdConn)
+}
+
+// @Summary 创建群邀请链接
+// @Description 群主或管理员创建邀请链接或二维码token，可设置有效期、最大使用次数以及是否免审核入群
+// @Tags 群组相关
+// @ID CreateGroupInviteLink
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.CreateGroupInviteLinkReq true "expireSeconds为有效秒数，0为永久<br>maxUses为最大使用次数，0为不限<br>autoApprove为true时通过链接入群无需审核"
+// @Produce json
+// @Success 0 {object} api.CreateGroupInviteLinkResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/create_invite_link [post]
+func CreateGroupInviteLink(c *gin.Context) {
+	var (
+		req  api.CreateGroupInviteLinkReq
+		resp api.CreateGroupInviteLinkResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.CreateGroupInviteLink(context.Background(), &rpc.CreateGroupInviteLinkReq{GroupID: req.GroupID, ExpireSeconds: req.ExpireSeconds,
+		MaxUses: req.MaxUses, AutoApprove: req.AutoApprove, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.ErrCode, resp.ErrMsg = respPb.CommonResp.ErrCode, respPb.CommonResp.ErrMsg
+	if respPb.Link != nil {
+		resp.Link = toInviteLink(respPb.Link)
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 获取群邀请链接
+// @Description 群主或管理员获取群的全部邀请链接，包含已撤销和已过期的
+// @Tags 群组相关
+// @ID GetGroupInviteLinks
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetGroupInviteLinksReq true "groupID为群ID"
+// @Produce json
+// @Success 0 {object} api.GetGroupInviteLinksResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/get_invite_links [post]
+func GetGroupInviteLinks(c *gin.Context) {
+	var (
+		req  api.GetGroupInviteLinksReq
+		resp api.GetGroupInviteLinksResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.GetGroupInviteLinks(context.Background(), &rpc.GetGroupInviteLinksReq{GroupID: req.GroupID, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.ErrCode, resp.ErrMsg = respPb.CommonResp.ErrCode, respPb.CommonResp.ErrMsg
+	resp.Links = []*api.GroupInviteLink{}
+	for _, link := range respPb.Links {
+		resp.Links = append(resp.Links, toInviteLink(link))
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", len(resp.Links))
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 撤销群邀请链接
+// @Description 群主或管理员撤销邀请链接，撤销后无法再通过该链接入群
+// @Tags 群组相关
+// @ID RevokeGroupInviteLink
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.RevokeGroupInviteLinkReq true "token为要撤销的邀请链接token"
+// @Produce json
+// @Success 0 {object} api.RevokeGroupInviteLinkResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/revoke_invite_link [post]
+func RevokeGroupInviteLink(c *gin.Context) {
+	var req api.RevokeGroupInviteLinkReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.RevokeGroupInviteLink(context.Background(), &rpc.RevokeGroupInviteLinkReq{GroupID: req.GroupID, Token: req.Token,
+		OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.RevokeGroupInviteLinkResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
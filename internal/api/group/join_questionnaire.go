@@ -0,0 +1,121 @@
+package group
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	rpc "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 设置入群问题和自动审核规则
+// @Description 群主或管理员设置入群问题和自动审核规则，整体替换原有设置；拒绝规则优先于通过规则，均未命中时等待管理员审核
+// @Tags 群组相关
+// @ID SetGroupJoinQuestionnaire
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.SetGroupJoinQuestionnaireReq true "questions为入群问题，answer为标准答案，为空时不校验<br>rules为规则，ruleType 1部门(value为部门ID，含子部门) 2为群主或管理员的好友 3问题全部答对 4指定用户(value为用户ID)<br>action 1自动通过 2自动拒绝"
+// @Produce json
+// @Success 0 {object} api.SetGroupJoinQuestionnaireResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/set_join_questionnaire [post]
+func SetGroupJoinQuestionnaire(c *gin.Context) {
+	var req api.SetGroupJoinQuestionnaireReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	reqPb := &rpc.SetGroupJoinQuestionnaireReq{GroupID: req.GroupID, OpUserID: opUserID, OperationID: req.OperationID}
+	for _, v := range req.Questions {
+		reqPb.Questions = append(reqPb.Questions, &rpc.GroupJoinQuestion{QuestionID: v.QuestionID, Question: v.Question, Answer: v.Answer})
+	}
+	for _, v := range req.Rules {
+		reqPb.Rules = append(reqPb.Rules, &rpc.GroupJoinRule{RuleID: v.RuleID, RuleType: v.RuleType, Action: v.Action, Value: v.Value})
+	}
+	respPb, err := client.SetGroupJoinQuestionnaire(context.Background(), reqPb)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.SetGroupJoinQuestionnaireResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", resp)
+	c.JSON(http.StatusOK, resp)
+}
+
+// @Summary 获取入群问题和自动审核规则
+// @Description 申请者只能看到问题，群主和管理员还能看到标准答案和规则
+// @Tags 群组相关
+// @ID GetGroupJoinQuestionnaire
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.GetGroupJoinQuestionnaireReq true "groupID为群ID"
+// @Produce json
+// @Success 0 {object} api.GetGroupJoinQuestionnaireResp
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/get_join_questionnaire [post]
+func GetGroupJoinQuestionnaire(c *gin.Context) {
+	var (
+		req  api.GetGroupJoinQuestionnaireReq
+		resp api.GetGroupJoinQuestionnaireResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	respPb, err := client.GetGroupJoinQuestionnaire(context.Background(), &rpc.GetGroupJoinQuestionnaireReq{GroupID: req.GroupID, OpUserID: opUserID, OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.ErrCode, resp.ErrMsg = respPb.CommonResp.ErrCode, respPb.CommonResp.ErrMsg
+	resp.Questionnaire.Questions = []*api.GroupJoinQuestion{}
+	for _, v := range respPb.Questions {
+		resp.Questionnaire.Questions = append(resp.Questionnaire.Questions, &api.GroupJoinQuestion{QuestionID: v.QuestionID, Question: v.Question, Answer: v.Answer})
+	}
+	resp.Questionnaire.Rules = []*api.GroupJoinRule{}
+	for _, v := range respPb.Rules {
+		resp.Questionnaire.Rules = append(resp.Questionnaire.Rules, &api.GroupJoinRule{RuleID: v.RuleID, RuleType: v.RuleType, Action: v.Action, Value: v.Value})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", len(resp.Questionnaire.Questions), len(resp.Questionnaire.Rules))
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,67 @@
+package group
+
+import (
+	jsonData "Open_IM/internal/utils"
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	rpc "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// @Summary 搜索和筛选群成员
+// @Description 按昵称、群昵称或用户ID搜索群成员，可按角色、禁言状态和入群时间筛选，按入群时间游标分页；第一页同时返回各角色人数
+// @Tags 群组相关
+// @ID QueryGroupMembers
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.QueryGroupMembersReq true "keyword为搜索关键字<br>roles为角色，owner群主 admin管理员 member普通成员，或自定义角色ID<br>muteStatus 0不限 1禁言中 2未禁言<br>joinTimeBegin和joinTimeEnd为入群时间范围，单位秒<br>cursor为上一页返回的nextCursor，第一页为空<br>count为每页数量，默认100，最大1000"
+// @Produce json
+// @Success 0 {object} api.QueryGroupMembersResp{data=object{members=[]open_im_sdk.GroupMemberFullInfo}}
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /group/query_group_members [post]
+func QueryGroupMembers(c *gin.Context) {
+	var req api.QueryGroupMembersReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ok, opUserID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api args ", req)
+	client := groupClient(req.OperationID)
+	if client == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	reqPb := &rpc.QueryGroupMembersReq{GroupID: req.GroupID, Keyword: req.Keyword, Roles: req.Roles, MuteStatus: req.MuteStatus, JoinTimeBegin: req.JoinTimeBegin,
+		JoinTimeEnd: req.JoinTimeEnd, Cursor: req.Cursor, Count: req.Count, OpUserID: opUserID, OperationID: req.OperationID}
+	respPb, err := client.QueryGroupMembers(context.Background(), reqPb)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), " failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp := api.QueryGroupMembersResp{CommResp: api.CommResp{ErrCode: respPb.CommonResp.ErrCode, ErrMsg: respPb.CommonResp.ErrMsg}, MemberList: respPb.Members}
+	resp.Data.Members = jsonData.JsonDataList(resp.MemberList)
+	resp.Data.NextCursor = respPb.NextCursor
+	resp.Data.RoleCounts = []*api.GroupRoleMemberCount{}
+	for _, v := range respPb.RoleCounts {
+		resp.Data.RoleCounts = append(resp.Data.RoleCounts, &api.GroupRoleMemberCount{Role: v.Role, Count: v.Count})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " api return ", len(resp.MemberList), resp.Data.NextCursor)
+	c.JSON(http.StatusOK, resp)
+}
@@ -97,6 +97,7 @@ func SendMsg(c *gin.Context) {
 			"clientMsgID": reply.ClientMsgID,
 			"serverMsgID": reply.ServerMsgID,
 			"sendTime":    reply.SendTime,
+			"ex":          reply.Ex,
 		},
 	})
 
@@ -0,0 +1,510 @@
+package apiThird
+
+import (
+	api "Open_IM/pkg/base_info"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/utils"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/minio/minio-go/v7"
+)
+
+const (
+	minioMinPartSize = 5 * 1024 * 1024
+	minioMaxPartNum  = 10000
+)
+
+func getMinioPartSize() int64 {
+	partSize := config.Config.Credential.Minio.Multipart.PartSize
+	if partSize < minioMinPartSize {
+		partSize = minioMinPartSize
+	}
+	return partSize
+}
+
+func getMinioObjectURL(objectName string) string {
+	return config.Config.Credential.Minio.Endpoint + "/" + config.Config.Credential.Minio.Bucket + "/" + objectName
+}
+
+// getMultipartUpload returns the upload session if it belongs to userID
+func getMultipartUpload(uploadID, userID string) (*db.MultipartUpload, error) {
+	upload, err := db.DB.GetMultipartUpload(uploadID)
+	if err != nil {
+		return nil, errors.New("upload not exist or expired")
+	}
+	if upload.UserID != userID {
+		return nil, errors.New("upload not belong to user")
+	}
+	return upload, nil
+}
+
+func listMinioParts(ctx context.Context, upload *db.MultipartUpload) ([]minio.ObjectPart, error) {
+	core := minio.Core{Client: MinioClient}
+	var parts []minio.ObjectPart
+	partNumberMarker := 0
+	for {
+		result, err := core.ListObjectParts(ctx, config.Config.Credential.Minio.Bucket, upload.ObjectName, upload.UploadID, partNumberMarker, minioMaxPartNum)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, result.ObjectParts...)
+		if !result.IsTruncated {
+			return parts, nil
+		}
+		partNumberMarker = result.NextPartNumberMarker
+	}
+}
+
+func getMinioObjectSha256(ctx context.Context, objectName string) (string, error) {
+	object, err := MinioClient.GetObject(ctx, config.Config.Credential.Minio.Bucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer object.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, object); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// copyMinioObjectIfNotExist copies srcName to dstName on the server, an existing dstName is left untouched
+func copyMinioObjectIfNotExist(ctx context.Context, srcName, dstName, contentType string) error {
+	bucket := config.Config.Credential.Minio.Bucket
+	_, err := MinioClient.StatObject(ctx, bucket, dstName, minio.StatObjectOptions{})
+	if err == nil {
+		return nil
+	}
+	if minio.ToErrorResponse(err).Code != "NoSuchKey" {
+		return err
+	}
+	// compose copies objects over the 5GB limit of a single copy in parts
+	_, err = MinioClient.ComposeObject(ctx, minio.CopyDestOptions{Bucket: bucket, Object: dstName, ReplaceMetadata: true, UserMetadata: map[string]string{"Content-Type": contentType}},
+		minio.CopySrcOptions{Bucket: bucket, Object: srcName})
+	return err
+}
+
+// @Summary minio初始化分片上传
+// @Description 按文件sha256去重，当前用户上传过的文件直接返回地址(秒传)，否则返回uploadID和分片信息
+// @Tags 第三方服务相关
+// @ID MinioInitiateMultipartUpload
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.MinioInitiateMultipartUploadReq true "请求体"
+// @Produce json
+// @Success 0 {object} api.MinioInitiateMultipartUploadResp ""
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /third/minio_multipart/initiate [post]
+func MinioInitiateMultipartUpload(c *gin.Context) {
+	var (
+		req  api.MinioInitiateMultipartUploadReq
+		resp api.MinioInitiateMultipartUpload
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), req)
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	req.Hash = strings.ToLower(req.Hash)
+	if !utils.IsSha256Hex(req.Hash) {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "hash must be hex encoded sha256"})
+		return
+	}
+	multipartConfig := config.Config.Credential.Minio.Multipart
+	if multipartConfig.MaxFileSize > 0 && req.Size > multipartConfig.MaxFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "file too large"})
+		return
+	}
+	if multipartConfig.UserQuota > 0 {
+		usedSize, err := imdb.GetUserUploadSize(userID)
+		if err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetUserUploadSize failed", err.Error(), userID)
+			c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+			return
+		}
+		if usedSize+req.Size > multipartConfig.UserQuota {
+			log.NewWarn(req.OperationID, utils.GetSelfFuncName(), "upload quota exceeded", userID, usedSize, req.Size)
+			c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "upload quota exceeded"})
+			return
+		}
+	}
+	// knowing a hash is no proof of having the file, only content the user uploaded before is reused
+	uploaded, err := imdb.UserUploadedHash(userID, req.Hash)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "UserUploadedHash failed", err.Error(), userID, req.Hash)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	object, err := imdb.GetUploadObjectByHash(req.Hash)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetUploadObjectByHash failed", err.Error(), req.Hash)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	if uploaded && object.Hash != "" && object.Size == req.Size {
+		record := db.UploadRecord{UserID: userID, Hash: object.Hash, FileName: req.FileName, Size: req.Size, ClientMsgID: req.ClientMsgID}
+		if err := imdb.InsertUploadRecord(record, multipartConfig.UserQuota); err != nil {
+			if err == imdb.ErrUploadQuotaExceeded {
+				c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+				return
+			}
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "InsertUploadRecord failed", err.Error(), record)
+			c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+			return
+		}
+		resp.Exist = true
+		resp.NewName = object.ObjectName
+		resp.URL = getMinioObjectURL(object.ObjectName)
+		log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "file exist, skip upload", resp)
+		c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+		return
+	}
+	partSize := getMinioPartSize()
+	partNum := utils.GetPartNum(req.Size, partSize)
+	if partNum > minioMaxPartNum {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "too many parts, file too large"})
+		return
+	}
+	upload := &db.MultipartUpload{
+		UserID:      userID,
+		Hash:        req.Hash,
+		ObjectName:  utils.GetStagingObjectName(),
+		FileName:    req.FileName,
+		ContentType: mime.TypeByExtension(path.Ext(req.FileName)),
+		Size:        req.Size,
+		PartSize:    partSize,
+		PartNum:     partNum,
+		ClientMsgID: req.ClientMsgID,
+	}
+	core := minio.Core{Client: MinioClient}
+	upload.UploadID, err = core.NewMultipartUpload(context.Background(), config.Config.Credential.Minio.Bucket, upload.ObjectName, minio.PutObjectOptions{ContentType: upload.ContentType})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "NewMultipartUpload failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	// minio removes stale multipart uploads itself, the session only needs to outlive the client retries
+	if err := db.DB.SetMultipartUpload(upload, time.Duration(multipartConfig.UploadExpire)*time.Second); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "SetMultipartUpload failed", err.Error(), upload)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.UploadID = upload.UploadID
+	resp.PartSize = upload.PartSize
+	resp.PartNum = upload.PartNum
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// @Summary minio上传分片
+// @Description 上传单个分片，请注意本api请求为form并非json，除最后一片外分片大小必须等于partSize
+// @Tags 第三方服务相关
+// @ID MinioUploadPart
+// @Accept json
+// @Param token header string true "im token"
+// @Param file formData file true "分片数据"
+// @Param uploadID formData string true "初始化返回的uploadID"
+// @Param partNumber formData int true "分片序号，从1开始"
+// @Param operationID formData string true "操作唯一ID"
+// @Produce json
+// @Success 0 {object} api.MinioUploadPartResp ""
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /third/minio_multipart/upload_part [post]
+func MinioUploadPart(c *gin.Context) {
+	var (
+		req  api.MinioUploadPartReq
+		resp api.MinioUploadPart
+	)
+	if err := c.Bind(&req); err != nil {
+		log.NewError("0", utils.GetSelfFuncName(), "Bind failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), req)
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	upload, err := getMultipartUpload(req.UploadID, userID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error(), req.UploadID, userID)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	if req.PartNumber > upload.PartNum {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "invalid partNumber"})
+		return
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "FormFile failed", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "missing file arg: " + err.Error()})
+		return
+	}
+	expectSize := upload.PartSize
+	if req.PartNumber == upload.PartNum {
+		expectSize = upload.Size - upload.PartSize*int64(upload.PartNum-1)
+	}
+	if file.Size != expectSize {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "invalid part size"})
+		return
+	}
+	fileObj, err := file.Open()
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "Open file error", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	defer fileObj.Close()
+	core := minio.Core{Client: MinioClient}
+	part, err := core.PutObjectPart(context.Background(), config.Config.Credential.Minio.Bucket, upload.ObjectName, upload.UploadID, req.PartNumber, fileObj, file.Size, "", "", nil)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "PutObjectPart failed", err.Error(), upload.ObjectName, req.PartNumber)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.PartNumber = part.PartNumber
+	resp.ETag = part.ETag
+	resp.Size = part.Size
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// @Summary minio查询已上传分片
+// @Description 断点续传时查询已上传的分片，只需重传缺失的分片
+// @Tags 第三方服务相关
+// @ID MinioListParts
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.MinioListPartsReq true "请求体"
+// @Produce json
+// @Success 0 {object} api.MinioListPartsResp ""
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /third/minio_multipart/list_parts [post]
+func MinioListParts(c *gin.Context) {
+	var (
+		req  api.MinioListPartsReq
+		resp api.MinioListParts
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), req)
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	upload, err := getMultipartUpload(req.UploadID, userID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error(), req.UploadID, userID)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	parts, err := listMinioParts(context.Background(), upload)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "ListObjectParts failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.PartSize = upload.PartSize
+	resp.PartNum = upload.PartNum
+	resp.Parts = []*api.MinioUploadPart{}
+	for _, v := range parts {
+		resp.Parts = append(resp.Parts, &api.MinioUploadPart{PartNumber: v.PartNumber, ETag: v.ETag, Size: v.Size})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// @Summary minio完成分片上传
+// @Description 合并已上传的分片并校验文件sha256
+// @Tags 第三方服务相关
+// @ID MinioCompleteMultipartUpload
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.MinioCompleteMultipartUploadReq true "请求体"
+// @Produce json
+// @Success 0 {object} api.MinioCompleteMultipartUploadResp ""
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /third/minio_multipart/complete [post]
+func MinioCompleteMultipartUpload(c *gin.Context) {
+	var (
+		req  api.MinioCompleteMultipartUploadReq
+		resp api.MinioUploadFile
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), req)
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	upload, err := getMultipartUpload(req.UploadID, userID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error(), req.UploadID, userID)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	ctx := context.Background()
+	parts, err := listMinioParts(ctx, upload)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "ListObjectParts failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	if len(parts) != upload.PartNum {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "parts missing", len(parts), upload.PartNum)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "parts missing"})
+		return
+	}
+	completeParts := make([]minio.CompletePart, 0, len(parts))
+	for _, v := range parts {
+		completeParts = append(completeParts, minio.CompletePart{PartNumber: v.PartNumber, ETag: v.ETag})
+	}
+	core := minio.Core{Client: MinioClient}
+	if _, err := core.CompleteMultipartUpload(ctx, config.Config.Credential.Minio.Bucket, upload.ObjectName, upload.UploadID, completeParts, minio.PutObjectOptions{ContentType: upload.ContentType}); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "CompleteMultipartUpload failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	_ = db.DB.DelMultipartUpload(upload.UploadID)
+	// the parts are assembled in a staging object, only verified content is copied to the hash name
+	defer func() {
+		if err := MinioClient.RemoveObject(ctx, config.Config.Credential.Minio.Bucket, upload.ObjectName, minio.RemoveObjectOptions{}); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "remove staging object failed", err.Error(), upload.ObjectName)
+		}
+	}()
+	hash, err := getMinioObjectSha256(ctx, upload.ObjectName)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "getMinioObjectSha256 failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	if hash != upload.Hash {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "hash mismatch", hash, upload.Hash, upload.ObjectName)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": "hash mismatch"})
+		return
+	}
+	object, err := imdb.GetUploadObjectByHash(upload.Hash)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetUploadObjectByHash failed", err.Error(), upload.Hash)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	if object.Hash == "" {
+		hashObjectName := utils.GetHashObjectName(upload.Hash, upload.FileName)
+		if err := copyMinioObjectIfNotExist(ctx, upload.ObjectName, hashObjectName, upload.ContentType); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "copyMinioObjectIfNotExist failed", err.Error(), upload.ObjectName, hashObjectName)
+			c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+			return
+		}
+		object = db.UploadObject{Hash: upload.Hash, Bucket: config.Config.Credential.Minio.Bucket, ObjectName: hashObjectName, Size: upload.Size, ContentType: upload.ContentType}
+		// a concurrent upload of the same content may have inserted the object first, both keep the stored one
+		if object, err = imdb.InsertUploadObject(object); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "InsertUploadObject failed", err.Error(), object)
+			c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+			return
+		}
+	}
+	// the quota checked at initiate may have been used by uploads completed since
+	record := db.UploadRecord{UserID: userID, Hash: upload.Hash, FileName: upload.FileName, Size: upload.Size, ClientMsgID: upload.ClientMsgID}
+	if err := imdb.InsertUploadRecord(record, config.Config.Credential.Minio.Multipart.UserQuota); err != nil {
+		if err == imdb.ErrUploadQuotaExceeded {
+			log.NewWarn(req.OperationID, utils.GetSelfFuncName(), "upload quota exceeded", userID, upload.Size)
+			c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+			return
+		}
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "InsertUploadRecord failed", err.Error(), record)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.NewName = object.ObjectName
+	resp.URL = getMinioObjectURL(object.ObjectName)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// @Summary minio取消分片上传
+// @Description 取消分片上传并删除已上传的分片
+// @Tags 第三方服务相关
+// @ID MinioAbortMultipartUpload
+// @Accept json
+// @Param token header string true "im token"
+// @Param req body api.MinioAbortMultipartUploadReq true "请求体"
+// @Produce json
+// @Success 0 {object} api.MinioAbortMultipartUploadResp ""
+// @Failure 500 {object} api.Swagger500Resp "errCode为500 一般为服务器内部错误"
+// @Failure 400 {object} api.Swagger400Resp "errCode为400 一般为参数输入错误, token未带上等"
+// @Router /third/minio_multipart/abort [post]
+func MinioAbortMultipartUpload(c *gin.Context) {
+	var req api.MinioAbortMultipartUploadReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError("0", utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), req)
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), req.OperationID)
+	if !ok {
+		errMsg := req.OperationID + " " + "GetUserIDFromToken failed " + errInfo + " token:" + c.Request.Header.Get("token")
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	upload, err := getMultipartUpload(req.UploadID, userID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error(), req.UploadID, userID)
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	core := minio.Core{Client: MinioClient}
+	if err := core.AbortMultipartUpload(context.Background(), config.Config.Credential.Minio.Bucket, upload.ObjectName, upload.UploadID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "AbortMultipartUpload failed", err.Error(), upload.ObjectName)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	if err := db.DB.DelMultipartUpload(upload.UploadID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "DelMultipartUpload failed", err.Error(), upload.UploadID)
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": ""})
+}
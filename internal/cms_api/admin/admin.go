@@ -124,6 +124,9 @@ func AdminLogin(c *gin.Context) {
 	resp.FaceURL = respPb.FaceURL
 	resp.UserName = respPb.UserName
 	resp.Token = respPb.Token
+	if respPb.Token != "" {
+		resp.Role, resp.Permissions = getAdminPermissions(req.OperationID, req.AdminName)
+	}
 	c.JSON(http.StatusOK, gin.H{"errCode": respPb.CommonResp.ErrCode, "errMsg": respPb.CommonResp.ErrMsg, "data": resp})
 }
 
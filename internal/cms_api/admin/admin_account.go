@@ -0,0 +1,282 @@
+package admin
+
+import (
+	apiStruct "Open_IM/pkg/cms_api_struct"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func isValidAdminRole(role int32) bool {
+	_, ok := constant.AdminRolePermissions[role]
+	return ok
+}
+
+// checkKeepSuperAdmin refuses to demote, disable or delete the last super admin
+func checkKeepSuperAdmin(admin *db.AdminAccount) error {
+	if admin.Role != constant.AdminRoleSuperAdmin || admin.Status != constant.AdminStatusNormal {
+		return nil
+	}
+	count, err := imdb.GetSuperAdminCount()
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return constant.ErrAccess
+	}
+	return nil
+}
+
+func GetAdmins(c *gin.Context) {
+	var (
+		req  apiStruct.GetAdminsRequest
+		resp apiStruct.GetAdminsResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	admins, count, err := imdb.GetAdminAccounts(int32(req.ShowNumber), int32(req.PageNumber))
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccounts failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	resp.Admins = []*apiStruct.AdminAccountInfo{}
+	for _, v := range admins {
+		resp.Admins = append(resp.Admins, &apiStruct.AdminAccountInfo{AdminID: v.AdminID, Nickname: v.Nickname, FaceURL: v.FaceURL, Role: v.Role,
+			Status: v.Status, CreatorUserID: v.CreatorUserID, CreateTime: v.CreateTime.Unix()})
+	}
+	resp.AdminNums = int32(count)
+	resp.CurrentPage = req.PageNumber
+	resp.ShowNumber = req.ShowNumber
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp:", resp.AdminNums)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+func AddAdmin(c *gin.Context) {
+	var (
+		req  apiStruct.AddAdminRequest
+		resp apiStruct.AddAdminResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req.AdminID, req.Role)
+	if !isValidAdminRole(req.Role) {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "invalid role", req.AdminID, req.Role)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "invalid role"})
+		return
+	}
+	_, err := imdb.GetAdminAccount(req.AdminID)
+	if err == nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "admin already exists", req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "admin already exists"})
+		return
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	password, err := imdb.HashAdminPassword(req.Password)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "HashAdminPassword failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	admin := db.AdminAccount{AdminID: req.AdminID, Password: password, Nickname: req.Nickname, FaceURL: req.FaceURL, Role: req.Role,
+		Status: constant.AdminStatusNormal, CreatorUserID: c.GetString("userID")}
+	if err := imdb.InsertAdminAccount(admin); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "InsertAdminAccount failed", err.Error(), req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+func UpdateAdmin(c *gin.Context) {
+	var (
+		req  apiStruct.UpdateAdminRequest
+		resp apiStruct.UpdateAdminResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req.AdminID, req.Role, req.Status)
+	admin, err := imdb.GetAdminAccount(req.AdminID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), req.AdminID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "admin not exist"})
+		} else {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		}
+		return
+	}
+	args := make(map[string]interface{})
+	if req.Nickname != "" {
+		args["nickname"] = req.Nickname
+	}
+	if req.FaceURL != "" {
+		args["face_url"] = req.FaceURL
+	}
+	if req.Password != "" {
+		password, err := imdb.HashAdminPassword(req.Password)
+		if err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "HashAdminPassword failed", err.Error())
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+			return
+		}
+		args["password"] = password
+		imdb.RevokeAdminTokens(args)
+	}
+	demote := false
+	if req.Role != 0 && req.Role != admin.Role {
+		if !isValidAdminRole(req.Role) {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "invalid role", req.AdminID, req.Role)
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "invalid role"})
+			return
+		}
+		args["role"] = req.Role
+		demote = true
+	}
+	if req.Status != nil && *req.Status != admin.Status {
+		if *req.Status != constant.AdminStatusNormal && *req.Status != constant.AdminStatusDisabled {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "invalid status", req.AdminID, *req.Status)
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "invalid status"})
+			return
+		}
+		args["status"] = *req.Status
+		if *req.Status == constant.AdminStatusDisabled {
+			imdb.RevokeAdminTokens(args)
+			demote = true
+		}
+	}
+	if demote {
+		if err := checkKeepSuperAdmin(admin); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "checkKeepSuperAdmin failed", req.AdminID, err.Error())
+			if errors.Is(err, constant.ErrAccess) {
+				c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrAccess.ErrCode, "errMsg": "can not demote the last super admin"})
+			} else {
+				c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+			}
+			return
+		}
+	}
+	if len(args) == 0 {
+		c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+		return
+	}
+	if err := imdb.UpdateAdminAccount(req.AdminID, args); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "UpdateAdminAccount failed", err.Error(), req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+func DeleteAdmin(c *gin.Context) {
+	var (
+		req  apiStruct.DeleteAdminRequest
+		resp apiStruct.DeleteAdminResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req.AdminID)
+	if req.AdminID == c.GetString("userID") {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "can not delete yourself", req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "can not delete yourself"})
+		return
+	}
+	admin, err := imdb.GetAdminAccount(req.AdminID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), req.AdminID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "admin not exist"})
+		} else {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		}
+		return
+	}
+	if err := checkKeepSuperAdmin(admin); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "checkKeepSuperAdmin failed", req.AdminID, err.Error())
+		if errors.Is(err, constant.ErrAccess) {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrAccess.ErrCode, "errMsg": "can not delete the last super admin"})
+		} else {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		}
+		return
+	}
+	if err := imdb.DeleteAdminAccount(req.AdminID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "DeleteAdminAccount failed", err.Error(), req.AdminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+func ChangeAdminPassword(c *gin.Context) {
+	var (
+		req  apiStruct.ChangeAdminPasswordRequest
+		resp apiStruct.ChangeAdminPasswordResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	adminID := c.GetString("userID")
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", adminID)
+	admin, err := imdb.GetAdminAccount(adminID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), adminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if !imdb.CheckAdminPassword(admin, req.OldPassword) {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "old password error", adminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "old password error"})
+		return
+	}
+	password, err := imdb.HashAdminPassword(req.NewPassword)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "HashAdminPassword failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	args := map[string]interface{}{"password": password}
+	imdb.RevokeAdminTokens(args)
+	if err := imdb.UpdateAdminAccount(adminID, args); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "UpdateAdminAccount failed", err.Error(), adminID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// getAdminPermissions is returned on login so the cms can hide what the admin may not use
+func getAdminPermissions(operationID, adminID string) (int32, []string) {
+	admin, err := imdb.GetAdminAccount(adminID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), adminID)
+		return 0, []string{}
+	}
+	return admin.Role, constant.AdminRolePermissions[admin.Role]
+}
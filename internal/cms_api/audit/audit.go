@@ -0,0 +1,117 @@
+package audit
+
+import (
+	apiStruct "Open_IM/pkg/cms_api_struct"
+	"Open_IM/pkg/common/audit"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toFilter(filter apiStruct.AuditLogFilter) *imdb.AuditLogFilter {
+	f := &imdb.AuditLogFilter{Actor: filter.Actor, Action: filter.Action, Target: filter.Target}
+	if filter.StartTime > 0 {
+		f.StartTime = time.Unix(filter.StartTime, 0)
+	}
+	if filter.EndTime > 0 {
+		f.EndTime = time.Unix(filter.EndTime, 0)
+	}
+	return f
+}
+
+func toAuditLogInfo(auditLog *db.AuditLog) *apiStruct.AuditLogInfo {
+	return &apiStruct.AuditLogInfo{ID: auditLog.ID, Actor: auditLog.Actor, Action: auditLog.Action, Target: auditLog.Target,
+		Params: auditLog.Params, SourceIP: auditLog.SourceIP, OperationID: auditLog.OperationID, ErrCode: auditLog.ErrCode,
+		ErrMsg: auditLog.ErrMsg, CreateTime: auditLog.CreateTime.Unix(), PrevHash: auditLog.PrevHash, Hash: auditLog.Hash}
+}
+
+func GetAuditLogs(c *gin.Context) {
+	var (
+		req  apiStruct.GetAuditLogsRequest
+		resp apiStruct.GetAuditLogsResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	auditLogs, count, err := imdb.GetAuditLogs(toFilter(req.AuditLogFilter), int32(req.ShowNumber), int32(req.PageNumber))
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAuditLogs failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	resp.AuditLogs = []*apiStruct.AuditLogInfo{}
+	for i := range auditLogs {
+		resp.AuditLogs = append(resp.AuditLogs, toAuditLogInfo(&auditLogs[i]))
+	}
+	resp.AuditLogNums = int32(count)
+	resp.CurrentPage = req.PageNumber
+	resp.ShowNumber = req.ShowNumber
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp:", resp.AuditLogNums)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// ExportAuditLogs writes the matching entries as csv, oldest first
+func ExportAuditLogs(c *gin.Context) {
+	var req apiStruct.ExportAuditLogsRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	auditLogs, err := imdb.GetAuditLogsByFilter(toFilter(req.AuditLogFilter), config.Config.Audit.ExportMaxNum)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAuditLogsByFilter failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename=audit_logs_"+strconv.FormatInt(time.Now().Unix(), 10)+".csv")
+	c.Status(http.StatusOK)
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"id", "createTime", "actor", "action", "target", "params", "sourceIP", "operationID", "errCode", "errMsg", "prevHash", "hash"})
+	for _, v := range auditLogs {
+		_ = w.Write([]string{strconv.FormatInt(v.ID, 10), v.CreateTime.Format(time.RFC3339), v.Actor, v.Action, v.Target, v.Params,
+			v.SourceIP, v.OperationID, strconv.Itoa(int(v.ErrCode)), v.ErrMsg, v.PrevHash, v.Hash})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "write csv failed", err.Error())
+	}
+}
+
+func VerifyAuditLogs(c *gin.Context) {
+	var (
+		req  apiStruct.VerifyAuditLogsRequest
+		resp apiStruct.VerifyAuditLogsResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	checked, brokenID, err := audit.VerifyChain()
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "VerifyChain failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	resp.Checked, resp.BrokenID, resp.Intact = checked, brokenID, brokenID == 0
+	if !resp.Intact {
+		log.NewWarn(req.OperationID, utils.GetSelfFuncName(), "audit log chain broken at", brokenID)
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
@@ -0,0 +1,91 @@
+package callback
+
+import (
+	apiStruct "Open_IM/pkg/cms_api_struct"
+	commonCallback "Open_IM/pkg/common/callback"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func toCallbackDeliveryInfo(delivery *db.CallbackDelivery) *apiStruct.CallbackDeliveryInfo {
+	return &apiStruct.CallbackDeliveryInfo{DeliveryID: delivery.DeliveryID, Command: delivery.Command, Url: delivery.Url, Body: delivery.Body,
+		Async: delivery.Async, Status: delivery.Status, Attempts: delivery.Attempts, NextAttemptTime: delivery.NextAttemptTime.Unix(),
+		ResponseStatus: delivery.ResponseStatus, LastError: delivery.LastError, CostMs: delivery.CostMs,
+		CreateTime: delivery.CreateTime.Unix(), UpdateTime: delivery.UpdateTime.Unix()}
+}
+
+func GetCallbackDeliveries(c *gin.Context) {
+	var (
+		req  apiStruct.GetCallbackDeliveriesRequest
+		resp apiStruct.GetCallbackDeliveriesResponse
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	filter := &imdb.CallbackDeliveryFilter{Command: req.Command, Status: req.Status}
+	if req.StartTime > 0 {
+		filter.StartTime = time.Unix(req.StartTime, 0)
+	}
+	if req.EndTime > 0 {
+		filter.EndTime = time.Unix(req.EndTime, 0)
+	}
+	deliveries, count, err := imdb.GetCallbackDeliveries(filter, int32(req.ShowNumber), int32(req.PageNumber))
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetCallbackDeliveries failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	resp.Deliveries = []*apiStruct.CallbackDeliveryInfo{}
+	for _, v := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, toCallbackDeliveryInfo(v))
+	}
+	resp.DeliveryNums = int32(count)
+	resp.CurrentPage = req.PageNumber
+	resp.ShowNumber = req.ShowNumber
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp:", resp.DeliveryNums)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+// RedeliverCallback queues a finished delivery again, the worker sends it with the same delivery id
+func RedeliverCallback(c *gin.Context) {
+	var req apiStruct.RedeliverCallbackRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req:", req)
+	delivery, err := imdb.GetCallbackDelivery(req.DeliveryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "delivery not found"})
+		return
+	}
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetCallbackDelivery failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if delivery.Status == constant.CallbackDeliveryPending {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrArgs.ErrCode, "errMsg": "delivery is still queued"})
+		return
+	}
+	if err := imdb.RedeliverCallbackDelivery(req.DeliveryID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "RedeliverCallbackDelivery failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	commonCallback.WakeDeliveryWorker()
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": ""})
+}
@@ -0,0 +1,78 @@
+package group
+
+import (
+	"Open_IM/pkg/cms_api_struct"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+func toGroupConversionInfo(conversion *pbGroup.GroupConversion) *cms_api_struct.GroupConversionInfo {
+	if conversion == nil {
+		return nil
+	}
+	info := &cms_api_struct.GroupConversionInfo{}
+	utils.CopyStructFields(info, conversion)
+	return info
+}
+
+// ConvertGroupType moves a group between normal and super group storage in the background,
+// the app manager is the operator the members see in the notification
+func ConvertGroupType(c *gin.Context) {
+	var req cms_api_struct.ConvertGroupTypeRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req)
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImGroupName, req.OperationID)
+	if etcdConn == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	client := pbGroup.NewGroupClient(etcdConn)
+	respPb, err := client.ConvertGroupType(context.Background(), &pbGroup.ConvertGroupTypeReq{GroupID: req.GroupID, GroupType: req.GroupType,
+		HistoryCount: req.HistoryCount, OpUserID: config.Config.Manager.AppManagerUid[0], OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "ConvertGroupType failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": respPb.CommonResp.ErrCode, "errMsg": respPb.CommonResp.ErrMsg, "data": toGroupConversionInfo(respPb.Conversion)})
+}
+
+func GetGroupConversion(c *gin.Context) {
+	var req cms_api_struct.GetGroupConversionRequest
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req)
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImGroupName, req.OperationID)
+	if etcdConn == nil {
+		errMsg := req.OperationID + "getcdv3.GetDefaultConn == nil"
+		log.NewError(req.OperationID, errMsg)
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": errMsg})
+		return
+	}
+	client := pbGroup.NewGroupClient(etcdConn)
+	respPb, err := client.GetGroupConversion(context.Background(), &pbGroup.GetGroupConversionReq{GroupID: req.GroupID,
+		OpUserID: config.Config.Manager.AppManagerUid[0], OperationID: req.OperationID})
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetGroupConversion failed ", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": respPb.CommonResp.ErrCode, "errMsg": respPb.CommonResp.ErrMsg, "data": toGroupConversionInfo(respPb.Conversion)})
+}
@@ -0,0 +1,68 @@
+package messageCMS
+
+import (
+	"Open_IM/pkg/cms_api_struct"
+	"Open_IM/pkg/common/kafka"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func GetDeadLetters(c *gin.Context) {
+	var (
+		req  cms_api_struct.GetDeadLettersReq
+		resp cms_api_struct.GetDeadLettersResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req)
+	records, nextOffset, err := kafka.GetDeadLetters(req.Partition, req.Offset, req.ShowNumber)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetDeadLetters failed", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	resp.DeadLetters = []*cms_api_struct.DeadLetter{}
+	for _, v := range records {
+		resp.DeadLetters = append(resp.DeadLetters, &cms_api_struct.DeadLetter{
+			Partition:    v.Partition,
+			Offset:       v.Offset,
+			Topic:        v.DeadLetter.Topic,
+			SrcPartition: v.DeadLetter.Partition,
+			SrcOffset:    v.DeadLetter.Offset,
+			Key:          string(v.DeadLetter.Key),
+			Value:        v.DeadLetter.Value,
+			Handler:      v.DeadLetter.Handler,
+			ErrMsg:       v.DeadLetter.ErrMsg,
+			Attempt:      v.DeadLetter.Attempt,
+			CreateTime:   v.DeadLetter.CreateTime,
+		})
+		resp.NextOffset = v.Offset + 1
+	}
+	if len(records) == 0 {
+		resp.NextOffset = nextOffset
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", len(resp.DeadLetters), resp.NextOffset)
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": "", "data": resp})
+}
+
+func RedriveDeadLetter(c *gin.Context) {
+	var req cms_api_struct.RedriveDeadLetterReq
+	if err := c.BindJSON(&req); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "BindJSON failed ", err.Error())
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": 400, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req)
+	if _, err := kafka.RedriveDeadLetter(req.Partition, req.Offset, req.Force, req.OperationID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "RedriveDeadLetter failed", err.Error())
+		c.JSON(http.StatusInternalServerError, gin.H{"errCode": 500, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": 0, "errMsg": ""})
+}
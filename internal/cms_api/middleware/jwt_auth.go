@@ -1,7 +1,8 @@
 package middleware
 
 import (
-	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
 	"Open_IM/pkg/common/log"
 	"Open_IM/pkg/common/token_verify"
 	"Open_IM/pkg/utils"
@@ -12,21 +13,57 @@ import (
 
 func JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), "")
-		// log.NewInfo("0", utils.GetSelfFuncName(), "userID: ", userID)
+		// IM user tokens are rejected here, an admin id may also be an IM user id
+		claims, err := token_verify.ParseCMSToken(c.Request.Header.Get("token"))
+		if err != nil {
+			log.NewError("", "ParseCMSToken failed ", err.Error(), c.Request.Header.Get("token"))
+			c.Abort()
+			c.JSON(http.StatusOK, gin.H{"errCode": 400, "errMsg": err.Error()})
+			return
+		}
+		userID := claims.AdminID
 		c.Set("userID", userID)
-		if !ok {
-			log.NewError("", "GetUserIDFromToken false ", c.Request.Header.Get("token"))
+		// the account is checked on every request so that disabling an admin or changing the password takes effect at once
+		admin, err := imdb.GetAdminAccount(userID)
+		if err != nil {
+			log.NewError("", utils.GetSelfFuncName(), "GetAdminAccount failed", err.Error(), userID)
+			c.Abort()
+			c.JSON(http.StatusOK, gin.H{"errCode": 400, "errMsg": "user is not admin"})
+			return
+		}
+		if admin.Status != constant.AdminStatusNormal {
+			log.NewError("", utils.GetSelfFuncName(), "user is not admin", userID)
+			c.Abort()
+			c.JSON(http.StatusOK, gin.H{"errCode": 400, "errMsg": "user is not admin"})
+			return
+		}
+		if admin.TokenVersion != claims.TokenVersion {
+			log.NewError("", utils.GetSelfFuncName(), "token revoked", userID, claims.TokenVersion, admin.TokenVersion)
 			c.Abort()
-			c.JSON(http.StatusOK, gin.H{"errCode": 400, "errMsg": errInfo})
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrTokenInvalid.ErrCode, "errMsg": constant.ErrTokenInvalid.ErrMsg})
 			return
-		} else {
-			if !utils.IsContain(userID, config.Config.Manager.AppManagerUid) {
+		}
+		c.Set("adminRole", admin.Role)
+	}
+}
+
+func GetAdminRole(c *gin.Context) int32 {
+	role, _ := c.Get("adminRole")
+	v, _ := role.(int32)
+	return v
+}
+
+// RequirePermission must be used after JWTAuth, the admin needs every permission listed.
+// On audited routes it goes after audit.Middleware so that denied calls are recorded too.
+func RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for _, permission := range permissions {
+			if !constant.AdminRoleHasPermission(GetAdminRole(c), permission) {
+				log.NewError("", utils.GetSelfFuncName(), "permission denied", c.GetString("userID"), GetAdminRole(c), permission)
 				c.Abort()
-				c.JSON(http.StatusOK, gin.H{"errCode": 400, "errMsg": "user is not admin"})
+				c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrAccess.ErrCode, "errMsg": constant.ErrAccess.ErrMsg + " " + permission})
 				return
 			}
-			log.NewInfo("0", utils.GetSelfFuncName(), "failed: ", errInfo)
 		}
 	}
 }
@@ -2,6 +2,8 @@ package cms_api
 
 import (
 	"Open_IM/internal/cms_api/admin"
+	cmsAudit "Open_IM/internal/cms_api/audit"
+	cmsCallback "Open_IM/internal/cms_api/callback"
 	"Open_IM/internal/cms_api/friend"
 	"Open_IM/internal/cms_api/group"
 	messageCMS "Open_IM/internal/cms_api/message_cms"
@@ -9,7 +11,9 @@ import (
 	"Open_IM/internal/cms_api/statistics"
 	"Open_IM/internal/cms_api/user"
 	"Open_IM/internal/demo/register"
+	"Open_IM/pkg/common/audit"
 	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
 
 	promePkg "Open_IM/pkg/common/prometheus"
 
@@ -27,29 +31,36 @@ func NewGinRouter() *gin.Engine {
 	router := baseRouter.Group("/cms")
 	adminRouterGroup := router.Group("/admin")
 	{
-		adminRouterGroup.POST("/login", admin.AdminLogin)
+		adminRouterGroup.POST("/login", audit.Middleware("adminID"), admin.AdminLogin)
 		adminRouterGroup.Use(middleware.JWTAuth())
-		adminRouterGroup.POST("/get_user_token", admin.GetUserToken)
+		adminRouterGroup.POST("/change_password", audit.Middleware(), admin.ChangeAdminPassword)
+		adminRouterGroup.POST("/get_user_token", audit.Middleware("userID"), middleware.RequirePermission(constant.PermUserToken), admin.GetUserToken)
 
-		adminRouterGroup.POST("/add_user_register_add_friend_id", admin.AddUserRegisterAddFriendIDList)
-		adminRouterGroup.POST("/reduce_user_register_reduce_friend_id", admin.ReduceUserRegisterAddFriendIDList)
-		adminRouterGroup.POST("/get_user_register_reduce_friend_id_list", admin.GetUserRegisterAddFriendIDList)
+		adminRouterGroup.POST("/get_admins", middleware.RequirePermission(constant.PermAdminManage), admin.GetAdmins)
+		adminRouterGroup.POST("/add_admin", audit.Middleware("adminID"), middleware.RequirePermission(constant.PermAdminManage), admin.AddAdmin)
+		adminRouterGroup.POST("/update_admin", audit.Middleware("adminID"), middleware.RequirePermission(constant.PermAdminManage), admin.UpdateAdmin)
+		adminRouterGroup.POST("/delete_admin", audit.Middleware("adminID"), middleware.RequirePermission(constant.PermAdminManage), admin.DeleteAdmin)
 
-		adminRouterGroup.POST("/generate_invitation_code", register.GenerateInvitationCode)
-		adminRouterGroup.POST("/query_invitation_code", register.QueryInvitationCode)
-		adminRouterGroup.POST("/get_invitation_codes", register.GetInvitationCodes)
+		adminRouterGroup.POST("/add_user_register_add_friend_id", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), admin.AddUserRegisterAddFriendIDList)
+		adminRouterGroup.POST("/reduce_user_register_reduce_friend_id", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), admin.ReduceUserRegisterAddFriendIDList)
+		adminRouterGroup.POST("/get_user_register_reduce_friend_id_list", middleware.RequirePermission(constant.PermRegisterRead), admin.GetUserRegisterAddFriendIDList)
 
-		adminRouterGroup.POST("/query_user_ip_limit_login", register.QueryUserIDLimitLogin)
-		adminRouterGroup.POST("/add_user_ip_limit_login", register.AddUserIPLimitLogin)
-		adminRouterGroup.POST("/remove_user_ip_limit_login", register.RemoveUserIPLimitLogin)
+		adminRouterGroup.POST("/generate_invitation_code", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), register.GenerateInvitationCode)
+		adminRouterGroup.POST("/query_invitation_code", middleware.RequirePermission(constant.PermRegisterRead), register.QueryInvitationCode)
+		adminRouterGroup.POST("/get_invitation_codes", middleware.RequirePermission(constant.PermRegisterRead), register.GetInvitationCodes)
 
-		adminRouterGroup.POST("/query_ip_register", register.QueryIPRegister)
-		adminRouterGroup.POST("/add_ip_limit", register.AddIPLimit)
-		adminRouterGroup.POST("/remove_ip_Limit", register.RemoveIPLimit)
+		adminRouterGroup.POST("/query_user_ip_limit_login", middleware.RequirePermission(constant.PermRegisterRead), register.QueryUserIDLimitLogin)
+		adminRouterGroup.POST("/add_user_ip_limit_login", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), register.AddUserIPLimitLogin)
+		adminRouterGroup.POST("/remove_user_ip_limit_login", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), register.RemoveUserIPLimitLogin)
+
+		adminRouterGroup.POST("/query_ip_register", middleware.RequirePermission(constant.PermRegisterRead), register.QueryIPRegister)
+		adminRouterGroup.POST("/add_ip_limit", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), register.AddIPLimit)
+		adminRouterGroup.POST("/remove_ip_Limit", audit.Middleware("userID", "userIDList", "ip"), middleware.RequirePermission(constant.PermRegisterWrite), register.RemoveIPLimit)
 	}
 	r2 := router.Group("")
 	r2.Use(middleware.JWTAuth())
 	statisticsRouterGroup := r2.Group("/statistics")
+	statisticsRouterGroup.Use(middleware.RequirePermission(constant.PermStatisticsRead))
 	{
 		statisticsRouterGroup.POST("/get_messages_statistics", statistics.GetMessagesStatistics)
 		statisticsRouterGroup.POST("/get_user_statistics", statistics.GetUserStatistics)
@@ -59,26 +70,42 @@ func NewGinRouter() *gin.Engine {
 	}
 	groupRouterGroup := r2.Group("/group")
 	{
-		groupRouterGroup.POST("/get_groups", group.GetGroups)
-		groupRouterGroup.POST("/get_group_members", group.GetGroupMembers)
+		groupRouterGroup.POST("/get_groups", middleware.RequirePermission(constant.PermGroupRead), group.GetGroups)
+		groupRouterGroup.POST("/get_group_members", middleware.RequirePermission(constant.PermGroupRead), group.GetGroupMembers)
+		groupRouterGroup.POST("/convert_group_type", audit.Middleware("groupID"), middleware.RequirePermission(constant.PermGroupRead, constant.PermGroupWrite), group.ConvertGroupType)
+		groupRouterGroup.POST("/get_group_conversion", middleware.RequirePermission(constant.PermGroupRead), group.GetGroupConversion)
 	}
 	userRouterGroup := r2.Group("/user")
 	{
-		userRouterGroup.POST("/get_user_id_by_email_phone", user.GetUserIDByEmailAndPhoneNumber)
+		userRouterGroup.POST("/get_user_id_by_email_phone", middleware.RequirePermission(constant.PermUserRead), user.GetUserIDByEmailAndPhoneNumber)
 
-		userRouterGroup.POST("/add_user", user.AddUser)
-		userRouterGroup.POST("/unblock_user", user.UnblockUser)
-		userRouterGroup.POST("/block_user", user.BlockUser)
-		userRouterGroup.POST("/get_block_users", user.GetBlockUsers)
+		userRouterGroup.POST("/add_user", audit.Middleware("userID"), middleware.RequirePermission(constant.PermUserRead, constant.PermUserWrite), user.AddUser)
+		userRouterGroup.POST("/unblock_user", audit.Middleware("userID"), middleware.RequirePermission(constant.PermUserRead, constant.PermUserWrite), user.UnblockUser)
+		userRouterGroup.POST("/block_user", audit.Middleware("userID"), middleware.RequirePermission(constant.PermUserRead, constant.PermUserWrite), user.BlockUser)
+		userRouterGroup.POST("/get_block_users", middleware.RequirePermission(constant.PermUserRead), user.GetBlockUsers)
 	}
 	messageCMSRouterGroup := r2.Group("/message")
 	{
-		messageCMSRouterGroup.POST("/get_chat_logs", messageCMS.GetChatLogs)
+		messageCMSRouterGroup.POST("/get_chat_logs", audit.Middleware("sendID", "recvID", "groupID"), middleware.RequirePermission(constant.PermMessageRead), messageCMS.GetChatLogs)
+		messageCMSRouterGroup.POST("/get_dead_letters", middleware.RequirePermission(constant.PermMessageRead), messageCMS.GetDeadLetters)
+		messageCMSRouterGroup.POST("/redrive_dead_letter", audit.Middleware("partition", "offset"), middleware.RequirePermission(constant.PermMessageRead, constant.PermMessageWrite), messageCMS.RedriveDeadLetter)
 	}
 	friendCMSRouterGroup := r2.Group("/friend")
+	friendCMSRouterGroup.Use(middleware.RequirePermission(constant.PermFriendRead))
 	{
 		friendCMSRouterGroup.POST("/get_friends", friend.GetUserFriends)
 	}
+	auditRouterGroup := r2.Group("/audit")
+	{
+		auditRouterGroup.POST("/get_audit_logs", middleware.RequirePermission(constant.PermAuditRead), cmsAudit.GetAuditLogs)
+		auditRouterGroup.POST("/export", audit.Middleware(), middleware.RequirePermission(constant.PermAuditRead), cmsAudit.ExportAuditLogs)
+		auditRouterGroup.POST("/verify", middleware.RequirePermission(constant.PermAuditRead), cmsAudit.VerifyAuditLogs)
+	}
+	callbackRouterGroup := r2.Group("/callback")
+	{
+		callbackRouterGroup.POST("/get_deliveries", middleware.RequirePermission(constant.PermCallbackRead), cmsCallback.GetCallbackDeliveries)
+		callbackRouterGroup.POST("/redeliver", audit.Middleware("deliveryID"), middleware.RequirePermission(constant.PermCallbackRead, constant.PermCallbackWrite), cmsCallback.RedeliverCallback)
+	}
 
 	return baseRouter
 }
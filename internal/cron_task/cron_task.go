@@ -37,6 +37,12 @@ func StartCronTask(userID, workingGroupID string) {
 		fmt.Println("start cron failed", err.Error(), config.Config.Mongo.ChatRecordsClearTime)
 		panic(err)
 	}
+	if config.Config.GroupOwnerSuccession.Enable {
+		if _, err := c.AddFunc(config.Config.GroupOwnerSuccession.RepairCronTime, RepairOrphanedGroups); err != nil {
+			fmt.Println("start cron failed", err.Error(), config.Config.GroupOwnerSuccession.RepairCronTime)
+			panic(err)
+		}
+	}
 	c.Start()
 	fmt.Println("start cron task success")
 	for {
@@ -0,0 +1,46 @@
+package cronTask
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"strings"
+)
+
+// RepairOrphanedGroups hands the groups without an owner, or whose owner is blocked or deleted, to a successor
+func RepairOrphanedGroups() {
+	operationID := getCronTaskOperationID()
+	log.NewInfo(operationID, "====================== start repair orphaned groups ======================")
+	groups, err := im_mysql_model.GetOrphanedGroups()
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetOrphanedGroups failed ", err.Error())
+		return
+	}
+	if len(groups) == 0 {
+		return
+	}
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImGroupName, operationID)
+	if etcdConn == nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "getcdv3.GetDefaultConn == nil")
+		return
+	}
+	client := pbGroup.NewGroupClient(etcdConn)
+	for _, group := range groups {
+		resp, err := client.SucceedGroupOwner(context.Background(), &pbGroup.SucceedGroupOwnerReq{GroupID: group.GroupID, OldOwnerUserID: group.OwnerUserID,
+			OpUserID: config.Config.Manager.AppManagerUid[0], OperationID: operationID})
+		if err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "SucceedGroupOwner failed ", err.Error(), group.GroupID)
+			continue
+		}
+		if resp.CommonResp.ErrCode != 0 {
+			log.NewError(operationID, utils.GetSelfFuncName(), "SucceedGroupOwner failed ", resp.CommonResp.ErrMsg, group.GroupID)
+			continue
+		}
+		log.NewInfo(operationID, "repaired orphaned group ", group.GroupID, group.OwnerUserID, resp.NewOwnerUserID)
+	}
+	log.NewInfo(operationID, "====================== repair orphaned groups finished ======================", len(groups))
+}
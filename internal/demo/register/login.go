@@ -4,6 +4,7 @@ import (
 	api "Open_IM/pkg/base_info"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
 	"Open_IM/pkg/common/db/mysql_model/im_mysql_model"
 	http2 "Open_IM/pkg/common/http"
 	"Open_IM/pkg/common/log"
@@ -22,6 +23,7 @@ type ParamsLogin struct {
 	Platform    int32  `json:"platform"`
 	OperationID string `json:"operationID" binding:"required"`
 	AreaCode    string `json:"areaCode"`
+	DeviceToken string `json:"deviceToken"`
 }
 
 func Login(c *gin.Context) {
@@ -45,50 +47,77 @@ func Login(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"errCode": constant.NotRegistered, "errMsg": "Mobile phone number is not registered"})
 		return
 	}
-	if r.Password != params.Password {
-		log.NewError(params.OperationID, "password  err", params.Password, account, r.Password, r.Account)
-		c.JSON(http.StatusOK, gin.H{"errCode": constant.PasswordErr, "errMsg": "password err"})
-		return
-	}
 	var userID string
 	if r.UserID != "" {
 		userID = r.UserID
 	} else {
 		userID = r.Account
 	}
+	if isAccountLocked(loginLockKey(userID), params.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
+	if r.Password != params.Password {
+		log.NewError(params.OperationID, "password  err", params.Password, account, r.Password, r.Account)
+		addLoginFailed(loginLockKey(userID), params.OperationID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.PasswordErr, "errMsg": "password err"})
+		return
+	}
 	ip := c.Request.Header.Get("X-Forward-For")
 	if ip == "" {
 		ip = c.ClientIP()
 	}
+	need, err := needTwoFactor(userID, params.DeviceToken)
+	if err != nil {
+		log.NewError(params.OperationID, "needTwoFactor failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if need {
+		challengeID, err := randomHex(16)
+		if err == nil {
+			err = db.DB.SetTwoFactorChallenge(challengeID, &db.TwoFactorChallenge{UserID: userID, Platform: params.Platform, LoginIP: ip}, config.Config.Demo.TwoFactor.ChallengeTTL)
+		}
+		if err != nil {
+			log.NewError(params.OperationID, "SetTwoFactorChallenge failed", userID, err.Error())
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+			return
+		}
+		log.NewInfo(params.OperationID, "two factor required", userID, ip)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.TwoFactorRequired, "errMsg": "two factor required", "data": gin.H{"challengeID": challengeID}})
+		return
+	}
+	resetLoginFailed(loginLockKey(userID), params.OperationID)
+	userToken, errCode, errMsg := getIMUserToken(userID, params.Platform, ip, params.OperationID)
+	if errCode != constant.NoError {
+		c.JSON(http.StatusOK, gin.H{"errCode": errCode, "errMsg": errMsg})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": userToken})
+
+}
+
+func getIMUserToken(userID string, platform int32, loginIp, operationID string) (*api.UserTokenInfo, int, string) {
 	url := fmt.Sprintf("%s/auth/user_token", config.Config.Demo.ImAPIURL)
 	openIMGetUserToken := api.UserTokenReq{}
-	openIMGetUserToken.OperationID = params.OperationID
-	openIMGetUserToken.Platform = params.Platform
+	openIMGetUserToken.OperationID = operationID
+	openIMGetUserToken.Platform = platform
 	openIMGetUserToken.Secret = config.Config.Secret
 	openIMGetUserToken.UserID = userID
-	openIMGetUserToken.LoginIp = ip
-	loginIp := c.Request.Header.Get("X-Forward-For")
-	if loginIp == "" {
-		loginIp = c.ClientIP()
-	}
 	openIMGetUserToken.LoginIp = loginIp
 	openIMGetUserTokenResp := api.UserTokenResp{}
 	bMsg, err := http2.Post(url, openIMGetUserToken, 2)
 	if err != nil {
-		log.NewError(params.OperationID, "request openIM get user token error", account, "err", err.Error())
-		c.JSON(http.StatusOK, gin.H{"errCode": constant.GetIMTokenErr, "errMsg": err.Error()})
-		return
+		log.NewError(operationID, "request openIM get user token error", userID, "err", err.Error())
+		return nil, constant.GetIMTokenErr, err.Error()
 	}
 	err = json.Unmarshal(bMsg, &openIMGetUserTokenResp)
 	if err != nil || openIMGetUserTokenResp.ErrCode != 0 {
-		log.NewError(params.OperationID, "request get user token", account, "err", "")
+		log.NewError(operationID, "request get user token", userID, "err", "")
 		if openIMGetUserTokenResp.ErrCode == constant.LoginLimit {
-			c.JSON(http.StatusOK, gin.H{"errCode": constant.LoginLimit, "errMsg": "用户登录被限制"})
-		} else {
-			c.JSON(http.StatusOK, gin.H{"errCode": constant.GetIMTokenErr, "errMsg": ""})
+			return nil, constant.LoginLimit, "用户登录被限制"
 		}
-		return
+		return nil, constant.GetIMTokenErr, ""
 	}
-	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": openIMGetUserTokenResp.UserToken})
-
+	return &openIMGetUserTokenResp.UserToken, constant.NoError, ""
 }
@@ -0,0 +1,55 @@
+package register
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/db"
+	"Open_IM/pkg/common/log"
+	promePkg "Open_IM/pkg/common/prometheus"
+	"Open_IM/pkg/utils"
+)
+
+// password and second factor failures of an account share one counter
+func loginLockKey(userID string) string {
+	return "login_" + userID
+}
+
+func verifyLockKey(account string) string {
+	return "verify_" + account
+}
+
+// isAccountLocked fails closed, an attempt whose failures can't be counted is refused
+func isAccountLocked(key, operationID string) bool {
+	maxFailedAttempts := config.Config.Demo.LoginLock.MaxFailedAttempts
+	if maxFailedAttempts <= 0 {
+		return false
+	}
+	count, err := db.DB.GetLoginFailedCount(key)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetLoginFailedCount failed", key, err.Error())
+		promePkg.PromeInc(promePkg.LoginLockCheckFailedCounter)
+		return true
+	}
+	if count >= maxFailedAttempts {
+		log.NewWarn(operationID, utils.GetSelfFuncName(), "account locked", key, count)
+		return true
+	}
+	return false
+}
+
+func addLoginFailed(key, operationID string) {
+	if config.Config.Demo.LoginLock.MaxFailedAttempts <= 0 {
+		return
+	}
+	count, err := db.DB.IncrLoginFailedCount(key, config.Config.Demo.LoginLock.LockSeconds)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "IncrLoginFailedCount failed", key, err.Error())
+		return
+	}
+	log.NewInfo(operationID, utils.GetSelfFuncName(), "failed attempt", key, count)
+}
+
+func resetLoginFailed(key, operationID string) {
+	if err := db.DB.ResetLoginFailedCount(key); err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "ResetLoginFailedCount failed", key, err.Error())
+	}
+}
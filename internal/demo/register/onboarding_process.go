@@ -238,7 +238,7 @@ func onboardingProcessNotification(operationID, userID, groupID, userName, faceU
 		log.NewInfo(operationID, utils.GetSelfFuncName(), userID, groupID)
 	}()
 	//var tips commonPb.TipsComm
-	//tips.DefaultTips = config.Config.Notification.JoinDepartmentNotification.DefaultTips.Tips
+	//tips.DefaultTips = config.Current().Notification.JoinDepartmentNotification.DefaultTips.Tips
 	//tips.JsonDetail = ""
 	//content, err := proto.Marshal(&tips)
 	//if err != nil {
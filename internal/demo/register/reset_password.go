@@ -35,14 +35,20 @@ func ResetPassword(c *gin.Context) {
 		account = req.PhoneNumber
 	}
 
+	if isAccountLocked(verifyLockKey(account), req.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
 	if (config.Config.Demo.UseSuperCode && req.VerificationCode != config.Config.Demo.SuperCode) || !config.Config.Demo.UseSuperCode {
 		accountKey := req.AreaCode + account + "_" + constant.VerificationCodeForResetSuffix
 		v, err := db.DB.GetAccountCode(accountKey)
 		if err != nil || v != req.VerificationCode {
 			log.NewError(req.OperationID, "password Verification code error", account, req.VerificationCode, v)
+			addLoginFailed(verifyLockKey(account), req.OperationID)
 			c.JSON(http.StatusOK, gin.H{"errCode": constant.CodeInvalidOrExpired, "errMsg": "Verification code error!"})
 			return
 		}
+		resetLoginFailed(verifyLockKey(account), req.OperationID)
 	}
 	user, err := im_mysql_model.GetRegister(account, req.AreaCode, "")
 	if err != nil || user.Account == "" {
@@ -20,7 +20,9 @@ var sms SMS
 
 func init() {
 	var err error
-	if config.Config.Demo.AliSMSVerify.Enable {
+	if config.Config.Demo.StubSender {
+		sms = NewStubSMS()
+	} else if config.Config.Demo.AliSMSVerify.Enable {
 		sms, err = NewAliSMS()
 		if err != nil {
 			panic(err)
@@ -109,7 +111,9 @@ func SendVerificationCode(c *gin.Context) {
 		return
 	}
 	log.NewDebug(params.OperationID, config.Config.Demo)
-	if params.Email != "" {
+	if params.Email != "" && config.Config.Demo.StubSender {
+		log.NewInfo(params.OperationID, "stub send mail code", account, code)
+	} else if params.Email != "" {
 		m := gomail.NewMessage()
 		m.SetHeader(`From`, config.Config.Demo.Mail.SenderMail)
 		m.SetHeader(`To`, []string{account}...)
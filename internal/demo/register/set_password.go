@@ -79,16 +79,22 @@ func SetPassword(c *gin.Context) {
 		params.Nickname = account
 	}
 	if params.UserID == "" {
+		if isAccountLocked(verifyLockKey(account), params.OperationID) {
+			c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+			return
+		}
 		if (config.Config.Demo.UseSuperCode && params.VerificationCode != config.Config.Demo.SuperCode) || !config.Config.Demo.UseSuperCode {
 			accountKey := params.AreaCode + account + "_" + constant.VerificationCodeForRegisterSuffix
 			v, err := db.DB.GetAccountCode(accountKey)
 			if err != nil || v != params.VerificationCode {
 				log.NewError(params.OperationID, "password Verification code error", account, params.VerificationCode)
+				addLoginFailed(verifyLockKey(account), params.OperationID)
 				data := make(map[string]interface{})
 				data["PhoneNumber"] = account
 				c.JSON(http.StatusOK, gin.H{"errCode": constant.CodeInvalidOrExpired, "errMsg": "Verification code error!", "data": data})
 				return
 			}
+			resetLoginFailed(verifyLockKey(account), params.OperationID)
 		}
 		if config.Config.Demo.NeedInvitationCode && params.InvitationCode != "" {
 			err := imdb.CheckInvitationCode(params.InvitationCode)
@@ -0,0 +1,17 @@
+package register
+
+import (
+	"Open_IM/pkg/common/log"
+)
+
+// StubSMS only logs the code, it replaces the sms provider when demo.stubSender is set for local testing
+type StubSMS struct{}
+
+func (s StubSMS) SendSms(code int, phoneNumber string) (resp interface{}, err error) {
+	log.NewInfo("", "stub send sms code", phoneNumber, code)
+	return nil, nil
+}
+
+func NewStubSMS() *StubSMS {
+	return &StubSMS{}
+}
@@ -0,0 +1,410 @@
+package register
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/utils"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const useRecoveryCodeRetry = 3
+
+type paramsTwoFactorCode struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+	OperationID  string `json:"operationID" binding:"required"`
+}
+
+type paramsVerifyTwoFactor struct {
+	ChallengeID  string `json:"challengeID" binding:"required"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+	TrustDevice  bool   `json:"trustDevice"`
+	OperationID  string `json:"operationID" binding:"required"`
+}
+
+type paramsRemoveTrustedDevice struct {
+	DeviceID    string `json:"deviceID" binding:"required"`
+	OperationID string `json:"operationID" binding:"required"`
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashSecret(s string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.ReplaceAll(s, "-", ""))))
+	return hex.EncodeToString(sum[:])
+}
+
+// needTwoFactor is true for enrolled accounts logging in from a device that is not trusted,
+// only a missing record means not enrolled, any other error fails the login
+func needTwoFactor(userID, deviceToken string) (bool, error) {
+	twoFactorAuth, err := imdb.GetTwoFactorAuth(userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !twoFactorAuth.Enabled {
+		return false, nil
+	}
+	return deviceToken == "" || !imdb.IsTrustedDevice(userID, hashSecret(deviceToken)), nil
+}
+
+// newRecoveryCodes returns the codes shown to the user once and the json of their hashes
+func newRecoveryCodes() ([]string, string, error) {
+	num := config.Config.Demo.TwoFactor.RecoveryCodeNum
+	if num <= 0 {
+		num = 10
+	}
+	var codes, hashes []string
+	for i := 0; i < num; i++ {
+		code, err := randomHex(5)
+		if err != nil {
+			return nil, "", err
+		}
+		code = code[:5] + "-" + code[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashSecret(code))
+	}
+	return codes, utils.StructToJsonString(hashes), nil
+}
+
+// checkTOTPCode accepts a code once, a code seen again within its validity window is a replay
+func checkTOTPCode(twoFactorAuth *db.TwoFactorAuth, code string) (bool, error) {
+	step, ok := utils.VerifyTOTPStep(twoFactorAuth.Secret, code, time.Now(), 1)
+	if !ok {
+		return false, nil
+	}
+	return imdb.UseTwoFactorStep(twoFactorAuth.UserID, step)
+}
+
+// checkTwoFactorCode accepts a totp code or consumes a recovery code
+func checkTwoFactorCode(twoFactorAuth *db.TwoFactorAuth, code, recoveryCode string) (bool, error) {
+	if code != "" {
+		return checkTOTPCode(twoFactorAuth, code)
+	}
+	if recoveryCode == "" {
+		return false, nil
+	}
+	return useRecoveryCode(twoFactorAuth, hashSecret(recoveryCode))
+}
+
+// useRecoveryCode removes the code with a conditional update so two requests can't both use it.
+// When another code was used in between the codes are read again.
+func useRecoveryCode(twoFactorAuth *db.TwoFactorAuth, hash string) (bool, error) {
+	for i := 0; i < useRecoveryCodeRetry; i++ {
+		if i > 0 {
+			var err error
+			if twoFactorAuth, err = imdb.GetTwoFactorAuth(twoFactorAuth.UserID); err != nil {
+				return false, err
+			}
+		}
+		var hashes []string
+		if err := utils.JsonStringToStruct(twoFactorAuth.RecoveryCodes, &hashes); err != nil {
+			return false, err
+		}
+		remaining, ok := removeRecoveryCode(hashes, hash)
+		if !ok {
+			return false, nil
+		}
+		replaced, err := imdb.ReplaceRecoveryCodes(twoFactorAuth.UserID, twoFactorAuth.RecoveryCodes, utils.StructToJsonString(remaining))
+		if err != nil || replaced {
+			return replaced, err
+		}
+	}
+	return false, errors.New("recovery codes changed concurrently")
+}
+
+func removeRecoveryCode(hashes []string, hash string) ([]string, bool) {
+	for i, v := range hashes {
+		if v == hash {
+			return append(hashes[:i:i], hashes[i+1:]...), true
+		}
+	}
+	return hashes, false
+}
+
+func getTokenUserID(c *gin.Context, operationID string) (string, bool) {
+	ok, userID, errInfo := token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), operationID)
+	if !ok {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetUserIDFromToken failed", errInfo)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrAccess.ErrCode, "errMsg": errInfo})
+	}
+	return userID, ok
+}
+
+// EnrollTwoFactor creates a new totp secret, it takes effect once ActivateTwoFactor confirms a code
+func EnrollTwoFactor(c *gin.Context) {
+	params := paramsTwoFactorCode{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	if twoFactorAuth, err := imdb.GetTwoFactorAuth(userID); err == nil && twoFactorAuth.Enabled {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.FormattingError, "errMsg": "two factor already enabled"})
+		return
+	}
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "GenerateTOTPSecret failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if err := imdb.SetTwoFactorAuth(&db.TwoFactorAuth{UserID: userID, Secret: secret}); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "SetTwoFactorAuth failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(params.OperationID, utils.GetSelfFuncName(), "two factor enrolled", userID)
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": gin.H{"secret": secret,
+		"uri": utils.TOTPURI(config.Config.Demo.TwoFactor.Issuer, userID, secret)}})
+}
+
+func ActivateTwoFactor(c *gin.Context) {
+	params := paramsTwoFactorCode{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	twoFactorAuth, err := imdb.GetTwoFactorAuth(userID)
+	if err != nil || twoFactorAuth.Enabled {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.FormattingError, "errMsg": "two factor not enrolled"})
+		return
+	}
+	if isAccountLocked(loginLockKey(userID), params.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
+	if ok, err := checkTOTPCode(twoFactorAuth, params.Code); err != nil || !ok {
+		if err != nil {
+			log.NewError(params.OperationID, utils.GetSelfFuncName(), "checkTOTPCode failed", userID, err.Error())
+		}
+		addLoginFailed(loginLockKey(userID), params.OperationID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.TwoFactorCodeErr, "errMsg": "code error"})
+		return
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "newRecoveryCodes failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if err := imdb.UpdateTwoFactorAuth(userID, map[string]interface{}{"enabled": true, "recovery_codes": hashes}); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "UpdateTwoFactorAuth failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	log.NewInfo(params.OperationID, utils.GetSelfFuncName(), "two factor enabled", userID)
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": gin.H{"recoveryCodes": codes}})
+}
+
+// DisableTwoFactor also forgets the trusted devices so enabling it again asks everywhere
+func DisableTwoFactor(c *gin.Context) {
+	params := paramsTwoFactorCode{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	twoFactorAuth, err := imdb.GetTwoFactorAuth(userID)
+	if err != nil || !twoFactorAuth.Enabled {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.FormattingError, "errMsg": "two factor not enabled"})
+		return
+	}
+	if isAccountLocked(loginLockKey(userID), params.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
+	if ok, err := checkTwoFactorCode(twoFactorAuth, params.Code, params.RecoveryCode); err != nil || !ok {
+		addLoginFailed(loginLockKey(userID), params.OperationID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.TwoFactorCodeErr, "errMsg": "code error"})
+		return
+	}
+	if err := imdb.DeleteTwoFactorAuth(userID); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "DeleteTwoFactorAuth failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if err := imdb.DeleteTrustedDevices(userID); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "DeleteTrustedDevices failed", userID, err.Error())
+	}
+	log.NewInfo(params.OperationID, utils.GetSelfFuncName(), "two factor disabled", userID)
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": ""})
+}
+
+// RegenerateRecoveryCodes invalidates the remaining recovery codes
+func RegenerateRecoveryCodes(c *gin.Context) {
+	params := paramsTwoFactorCode{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	twoFactorAuth, err := imdb.GetTwoFactorAuth(userID)
+	if err != nil || !twoFactorAuth.Enabled {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.FormattingError, "errMsg": "two factor not enabled"})
+		return
+	}
+	if isAccountLocked(loginLockKey(userID), params.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
+	if ok, err := checkTOTPCode(twoFactorAuth, params.Code); err != nil || !ok {
+		if err != nil {
+			log.NewError(params.OperationID, utils.GetSelfFuncName(), "checkTOTPCode failed", userID, err.Error())
+		}
+		addLoginFailed(loginLockKey(userID), params.OperationID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.TwoFactorCodeErr, "errMsg": "code error"})
+		return
+	}
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "newRecoveryCodes failed", err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrInternal.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if err := imdb.UpdateTwoFactorAuth(userID, map[string]interface{}{"recovery_codes": hashes}); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "UpdateTwoFactorAuth failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": gin.H{"recoveryCodes": codes}})
+}
+
+// VerifyTwoFactor completes a login that Login answered with TwoFactorRequired
+func VerifyTwoFactor(c *gin.Context) {
+	params := paramsVerifyTwoFactor{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	challenge, err := db.DB.GetTwoFactorChallenge(params.ChallengeID)
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "GetTwoFactorChallenge failed", params.ChallengeID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.CodeInvalidOrExpired, "errMsg": "login expired, please login again"})
+		return
+	}
+	if isAccountLocked(loginLockKey(challenge.UserID), params.OperationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
+	twoFactorAuth, err := imdb.GetTwoFactorAuth(challenge.UserID)
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "GetTwoFactorAuth failed", challenge.UserID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	if ok, err := checkTwoFactorCode(twoFactorAuth, params.Code, params.RecoveryCode); err != nil || !ok {
+		log.NewInfo(params.OperationID, utils.GetSelfFuncName(), "two factor code error", challenge.UserID)
+		addLoginFailed(loginLockKey(challenge.UserID), params.OperationID)
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.TwoFactorCodeErr, "errMsg": "code error"})
+		return
+	}
+	if err := db.DB.DelTwoFactorChallenge(params.ChallengeID); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "DelTwoFactorChallenge failed", params.ChallengeID, err.Error())
+	}
+	resetLoginFailed(loginLockKey(challenge.UserID), params.OperationID)
+	data := gin.H{}
+	if params.TrustDevice {
+		deviceToken, err := randomHex(32)
+		if err == nil {
+			now := time.Now()
+			err = imdb.InsertTrustedDevice(&db.TrustedDevice{UserID: challenge.UserID, DeviceID: hashSecret(deviceToken), Platform: challenge.Platform,
+				LoginIP: challenge.LoginIP, CreateTime: now, ExpireTime: now.AddDate(0, 0, config.Config.Demo.TwoFactor.TrustedDeviceDays)})
+		}
+		if err != nil {
+			log.NewError(params.OperationID, utils.GetSelfFuncName(), "InsertTrustedDevice failed", challenge.UserID, err.Error())
+		} else {
+			data["deviceToken"] = deviceToken
+		}
+	}
+	userToken, errCode, errMsg := getIMUserToken(challenge.UserID, challenge.Platform, challenge.LoginIP, params.OperationID)
+	if errCode != constant.NoError {
+		c.JSON(http.StatusOK, gin.H{"errCode": errCode, "errMsg": errMsg})
+		return
+	}
+	data["userID"] = userToken.UserID
+	data["token"] = userToken.Token
+	data["expiredTime"] = userToken.ExpiredTime
+	if userToken.RefreshToken != "" {
+		data["refreshToken"] = userToken.RefreshToken
+		data["refreshExpiredTime"] = userToken.RefreshExpiredTime
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": data})
+}
+
+func GetTrustedDevices(c *gin.Context) {
+	params := paramsTwoFactorCode{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	trustedDevices, err := imdb.GetTrustedDevices(userID)
+	if err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "GetTrustedDevices failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	devices := make([]gin.H, 0, len(trustedDevices))
+	for _, v := range trustedDevices {
+		devices = append(devices, gin.H{"deviceID": v.DeviceID, "platform": v.Platform, "loginIP": v.LoginIP,
+			"createTime": v.CreateTime.Unix(), "expireTime": v.ExpireTime.Unix()})
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": "", "data": devices})
+}
+
+func RemoveTrustedDevice(c *gin.Context) {
+	params := paramsRemoveTrustedDevice{}
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"errCode": constant.FormattingError, "errMsg": err.Error()})
+		return
+	}
+	userID, ok := getTokenUserID(c, params.OperationID)
+	if !ok {
+		return
+	}
+	if err := imdb.DeleteTrustedDevice(userID, params.DeviceID); err != nil {
+		log.NewError(params.OperationID, utils.GetSelfFuncName(), "DeleteTrustedDevice failed", userID, err.Error())
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.ErrDB.ErrCode, "errMsg": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"errCode": constant.NoError, "errMsg": ""})
+}
@@ -40,6 +40,10 @@ func Verify(c *gin.Context) {
 		account = params.AreaCode + params.PhoneNumber
 	}
 
+	if isAccountLocked(verifyLockKey(account), operationID) {
+		c.JSON(http.StatusOK, gin.H{"errCode": constant.AccountLocked, "errMsg": "too many failed attempts, try again later"})
+		return
+	}
 	if config.Config.Demo.UseSuperCode && params.VerificationCode == config.Config.Demo.SuperCode {
 		log.NewInfo(params.OperationID, "Super Code Verified successfully", account)
 		data := make(map[string]interface{})
@@ -72,6 +76,7 @@ func Verify(c *gin.Context) {
 	}
 	if params.VerificationCode == code {
 		log.Info(params.OperationID, "Verified successfully", account)
+		resetLoginFailed(verifyLockKey(account), operationID)
 		data := make(map[string]interface{})
 		data["account"] = account
 		data["verificationCode"] = params.VerificationCode
@@ -79,6 +84,7 @@ func Verify(c *gin.Context) {
 		return
 	} else {
 		log.Info(params.OperationID, "Verification code error", account, params.VerificationCode)
+		addLoginFailed(verifyLockKey(account), operationID)
 		data := make(map[string]interface{})
 		data["account"] = account
 		c.JSON(http.StatusOK, gin.H{"errCode": constant.CodeInvalidOrExpired, "errMsg": "Verification code error!", "data": data})
@@ -2,16 +2,16 @@ package gate
 
 import (
 	cbApi "Open_IM/pkg/call_back_struct"
+	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
-	"Open_IM/pkg/common/http"
 	http2 "net/http"
 	"time"
 )
 
 func callbackUserOnline(operationID, userID string, platformID int, token string, isAppBackground bool, connID string) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackUserOnline.Enable {
+	if !config.Current().Callback.CallbackUserOnline.Enable {
 		return callbackResp
 	}
 	callbackUserOnlineReq := cbApi.CallbackUserOnlineReq{
@@ -30,7 +30,7 @@ func callbackUserOnline(operationID, userID string, platformID int, token string
 		ConnID:          connID,
 	}
 	callbackUserOnlineResp := &cbApi.CallbackUserOnlineResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackUserOnlineCommand, callbackUserOnlineReq, callbackUserOnlineResp, config.Config.Callback.CallbackUserOnline.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackUserOnlineCommand, callbackUserOnlineReq, callbackUserOnlineResp, config.Current().Callback.CallbackUserOnline.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
 	}
@@ -39,7 +39,7 @@ func callbackUserOnline(operationID, userID string, platformID int, token string
 
 func callbackUserOffline(operationID, userID string, platformID int, connID string) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackUserOffline.Enable {
+	if !config.Current().Callback.CallbackUserOffline.Enable {
 		return callbackResp
 	}
 	callbackOfflineReq := cbApi.CallbackUserOfflineReq{
@@ -56,7 +56,7 @@ func callbackUserOffline(operationID, userID string, platformID int, connID stri
 		ConnID: connID,
 	}
 	callbackUserOfflineResp := &cbApi.CallbackUserOfflineResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackUserOfflineCommand, callbackOfflineReq, callbackUserOfflineResp, config.Config.Callback.CallbackUserOffline.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackUserOfflineCommand, callbackOfflineReq, callbackUserOfflineResp, config.Current().Callback.CallbackUserOffline.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
 	}
@@ -65,7 +65,7 @@ func callbackUserOffline(operationID, userID string, platformID int, connID stri
 
 func callbackUserKickOff(operationID string, userID string, platformID int) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackUserKickOff.Enable {
+	if !config.Current().Callback.CallbackUserKickOff.Enable {
 		return callbackResp
 	}
 	callbackUserKickOffReq := cbApi.CallbackUserKickOffReq{
@@ -81,7 +81,7 @@ func callbackUserKickOff(operationID string, userID string, platformID int) cbAp
 		Seq: int(time.Now().UnixNano() / 1e6),
 	}
 	callbackUserKickOffResp := &cbApi.CallbackUserKickOffResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackUserKickOffCommand, callbackUserKickOffReq, callbackUserKickOffResp, config.Config.Callback.CallbackUserOffline.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackUserKickOffCommand, callbackUserKickOffReq, callbackUserKickOffResp, config.Current().Callback.CallbackUserOffline.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
 	}
@@ -6,6 +6,7 @@ import (
 	"Open_IM/pkg/common/db"
 	"Open_IM/pkg/common/log"
 	promePkg "Open_IM/pkg/common/prometheus"
+	"Open_IM/pkg/common/token_verify"
 	"Open_IM/pkg/grpc-etcdv3/getcdv3"
 	pbChat "Open_IM/pkg/proto/msg"
 	push "Open_IM/pkg/proto/push"
@@ -186,6 +187,10 @@ func (ws *WServer) pullMsgBySeqListResp(conn *UserConn, m *Req, pb *sdk_ws.PullM
 }
 func (ws *WServer) userLogoutReq(conn *UserConn, m *Req) {
 	log.NewInfo(m.OperationID, "Ws call success to userLogoutReq start", m.SendID, m.ReqIdentifier, m.MsgIncr, string(m.Data))
+	// the refresh token of the logged out session must not mint new access tokens
+	if err := token_verify.RevokeAccessTokenFamily(m.SendID, int(conn.PlatformID), conn.token); err != nil {
+		log.NewError(m.OperationID, "RevokeAccessTokenFamily failed", err.Error(), m.SendID, conn.PlatformID)
+	}
 
 	rpcReq := push.DelUserPushTokenReq{}
 	rpcReq.UserID = m.SendID
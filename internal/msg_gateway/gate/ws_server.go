@@ -5,6 +5,7 @@ import (
 	"Open_IM/pkg/common/constant"
 	"Open_IM/pkg/common/db"
 	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/multi_terminal_login"
 	promePkg "Open_IM/pkg/common/prometheus"
 	"Open_IM/pkg/common/token_verify"
 	"Open_IM/pkg/grpc-etcdv3/getcdv3"
@@ -15,6 +16,7 @@ import (
 	"context"
 	"encoding/gob"
 	"io/ioutil"
+	"net"
 	"strconv"
 	"strings"
 
@@ -192,6 +194,11 @@ func (ws *WServer) MultiTerminalLoginCheckerWithLock(uid string, platformID int,
 	rwLock.Lock()
 	defer rwLock.Unlock()
 	log.NewInfo(operationID, utils.GetSelfFuncName(), " rpc args: ", uid, platformID, token)
+	// the policy was applied where the token logged in or connected, a check without token comes from a revoked session
+	if multi_terminal_login.IsEnabled() || token == "" {
+		ws.closeEndedConns(uid, operationID)
+		return
+	}
 	switch config.Config.MultiLoginPolicy {
 	case constant.DefalutNotKick:
 	case constant.PCAndOther:
@@ -247,6 +254,21 @@ func (ws *WServer) MultiTerminalLoginCheckerWithLock(uid string, platformID int,
 }
 
 func (ws *WServer) MultiTerminalLoginChecker(uid string, platformID int, newConn *UserConn, token string, operationID string) {
+	if multi_terminal_login.IsEnabled() {
+		ip, _, err := net.SplitHostPort(newConn.RemoteAddr().String())
+		if err != nil {
+			ip = newConn.RemoteAddr().String()
+		}
+		ended, err := multi_terminal_login.Connect(uid, platformID, token, ip, operationID)
+		if err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "multi_terminal_login.Connect failed", err.Error(), uid, platformID)
+			return
+		}
+		if len(ended) != 0 {
+			ws.closeEndedConns(uid, operationID)
+		}
+		return
+	}
 	switch config.Config.MultiLoginPolicy {
 	case constant.DefalutNotKick:
 	case constant.PCAndOther:
@@ -304,6 +326,46 @@ func (ws *WServer) MultiTerminalLoginChecker(uid string, platformID int, newConn
 	case constant.WebAndOther:
 	}
 }
+
+// closeEndedConns closes the connections whose token was kicked or revoked, the caller holds rwLock
+func (ws *WServer) closeEndedConns(uid string, operationID string) {
+	oldConnMap, ok := ws.wsUserToConn[uid]
+	if !ok {
+		return
+	}
+	for platformID, conns := range oldConnMap {
+		m, err := db.DB.GetTokenMapByUidPid(uid, constant.PlatformIDToName(platformID))
+		if err != nil && err != go_redis.Nil {
+			log.NewError(operationID, "get token from redis err", err.Error(), uid, constant.PlatformIDToName(platformID))
+			continue
+		}
+		var remainConns []*UserConn
+		for _, conn := range conns {
+			if status, ok := m[conn.token]; ok && status != constant.NormalToken {
+				log.NewDebug(operationID, uid, platformID, "kick ended conn", status)
+				ws.sendKickMsg(conn, operationID)
+				continue
+			}
+			remainConns = append(remainConns, conn)
+		}
+		if len(remainConns) == len(conns) {
+			continue
+		}
+		if len(remainConns) == 0 {
+			delete(oldConnMap, platformID)
+		} else {
+			oldConnMap[platformID] = remainConns
+		}
+		callbackResp := callbackUserKickOff(operationID, uid, platformID)
+		if callbackResp.ErrCode != 0 {
+			log.NewError(operationID, utils.GetSelfFuncName(), "callbackUserKickOff failed", callbackResp)
+		}
+	}
+	if len(oldConnMap) == 0 {
+		delete(ws.wsUserToConn, uid)
+	}
+}
+
 func (ws *WServer) sendKickMsg(oldConn *UserConn, operationID string) {
 	mReply := Resp{
 		ReqIdentifier: constant.WSKickOnlineMsg,
@@ -337,8 +399,8 @@ func (ws *WServer) addUserConn(uid string, platformID int, conn *UserConn, token
 	if callbackResp.ErrCode != 0 {
 		log.NewError(operationID, utils.GetSelfFuncName(), "callbackUserOnline resp:", callbackResp)
 	}
-	go ws.MultiTerminalLoginRemoteChecker(uid, int32(platformID), token, operationID)
 	ws.MultiTerminalLoginChecker(uid, platformID, conn, token, operationID)
+	go ws.MultiTerminalLoginRemoteChecker(uid, int32(platformID), token, operationID)
 	if oldConnMap, ok := ws.wsUserToConn[uid]; ok {
 		if conns, ok := oldConnMap[platformID]; ok {
 			conns = append(conns, conn)
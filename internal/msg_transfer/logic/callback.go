@@ -5,7 +5,6 @@ import (
 	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
-	"Open_IM/pkg/common/http"
 	"Open_IM/pkg/common/log"
 	pbChat "Open_IM/pkg/proto/msg"
 	"Open_IM/pkg/utils"
@@ -14,7 +13,7 @@ import (
 
 func callbackAfterConsumeGroupMsg(msg []*pbChat.MsgDataToMQ, triggerID string) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: triggerID}
-	if !config.Config.Callback.CallbackAfterConsumeGroupMsg.Enable {
+	if !config.Current().Callback.CallbackAfterConsumeGroupMsg.Enable {
 		return callbackResp
 	}
 	for _, v := range msg {
@@ -27,7 +26,7 @@ func callbackAfterConsumeGroupMsg(msg []*pbChat.MsgDataToMQ, triggerID string) c
 			}
 			resp := &cbApi.CallbackAfterConsumeGroupMsgResp{CommonCallbackResp: &callbackResp}
 			defer log.NewDebug(triggerID, utils.GetSelfFuncName(), req, *resp)
-			if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackAfterConsumeGroupMsgCommand, req, resp, config.Config.Callback.CallbackAfterConsumeGroupMsg.CallbackTimeOut); err != nil {
+			if err := callback.Post(constant.CallbackAfterConsumeGroupMsgCommand, req, resp, config.Current().Callback.CallbackAfterConsumeGroupMsg.CallbackTimeOut); err != nil {
 				callbackResp.ErrCode = http2.StatusInternalServerError
 				callbackResp.ErrMsg = err.Error()
 				return callbackResp
@@ -26,6 +26,7 @@ var (
 	producer              *kafka.Producer
 	producerToModify      *kafka.Producer
 	producerToMongo       *kafka.Producer
+	deadLetterProducer    *kafka.DeadLetterProducer
 	cmdCh                 chan Cmd2Value
 	onlineTopicStatus     int
 	w                     *sync.Mutex
@@ -53,6 +54,7 @@ func Init() {
 	producer = kafka.NewKafkaProducer(config.Config.Kafka.Ms2pschat.Addr, config.Config.Kafka.Ms2pschat.Topic)
 	producerToModify = kafka.NewKafkaProducer(config.Config.Kafka.MsgToModify.Addr, config.Config.Kafka.MsgToModify.Topic)
 	producerToMongo = kafka.NewKafkaProducer(config.Config.Kafka.MsgToMongo.Addr, config.Config.Kafka.MsgToMongo.Topic)
+	deadLetterProducer = kafka.NewDeadLetterProducer()
 }
 func Run(promethuesPort int) {
 	//register mysqlConsumerHandler to
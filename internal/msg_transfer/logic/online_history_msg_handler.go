@@ -23,12 +23,41 @@ type MsgChannelValue struct {
 	aggregationID string //maybe userID or super groupID
 	triggerID     string
 	msgList       []*pbMsg.MsgDataToMQ
+	cMsgList      []*sarama.ConsumerMessage
+	batchOffset   int64
 	lastSeq       uint64
+	result        *batchResult
 }
 type TriggerChannelValue struct {
-	triggerID string
-	cmsgList  []*sarama.ConsumerMessage
+	triggerID   string
+	cmsgList    []*sarama.ConsumerMessage
+	batchOffset int64
+	result      *batchResult
 }
+
+// batchResult collects the storage results of every aggregation split from one consumed batch
+type batchResult struct {
+	wg  sync.WaitGroup
+	mu  sync.Mutex
+	err error
+}
+
+func (b *batchResult) done(err error) {
+	if err != nil {
+		b.mu.Lock()
+		b.err = err
+		b.mu.Unlock()
+	}
+	b.wg.Done()
+}
+
+func (b *batchResult) wait() error {
+	b.wg.Wait()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.err
+}
+
 type fcb func(cMsg *sarama.ConsumerMessage, msgKey string, sess sarama.ConsumerGroupSession)
 type Cmd2Value struct {
 	Cmd   int
@@ -67,12 +96,19 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 			switch cmd.Cmd {
 			case AggregationMessages:
 				msgChannelValue := cmd.Value.(MsgChannelValue)
-				msgList := msgChannelValue.msgList
 				triggerID := msgChannelValue.triggerID
+				msgList, err := filterStoredMsgs(msgChannelValue, triggerID)
+				if err != nil {
+					if msgChannelValue.result != nil {
+						msgChannelValue.result.done(err)
+					}
+					continue
+				}
 				storageMsgList := make([]*pbMsg.MsgDataToMQ, 0, 80)
 				notStoragePushMsgList := make([]*pbMsg.MsgDataToMQ, 0, 80)
 				log.Debug(triggerID, "msg arrived channel", "channel id", channelID, msgList, msgChannelValue.aggregationID, len(msgList))
 				var modifyMsgList []*pbMsg.MsgDataToMQ
+				var storageErr error
 				for _, v := range msgList {
 					log.Debug(triggerID, "msg come to storage center", v.String())
 					isHistory := utils.GetSwitchFromOptions(v.MsgData.Options, constant.IsHistory)
@@ -107,6 +143,8 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 					if err != nil {
 						singleMsgFailedCount += uint64(len(storageMsgList))
 						log.NewError(triggerID, "single data insert to redis err", err.Error(), storageMsgList)
+						// keep consuming once the aggregation is captured in the dead letter topic, otherwise consume it again later
+						storageErr = deadLetterAggregation(msgChannelValue.cMsgList, err, triggerID)
 					} else {
 						singleMsgSuccessCountMutex.Lock()
 						singleMsgSuccessCount += uint64(len(storageMsgList))
@@ -115,7 +153,7 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 						if callbackResp.ErrCode != 0 {
 							log.NewError(triggerID, utils.GetSelfFuncName(), "callbackAfterConsumeGroupMsg resp: ", callbackResp)
 						}
-						och.SendMessageToMongoCH(msgChannelValue.aggregationID, triggerID, storageMsgList, lastSeq)
+						storageErr = och.SendMessageToMongoCH(msgChannelValue.aggregationID, triggerID, storageMsgList, lastSeq)
 
 						for _, v := range storageMsgList {
 							sendMessageToPushMQ(v, msgChannelValue.aggregationID)
@@ -130,16 +168,73 @@ func (och *OnlineHistoryRedisConsumerHandler) Run(channelID int) {
 						sendMessageToPushMQ(x, msgChannelValue.aggregationID)
 					}
 				}
+				if storageErr == nil {
+					markStoredMsgs(msgChannelValue, triggerID)
+				}
+				if msgChannelValue.result != nil {
+					msgChannelValue.result.done(storageErr)
+				}
 			}
 		}
 	}
 }
 
-func (och *OnlineHistoryRedisConsumerHandler) SendMessageToMongoCH(aggregationID string, triggerID string, messages []*pbMsg.MsgDataToMQ, lastSeq uint64) {
+// storedMsgExpire covers the time a failed batch takes to be consumed again
+const storedMsgExpire = time.Hour
+
+// filterStoredMsgs drops the messages an earlier delivery of the batch already stored, a batch is handled again
+// when any of its aggregations failed and the others must not get new seqs or be pushed twice.
+// The batch is redelivered from the same first offset, so the stored offsets are recorded per batch and aggregation.
+func filterStoredMsgs(msgChannelValue MsgChannelValue, triggerID string) ([]*pbMsg.MsgDataToMQ, error) {
+	cMsgList := msgChannelValue.cMsgList
+	if len(cMsgList) == 0 {
+		return msgChannelValue.msgList, nil
+	}
+	storedOffset, err := db.DB.GetTransferStoredOffset(cMsgList[0].Topic, cMsgList[0].Partition, msgChannelValue.batchOffset, msgChannelValue.aggregationID)
+	if err != nil {
+		log.NewError(triggerID, "GetTransferStoredOffset failed", msgChannelValue.aggregationID, err.Error())
+		return nil, err
+	}
+	if storedOffset < cMsgList[0].Offset {
+		return msgChannelValue.msgList, nil
+	}
+	notStored := make([]*pbMsg.MsgDataToMQ, 0, len(cMsgList))
+	for i, cMsg := range cMsgList {
+		if cMsg.Offset > storedOffset {
+			notStored = append(notStored, msgChannelValue.msgList[i])
+		}
+	}
+	log.NewWarn(triggerID, "skip messages stored by an earlier delivery", msgChannelValue.aggregationID, len(cMsgList)-len(notStored))
+	return notStored, nil
+}
+
+// deadLetterAggregation captures the raw messages of an aggregation that could not be stored
+func deadLetterAggregation(cMsgList []*sarama.ConsumerMessage, reason error, triggerID string) error {
+	for _, cMsg := range cMsgList {
+		if err := deadLetterProducer.Send(cMsg, "OnlineHistoryRedisConsumerHandler.saveUserChatList", reason, triggerID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func markStoredMsgs(msgChannelValue MsgChannelValue, triggerID string) {
+	cMsgList := msgChannelValue.cMsgList
+	if len(cMsgList) == 0 {
+		return
+	}
+	last := cMsgList[len(cMsgList)-1]
+	if err := db.DB.SetTransferStoredOffset(last.Topic, last.Partition, msgChannelValue.batchOffset, msgChannelValue.aggregationID, last.Offset, storedMsgExpire); err != nil {
+		log.NewError(triggerID, "SetTransferStoredOffset failed", msgChannelValue.aggregationID, err.Error())
+	}
+}
+
+func (och *OnlineHistoryRedisConsumerHandler) SendMessageToMongoCH(aggregationID string, triggerID string, messages []*pbMsg.MsgDataToMQ, lastSeq uint64) error {
 	if len(messages) > 0 {
 		pid, offset, err := producerToMongo.SendMessage(&pbMsg.MsgDataToMongoByMQ{LastSeq: lastSeq, AggregationID: aggregationID, MessageList: messages, TriggerID: triggerID}, aggregationID, triggerID)
 		if err != nil {
 			log.Error(triggerID, "kafka send failed", "send data", len(messages), "pid", pid, "offset", offset, "err", err.Error(), "key", aggregationID)
+			return err
 		} else {
 			//	log.NewWarn(m.OperationID, "sendMsgToKafka   client msgID ", m.MsgData.ClientMsgID)
 		}
@@ -149,6 +244,7 @@ func (och *OnlineHistoryRedisConsumerHandler) SendMessageToMongoCH(aggregationID
 	//log.Debug(triggerID, "generate channelID", hashCode, channelID, aggregationID)
 	////go func(cID uint32, userID string, messages []*pbMsg.MsgDataToMQ) {
 	//och.chMongoArrays[channelID] <- Cmd2Value{Cmd: MongoMessages, Value: MsgChannelValue{aggregationID: aggregationID, msgList: messages, triggerID: triggerID, lastSeq: lastSeq}}
+	return nil
 }
 
 //func (och *OnlineHistoryRedisConsumerHandler) MongoMessageRun(channelID int) {
@@ -194,6 +290,7 @@ func (och *OnlineHistoryRedisConsumerHandler) SendMessageToMongoCH(aggregationID
 func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 	for {
 		aggregationMsgs := make(map[string][]*pbMsg.MsgDataToMQ, ChannelNum)
+		aggregationCMsgs := make(map[string][]*sarama.ConsumerMessage, ChannelNum)
 		select {
 		case cmd := <-och.msgDistributionCh:
 			switch cmd.Cmd {
@@ -201,6 +298,7 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 				triggerChannelValue := cmd.Value.(TriggerChannelValue)
 				triggerID := triggerChannelValue.triggerID
 				consumerMessages := triggerChannelValue.cmsgList
+				result := triggerChannelValue.result
 				//Aggregation map[userid]message list
 				log.Debug(triggerID, "batch messages come to distribution center", len(consumerMessages))
 				for i := 0; i < len(consumerMessages); i++ {
@@ -208,9 +306,11 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 					err := proto.Unmarshal(consumerMessages[i].Value, &msgFromMQ)
 					if err != nil {
 						log.Error(triggerID, "msg_transfer Unmarshal msg err", "msg", string(consumerMessages[i].Value), "err", err.Error())
-						return
+						deadLetterProducer.Send(consumerMessages[i], "MessagesDistributionHandle", err, triggerID)
+						continue
 					}
 					log.Debug(triggerID, "single msg come to distribution center", msgFromMQ.String(), string(consumerMessages[i].Key))
+					aggregationCMsgs[string(consumerMessages[i].Key)] = append(aggregationCMsgs[string(consumerMessages[i].Key)], consumerMessages[i])
 					if oldM, ok := aggregationMsgs[string(consumerMessages[i].Key)]; ok {
 						oldM = append(oldM, &msgFromMQ)
 						aggregationMsgs[string(consumerMessages[i].Key)] = oldM
@@ -221,16 +321,23 @@ func (och *OnlineHistoryRedisConsumerHandler) MessagesDistributionHandle() {
 					}
 				}
 				log.Debug(triggerID, "generate map list users len", len(aggregationMsgs))
+				if result != nil {
+					result.wg.Add(len(aggregationMsgs))
+				}
 				for aggregationID, v := range aggregationMsgs {
 					if len(v) >= 0 {
 						hashCode := getHashCode(aggregationID)
 						channelID := hashCode % ChannelNum
 						log.Debug(triggerID, "generate channelID", hashCode, channelID, aggregationID)
 						//go func(cID uint32, userID string, messages []*pbMsg.MsgDataToMQ) {
-						och.chArrays[channelID] <- Cmd2Value{Cmd: AggregationMessages, Value: MsgChannelValue{aggregationID: aggregationID, msgList: v, triggerID: triggerID}}
+						och.chArrays[channelID] <- Cmd2Value{Cmd: AggregationMessages, Value: MsgChannelValue{aggregationID: aggregationID, msgList: v, cMsgList: aggregationCMsgs[aggregationID],
+							batchOffset: triggerChannelValue.batchOffset, triggerID: triggerID, result: result}}
 						//}(channelID, userID, v)
 					}
 				}
+				if result != nil {
+					result.done(nil)
+				}
 			}
 		}
 
@@ -349,8 +456,18 @@ func (och *OnlineHistoryRedisConsumerHandler) handleChatWs2MongoLowReliability(c
 	}
 }
 
-func (OnlineHistoryRedisConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+func (OnlineHistoryRedisConsumerHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	log.NewInfo("", "online history consumer session setup", sess.MemberID(), sess.GenerationID(), sess.Claims())
+	return nil
+}
+
+// Cleanup runs after every ConsumeClaim has flushed its pending batch,
+// commit the marked offsets now so the next owner of the partitions does not consume them again
+func (OnlineHistoryRedisConsumerHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	log.NewInfo("", "online history consumer session cleanup", sess.MemberID(), sess.GenerationID(), sess.Claims())
+	sess.Commit()
+	return nil
+}
 
 //func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
 //	claim sarama.ConsumerGroupClaim) error { // a instance in the consumer group
@@ -371,96 +488,41 @@ func (OnlineHistoryRedisConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession)
 
 func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
 	claim sarama.ConsumerGroupClaim) error { // a instance in the consumer group
-
-	for {
-		if sess == nil {
-			log.NewWarn("", " sess == nil, waiting ")
-			time.Sleep(100 * time.Millisecond)
-		} else {
-			break
-		}
-	}
-	rwLock := new(sync.RWMutex)
 	log.NewDebug("", "online new session msg come", claim.HighWaterMarkOffset(), claim.Topic(), claim.Partition())
-	cMsg := make([]*sarama.ConsumerMessage, 0, 1000)
-	t := time.NewTicker(time.Duration(100) * time.Millisecond)
-	var triggerID string
-	go func() {
-		for {
-			select {
-			//case :
-			//	triggerID = utils.OperationIDGenerator()
-			//
-			//	log.NewDebug(triggerID, "claim.Messages ", msg)
-			//	cMsg = append(cMsg, msg)
-			//	if len(cMsg) >= 1000 {
-			//		ccMsg := make([]*sarama.ConsumerMessage, 0, 1000)
-			//		for _, v := range cMsg {
-			//			ccMsg = append(ccMsg, v)
-			//		}
-			//		log.Debug(triggerID, "length trigger msg consumer start", len(ccMsg))
-			//		och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
-			//			triggerID: triggerID, cmsgList: ccMsg}}
-			//		sess.MarkMessage(msg, "")
-			//		cMsg = make([]*sarama.ConsumerMessage, 0, 1000)
-			//		log.Debug(triggerID, "length trigger msg consumer end", len(cMsg))
-			//	}
-
-			case <-t.C:
-				if len(cMsg) > 0 {
-					rwLock.Lock()
-					ccMsg := make([]*sarama.ConsumerMessage, 0, 1000)
-					for _, v := range cMsg {
-						ccMsg = append(ccMsg, v)
-					}
-					cMsg = make([]*sarama.ConsumerMessage, 0, 1000)
-					rwLock.Unlock()
-					split := 1000
-					triggerID = utils.OperationIDGenerator()
-					log.Debug(triggerID, "timer trigger msg consumer start", len(ccMsg))
-					for i := 0; i < len(ccMsg)/split; i++ {
-						//log.Debug()
-						och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
-							triggerID: triggerID, cmsgList: ccMsg[i*split : (i+1)*split]}}
-					}
-					if (len(ccMsg) % split) > 0 {
-						och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
-							triggerID: triggerID, cmsgList: ccMsg[split*(len(ccMsg)/split):]}}
-					}
-					//sess.MarkMessage(ccMsg[len(cMsg)-1], "")
-
-					log.Debug(triggerID, "timer trigger msg consumer end", len(cMsg))
-				}
-			}
-		}
+	err := kfk.ConsumeClaimInBatches(sess, claim, 1000, time.Duration(100)*time.Millisecond, och.handleBatch)
+	if err != nil {
+		log.NewError("", "online history consume claim stopped", claim.Topic(), claim.Partition(), err.Error())
+	}
+	return err
+}
 
-	}()
-	for msg := range claim.Messages() {
-		//msgFromMQ := pbMsg.MsgDataToMQ{}
-		//err := proto.Unmarshal(msg.Value, &msgFromMQ)
-		//if err != nil {
-		//	log.Error(triggerID, "msg_transfer Unmarshal msg err", "msg", string(msg.Value), "err", err.Error())
-		//}
-		//userID := string(msg.Key)
-		//hashCode := getHashCode(userID)
-		//channelID := hashCode % ChannelNum
-		//log.Debug(triggerID, "generate channelID", hashCode, channelID, userID)
-		////go func(cID uint32, userID string, messages []*pbMsg.MsgDataToMQ) {
-		//och.chArrays[channelID] <- Cmd2Value{Cmd: UserMessages, Value: MsgChannelValue{userID: userID, msgList: []*pbMsg.MsgDataToMQ{&msgFromMQ}, triggerID: msgFromMQ.OperationID}}
-		//sess.MarkMessage(msg, "")
-		rwLock.Lock()
+// handleBatch distributes the batch to the storage channels and waits until every aggregation is stored
+func (och *OnlineHistoryRedisConsumerHandler) handleBatch(msgs []*sarama.ConsumerMessage) error {
+	cMsg := make([]*sarama.ConsumerMessage, 0, len(msgs))
+	for _, msg := range msgs {
 		if len(msg.Value) != 0 {
 			cMsg = append(cMsg, msg)
 		}
-		rwLock.Unlock()
-		sess.MarkMessage(msg, "")
-		//och.TriggerCmd(OnlineTopicBusy)
-
-		//log.NewDebug("", "online kafka get info to mongo", "msgTopic", msg.Topic, "msgPartition", msg.Partition, "online", msg.Offset, claim.HighWaterMarkOffset())
-
 	}
-
-	return nil
+	if len(cMsg) == 0 {
+		return nil
+	}
+	triggerID := utils.OperationIDGenerator()
+	log.Debug(triggerID, "batch trigger msg consumer start", len(cMsg))
+	result := &batchResult{}
+	split := 1000
+	for i := 0; i < len(cMsg); i += split {
+		end := i + split
+		if end > len(cMsg) {
+			end = len(cMsg)
+		}
+		result.wg.Add(1)
+		och.msgDistributionCh <- Cmd2Value{Cmd: ConsumerMsgs, Value: TriggerChannelValue{
+			triggerID: triggerID, cmsgList: cMsg[i:end], batchOffset: msgs[0].Offset, result: result}}
+	}
+	err := result.wait()
+	log.Debug(triggerID, "batch trigger msg consumer end", len(cMsg), err)
+	return err
 }
 
 //func (och *OnlineHistoryRedisConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
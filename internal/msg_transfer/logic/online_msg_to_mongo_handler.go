@@ -13,36 +13,41 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
+// efcb handles a message and reports whether it is stored, the offset is marked only on success
+type efcb func(cMsg *sarama.ConsumerMessage, msgKey string) error
+
 type OnlineHistoryMongoConsumerHandler struct {
-	msgHandle            map[string]fcb
+	msgHandle            map[string]efcb
 	historyConsumerGroup *kfk.MConsumerGroup
 }
 
 func (och *OnlineHistoryMongoConsumerHandler) Init() {
-	och.msgHandle = make(map[string]fcb)
+	och.msgHandle = make(map[string]efcb)
 	och.msgHandle[config.Config.Kafka.MsgToMongo.Topic] = och.handleChatWs2Mongo
 	och.historyConsumerGroup = kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{KafkaVersion: sarama.V2_0_0_0,
 		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false}, []string{config.Config.Kafka.MsgToMongo.Topic},
 		config.Config.Kafka.Ws2mschat.Addr, config.Config.Kafka.ConsumerGroupID.MsgToMongo)
 
 }
-func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(cMsg *sarama.ConsumerMessage, msgKey string, _ sarama.ConsumerGroupSession) {
+func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(cMsg *sarama.ConsumerMessage, msgKey string) error {
 	msg := cMsg.Value
 	msgFromMQ := pbMsg.MsgDataToMongoByMQ{}
 	err := proto.Unmarshal(msg, &msgFromMQ)
 	if err != nil {
 		log.Error("msg_transfer Unmarshal msg err", "", "msg", string(msg), "err", err.Error())
-		return
+		deadLetterProducer.Send(cMsg, "OnlineHistoryMongoConsumerHandler.handleChatWs2Mongo", err, "")
+		return nil
 	}
 	log.Info(msgFromMQ.TriggerID, "BatchInsertChat2DB userID: ", msgFromMQ.AggregationID, "msgFromMQ.LastSeq: ", msgFromMQ.LastSeq)
 	err = db.DB.BatchInsertChat2DB(msgFromMQ.AggregationID, msgFromMQ.MessageList, msgFromMQ.TriggerID, msgFromMQ.LastSeq)
 	if err != nil {
 		log.NewError(msgFromMQ.TriggerID, "single data insert to mongo err", err.Error(), msgFromMQ.MessageList, msgFromMQ.AggregationID, msgFromMQ.TriggerID)
-	} else {
-		err = db.DB.DeleteMessageFromCache(msgFromMQ.MessageList, msgFromMQ.AggregationID, msgFromMQ.GetTriggerID())
-		if err != nil {
-			log.NewError(msgFromMQ.TriggerID, "remove cache msg from redis  err", err.Error(), msgFromMQ.MessageList, msgFromMQ.AggregationID, msgFromMQ.TriggerID)
-		}
+		// keep consuming once the message is captured in the dead letter topic, otherwise consume it again later
+		return deadLetterProducer.Send(cMsg, "OnlineHistoryMongoConsumerHandler.handleChatWs2Mongo", err, msgFromMQ.TriggerID)
+	}
+	err = db.DB.DeleteMessageFromCache(msgFromMQ.MessageList, msgFromMQ.AggregationID, msgFromMQ.GetTriggerID())
+	if err != nil {
+		log.NewError(msgFromMQ.TriggerID, "remove cache msg from redis  err", err.Error(), msgFromMQ.MessageList, msgFromMQ.AggregationID, msgFromMQ.TriggerID)
 	}
 	for _, v := range msgFromMQ.MessageList {
 		if v.MsgData.ContentType == constant.DeleteMessageNotification {
@@ -63,10 +68,15 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(cMsg *sarama.Con
 			}
 		}
 	}
+	return nil
 }
 
-func (OnlineHistoryMongoConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error   { return nil }
-func (OnlineHistoryMongoConsumerHandler) Cleanup(_ sarama.ConsumerGroupSession) error { return nil }
+func (OnlineHistoryMongoConsumerHandler) Setup(_ sarama.ConsumerGroupSession) error { return nil }
+
+func (OnlineHistoryMongoConsumerHandler) Cleanup(sess sarama.ConsumerGroupSession) error {
+	sess.Commit()
+	return nil
+}
 
 func (och *OnlineHistoryMongoConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
 	claim sarama.ConsumerGroupClaim) error { // a instance in the consumer group
@@ -74,7 +84,10 @@ func (och *OnlineHistoryMongoConsumerHandler) ConsumeClaim(sess sarama.ConsumerG
 	for msg := range claim.Messages() {
 		log.NewDebug("", "kafka get info to mongo", "msgTopic", msg.Topic, "msgPartition", msg.Partition, "msg", string(msg.Value), "key", string(msg.Key))
 		if len(msg.Value) != 0 {
-			och.msgHandle[msg.Topic](msg, string(msg.Key), sess)
+			if err := och.msgHandle[msg.Topic](msg, string(msg.Key)); err != nil {
+				// leave the offset unmarked, the message is consumed again after the session is rejoined
+				return err
+			}
 		} else {
 			log.Error("", "mongo msg get from kafka but is nil", msg.Key)
 		}
@@ -54,6 +54,7 @@ func (pc *PersistentConsumerHandler) handleChatWs2Mysql(cMsg *sarama.ConsumerMes
 	err := proto.Unmarshal(msg, &msgFromMQ)
 	if err != nil {
 		log.NewError(msgFromMQ.OperationID, "msg_transfer Unmarshal msg err", "msg", string(msg), "err", err.Error())
+		deadLetterProducer.Send(cMsg, "PersistentConsumerHandler.handleChatWs2Mysql", err, "")
 		return
 	}
 	log.Debug(msgFromMQ.OperationID, "proto.Unmarshal MsgDataToMQ", msgFromMQ.String())
@@ -51,7 +51,7 @@ func (j *JPush) Push(accounts []string, title, detailContent, operationID string
 	var me requestBody.Message
 	me.SetMsgContent(detailContent)
 	var o requestBody.Options
-	o.SetApnsProduction(config.Config.IOSPush.Production)
+	o.SetApnsProduction(config.Current().IOSPush.Production)
 	var po requestBody.PushObj
 	po.SetPlatform(&pf)
 	po.SetAudience(&au)
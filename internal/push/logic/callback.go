@@ -5,7 +5,6 @@ import (
 	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
-	"Open_IM/pkg/common/http"
 	"Open_IM/pkg/common/log"
 	commonPb "Open_IM/pkg/proto/sdk_ws"
 	"Open_IM/pkg/utils"
@@ -14,7 +13,7 @@ import (
 
 func callbackOfflinePush(operationID string, userIDList []string, msg *commonPb.MsgData, offlinePushUserIDList *[]string) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackOfflinePush.Enable {
+	if !config.Current().Callback.CallbackOfflinePush.Enable {
 		return callbackResp
 	}
 	req := cbApi.CallbackBeforePushReq{
@@ -37,10 +36,10 @@ func callbackOfflinePush(operationID string, userIDList []string, msg *commonPb.
 		Content:         callback.GetContent(msg),
 	}
 	resp := &cbApi.CallbackBeforePushResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackOfflinePushCommand, req, resp, config.Config.Callback.CallbackOfflinePush.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackOfflinePushCommand, req, resp, config.Current().Callback.CallbackOfflinePush.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackOfflinePush.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackOfflinePush.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
@@ -62,7 +61,7 @@ func callbackOfflinePush(operationID string, userIDList []string, msg *commonPb.
 
 func callbackOnlinePush(operationID string, userIDList []string, msg *commonPb.MsgData) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackOnlinePush.Enable || utils.IsContain(msg.SendID, userIDList) {
+	if !config.Current().Callback.CallbackOnlinePush.Enable || utils.IsContain(msg.SendID, userIDList) {
 		return callbackResp
 	}
 	req := cbApi.CallbackBeforePushReq{
@@ -85,10 +84,10 @@ func callbackOnlinePush(operationID string, userIDList []string, msg *commonPb.M
 		Content:      callback.GetContent(msg),
 	}
 	resp := &cbApi.CallbackBeforePushResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackOnlinePushCommand, req, resp, config.Config.Callback.CallbackOnlinePush.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackOnlinePushCommand, req, resp, config.Current().Callback.CallbackOnlinePush.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackOnlinePush.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackOnlinePush.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
@@ -107,7 +106,7 @@ func callbackOnlinePush(operationID string, userIDList []string, msg *commonPb.M
 func callbackBeforeSuperGroupOnlinePush(operationID string, groupID string, msg *commonPb.MsgData, pushToUserList *[]string) cbApi.CommonCallbackResp {
 	log.Debug(operationID, utils.GetSelfFuncName(), groupID, msg.String(), pushToUserList)
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackBeforeSuperGroupOnlinePush.Enable {
+	if !config.Current().Callback.CallbackBeforeSuperGroupOnlinePush.Enable {
 		return callbackResp
 	}
 	req := cbApi.CallbackBeforeSuperGroupOnlinePushReq{
@@ -128,10 +127,10 @@ func callbackBeforeSuperGroupOnlinePush(operationID string, groupID string, msg
 		Seq:          msg.Seq,
 	}
 	resp := &cbApi.CallbackBeforeSuperGroupOnlinePushResp{CommonCallbackResp: &callbackResp}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackSuperGroupOnlinePushCommand, req, resp, config.Config.Callback.CallbackBeforeSuperGroupOnlinePush.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackSuperGroupOnlinePushCommand, req, resp, config.Current().Callback.CallbackBeforeSuperGroupOnlinePush.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackBeforeSuperGroupOnlinePush.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackBeforeSuperGroupOnlinePush.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
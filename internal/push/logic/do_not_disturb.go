@@ -0,0 +1,119 @@
+package logic
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	commonPb "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"time"
+)
+
+const conversationMuteUntilTimeExpire = 24 * time.Hour
+
+// remove users who are in their do not disturb period or have muted the conversation from the offline push list
+func filterDoNotDisturbUserIDList(operationID string, msg *commonPb.MsgData, userIDList []string) []string {
+	if len(userIDList) == 0 {
+		return userIDList
+	}
+	if config.Config.Push.DoNotDisturb.SignalOverride && msg.ContentType == constant.SignalingNotification {
+		return userIDList
+	}
+	var conversationID string
+	switch msg.SessionType {
+	case constant.SingleChatType, constant.NotificationChatType:
+		conversationID = utils.GetConversationIDBySessionType(msg.SendID, int(msg.SessionType))
+	default:
+		conversationID = utils.GetConversationIDBySessionType(msg.GroupID, int(msg.SessionType))
+	}
+	muteUntilTimeMap := getUsersConversationMuteUntilTime(operationID, userIDList, conversationID)
+	now := time.Now()
+	var pushUserIDList []string
+	for _, userID := range userIDList {
+		if config.Config.Push.DoNotDisturb.AtMentionOverride && isAtUser(msg, userID) {
+			pushUserIDList = append(pushUserIDList, userID)
+			continue
+		}
+		if muteUntilTime, ok := muteUntilTimeMap[userID]; ok && muteUntilTime > now.Unix() {
+			log.NewDebug(operationID, utils.GetSelfFuncName(), "conversation muted", userID, conversationID, muteUntilTime)
+			continue
+		}
+		if isInDoNotDisturbPeriod(operationID, userID, now) {
+			log.NewDebug(operationID, utils.GetSelfFuncName(), "in do not disturb period", userID)
+			continue
+		}
+		pushUserIDList = append(pushUserIDList, userID)
+	}
+	return pushUserIDList
+}
+
+// getUsersConversationMuteUntilTime reads the mute until time from redis and rebuilds the users missing there from the db
+func getUsersConversationMuteUntilTime(operationID string, userIDList []string, conversationID string) map[string]int64 {
+	muteUntilTimeMap, err := db.DB.GetUsersConversationMuteUntilTime(userIDList, conversationID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetUsersConversationMuteUntilTime failed", err.Error(), conversationID)
+		muteUntilTimeMap = make(map[string]int64)
+	}
+	var missUserIDList []string
+	for _, userID := range userIDList {
+		if _, ok := muteUntilTimeMap[userID]; !ok {
+			missUserIDList = append(missUserIDList, userID)
+		}
+	}
+	if len(missUserIDList) == 0 {
+		return muteUntilTimeMap
+	}
+	conversations, err := imdb.GetMultipleUserConversationByConversationID(missUserIDList, conversationID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetMultipleUserConversationByConversationID failed", err.Error(), conversationID)
+		return muteUntilTimeMap
+	}
+	// users without a conversation are cached as not muted too
+	rebuilt := make(map[string]int64, len(missUserIDList))
+	for _, userID := range missUserIDList {
+		rebuilt[userID] = 0
+	}
+	for _, conversation := range conversations {
+		rebuilt[conversation.OwnerUserID] = conversation.MuteUntilTime
+	}
+	if err := db.DB.SetUsersConversationMuteUntilTime(rebuilt, conversationID, conversationMuteUntilTimeExpire); err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "SetUsersConversationMuteUntilTime failed", err.Error(), conversationID)
+	}
+	for userID, muteUntilTime := range rebuilt {
+		muteUntilTimeMap[userID] = muteUntilTime
+	}
+	return muteUntilTimeMap
+}
+
+func isAtUser(msg *commonPb.MsgData, userID string) bool {
+	if msg.ContentType != constant.AtText {
+		return false
+	}
+	atUserIDList := msg.AtUserIDList
+	if len(atUserIDList) == 0 {
+		a := AtContent{}
+		_ = utils.JsonStringToStruct(string(msg.Content), &a)
+		atUserIDList = a.AtUserList
+	}
+	return utils.IsContain(userID, atUserIDList) || utils.IsContain(constant.AtAllString, atUserIDList)
+}
+
+func isInDoNotDisturbPeriod(operationID, userID string, now time.Time) bool {
+	doNotDisturb, err := rocksCache.GetUserDoNotDisturbFromCache(userID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "GetUserDoNotDisturbFromCache failed", err.Error(), userID)
+		return false
+	}
+	if !doNotDisturb.Enable {
+		return false
+	}
+	in, err := utils.IsInDailyPeriod(doNotDisturb.StartTime, doNotDisturb.EndTime, doNotDisturb.TimeZone, now)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "IsInDailyPeriod failed", err.Error(), *doNotDisturb)
+		return false
+	}
+	return in
+}
@@ -21,11 +21,12 @@ import (
 )
 
 var (
-	rpcServer     RPCServer
-	pushCh        PushConsumerHandler
-	producer      *kafka.Producer
-	offlinePusher pusher.OfflinePusher
-	successCount  uint64
+	rpcServer          RPCServer
+	pushCh             PushConsumerHandler
+	producer           *kafka.Producer
+	deadLetterProducer *kafka.DeadLetterProducer
+	offlinePusher      pusher.OfflinePusher
+	successCount       uint64
 )
 
 func Init(rpcPort int) {
@@ -35,6 +36,7 @@ func Init(rpcPort int) {
 }
 func init() {
 	producer = kafka.NewKafkaProducer(config.Config.Kafka.Ws2mschat.Addr, config.Config.Kafka.Ws2mschat.Topic)
+	deadLetterProducer = kafka.NewDeadLetterProducer()
 	statistics.NewStatistics(&successCount, config.Config.ModuleName.PushName, fmt.Sprintf("%d second push to msg_gateway count", constant.StatisticsTimeInterval), constant.StatisticsTimeInterval)
 	if *config.Config.Push.Getui.Enable {
 		offlinePusher = getui.GetuiClient
@@ -18,7 +18,7 @@ import (
 	"github.com/golang/protobuf/proto"
 )
 
-type fcb func(msg []byte)
+type fcb func(cMsg *sarama.ConsumerMessage)
 
 type PushConsumerHandler struct {
 	msgHandle         map[string]fcb
@@ -32,11 +32,13 @@ func (ms *PushConsumerHandler) Init() {
 		OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false}, []string{config.Config.Kafka.Ms2pschat.Topic}, config.Config.Kafka.Ms2pschat.Addr,
 		config.Config.Kafka.ConsumerGroupID.MsgToPush)
 }
-func (ms *PushConsumerHandler) handleMs2PsChat(msg []byte) {
+func (ms *PushConsumerHandler) handleMs2PsChat(cMsg *sarama.ConsumerMessage) {
+	msg := cMsg.Value
 	log.NewDebug("", "msg come from kafka  And push!!!", "msg", string(msg))
 	msgFromMQ := pbChat.PushMsgDataToMQ{}
 	if err := proto.Unmarshal(msg, &msgFromMQ); err != nil {
 		log.Error("", "push Unmarshal msg err", "msg", string(msg), "err", err.Error())
+		deadLetterProducer.Send(cMsg, "PushConsumerHandler.handleMs2PsChat", err, "")
 		return
 	}
 	pbData := &pbPush.PushMsgReq{
@@ -64,7 +66,7 @@ func (ms *PushConsumerHandler) ConsumeClaim(sess sarama.ConsumerGroupSession,
 	claim sarama.ConsumerGroupClaim) error {
 	for msg := range claim.Messages() {
 		log.NewDebug("", "kafka get info to mysql", "msgTopic", msg.Topic, "msgPartition", msg.Partition, "msg", string(msg.Value))
-		ms.msgHandle[msg.Topic](msg.Value)
+		ms.msgHandle[msg.Topic](msg)
 		sess.MarkMessage(msg, "")
 	}
 	return nil
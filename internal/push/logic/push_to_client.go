@@ -107,6 +107,11 @@ func MsgToUser(pushMsg *pbPush.PushMsgReq) {
 		if offlinePusher == nil {
 			return
 		}
+		UIDList = filterDoNotDisturbUserIDList(pushMsg.OperationID, pushMsg.MsgData, UIDList)
+		if len(UIDList) == 0 {
+			log.NewDebug(pushMsg.OperationID, utils.GetSelfFuncName(), "do not disturb, offlinePush stop")
+			return
+		}
 		opts, err := GetOfflinePushOpts(pushMsg)
 		if err != nil {
 			log.NewError(pushMsg.OperationID, utils.GetSelfFuncName(), "GetOfflinePushOpts failed", pushMsg, err.Error())
@@ -157,9 +162,9 @@ func MsgToUser(pushMsg *pbPush.PushMsgReq) {
 func MsgToSuperGroupUser(pushMsg *pbPush.PushMsgReq) {
 	var wsResult []*pbRelay.SingelMsgToUserResultList
 	isOfflinePush := utils.GetSwitchFromOptions(pushMsg.MsgData.Options, constant.IsOfflinePush)
-	log.Debug(pushMsg.OperationID, "Get super group msg from msg_transfer And push msg", pushMsg.String(), config.Config.Callback.CallbackBeforeSuperGroupOnlinePush.Enable)
+	log.Debug(pushMsg.OperationID, "Get super group msg from msg_transfer And push msg", pushMsg.String(), config.Current().Callback.CallbackBeforeSuperGroupOnlinePush.Enable)
 	var pushToUserIDList []string
-	if config.Config.Callback.CallbackBeforeSuperGroupOnlinePush.Enable {
+	if config.Current().Callback.CallbackBeforeSuperGroupOnlinePush.Enable {
 		callbackResp := callbackBeforeSuperGroupOnlinePush(pushMsg.OperationID, pushMsg.PushToUserID, pushMsg.MsgData, &pushToUserIDList)
 		log.NewDebug(pushMsg.OperationID, utils.GetSelfFuncName(), "offline callback Resp")
 		if callbackResp.ErrCode != 0 {
@@ -257,6 +262,11 @@ func MsgToSuperGroupUser(pushMsg *pbPush.PushMsgReq) {
 			if offlinePusher == nil {
 				return
 			}
+			needOfflinePushUserIDList = filterDoNotDisturbUserIDList(pushMsg.OperationID, pushMsg.MsgData, needOfflinePushUserIDList)
+			if len(needOfflinePushUserIDList) == 0 {
+				log.NewDebug(pushMsg.OperationID, utils.GetSelfFuncName(), "do not disturb, offlinePush stop")
+				return
+			}
 			opts, err := GetOfflinePushOpts(pushMsg)
 			if err != nil {
 				log.NewError(pushMsg.OperationID, utils.GetSelfFuncName(), "GetOfflinePushOpts failed", pushMsg, err.Error())
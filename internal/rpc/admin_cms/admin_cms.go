@@ -44,8 +44,44 @@ func NewAdminCMSServer(port int) *adminCMSServer {
 	}
 }
 
+// initAdminAccounts imports the admins of Manager.AppManagerUid as super admins,
+// after that the accounts in the database are the only ones that can log in
+func (s *adminCMSServer) initAdminAccounts() {
+	operationID := utils.OperationIDGenerator()
+	for i, adminID := range config.Config.Manager.AppManagerUid {
+		if i >= len(config.Config.Manager.Secrets) {
+			log.NewError(operationID, utils.GetSelfFuncName(), "no secret for admin", adminID)
+			continue
+		}
+		_, err := imdb.GetAdminAccount(adminID)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.NewError(operationID, utils.GetSelfFuncName(), "GetAdminAccount failed", adminID, err.Error())
+			continue
+		}
+		password, err := imdb.HashAdminPassword(config.Config.Manager.Secrets[i])
+		if err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "HashAdminPassword failed", adminID, err.Error())
+			continue
+		}
+		admin := db.AdminAccount{AdminID: adminID, Password: password, Role: constant.AdminRoleSuperAdmin, Status: constant.AdminStatusNormal}
+		if user, err := imdb.GetUserByUserID(adminID); err == nil {
+			admin.Nickname = user.Nickname
+			admin.FaceURL = user.FaceURL
+		}
+		if err := imdb.InsertAdminAccount(admin); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "InsertAdminAccount failed", adminID, err.Error())
+			continue
+		}
+		log.NewInfo(operationID, utils.GetSelfFuncName(), "import admin from config", adminID)
+	}
+}
+
 func (s *adminCMSServer) Run() {
 	log.NewInfo("0", "AdminCMS rpc start ")
+	s.initAdminAccounts()
 	listenIP := ""
 	if config.Config.ListenIP == "" {
 		listenIP = "0.0.0.0"
@@ -98,38 +134,33 @@ func (s *adminCMSServer) Run() {
 }
 
 func (s *adminCMSServer) AdminLogin(_ context.Context, req *pbAdminCMS.AdminLoginReq) (*pbAdminCMS.AdminLoginResp, error) {
-	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req.String())
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req.AdminID)
 	resp := &pbAdminCMS.AdminLoginResp{CommonResp: &pbAdminCMS.CommonResp{}}
-	for i, adminID := range config.Config.Manager.AppManagerUid {
-		if adminID == req.AdminID && config.Config.Manager.Secrets[i] == req.Secret {
-			token, expTime, err := token_verify.CreateToken(adminID, constant.SingleChatType)
-			if err != nil {
-				log.NewError(req.OperationID, utils.GetSelfFuncName(), "generate token failed", "adminID: ", adminID, err.Error())
-				resp.CommonResp.ErrCode = constant.ErrTokenUnknown.ErrCode
-				resp.CommonResp.ErrMsg = err.Error()
-				return resp, nil
-			}
-			log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "generate token success", "token: ", token, "expTime:", expTime)
-			resp.Token = token
-			break
-		}
+	admin, err := imdb.GetAdminAccount(req.AdminID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetAdminAccount failed", req.AdminID, err.Error())
+		resp.CommonResp.ErrCode = constant.ErrTokenUnknown.ErrCode
+		resp.CommonResp.ErrMsg = constant.ErrTokenMalformed.ErrMsg
+		return resp, nil
 	}
-	if resp.Token == "" {
-		log.NewError(req.OperationID, utils.GetSelfFuncName(), "failed")
+	if admin.Status != constant.AdminStatusNormal || !imdb.CheckAdminPassword(admin, req.Secret) {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "admin disabled or password error", req.AdminID, admin.Status)
 		resp.CommonResp.ErrCode = constant.ErrTokenUnknown.ErrCode
 		resp.CommonResp.ErrMsg = constant.ErrTokenMalformed.ErrMsg
 		return resp, nil
 	}
-	admin, err := imdb.GetUserByUserID(req.AdminID)
+	token, expTime, err := token_verify.CreateCMSToken(admin.AdminID, admin.TokenVersion)
 	if err != nil {
-		log.NewError(req.OperationID, utils.GetSelfFuncName(), "failed", req.AdminID, err.Error())
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "generate token failed", "adminID: ", admin.AdminID, err.Error())
 		resp.CommonResp.ErrCode = constant.ErrTokenUnknown.ErrCode
 		resp.CommonResp.ErrMsg = err.Error()
 		return resp, nil
 	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "generate token success", "expTime:", expTime)
+	resp.Token = token
 	resp.UserName = admin.Nickname
 	resp.FaceURL = admin.FaceURL
-	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp.String())
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp.UserName, resp.FaceURL)
 	return resp, nil
 }
 
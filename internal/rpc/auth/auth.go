@@ -5,6 +5,7 @@ import (
 	"Open_IM/pkg/common/db"
 	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
 	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/multi_terminal_login"
 	promePkg "Open_IM/pkg/common/prometheus"
 	"Open_IM/pkg/common/token_verify"
 	"Open_IM/pkg/grpc-etcdv3/getcdv3"
@@ -12,12 +13,12 @@ import (
 	pbRelay "Open_IM/pkg/proto/relay"
 	"Open_IM/pkg/utils"
 	"context"
-	"errors"
 	"net"
 	"strconv"
 	"strings"
 
 	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
 
 	"Open_IM/pkg/common/config"
 
@@ -55,17 +56,92 @@ func (rpc *rpcAuth) UserToken(_ context.Context, req *pbAuth.UserTokenReq) (*pbA
 		log.NewError(req.OperationID, "not this user:", req.FromUserID, req.String())
 		return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}}, nil
 	}
+	if token_verify.IsRefreshTokenEnabled() {
+		pair, err := token_verify.CreateTokenPair(req.FromUserID, int(req.Platform))
+		if err != nil {
+			errMsg := req.OperationID + " token_verify.CreateTokenPair failed " + err.Error() + req.FromUserID + utils.Int32ToString(req.Platform)
+			log.NewError(req.OperationID, errMsg)
+			return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}}, nil
+		}
+		if err := rpc.login(req.FromUserID, req.Platform, pair.SessionID, pair.Token, req.LoginIp, pair.RefreshExpiredTime, req.OperationID); err != nil {
+			errMsg := req.OperationID + " login failed " + err.Error() + req.FromUserID + utils.Int32ToString(req.Platform)
+			log.NewError(req.OperationID, errMsg)
+			return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}}, nil
+		}
+		promePkg.PromeInc(promePkg.UserLoginCounter)
+		log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc return token pair ", pair.ExpiredTime, pair.RefreshExpiredTime)
+		return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{}, Token: pair.Token, ExpiredTime: pair.ExpiredTime, RefreshToken: pair.RefreshToken, RefreshExpiredTime: pair.RefreshExpiredTime}, nil
+	}
 	tokens, expTime, err := token_verify.CreateToken(req.FromUserID, int(req.Platform))
 	if err != nil {
 		errMsg := req.OperationID + " token_verify.CreateToken failed " + err.Error() + req.FromUserID + utils.Int32ToString(req.Platform)
 		log.NewError(req.OperationID, errMsg)
 		return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}}, nil
 	}
+	if err := rpc.login(req.FromUserID, req.Platform, utils.Md5(tokens), tokens, req.LoginIp, expTime, req.OperationID); err != nil {
+		errMsg := req.OperationID + " login failed " + err.Error() + req.FromUserID + utils.Int32ToString(req.Platform)
+		log.NewError(req.OperationID, errMsg)
+		return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}}, nil
+	}
 	promePkg.PromeInc(promePkg.UserLoginCounter)
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc return ", pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{}, Token: tokens, ExpiredTime: expTime})
 	return &pbAuth.UserTokenResp{CommonResp: &pbAuth.CommonResp{}, Token: tokens, ExpiredTime: expTime}, nil
 }
 
+// login records the session and applies the multi login policy. When that fails the issued token is revoked
+// before it reaches the client, so a login never gets around the policy.
+func (rpc *rpcAuth) login(userID string, platformID int32, sessionID, token, loginIP string, expireTime int64, operationID string) error {
+	ended, err := multi_terminal_login.Login(userID, int(platformID), sessionID, token, loginIP, expireTime, operationID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "multi_terminal_login.Login failed", err.Error(), userID, platformID)
+		if err := db.DB.SetTokenMapByUidPid(userID, int(platformID), map[string]int{token: constant.InValidToken}); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "SetTokenMapByUidPid failed", err.Error(), userID, platformID)
+		}
+		if err := token_verify.RevokeRefreshTokenFamily(userID, int(platformID), sessionID); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "RevokeRefreshTokenFamily failed", err.Error(), userID, platformID)
+		}
+		return err
+	}
+	if len(ended) != 0 {
+		rpc.closeEndedConns(userID, platformID, operationID)
+	}
+	return nil
+}
+
+// closeEndedConns asks every gateway to close the connections whose token is no longer valid
+func (rpc *rpcAuth) closeEndedConns(userID string, platformID int32, operationID string) {
+	grpcCons := getcdv3.GetDefaultGatewayConn4Unique(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), operationID)
+	for _, v := range grpcCons {
+		client := pbRelay.NewRelayClient(v)
+		// without a token the gateway only closes ended connections
+		checkReq := &pbRelay.MultiTerminalLoginCheckReq{OperationID: operationID, UserID: userID, PlatformID: platformID}
+		if _, err := client.MultiTerminalLoginCheck(context.Background(), checkReq); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "MultiTerminalLoginCheck failed", err.Error(), v.Target(), userID)
+		}
+	}
+}
+
+func (rpc *rpcAuth) RefreshToken(_ context.Context, req *pbAuth.RefreshTokenReq) (*pbAuth.RefreshTokenResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc args ", req.Platform, req.FromUserID)
+	if !token_verify.IsRefreshTokenEnabled() {
+		errMsg := req.OperationID + " refresh token disabled"
+		log.NewError(req.OperationID, errMsg)
+		return &pbAuth.RefreshTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: errMsg}}, nil
+	}
+	pair, err := token_verify.RefreshToken(req.FromUserID, int(req.Platform), req.RefreshToken, req.OperationID)
+	if err != nil {
+		errMsg := req.OperationID + " token_verify.RefreshToken failed " + err.Error() + req.FromUserID + utils.Int32ToString(req.Platform)
+		log.NewError(req.OperationID, errMsg)
+		errCode := constant.ErrDB.ErrCode
+		if errInfo, ok := errors.Cause(err).(constant.ErrInfo); ok {
+			errCode = errInfo.ErrCode
+		}
+		return &pbAuth.RefreshTokenResp{CommonResp: &pbAuth.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc return token pair ", pair.ExpiredTime, pair.RefreshExpiredTime)
+	return &pbAuth.RefreshTokenResp{CommonResp: &pbAuth.CommonResp{}, Token: pair.Token, ExpiredTime: pair.ExpiredTime, RefreshToken: pair.RefreshToken, RefreshExpiredTime: pair.RefreshExpiredTime}, nil
+}
+
 func (rpc *rpcAuth) ParseToken(_ context.Context, req *pbAuth.ParseTokenReq) (*pbAuth.ParseTokenResp, error) {
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc args ", req.String())
 	claims, err := token_verify.ParseToken(req.Token, req.OperationID)
@@ -100,8 +176,56 @@ func (rpc *rpcAuth) ForceLogout(_ context.Context, req *pbAuth.ForceLogoutReq) (
 	return &pbAuth.ForceLogoutResp{CommonResp: &pbAuth.CommonResp{}}, nil
 }
 
+func (rpc *rpcAuth) GetUserSessions(_ context.Context, req *pbAuth.GetUserSessionsReq) (*pbAuth.GetUserSessionsResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc args ", req.UserID, req.OpUserID)
+	if req.UserID != req.OpUserID && !token_verify.IsManagerUserID(req.OpUserID) {
+		errMsg := req.OperationID + " no permission to get sessions of " + req.UserID + " " + req.OpUserID
+		log.NewError(req.OperationID, errMsg)
+		return &pbAuth.GetUserSessionsResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
+	}
+	sessions, err := multi_terminal_login.GetSessions(req.UserID)
+	if err != nil {
+		errMsg := req.OperationID + " multi_terminal_login.GetSessions failed " + err.Error() + req.UserID
+		log.NewError(req.OperationID, errMsg)
+		return &pbAuth.GetUserSessionsResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}}, nil
+	}
+	resp := &pbAuth.GetUserSessionsResp{CommonResp: &pbAuth.CommonResp{}}
+	for _, v := range sessions {
+		resp.Sessions = append(resp.Sessions, &pbAuth.UserSession{SessionID: v.SessionID, PlatformID: int32(v.PlatformID), LoginIP: v.LoginIP,
+			LoginTime: v.LoginTime, Current: v.Token == req.Token})
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc return ", len(resp.Sessions))
+	return resp, nil
+}
+
+func (rpc *rpcAuth) RevokeUserSession(_ context.Context, req *pbAuth.RevokeUserSessionReq) (*pbAuth.RevokeUserSessionResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc args ", req.String())
+	if req.UserID != req.OpUserID && !token_verify.IsManagerUserID(req.OpUserID) {
+		errMsg := req.OperationID + " no permission to revoke session of " + req.UserID + " " + req.OpUserID
+		log.NewError(req.OperationID, errMsg)
+		return &pbAuth.RevokeUserSessionResp{CommonResp: &pbAuth.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
+	}
+	session, err := multi_terminal_login.EndSession(req.UserID, req.SessionID)
+	if err != nil {
+		errMsg := req.OperationID + " multi_terminal_login.EndSession failed " + err.Error() + req.UserID + " " + req.SessionID
+		log.NewError(req.OperationID, errMsg)
+		errCode := constant.ErrDB.ErrCode
+		if errInfo, ok := errors.Cause(err).(constant.ErrInfo); ok {
+			errCode = errInfo.ErrCode
+		}
+		return &pbAuth.RevokeUserSessionResp{CommonResp: &pbAuth.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+	}
+	rpc.closeEndedConns(req.UserID, int32(session.PlatformID), req.OperationID)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc return ")
+	return &pbAuth.RevokeUserSessionResp{CommonResp: &pbAuth.CommonResp{}}, nil
+}
+
 func (rpc *rpcAuth) forceKickOff(userID string, platformID int32, operationID string) error {
 	log.NewInfo(operationID, utils.GetSelfFuncName(), " args ", userID, platformID)
+	// a kicked device must not refresh itself back in
+	if err := token_verify.RevokeRefreshTokens(userID, int(platformID)); err != nil {
+		return utils.Wrap(err, "RevokeRefreshTokens failed")
+	}
 	grpcCons := getcdv3.GetDefaultGatewayConn4Unique(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), operationID)
 	for _, v := range grpcCons {
 		client := pbRelay.NewRelayClient(v)
@@ -95,6 +95,8 @@ func (rpc *rpcConversation) ModifyConversationField(c context.Context, req *pbCo
 		err = imdb.UpdateColumnsConversations(haveUserID, req.Conversation.ConversationID, map[string]interface{}{"update_unread_count_time": conversation.UpdateUnreadCountTime})
 	case constant.FieldBurnDuration:
 		err = imdb.UpdateColumnsConversations(haveUserID, req.Conversation.ConversationID, map[string]interface{}{"burn_duration": conversation.BurnDuration})
+	case constant.FieldMuteUntilTime:
+		err = imdb.UpdateColumnsConversations(haveUserID, req.Conversation.ConversationID, map[string]interface{}{"mute_until_time": conversation.MuteUntilTime})
 	}
 	if err != nil {
 		log.NewError(req.OperationID, utils.GetSelfFuncName(), "UpdateColumnsConversations error", err.Error())
@@ -114,6 +116,14 @@ func (rpc *rpcConversation) ModifyConversationField(c context.Context, req *pbCo
 			return resp, nil
 		}
 	}
+	if req.FieldType == constant.FieldMuteUntilTime {
+		// the push path rebuilds the mute until time from the db on a miss
+		if err := db.DB.DelUsersConversationMuteUntilTime(req.UserIDList, req.Conversation.ConversationID); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "DelUsersConversationMuteUntilTime failed", err.Error(), req.Conversation.ConversationID)
+			resp.CommonResp = &pbConversation.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}
+			return resp, nil
+		}
+	}
 
 	// notification
 	if req.Conversation.ConversationType == constant.SingleChatType && req.FieldType == constant.FieldIsPrivateChat {
@@ -2,9 +2,9 @@ package friend
 
 import (
 	cbApi "Open_IM/pkg/call_back_struct"
+	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
-	"Open_IM/pkg/common/http"
 	"Open_IM/pkg/common/log"
 	pbFriend "Open_IM/pkg/proto/friend"
 	//"Open_IM/pkg/proto/msg"
@@ -14,7 +14,7 @@ import (
 
 func callbackBeforeAddFriend(req *pbFriend.AddFriendReq) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: req.CommID.OperationID}
-	if !config.Config.Callback.CallbackBeforeAddFriend.Enable {
+	if !config.Current().Callback.CallbackBeforeAddFriend.Enable {
 		return callbackResp
 	}
 	log.NewDebug(req.CommID.OperationID, utils.GetSelfFuncName(), req.String())
@@ -30,10 +30,10 @@ func callbackBeforeAddFriend(req *pbFriend.AddFriendReq) cbApi.CommonCallbackRes
 	}
 	//utils.CopyStructFields(req, msg.MsgData)
 	defer log.NewDebug(req.CommID.OperationID, utils.GetSelfFuncName(), commonCallbackReq, *resp)
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackBeforeAddFriendCommand, commonCallbackReq, resp, config.Config.Callback.CallbackBeforeAddFriend.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackBeforeAddFriendCommand, commonCallbackReq, resp, config.Current().Callback.CallbackBeforeAddFriend.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackBeforeAddFriend.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackBeforeAddFriend.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
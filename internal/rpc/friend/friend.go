@@ -78,6 +78,9 @@ func (s *friendServer) Run() {
 	defer srv.GracefulStop()
 	//User friend related services register to etcd
 	pbFriend.RegisterFriendServer(srv, s)
+	for i := 0; i < friendRecommendationWorkerNum; i++ {
+		go refreshFriendRecommendations()
+	}
 	rpcRegisterIP := config.Config.RpcRegisterIP
 	if config.Config.RpcRegisterIP == "" {
 		rpcRegisterIP, err = utils.GetLocalIP()
@@ -436,6 +439,9 @@ func (s *friendServer) DeleteFriend(ctx context.Context, req *pbFriend.DeleteFri
 		log.NewError(req.CommID.OperationID, "DeleteSingleFriendInfo failed", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
 		return &pbFriend.DeleteFriendResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
 	}
+	if err := imdb.DeleteFriendFromCategories(req.CommID.FromUserID, req.CommID.ToUserID); err != nil {
+		log.NewError(req.CommID.OperationID, "DeleteFriendFromCategories failed", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
+	}
 	log.NewInfo(req.CommID.OperationID, "DeleteFriend rpc ok")
 
 	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImCacheName, req.CommID.OperationID)
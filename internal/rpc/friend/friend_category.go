@@ -0,0 +1,181 @@
+package friend
+
+import (
+	chat "Open_IM/internal/rpc/msg"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	pbFriend "Open_IM/pkg/proto/friend"
+	"Open_IM/pkg/utils"
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+)
+
+func friendCategoryDBCopyPb(category *db.FriendCategory, friendUserIDList []string) *pbFriend.FriendCategory {
+	return &pbFriend.FriendCategory{CategoryID: category.CategoryID, Name: category.Name, FriendUserIDList: friendUserIDList,
+		CreateTime: category.CreateTime.Unix(), UpdateTime: category.UpdateTime.Unix()}
+}
+
+// SetFriendCategory creates a category when categoryID is empty and renames it otherwise
+func (s *friendServer) SetFriendCategory(_ context.Context, req *pbFriend.SetFriendCategoryReq) (*pbFriend.SetFriendCategoryResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > constant.FriendCategoryNameMaxLen {
+		return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "name must be 1 to 64 characters"}}, nil
+	}
+	var category *db.FriendCategory
+	var err error
+	if req.CategoryID == "" {
+		category = &db.FriendCategory{OwnerUserID: req.CommID.FromUserID, CategoryID: utils.OperationIDGenerator(), Name: name}
+		err = imdb.CreateFriendCategory(category, constant.FriendCategoryMaxNum)
+	} else {
+		if _, err := imdb.GetFriendCategory(req.CommID.FromUserID, req.CategoryID); errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "friend category not found"}}, nil
+		} else if err != nil {
+			log.NewError(req.CommID.OperationID, "GetFriendCategory failed ", err.Error(), req.CommID.FromUserID, req.CategoryID)
+			return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+		if err = imdb.UpdateFriendCategoryName(req.CommID.FromUserID, req.CategoryID, name); err == nil {
+			category, err = imdb.GetFriendCategory(req.CommID.FromUserID, req.CategoryID)
+		}
+	}
+	if errors.Is(err, imdb.ErrFriendCategoryNameExists) || errors.Is(err, imdb.ErrFriendCategoryLimit) {
+		return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: err.Error()}}, nil
+	}
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "set friend category failed ", err.Error(), req.CommID.FromUserID, req.CategoryID, name)
+		return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	chat.FriendCategoryChangedNotification(req.CommID.OperationID, req.CommID.FromUserID, category.CategoryID, nil, req.CommID.OpUserID)
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", category.CategoryID, category.Name)
+	return &pbFriend.SetFriendCategoryResp{CommonResp: &pbFriend.CommonResp{}, Category: friendCategoryDBCopyPb(category, nil)}, nil
+}
+
+func (s *friendServer) DeleteFriendCategory(_ context.Context, req *pbFriend.DeleteFriendCategoryReq) (*pbFriend.DeleteFriendCategoryResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.DeleteFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	_, err := imdb.GetFriendCategory(req.CommID.FromUserID, req.CategoryID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbFriend.DeleteFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "friend category not found"}}, nil
+	}
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendCategory failed ", err.Error(), req.CommID.FromUserID, req.CategoryID)
+		return &pbFriend.DeleteFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	friendUserIDList, err := imdb.DeleteFriendCategory(req.CommID.FromUserID, req.CategoryID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "DeleteFriendCategory failed ", err.Error(), req.CommID.FromUserID, req.CategoryID)
+		return &pbFriend.DeleteFriendCategoryResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	chat.FriendCategoryChangedNotification(req.CommID.OperationID, req.CommID.FromUserID, req.CategoryID, friendUserIDList, req.CommID.OpUserID)
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", req.CategoryID, len(friendUserIDList))
+	return &pbFriend.DeleteFriendCategoryResp{CommonResp: &pbFriend.CommonResp{}}, nil
+}
+
+func (s *friendServer) SetFriendCategories(_ context.Context, req *pbFriend.SetFriendCategoriesReq) (*pbFriend.SetFriendCategoriesResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if _, err := imdb.GetFriendRelationshipFromFriend(req.CommID.FromUserID, req.CommID.ToUserID); errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "not friend"}}, nil
+	} else if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendRelationshipFromFriend failed ", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
+		return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	categories, err := imdb.GetFriendCategories(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendCategories failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	exists := make(map[string]bool)
+	for _, v := range categories {
+		exists[v.CategoryID] = true
+	}
+	categoryIDList := utils.RemoveRepeatedStringInList(req.CategoryIDList)
+	for _, categoryID := range categoryIDList {
+		if !exists[categoryID] {
+			return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "friend category not found " + categoryID}}, nil
+		}
+	}
+	if err := imdb.SetFriendCategories(req.CommID.FromUserID, req.CommID.ToUserID, categoryIDList); err != nil {
+		log.NewError(req.CommID.OperationID, "SetFriendCategories failed ", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
+		return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	chat.FriendInfoUpdatedNotification(req.CommID.OperationID, req.CommID.ToUserID, req.CommID.FromUserID, req.CommID.OpUserID)
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", req.CommID.ToUserID, categoryIDList)
+	return &pbFriend.SetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{}}, nil
+}
+
+func (s *friendServer) SetFriendStarred(_ context.Context, req *pbFriend.SetFriendStarredReq) (*pbFriend.SetFriendStarredResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.SetFriendStarredResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if _, err := imdb.GetFriendRelationshipFromFriend(req.CommID.FromUserID, req.CommID.ToUserID); errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbFriend.SetFriendStarredResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "not friend"}}, nil
+	} else if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendRelationshipFromFriend failed ", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
+		return &pbFriend.SetFriendStarredResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if err := imdb.UpdateFriendStarred(req.CommID.FromUserID, req.CommID.ToUserID, req.Starred); err != nil {
+		log.NewError(req.CommID.OperationID, "UpdateFriendStarred failed ", err.Error(), req.CommID.FromUserID, req.CommID.ToUserID)
+		return &pbFriend.SetFriendStarredResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if err := rocksCache.DelAllFriendsInfoFromCache(req.CommID.FromUserID); err != nil {
+		log.NewError(req.CommID.OperationID, "DelAllFriendsInfoFromCache failed ", err.Error(), req.CommID.FromUserID)
+	}
+	chat.FriendInfoUpdatedNotification(req.CommID.OperationID, req.CommID.ToUserID, req.CommID.FromUserID, req.CommID.OpUserID)
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", req.CommID.ToUserID, req.Starred)
+	return &pbFriend.SetFriendStarredResp{CommonResp: &pbFriend.CommonResp{}}, nil
+}
+
+// GetFriendCategories returns everything a device needs to sync the contact organization of the user
+func (s *friendServer) GetFriendCategories(_ context.Context, req *pbFriend.GetFriendCategoriesReq) (*pbFriend.GetFriendCategoriesResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.GetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	categories, err := imdb.GetFriendCategories(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendCategories failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	members, err := imdb.GetFriendCategoryMembers(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendCategoryMembers failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	starred, err := imdb.GetStarredFriendIDList(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetStarredFriendIDList failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	friendUserIDs := make(map[string][]string)
+	for _, v := range members {
+		friendUserIDs[v.CategoryID] = append(friendUserIDs[v.CategoryID], v.FriendUserID)
+	}
+	resp := &pbFriend.GetFriendCategoriesResp{CommonResp: &pbFriend.CommonResp{}, StarredFriendUserIDList: starred}
+	for _, v := range categories {
+		resp.Categories = append(resp.Categories, friendCategoryDBCopyPb(v, friendUserIDs[v.CategoryID]))
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", len(resp.Categories), len(resp.StarredFriendUserIDList))
+	return resp, nil
+}
@@ -0,0 +1,146 @@
+package friend
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	pbFriend "Open_IM/pkg/proto/friend"
+	"Open_IM/pkg/utils"
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// these tests run against the configured mysql with users of their own
+const (
+	testCategoryOwner  = "test_category_owner"
+	testCategoryFriend = "test_category_friend"
+)
+
+func setupFriendCategoryTest(t *testing.T) {
+	cleanupFriendCategoryTest()
+	assert.Nil(t, imdb.UserRegister(db.User{UserID: testCategoryOwner, Nickname: "owner"}))
+	assert.Nil(t, imdb.UserRegister(db.User{UserID: testCategoryFriend, Nickname: "friend"}))
+	assert.Nil(t, imdb.InsertToFriend(&db.Friend{OwnerUserID: testCategoryOwner, FriendUserID: testCategoryFriend}))
+}
+
+func cleanupFriendCategoryTest() {
+	mysqlDB := db.DB.MysqlDB.DefaultGormDB()
+	mysqlDB.Table("friend_category_members").Where("owner_user_id=?", testCategoryOwner).Delete(&db.FriendCategoryMember{})
+	mysqlDB.Table("friend_categories").Where("owner_user_id=?", testCategoryOwner).Delete(&db.FriendCategory{})
+	mysqlDB.Table("friends").Where("owner_user_id=?", testCategoryOwner).Delete(&db.Friend{})
+	mysqlDB.Table("users").Where("user_id in (?)", []string{testCategoryOwner, testCategoryFriend}).Delete(&db.User{})
+}
+
+func testCategoryCommID(toUserID string) *pbFriend.CommID {
+	return &pbFriend.CommID{OpUserID: testCategoryOwner, OperationID: utils.OperationIDGenerator(), FromUserID: testCategoryOwner, ToUserID: toUserID}
+}
+
+func Test_SetFriendCategory(t *testing.T) {
+	setupFriendCategoryTest(t)
+	defer cleanupFriendCategoryTest()
+	s := &friendServer{}
+
+	resp, err := s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: " family "})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	assert.Equal(t, "family", resp.Category.Name)
+	familyID := resp.Category.CategoryID
+
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: "work"})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	workID := resp.Category.CategoryID
+
+	// names are unique per owner, for new and renamed categories alike
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: "family"})
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+	assert.Equal(t, imdb.ErrFriendCategoryNameExists.Error(), resp.CommonResp.ErrMsg)
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: workID, Name: "family"})
+	assert.Equal(t, imdb.ErrFriendCategoryNameExists.Error(), resp.CommonResp.ErrMsg)
+
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: familyID, Name: "relatives"})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	assert.Equal(t, familyID, resp.Category.CategoryID)
+	assert.Equal(t, "relatives", resp.Category.Name)
+	// keeping its own name is no conflict
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: familyID, Name: "relatives"})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: "unknown", Name: "x"})
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: "  "})
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+}
+
+func Test_FriendCategoryLimit(t *testing.T) {
+	setupFriendCategoryTest(t)
+	defer cleanupFriendCategoryTest()
+	s := &friendServer{}
+	for i := 0; i < constant.FriendCategoryMaxNum; i++ {
+		category := &db.FriendCategory{OwnerUserID: testCategoryOwner, CategoryID: "c" + strconv.Itoa(i), Name: "category " + strconv.Itoa(i)}
+		assert.Nil(t, imdb.CreateFriendCategory(category, constant.FriendCategoryMaxNum))
+	}
+	resp, err := s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: "one too many"})
+	assert.Nil(t, err)
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+	assert.Equal(t, imdb.ErrFriendCategoryLimit.Error(), resp.CommonResp.ErrMsg)
+	// renaming does not count against the limit
+	resp, _ = s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: "c0", Name: "renamed"})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+}
+
+func Test_SetFriendCategories(t *testing.T) {
+	setupFriendCategoryTest(t)
+	defer cleanupFriendCategoryTest()
+	s := &friendServer{}
+	var categoryIDList []string
+	for _, name := range []string{"a", "b", "c"} {
+		resp, _ := s.SetFriendCategory(context.Background(), &pbFriend.SetFriendCategoryReq{CommID: testCategoryCommID(""), Name: name})
+		assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+		categoryIDList = append(categoryIDList, resp.Category.CategoryID)
+	}
+	friendCategoryIDList := func() []string {
+		resp, err := s.GetFriendCategories(context.Background(), &pbFriend.GetFriendCategoriesReq{CommID: testCategoryCommID("")})
+		assert.Nil(t, err)
+		var categoryIDList []string
+		for _, v := range resp.Categories {
+			if utils.IsContain(testCategoryFriend, v.FriendUserIDList) {
+				categoryIDList = append(categoryIDList, v.CategoryID)
+			}
+		}
+		return categoryIDList
+	}
+
+	resp, err := s.SetFriendCategories(context.Background(), &pbFriend.SetFriendCategoriesReq{CommID: testCategoryCommID(testCategoryFriend),
+		CategoryIDList: []string{categoryIDList[0], categoryIDList[1], categoryIDList[0]}})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	assert.ElementsMatch(t, categoryIDList[:2], friendCategoryIDList())
+
+	// the list replaces the categories of the friend
+	resp, _ = s.SetFriendCategories(context.Background(), &pbFriend.SetFriendCategoriesReq{CommID: testCategoryCommID(testCategoryFriend), CategoryIDList: categoryIDList[1:]})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	assert.ElementsMatch(t, categoryIDList[1:], friendCategoryIDList())
+
+	// an unknown category changes nothing
+	resp, _ = s.SetFriendCategories(context.Background(), &pbFriend.SetFriendCategoriesReq{CommID: testCategoryCommID(testCategoryFriend), CategoryIDList: []string{categoryIDList[0], "unknown"}})
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+	assert.ElementsMatch(t, categoryIDList[1:], friendCategoryIDList())
+
+	resp, _ = s.SetFriendCategories(context.Background(), &pbFriend.SetFriendCategoriesReq{CommID: testCategoryCommID("not_a_friend"), CategoryIDList: categoryIDList})
+	assert.Equal(t, constant.ErrArgs.ErrCode, resp.CommonResp.ErrCode)
+
+	// deleting a category takes the friend out of it
+	deleteResp, err := s.DeleteFriendCategory(context.Background(), &pbFriend.DeleteFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: categoryIDList[1]})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), deleteResp.CommonResp.ErrCode)
+	assert.Equal(t, []string{categoryIDList[2]}, friendCategoryIDList())
+	deleteResp, _ = s.DeleteFriendCategory(context.Background(), &pbFriend.DeleteFriendCategoryReq{CommID: testCategoryCommID(""), CategoryID: categoryIDList[1]})
+	assert.Equal(t, constant.ErrArgs.ErrCode, deleteResp.CommonResp.ErrCode)
+
+	resp, _ = s.SetFriendCategories(context.Background(), &pbFriend.SetFriendCategoriesReq{CommID: testCategoryCommID(testCategoryFriend)})
+	assert.Equal(t, int32(0), resp.CommonResp.ErrCode)
+	assert.Empty(t, friendCategoryIDList())
+}
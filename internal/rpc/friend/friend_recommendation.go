@@ -0,0 +1,330 @@
+package friend
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/grpc-etcdv3/getcdv3"
+	pbFriend "Open_IM/pkg/proto/friend"
+	pbOrganization "Open_IM/pkg/proto/organization"
+	sdkws "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	go_redis "github.com/go-redis/redis/v8"
+)
+
+// getDepartmentColleagues returns the members of the departments userID is in, departments over
+// MaxDepartmentMemberNum are left out and so is the whole signal on an error
+func getDepartmentColleagues(operationID, userID string) []string {
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImOrganizationName, operationID)
+	if etcdConn == nil {
+		log.NewError(operationID, "getcdv3.GetDefaultConn == nil ", config.Config.RpcRegisterName.OpenImOrganizationName)
+		return nil
+	}
+	client := pbOrganization.NewOrganizationClient(etcdConn)
+	respPb, err := client.GetUserInDepartment(context.Background(), &pbOrganization.GetUserInDepartmentReq{UserID: userID, OperationID: operationID, OpUserID: userID})
+	if err != nil {
+		log.NewError(operationID, "GetUserInDepartment failed ", err.Error(), userID)
+		return nil
+	}
+	if respPb.ErrCode != 0 || respPb.UserInDepartment == nil {
+		log.NewDebug(operationID, "GetUserInDepartment no department ", respPb.ErrCode, respPb.ErrMsg, userID)
+		return nil
+	}
+	var colleagueIDList []string
+	for _, department := range respPb.UserInDepartment.DepartmentMemberList {
+		// like a large group, a large department says little about knowing each other
+		if maxNum := config.Config.FriendRecommendation.MaxDepartmentMemberNum; maxNum > 0 {
+			err, memberNum := imdb.GetDepartmentMemberNum(department.DepartmentID)
+			if err != nil {
+				log.NewError(operationID, "GetDepartmentMemberNum failed ", err.Error(), department.DepartmentID)
+				continue
+			}
+			if int(memberNum) > maxNum {
+				log.NewDebug(operationID, "department too large, skipped ", department.DepartmentID, memberNum)
+				continue
+			}
+		}
+		// only the user ids are needed, the organization rpc would load every member's profile
+		memberList, err := imdb.GetDepartmentMemberList(department.DepartmentID)
+		if err != nil {
+			log.NewError(operationID, "GetDepartmentMemberList failed ", err.Error(), department.DepartmentID)
+			continue
+		}
+		for _, member := range memberList {
+			colleagueIDList = append(colleagueIDList, member.UserID)
+		}
+	}
+	return colleagueIDList
+}
+
+// rankFriendRecommendations scores the candidates with the weights of each signal and returns at most maxNum of them,
+// best first. Candidates scoring nothing are dropped, ties go to more mutual friends and then to the smaller userID.
+func rankFriendRecommendations(candidates []*pbFriend.FriendRecommendation, mutualFriendWeight, sharedGroupWeight, sameDepartmentWeight int64, maxNum int) []*pbFriend.FriendRecommendation {
+	ranked := make([]*pbFriend.FriendRecommendation, 0, len(candidates))
+	for _, v := range candidates {
+		v.Score = int64(v.MutualFriendNum)*mutualFriendWeight + int64(v.SharedGroupNum)*sharedGroupWeight
+		if v.SameDepartment {
+			v.Score += sameDepartmentWeight
+		}
+		if v.Score > 0 {
+			ranked = append(ranked, v)
+		}
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		if ranked[i].MutualFriendNum != ranked[j].MutualFriendNum {
+			return ranked[i].MutualFriendNum > ranked[j].MutualFriendNum
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+	if maxNum > 0 && len(ranked) > maxNum {
+		ranked = ranked[:maxNum]
+	}
+	return ranked
+}
+
+// computeFriendRecommendations ranks friends of friends, members of the joined groups and colleagues of userID.
+// Only self and current friends are left out here, the other exclusions change often and are applied on every read.
+// At most MaxFriendScanNum friends and MaxGroupScanNum groups are scanned so one user can't fan out without bound.
+func computeFriendRecommendations(operationID, userID string) ([]*pbFriend.FriendRecommendation, error) {
+	friendIDList, err := rocksCache.GetFriendIDListFromCache(userID)
+	if err != nil {
+		return nil, utils.Wrap(err, "GetFriendIDListFromCache failed")
+	}
+	excluded := map[string]bool{userID: true}
+	for _, friendID := range friendIDList {
+		excluded[friendID] = true
+	}
+	candidates := make(map[string]*pbFriend.FriendRecommendation)
+	candidate := func(candidateID string) *pbFriend.FriendRecommendation {
+		if excluded[candidateID] {
+			return nil
+		}
+		if _, ok := candidates[candidateID]; !ok {
+			candidates[candidateID] = &pbFriend.FriendRecommendation{UserID: candidateID}
+		}
+		return candidates[candidateID]
+	}
+
+	scanFriendIDList := friendIDList
+	if maxNum := config.Config.FriendRecommendation.MaxFriendScanNum; maxNum > 0 && len(scanFriendIDList) > maxNum {
+		scanFriendIDList = scanFriendIDList[:maxNum]
+	}
+	for _, friendID := range scanFriendIDList {
+		friendOfFriendIDList, err := rocksCache.GetFriendIDListFromCache(friendID)
+		if err != nil {
+			log.NewError(operationID, "GetFriendIDListFromCache failed ", err.Error(), friendID)
+			continue
+		}
+		for _, candidateID := range friendOfFriendIDList {
+			if c := candidate(candidateID); c != nil {
+				c.MutualFriendNum++
+			}
+		}
+	}
+
+	joinedGroupIDList, err := rocksCache.GetJoinedGroupIDListFromCache(userID)
+	if err != nil {
+		return nil, utils.Wrap(err, "GetJoinedGroupIDListFromCache failed")
+	}
+	if maxNum := config.Config.FriendRecommendation.MaxGroupScanNum; maxNum > 0 && len(joinedGroupIDList) > maxNum {
+		joinedGroupIDList = joinedGroupIDList[:maxNum]
+	}
+	for _, groupID := range joinedGroupIDList {
+		memberIDList, err := rocksCache.GetGroupMemberIDListFromCache(groupID)
+		if err != nil {
+			log.NewError(operationID, "GetGroupMemberIDListFromCache failed ", err.Error(), groupID)
+			continue
+		}
+		// sharing a large group says little about knowing each other
+		if config.Config.FriendRecommendation.MaxGroupMemberNum > 0 && len(memberIDList) > config.Config.FriendRecommendation.MaxGroupMemberNum {
+			continue
+		}
+		for _, candidateID := range memberIDList {
+			if c := candidate(candidateID); c != nil {
+				c.SharedGroupNum++
+			}
+		}
+	}
+
+	for _, candidateID := range getDepartmentColleagues(operationID, userID) {
+		if c := candidate(candidateID); c != nil {
+			c.SameDepartment = true
+		}
+	}
+
+	candidateList := make([]*pbFriend.FriendRecommendation, 0, len(candidates))
+	for _, c := range candidates {
+		candidateList = append(candidateList, c)
+	}
+	return rankFriendRecommendations(candidateList, config.Config.FriendRecommendation.MutualFriendWeight, config.Config.FriendRecommendation.SharedGroupWeight,
+		config.Config.FriendRecommendation.SameDepartmentWeight, config.Config.FriendRecommendation.MaxNum), nil
+}
+
+const (
+	friendRecommendationWorkerNum = 4
+	friendRecommendationQueueSize = 1000
+)
+
+type friendRecommendationTask struct {
+	operationID string
+	userID      string
+}
+
+var (
+	friendRecommendationQueue = make(chan friendRecommendationTask, friendRecommendationQueueSize)
+	// refreshingRecommendations holds the users queued or being computed by this process
+	refreshingRecommendations sync.Map
+)
+
+// queueFriendRecommendations asks the background workers to recompute the recommendations of userID,
+// a user already queued is skipped and a full queue drops the task, the next read queues it again
+func queueFriendRecommendations(operationID, userID string) {
+	if _, queued := refreshingRecommendations.LoadOrStore(userID, struct{}{}); queued {
+		return
+	}
+	select {
+	case friendRecommendationQueue <- friendRecommendationTask{operationID: operationID, userID: userID}:
+	default:
+		refreshingRecommendations.Delete(userID)
+		log.NewWarn(operationID, "friend recommendation queue full ", userID)
+	}
+}
+
+// refreshFriendRecommendations computes the queued recommendations, friendRecommendationWorkerNum of them run in Run
+func refreshFriendRecommendations() {
+	for task := range friendRecommendationQueue {
+		recommendations, err := computeFriendRecommendations(task.operationID, task.userID)
+		if err != nil {
+			log.NewError(task.operationID, "computeFriendRecommendations failed ", err.Error(), task.userID)
+		} else {
+			// kept for two intervals so reads serve the old ranking while the next one is computed
+			expire := 2 * time.Duration(config.Config.FriendRecommendation.RefreshInterval) * time.Second
+			if err := db.DB.SetFriendRecommendations(task.userID, recommendations, expire); err != nil {
+				log.NewError(task.operationID, "SetFriendRecommendations failed ", err.Error(), task.userID)
+			}
+		}
+		refreshingRecommendations.Delete(task.userID)
+	}
+}
+
+// GetFriendRecommendations returns the cached ranking of FromUserID without new friends, blacklisted or blocked users,
+// users with a pending friend request and users who opted out. The ranking is computed by the background workers:
+// a ranking older than RefreshInterval is still served while it is refreshed, and nothing is returned before the first one.
+func (s *friendServer) GetFriendRecommendations(_ context.Context, req *pbFriend.GetFriendRecommendationsReq) (*pbFriend.GetFriendRecommendationsResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	recommendations, ttl, err := db.DB.GetFriendRecommendations(req.CommID.FromUserID)
+	refreshInterval := time.Duration(config.Config.FriendRecommendation.RefreshInterval) * time.Second
+	if err != nil {
+		if err != go_redis.Nil {
+			log.NewError(req.CommID.OperationID, "GetFriendRecommendations from redis failed ", err.Error(), req.CommID.FromUserID)
+		}
+		recommendations = nil
+		queueFriendRecommendations(req.CommID.OperationID, req.CommID.FromUserID)
+	} else if refreshInterval > 0 && ttl < refreshInterval {
+		queueFriendRecommendations(req.CommID.OperationID, req.CommID.FromUserID)
+	}
+
+	friendIDList, err := rocksCache.GetFriendIDListFromCache(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetFriendIDListFromCache failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	blackIDList, err := rocksCache.GetBlackListFromCache(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetBlackListFromCache failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	pendingUserIDList, err := imdb.GetPendingFriendRequestUserIDList(req.CommID.FromUserID)
+	if err != nil {
+		log.NewError(req.CommID.OperationID, "GetPendingFriendRequestUserIDList failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	var candidateIDList []string
+	for _, v := range recommendations {
+		candidateIDList = append(candidateIDList, v.UserID)
+	}
+	var blockedUserIDList []string
+	if len(candidateIDList) > 0 {
+		if blockedUserIDList, err = imdb.UsersIsBlock(candidateIDList); err != nil {
+			log.NewError(req.CommID.OperationID, "UsersIsBlock failed ", err.Error(), candidateIDList)
+			return &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
+	excluded := make(map[string]bool)
+	for _, idList := range [][]string{friendIDList, blackIDList, pendingUserIDList, blockedUserIDList} {
+		for _, userID := range idList {
+			excluded[userID] = true
+		}
+	}
+
+	resp := &pbFriend.GetFriendRecommendationsResp{CommonResp: &pbFriend.CommonResp{}}
+	for _, v := range recommendations {
+		if req.Count > 0 && len(resp.RecommendationList) >= int(req.Count) {
+			break
+		}
+		if excluded[v.UserID] {
+			continue
+		}
+		user, err := rocksCache.GetUserInfoFromCache(v.UserID)
+		if err != nil {
+			log.NewDebug(req.CommID.OperationID, "GetUserInfoFromCache failed ", err.Error(), v.UserID)
+			continue
+		}
+		if user.RecommendOpt == constant.FriendRecommendNotAllowed {
+			continue
+		}
+		candidateBlackIDList, err := rocksCache.GetBlackListFromCache(v.UserID)
+		if err != nil {
+			log.NewError(req.CommID.OperationID, "GetBlackListFromCache failed ", err.Error(), v.UserID)
+			continue
+		}
+		if utils.IsContain(req.CommID.FromUserID, candidateBlackIDList) {
+			continue
+		}
+		v.PublicUserInfo = &sdkws.PublicUserInfo{}
+		utils.CopyStructFields(v.PublicUserInfo, user)
+		resp.RecommendationList = append(resp.RecommendationList, v)
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ", len(recommendations), len(resp.RecommendationList))
+	return resp, nil
+}
+
+// SetFriendRecommendOpt sets whether FromUserID may appear in the recommendations of others
+func (s *friendServer) SetFriendRecommendOpt(_ context.Context, req *pbFriend.SetFriendRecommendOptReq) (*pbFriend.SetFriendRecommendOptResp, error) {
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.CheckAccess(req.CommID.OpUserID, req.CommID.FromUserID) {
+		log.NewError(req.CommID.OperationID, "CheckAccess false ", req.CommID.OpUserID, req.CommID.FromUserID)
+		return &pbFriend.SetFriendRecommendOptResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if req.RecommendOpt != constant.FriendRecommendAllowed && req.RecommendOpt != constant.FriendRecommendNotAllowed {
+		return &pbFriend.SetFriendRecommendOptResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "recommendOpt must be 0 or 1"}}, nil
+	}
+	if err := imdb.UpdateUserRecommendOpt(req.CommID.FromUserID, req.RecommendOpt); err != nil {
+		log.NewError(req.CommID.OperationID, "UpdateUserRecommendOpt failed ", err.Error(), req.CommID.FromUserID, req.RecommendOpt)
+		return &pbFriend.SetFriendRecommendOptResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	// the opt is read from the user info cache when recommendations are served
+	if err := rocksCache.DelUserInfoFromCache(req.CommID.FromUserID); err != nil {
+		log.NewError(req.CommID.OperationID, "DelUserInfoFromCache failed ", err.Error(), req.CommID.FromUserID)
+		return &pbFriend.SetFriendRecommendOptResp{CommonResp: &pbFriend.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	log.NewInfo(req.CommID.OperationID, utils.GetSelfFuncName(), "rpc return ")
+	return &pbFriend.SetFriendRecommendOptResp{CommonResp: &pbFriend.CommonResp{}}, nil
+}
@@ -0,0 +1,31 @@
+package friend
+
+import (
+	pbFriend "Open_IM/pkg/proto/friend"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RankFriendRecommendations(t *testing.T) {
+	candidates := []*pbFriend.FriendRecommendation{
+		{UserID: "u1", SharedGroupNum: 4},
+		{UserID: "u2", MutualFriendNum: 1, SharedGroupNum: 1},
+		{UserID: "u3", SameDepartment: true},
+		{UserID: "u4"},
+		{UserID: "u5", MutualFriendNum: 2},
+		{UserID: "u0", MutualFriendNum: 1, SharedGroupNum: 1},
+	}
+	ranked := rankFriendRecommendations(candidates, 10, 3, 5, 0)
+	var userIDList []string
+	for _, v := range ranked {
+		userIDList = append(userIDList, v.UserID)
+	}
+	assert.Equal(t, []string{"u5", "u0", "u2", "u1", "u3"}, userIDList)
+	assert.Equal(t, int64(20), ranked[0].Score)
+	assert.Equal(t, int64(5), ranked[4].Score)
+
+	assert.Len(t, rankFriendRecommendations(candidates, 10, 3, 5, 2), 2)
+	// a signal weighted zero no longer recommends anyone on its own
+	assert.Len(t, rankFriendRecommendations(candidates, 10, 3, 0, 0), 4)
+}
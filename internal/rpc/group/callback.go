@@ -2,10 +2,10 @@ package group
 
 import (
 	cbApi "Open_IM/pkg/call_back_struct"
+	"Open_IM/pkg/common/callback"
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
 	"Open_IM/pkg/common/db"
-	"Open_IM/pkg/common/http"
 	"Open_IM/pkg/common/log"
 	pbGroup "Open_IM/pkg/proto/group"
 	"Open_IM/pkg/utils"
@@ -16,7 +16,7 @@ import (
 
 func callbackBeforeCreateGroup(req *pbGroup.CreateGroupReq) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: req.OperationID}
-	if !config.Config.Callback.CallbackBeforeCreateGroup.Enable {
+	if !config.Current().Callback.CallbackBeforeCreateGroup.Enable {
 		return callbackResp
 	}
 	log.NewDebug(req.OperationID, utils.GetSelfFuncName(), req.String())
@@ -31,10 +31,10 @@ func callbackBeforeCreateGroup(req *pbGroup.CreateGroupReq) cbApi.CommonCallback
 	}
 	//utils.CopyStructFields(req, msg.MsgData)
 	defer log.NewDebug(req.OperationID, utils.GetSelfFuncName(), commonCallbackReq, *resp)
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackBeforeCreateGroupCommand, commonCallbackReq, resp, config.Config.Callback.CallbackBeforeCreateGroup.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackBeforeCreateGroupCommand, commonCallbackReq, resp, config.Current().Callback.CallbackBeforeCreateGroup.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackBeforeCreateGroup.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackBeforeCreateGroup.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
@@ -85,7 +85,7 @@ func callbackBeforeCreateGroup(req *pbGroup.CreateGroupReq) cbApi.CommonCallback
 
 func CallbackBeforeMemberJoinGroup(operationID string, groupMember *db.GroupMember, groupEx string) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
-	if !config.Config.Callback.CallbackBeforeMemberJoinGroup.Enable {
+	if !config.Current().Callback.CallbackBeforeMemberJoinGroup.Enable {
 		return callbackResp
 	}
 	log.NewDebug(operationID, "args: ", *groupMember)
@@ -100,10 +100,10 @@ func CallbackBeforeMemberJoinGroup(operationID string, groupMember *db.GroupMemb
 	resp := &cbApi.CallbackBeforeMemberJoinGroupResp{
 		CommonCallbackResp: &callbackResp,
 	}
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackBeforeMemberJoinGroupCommand, callbackReq, resp, config.Config.Callback.CallbackBeforeMemberJoinGroup.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackBeforeMemberJoinGroupCommand, callbackReq, resp, config.Current().Callback.CallbackBeforeMemberJoinGroup.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackBeforeMemberJoinGroup.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackBeforeMemberJoinGroup.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
@@ -131,7 +131,7 @@ func CallbackBeforeMemberJoinGroup(operationID string, groupMember *db.GroupMemb
 
 func CallbackBeforeSetGroupMemberInfo(req *pbGroup.SetGroupMemberInfoReq) cbApi.CommonCallbackResp {
 	callbackResp := cbApi.CommonCallbackResp{OperationID: req.OperationID}
-	if !config.Config.Callback.CallbackBeforeSetGroupMemberInfo.Enable {
+	if !config.Current().Callback.CallbackBeforeSetGroupMemberInfo.Enable {
 		return callbackResp
 	}
 	callbackReq := cbApi.CallbackBeforeSetGroupMemberInfoReq{
@@ -156,10 +156,10 @@ func CallbackBeforeSetGroupMemberInfo(req *pbGroup.SetGroupMemberInfoReq) cbApi.
 		CommonCallbackResp: &callbackResp,
 	}
 
-	if err := http.CallBackPostReturn(config.Config.Callback.CallbackUrl, constant.CallbackBeforeSetGroupMemberInfoCommand, callbackReq, resp, config.Config.Callback.CallbackBeforeSetGroupMemberInfo.CallbackTimeOut); err != nil {
+	if err := callback.Post(constant.CallbackBeforeSetGroupMemberInfoCommand, callbackReq, resp, config.Current().Callback.CallbackBeforeSetGroupMemberInfo.CallbackTimeOut); err != nil {
 		callbackResp.ErrCode = http2.StatusInternalServerError
 		callbackResp.ErrMsg = err.Error()
-		if !config.Config.Callback.CallbackBeforeSetGroupMemberInfo.CallbackFailedContinue {
+		if !config.Current().Callback.CallbackBeforeSetGroupMemberInfo.CallbackFailedContinue {
 			callbackResp.ActionCode = constant.ActionForbidden
 			return callbackResp
 		} else {
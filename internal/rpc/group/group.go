@@ -104,6 +104,9 @@ func (s *groupServer) Run() {
 
 	}
 	log.Info("", "RegisterEtcd ", s.etcdSchema, strings.Join(s.etcdAddr, ","), rpcRegisterIP, s.rpcPort, s.rpcRegisterName)
+	go resumeGroupConversions()
+	go resumeGroupImportJobs()
+	go backfillSuperGroupMembers()
 	err = srv.Serve(listener)
 	if err != nil {
 		log.NewError("", "Serve failed ", err.Error())
@@ -264,6 +267,7 @@ func (s *groupServer) CreateGroup(ctx context.Context, req *pbGroup.CreateGroupR
 			resp.ErrMsg = err.Error() + ": CreateSuperGroup failed"
 			return resp, nil
 		}
+		mirrorSuperGroupMembers(req.OperationID, groupId, req.OpUserID, constant.JoinByInvitation, okUserIDList)
 	}
 
 	if len(okUserIDList) != 0 {
@@ -345,8 +349,9 @@ func (s *groupServer) GetJoinedGroupList(ctx context.Context, req *pbGroup.GetJo
 
 func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbGroup.InviteUserToGroupReq) (*pbGroup.InviteUserToGroupResp, error) {
 	log.NewInfo(req.OperationID, "InviteUserToGroup args ", req.String())
-	if !imdb.IsExistGroupMember(req.GroupID, req.OpUserID) && !token_verify.IsManagerUserID(req.OpUserID) {
-		log.NewError(req.OperationID, "no permission InviteUserToGroup ", req.GroupID, req.OpUserID)
+	allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapInvite)
+	if err != nil || !allowed {
+		log.NewError(req.OperationID, "no permission InviteUserToGroup ", req.GroupID, req.OpUserID, err)
 		return &pbGroup.InviteUserToGroupResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}, nil
 	}
 
@@ -476,6 +481,7 @@ func (s *groupServer) InviteUserToGroup(ctx context.Context, req *pbGroup.Invite
 			log.NewError(req.OperationID, "AddUserToSuperGroup failed ", req.GroupID, err)
 			return &pbGroup.InviteUserToGroupResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}, nil
 		}
+		mirrorSuperGroupMembers(req.OperationID, req.GroupID, req.OpUserID, constant.JoinByInvitation, okUserIDList)
 	}
 
 	// set conversations
@@ -584,6 +590,9 @@ func (s *groupServer) InviteUserToGroups(ctx context.Context, req *pbGroup.Invit
 		log.NewError(req.OperationID, "AddUserToSuperGroups failed ", err.Error())
 		return &pbGroup.InviteUserToGroupsResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}, nil
 	}
+	for _, groupID := range req.GroupIDList {
+		mirrorSuperGroupMembers(req.OperationID, groupID, req.OpUserID, constant.JoinByInvitation, []string{req.InvitedUserID})
+	}
 	if err := rocksCache.DelJoinedSuperGroupIDListFromCache(req.InvitedUserID); err != nil {
 		log.NewError(req.OperationID, utils.GetSelfFuncName(), err.Error())
 	}
@@ -668,6 +677,17 @@ func (s *groupServer) getGroupUserLevel(groupID, userID string) (int, error) {
 	return opFlag, nil
 }
 
+// canKickRoleLevel reports whether an operator with opFlag (1 app manager, 2 owner, 3 admin, 0 member) may kick a member of roleLevel
+func canKickRoleLevel(opFlag int, roleLevel int32) bool {
+	if roleLevel == constant.GroupAdmin && (opFlag == 3 || opFlag == 0) {
+		return false
+	}
+	if roleLevel == constant.GroupOwner && opFlag != 1 {
+		return false
+	}
+	return true
+}
+
 func (s *groupServer) KickGroupMember(ctx context.Context, req *pbGroup.KickGroupMemberReq) (*pbGroup.KickGroupMemberResp, error) {
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), " rpc args ", req.String())
 	groupInfo, err := rocksCache.GetGroupInfoFromCache(req.GroupID)
@@ -675,35 +695,44 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbGroup.KickGrou
 		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetGroupInfoByGroupID", req.GroupID, err.Error())
 		return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}, nil
 	}
+	ownerUserID := ""
+	if owner, err := imdb.GetGroupOwnerInfoByGroupID(req.GroupID); err == nil {
+		ownerUserID = owner.UserID
+	}
 	var okUserIDList []string
 	var resp pbGroup.KickGroupMemberResp
-	if groupInfo.GroupType != constant.SuperGroup {
-		opFlag := 0
-		if !token_verify.IsManagerUserID(req.OpUserID) {
-			opInfo, err := rocksCache.GetGroupMemberInfoFromCache(req.GroupID, req.OpUserID)
-			if err != nil {
-				errMsg := req.OperationID + " GetGroupMemberInfoByGroupIDAndUserID  failed " + err.Error() + req.GroupID + req.OpUserID
-				log.Error(req.OperationID, errMsg)
-				return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}, nil
-			}
-			if opInfo.RoleLevel == constant.GroupOrdinaryUsers {
-				errMsg := req.OperationID + " opInfo.RoleLevel == constant.GroupOrdinaryUsers " + opInfo.UserID + opInfo.GroupID
-				log.Error(req.OperationID, errMsg)
-				return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}, nil
-			} else if opInfo.RoleLevel == constant.GroupOwner {
-				opFlag = 2 //owner
-			} else {
-				opFlag = 3 //admin
-			}
-		} else {
-			opFlag = 1 //app manager
+	opFlag := 0
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		opInfo, err := rocksCache.GetGroupMemberInfoFromCache(req.GroupID, req.OpUserID)
+		if err != nil {
+			errMsg := req.OperationID + " GetGroupMemberInfoByGroupIDAndUserID  failed " + err.Error() + req.GroupID + req.OpUserID
+			log.Error(req.OperationID, errMsg)
+			return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}, nil
 		}
-
-		//op is group owner?
-		if len(req.KickedUserIDList) == 0 {
-			log.NewError(req.OperationID, "failed, kick list 0")
-			return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: constant.ErrArgs.ErrMsg}, nil
+		allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapKick)
+		if err != nil {
+			errMsg := req.OperationID + " HasGroupCapability failed " + err.Error() + req.GroupID + req.OpUserID
+			log.Error(req.OperationID, errMsg)
+			return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: errMsg}, nil
+		}
+		if !allowed {
+			errMsg := req.OperationID + " no kick capability " + opInfo.UserID + opInfo.GroupID
+			log.Error(req.OperationID, errMsg)
+			return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}, nil
+		}
+		if opInfo.RoleLevel == constant.GroupOwner {
+			opFlag = 2 //owner
+		} else if opInfo.RoleLevel == constant.GroupAdmin {
+			opFlag = 3 //admin
 		}
+	} else {
+		opFlag = 1 //app manager
+	}
+	if len(req.KickedUserIDList) == 0 {
+		log.NewError(req.OperationID, "failed, kick list 0")
+		return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: constant.ErrArgs.ErrMsg}, nil
+	}
+	if groupInfo.GroupType != constant.SuperGroup {
 		if err := s.DelGroupAndUserCache(req.OperationID, req.GroupID, req.KickedUserIDList); err != nil {
 			log.NewError(req.OperationID, "DelGroupAndUserCache failed", err.Error())
 			return &pbGroup.KickGroupMemberResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}, nil
@@ -716,14 +745,8 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbGroup.KickGrou
 				resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: -1})
 				continue
 			}
-
-			if kickedInfo.RoleLevel == constant.GroupAdmin && opFlag == 3 {
-				log.Error(req.OperationID, "is constant.GroupAdmin, can't kicked ", v)
-				resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: -1})
-				continue
-			}
-			if kickedInfo.RoleLevel == constant.GroupOwner && opFlag != 1 {
-				log.NewDebug(req.OperationID, "is constant.GroupOwner, can't kicked ", v)
+			if !canKickRoleLevel(opFlag, kickedInfo.RoleLevel) {
+				log.NewError(req.OperationID, "role level can't be kicked ", v, kickedInfo.RoleLevel, opFlag)
 				resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: -1})
 				continue
 			}
@@ -765,13 +788,35 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbGroup.KickGrou
 			}
 		}
 	} else {
-		okUserIDList = req.KickedUserIDList
+		for _, v := range req.KickedUserIDList {
+			roleLevel := int32(constant.GroupOrdinaryUsers)
+			kickedInfo, err := rocksCache.GetGroupMemberInfoFromCache(req.GroupID, v)
+			if err == nil {
+				roleLevel = kickedInfo.RoleLevel
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.NewError(req.OperationID, " GetGroupMemberInfoByGroupIDAndUserID failed ", req.GroupID, v, err.Error())
+				resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: -1})
+				continue
+			}
+			if !canKickRoleLevel(opFlag, roleLevel) {
+				log.NewError(req.OperationID, "role level can't be kicked ", v, roleLevel, opFlag)
+				resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: -1})
+				continue
+			}
+			resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: v, Result: 0})
+			okUserIDList = append(okUserIDList, v)
+		}
+		if len(okUserIDList) == 0 {
+			log.NewInfo(req.OperationID, "GetGroupMemberList rpc return ", resp.String())
+			return &resp, nil
+		}
 		if err := db.DB.RemoverUserFromSuperGroup(req.GroupID, okUserIDList); err != nil {
 			log.NewError(req.OperationID, utils.GetSelfFuncName(), req.GroupID, req.KickedUserIDList, err.Error())
 			resp.ErrCode = constant.ErrDB.ErrCode
 			resp.ErrMsg = constant.ErrDB.ErrMsg
 			return &resp, nil
 		}
+		unmirrorSuperGroupMembers(req.OperationID, req.GroupID, okUserIDList)
 		if err := rocksCache.DelGroupMemberListHashFromCache(req.GroupID); err != nil {
 			log.NewError(req.OperationID, utils.GetSelfFuncName(), req.GroupID, err.Error())
 		}
@@ -816,12 +861,17 @@ func (s *groupServer) KickGroupMember(ctx context.Context, req *pbGroup.KickGrou
 			}
 		}
 		go func() {
-			for _, v := range req.KickedUserIDList {
+			for _, v := range okUserIDList {
 				chat.SuperGroupNotification(req.OperationID, v, v)
 			}
 		}()
 
 	}
+	if ownerUserID != "" && utils.IsContain(ownerUserID, okUserIDList) {
+		if _, err := succeedGroupOwner(req.OperationID, req.GroupID, ownerUserID, req.OpUserID); err != nil {
+			log.NewError(req.OperationID, "succeedGroupOwner failed ", err.Error(), req.GroupID, ownerUserID)
+		}
+	}
 
 	log.NewInfo(req.OperationID, "GetGroupMemberList rpc return ", resp.String())
 	return &resp, nil
@@ -1064,6 +1114,19 @@ func (s *groupServer) GroupApplicationResponse(_ context.Context, req *pbGroup.G
 
 func (s *groupServer) JoinGroup(ctx context.Context, req *pbGroup.JoinGroupReq) (*pbGroup.JoinGroupResp, error) {
 	log.NewInfo(req.OperationID, "JoinGroup args ", req.String())
+	// the inviter is only known from a link, a client can not claim one
+	req.JoinSource, req.InviterUserID = constant.JoinBySearch, ""
+	var inviteLink *db.GroupInviteLink
+	if req.InviteToken != "" {
+		link, errCode, errMsg := getInviteLink(req)
+		if errCode != 0 {
+			return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+		}
+		if errCode, errMsg := checkInviteLink(req, link); errCode != 0 {
+			return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+		}
+		inviteLink = link
+	}
 	if imdb.IsExistGroupMember(req.GroupID, req.OpUserID) {
 		log.NewInfo(req.OperationID, "IsExistGroupMember", req.GroupID, req.OpUserID)
 		return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{}}, nil
@@ -1082,8 +1145,29 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbGroup.JoinGroupReq)
 		errMsg := " group status is dismissed "
 		return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrStatus.ErrCode, ErrMsg: errMsg}}, nil
 	}
+	// an autoApprove link of a super group turns into an application, a super group has no direct join
+	directly := groupInfo.NeedVerification == constant.Directly || (inviteLink != nil && inviteLink.AutoApprove && groupInfo.GroupType != constant.SuperGroup)
+	review, errCode, errMsg := reviewJoinApplication(req, directly)
+	if errCode != 0 {
+		return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+	}
+	if review.rule != nil && review.rule.Action == constant.GroupJoinRuleReject {
+		log.NewInfo(req.OperationID, "join rejected by rule ", req.GroupID, req.OpUserID, review.rule.RuleID)
+		if err := recordJoinReview(req, review, constant.GroupResponseRefuse); err != nil {
+			log.NewError(req.OperationID, "recordJoinReview failed ", err.Error(), req.GroupID, req.OpUserID)
+		}
+		return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrGroupJoinRejected.ErrCode, ErrMsg: constant.ErrGroupJoinRejected.ErrMsg}}, nil
+	}
+	// a super group has no direct join, an application accepted by a rule there still waits for an admin
+	if !directly && review.rule != nil && groupInfo.GroupType != constant.SuperGroup {
+		log.NewInfo(req.OperationID, "join accepted by rule ", req.GroupID, req.OpUserID, review.rule.RuleID)
+		if err := recordJoinReview(req, review, constant.GroupResponseAgree); err != nil {
+			log.NewError(req.OperationID, "recordJoinReview failed ", err.Error(), req.GroupID, req.OpUserID)
+		}
+		directly = true
+	}
 
-	if groupInfo.NeedVerification == constant.Directly {
+	if directly {
 		if groupInfo.GroupType != constant.SuperGroup {
 			us, err := imdb.GetUserByUserID(req.OpUserID)
 			if err != nil {
@@ -1093,6 +1177,8 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbGroup.JoinGroupReq)
 			//to group member
 			groupMember := db.GroupMember{GroupID: req.GroupID, RoleLevel: constant.GroupOrdinaryUsers, OperatorUserID: req.OpUserID}
 			utils.CopyStructFields(&groupMember, us)
+			groupMember.JoinSource = req.JoinSource
+			groupMember.InviterUserID = req.InviterUserID
 			callbackResp := CallbackBeforeMemberJoinGroup(req.OperationID, &groupMember, groupInfo.Ex)
 			if callbackResp.ErrCode != 0 {
 				log.NewError(req.OperationID, utils.GetSelfFuncName(), "callbackBeforeSendSingleMsg resp: ", callbackResp)
@@ -1115,9 +1201,17 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbGroup.JoinGroupReq)
 				return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}}, nil
 			}
 
+			if inviteLink != nil {
+				if errCode, errMsg := redeemInviteLink(req, inviteLink); errCode != 0 {
+					return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+				}
+			}
 			err = imdb.InsertIntoGroupMember(groupMember)
 			if err != nil {
 				log.NewError(req.OperationID, "InsertIntoGroupMember failed ", err.Error(), groupMember)
+				if inviteLink != nil {
+					releaseInviteLink(req, inviteLink)
+				}
 				return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 			}
 			//}
@@ -1165,9 +1259,19 @@ func (s *groupServer) JoinGroup(ctx context.Context, req *pbGroup.JoinGroupReq)
 	groupRequest.ReqMsg = req.ReqMessage
 	groupRequest.GroupID = req.GroupID
 	groupRequest.JoinSource = req.JoinSource
+	groupRequest.InviterUserID = req.InviterUserID
+	groupRequest.Answers = review.answers
+	if inviteLink != nil {
+		if errCode, errMsg := redeemInviteLink(req, inviteLink); errCode != 0 {
+			return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: errCode, ErrMsg: errMsg}}, nil
+		}
+	}
 	err = imdb.InsertIntoGroupRequest(groupRequest)
 	if err != nil {
 		log.NewError(req.OperationID, "InsertIntoGroupRequest failed ", err.Error(), groupRequest)
+		if inviteLink != nil {
+			releaseInviteLink(req, inviteLink)
+		}
 		return &pbGroup.JoinGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 	}
 	//_, err = imdb.GetGroupMemberListByGroupIDAndRoleLevel(req.GroupID, constant.GroupOwner)
@@ -1187,6 +1291,8 @@ func (s *groupServer) QuitGroup(ctx context.Context, req *pbGroup.QuitGroupReq)
 		log.NewError(req.OperationID, "ReduceGroupMemberFromCache rpc call failed ", err.Error())
 		return &pbGroup.QuitGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 	}
+	owner, err := imdb.GetGroupOwnerInfoByGroupID(req.GroupID)
+	ownerQuit := err == nil && owner.UserID == req.OpUserID
 	if groupInfo.GroupType != constant.SuperGroup {
 		_, err = imdb.GetGroupMemberInfoByGroupIDAndUserID(req.GroupID, req.OpUserID)
 		if err != nil {
@@ -1239,6 +1345,7 @@ func (s *groupServer) QuitGroup(ctx context.Context, req *pbGroup.QuitGroupReq)
 			log.NewError(req.OperationID, utils.GetSelfFuncName(), req.GroupID, okUserIDList, err.Error())
 			return &pbGroup.QuitGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 		}
+		unmirrorSuperGroupMembers(req.OperationID, req.GroupID, okUserIDList)
 	}
 
 	if groupInfo.GroupType != constant.SuperGroup {
@@ -1255,6 +1362,11 @@ func (s *groupServer) QuitGroup(ctx context.Context, req *pbGroup.QuitGroupReq)
 		}
 		chat.SuperGroupNotification(req.OperationID, req.OpUserID, req.OpUserID)
 	}
+	if ownerQuit {
+		if _, err := succeedGroupOwner(req.OperationID, req.GroupID, req.OpUserID, req.OpUserID); err != nil {
+			log.NewError(req.OperationID, "succeedGroupOwner failed ", err.Error(), req.GroupID, req.OpUserID)
+		}
+	}
 	log.NewInfo(req.OperationID, "rpc QuitGroup return ", pbGroup.QuitGroupResp{CommonResp: &pbGroup.CommonResp{}})
 	return &pbGroup.QuitGroupResp{CommonResp: &pbGroup.CommonResp{}}, nil
 }
@@ -1263,16 +1375,12 @@ func hasAccess(req *pbGroup.SetGroupInfoReq) bool {
 	if utils.IsContain(req.OpUserID, config.Config.Manager.AppManagerUid) {
 		return true
 	}
-	groupUserInfo, err := imdb.GetGroupMemberInfoByGroupIDAndUserID(req.GroupInfoForSet.GroupID, req.OpUserID)
+	allowed, err := hasGroupCapability(req.GroupInfoForSet.GroupID, req.OpUserID, constant.GroupCapEditInfo)
 	if err != nil {
-		log.NewError(req.OperationID, "GetGroupMemberInfoByGroupIDAndUserID failed, ", err.Error(), req.GroupInfoForSet.GroupID, req.OpUserID)
+		log.NewError(req.OperationID, "HasGroupCapability failed, ", err.Error(), req.GroupInfoForSet.GroupID, req.OpUserID)
 		return false
-
-	}
-	if groupUserInfo.RoleLevel == constant.GroupOwner || groupUserInfo.RoleLevel == constant.GroupAdmin {
-		return true
 	}
-	return false
+	return allowed
 }
 
 func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbGroup.SetGroupInfoReq) (*pbGroup.SetGroupInfoResp, error) {
@@ -1293,6 +1401,15 @@ func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbGroup.SetGroupInf
 		return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrStatus.ErrCode, ErrMsg: errMsg}}, nil
 	}
 
+	if v := req.GroupInfoForSet.SlowModeSeconds; v != nil && (v.Value < 0 || v.Value > constant.GroupSlowModeMaxSeconds) {
+		log.NewError(req.OperationID, "slowModeSeconds out of range ", v.Value)
+		return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "slowModeSeconds out of range"}}, nil
+	}
+	if v := req.GroupInfoForSet.DailyMsgQuota; v != nil && (v.Value < 0 || v.Value > constant.GroupDailyMsgQuotaMax) {
+		log.NewError(req.OperationID, "dailyMsgQuota out of range ", v.Value)
+		return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "dailyMsgQuota out of range"}}, nil
+	}
+
 	////bitwise operators: 0001:groupName; 0010:Notification  0100:Introduction; 1000:FaceUrl; 10000:owner
 	var changedType int32
 	groupName := ""
@@ -1306,6 +1423,18 @@ func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbGroup.SetGroupInf
 	if group.Notification != req.GroupInfoForSet.Notification && req.GroupInfoForSet.Notification != "" {
 		changedType = changedType | (1 << 1)
 		notification = req.GroupInfoForSet.Notification
+		// the group notification is the pinned announcement of the group
+		if !utils.IsContain(req.OpUserID, config.Config.Manager.AppManagerUid) {
+			allowed, err := hasGroupCapability(req.GroupInfoForSet.GroupID, req.OpUserID, constant.GroupCapPin)
+			if err != nil {
+				log.NewError(req.OperationID, "HasGroupCapability failed ", err.Error(), req.GroupInfoForSet.GroupID, req.OpUserID)
+				return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+			}
+			if !allowed {
+				log.NewError(req.OperationID, "no pin capability ", req.GroupInfoForSet.GroupID, req.OpUserID)
+				return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+			}
+		}
 	}
 	if group.Introduction != req.GroupInfoForSet.Introduction && req.GroupInfoForSet.Introduction != "" {
 		changedType = changedType | (1 << 2)
@@ -1343,6 +1472,24 @@ func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbGroup.SetGroupInf
 			return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 		}
 	}
+	if req.GroupInfoForSet.SlowModeSeconds != nil {
+		changedType = changedType | (1 << 7)
+		m := make(map[string]interface{})
+		m["slow_mode_seconds"] = req.GroupInfoForSet.SlowModeSeconds.Value
+		if err := imdb.UpdateGroupInfoDefaultZero(req.GroupInfoForSet.GroupID, m); err != nil {
+			log.NewError(req.OperationID, "UpdateGroupInfoDefaultZero failed ", err.Error(), m)
+			return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
+	if req.GroupInfoForSet.DailyMsgQuota != nil {
+		changedType = changedType | (1 << 8)
+		m := make(map[string]interface{})
+		m["daily_msg_quota"] = req.GroupInfoForSet.DailyMsgQuota.Value
+		if err := imdb.UpdateGroupInfoDefaultZero(req.GroupInfoForSet.GroupID, m); err != nil {
+			log.NewError(req.OperationID, "UpdateGroupInfoDefaultZero failed ", err.Error(), m)
+			return &pbGroup.SetGroupInfoResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
 	//
 	//if req.RoleLevel != nil {
 	//
@@ -1659,11 +1806,20 @@ func (s *groupServer) DismissGroup(ctx context.Context, req *pbGroup.DismissGrou
 		}
 		chat.GroupDismissedNotification(req)
 	} else {
+		superGroup, err := db.DB.GetSuperGroup(req.GroupID)
+		if err != nil {
+			log.NewError(req.OperationID, "GetSuperGroup failed ", err.Error(), req.GroupID)
+			return &pbGroup.DismissGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
 		err = db.DB.DeleteSuperGroup(req.GroupID)
 		if err != nil {
 			log.NewError(req.OperationID, "DeleteGroupMemberByGroupID failed ", req.GroupID)
 			return &pbGroup.DismissGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
 		}
+		if err := unmirrorSuperGroup(req.OperationID, req.GroupID, superGroup.MemberIDList); err != nil {
+			log.NewError(req.OperationID, "unmirrorSuperGroup failed ", err.Error(), req.GroupID)
+			return &pbGroup.DismissGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
 	}
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", pbGroup.CommonResp{ErrCode: 0, ErrMsg: ""})
 	return &pbGroup.DismissGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: 0, ErrMsg: ""}}, nil
@@ -1682,9 +1838,9 @@ func (s *groupServer) MuteGroupMember(ctx context.Context, req *pbGroup.MuteGrou
 		log.Error(req.OperationID, errMsg)
 		return &pbGroup.MuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if opFlag == 0 {
-		errMsg := req.OperationID + "opFlag == 0  " + req.GroupID + req.OpUserID
-		log.Error(req.OperationID, errMsg)
+	if allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapMute); err != nil || !allowed {
+		errMsg := req.OperationID + " no mute capability " + req.GroupID + req.OpUserID
+		log.Error(req.OperationID, errMsg, err)
 		return &pbGroup.MuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
 
@@ -1697,7 +1853,7 @@ func (s *groupServer) MuteGroupMember(ctx context.Context, req *pbGroup.MuteGrou
 		errMsg := req.OperationID + " mutedInfo.RoleLevel == constant.GroupOwner " + req.GroupID + req.UserID
 		return &pbGroup.MuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if mutedInfo.RoleLevel == constant.GroupAdmin && opFlag == 3 {
+	if mutedInfo.RoleLevel == constant.GroupAdmin && (opFlag == 3 || opFlag == 0) {
 		errMsg := req.OperationID + " mutedInfo.RoleLevel == constant.GroupAdmin " + req.GroupID + req.UserID
 		return &pbGroup.MuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
@@ -1727,9 +1883,9 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbGroup.Ca
 		log.Error(req.OperationID, errMsg)
 		return &pbGroup.CancelMuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if opFlag == 0 {
-		errMsg := req.OperationID + "opFlag == 0  " + req.GroupID + req.OpUserID
-		log.Error(req.OperationID, errMsg)
+	if allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapMute); err != nil || !allowed {
+		errMsg := req.OperationID + " no mute capability " + req.GroupID + req.OpUserID
+		log.Error(req.OperationID, errMsg, err)
 		return &pbGroup.CancelMuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
 
@@ -1742,7 +1898,7 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbGroup.Ca
 		errMsg := req.OperationID + " mutedInfo.RoleLevel == constant.GroupOwner " + req.GroupID + req.UserID
 		return &pbGroup.CancelMuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if mutedInfo.RoleLevel == constant.GroupAdmin && opFlag == 3 {
+	if mutedInfo.RoleLevel == constant.GroupAdmin && (opFlag == 3 || opFlag == 0) {
 		errMsg := req.OperationID + " mutedInfo.RoleLevel == constant.GroupAdmin " + req.GroupID + req.UserID
 		return &pbGroup.CancelMuteGroupMemberResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
@@ -1767,15 +1923,15 @@ func (s *groupServer) CancelMuteGroupMember(ctx context.Context, req *pbGroup.Ca
 func (s *groupServer) MuteGroup(ctx context.Context, req *pbGroup.MuteGroupReq) (*pbGroup.MuteGroupResp, error) {
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
 
-	opFlag, err := s.getGroupUserLevel(req.GroupID, req.OpUserID)
+	_, err := s.getGroupUserLevel(req.GroupID, req.OpUserID)
 	if err != nil {
 		errMsg := req.OperationID + " getGroupUserLevel failed " + req.GroupID + req.OpUserID + err.Error()
 		log.Error(req.OperationID, errMsg)
 		return &pbGroup.MuteGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if opFlag == 0 {
-		errMsg := req.OperationID + "opFlag == 0  " + req.GroupID + req.OpUserID
-		log.Error(req.OperationID, errMsg)
+	if allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapMute); err != nil || !allowed {
+		errMsg := req.OperationID + " no mute capability " + req.GroupID + req.OpUserID
+		log.Error(req.OperationID, errMsg, err)
 		return &pbGroup.MuteGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
 
@@ -1810,15 +1966,15 @@ func (s *groupServer) MuteGroup(ctx context.Context, req *pbGroup.MuteGroupReq)
 
 func (s *groupServer) CancelMuteGroup(ctx context.Context, req *pbGroup.CancelMuteGroupReq) (*pbGroup.CancelMuteGroupResp, error) {
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
-	opFlag, err := s.getGroupUserLevel(req.GroupID, req.OpUserID)
+	_, err := s.getGroupUserLevel(req.GroupID, req.OpUserID)
 	if err != nil {
 		errMsg := req.OperationID + " getGroupUserLevel failed " + req.GroupID + req.OpUserID + err.Error()
 		log.Error(req.OperationID, errMsg)
 		return &pbGroup.CancelMuteGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
-	if opFlag == 0 {
-		errMsg := req.OperationID + "opFlag == 0  " + req.GroupID + req.OpUserID
-		log.Error(req.OperationID, errMsg)
+	if allowed, err := hasGroupCapability(req.GroupID, req.OpUserID, constant.GroupCapMute); err != nil || !allowed {
+		errMsg := req.OperationID + " no mute capability " + req.GroupID + req.OpUserID
+		log.Error(req.OperationID, errMsg, err)
 		return &pbGroup.CancelMuteGroupResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: errMsg}}, nil
 	}
 	//mutedInfo, err := imdb.GetGroupMemberInfoByGroupIDAndUserID(req.GroupID, req.)
@@ -0,0 +1,494 @@
+package group
+
+import (
+	chat "Open_IM/internal/rpc/msg"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	pbGroup "Open_IM/pkg/proto/group"
+	pbMsg "Open_IM/pkg/proto/msg"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	go_redis "github.com/go-redis/redis/v8"
+	"go.mongodb.org/mongo-driver/mongo"
+	"gorm.io/gorm"
+)
+
+const (
+	groupConversionLeaseTTL      = 30 * time.Second
+	groupConversionBatchSize     = 1000
+	groupConversionDrainInterval = time.Second
+	// groupConversionDrainTimeout bounds the wait for the drain marker of a member, one whose notification was lost
+	// must not hold the job forever
+	groupConversionDrainTimeout = 5 * time.Minute
+)
+
+// what converting a group does given its last conversion
+const (
+	groupConversionStart = iota
+	groupConversionBusy
+	groupConversionTakeOver
+	groupConversionResume
+)
+
+// groupConversionAction decides what converting the group to groupType does, conversion is nil when the group was never
+// converted. A running job is taken over once its lease lapsed, a failed one is resumed when it had the same target.
+func groupConversionAction(conversion *db.GroupConversion, locked bool, groupType int32) int {
+	switch {
+	case conversion == nil:
+		return groupConversionStart
+	case conversion.Status == constant.GroupConversionRunning && locked:
+		return groupConversionBusy
+	case conversion.Status == constant.GroupConversionRunning:
+		return groupConversionTakeOver
+	case conversion.Status == constant.GroupConversionFailed && conversion.ToGroupType == groupType:
+		return groupConversionResume
+	}
+	return groupConversionStart
+}
+
+func groupConversionDBCopyPb(conversion *db.GroupConversion) *pbGroup.GroupConversion {
+	return &pbGroup.GroupConversion{GroupID: conversion.GroupID, FromGroupType: conversion.FromGroupType, ToGroupType: conversion.ToGroupType,
+		Status: conversion.Status, Step: conversion.Step, HistoryCount: conversion.HistoryCount, CopiedCount: conversion.CopiedCount,
+		OpUserID: conversion.OpUserID, ErrMsg: conversion.ErrMsg, CreateTime: conversion.CreateTime.Unix(), UpdateTime: conversion.UpdateTime.Unix()}
+}
+
+// ConvertGroupType starts moving a normal group (write diffusion) to super group (read diffusion) storage. A super group
+// can not be converted back, its history lives in its own documents and not in the timelines of the members.
+// The job runs in the background, a failed job is resumed by converting the group again and a running job whose
+// instance stopped is taken over.
+func (s *groupServer) ConvertGroupType(_ context.Context, req *pbGroup.ConvertGroupTypeReq) (*pbGroup.ConvertGroupTypeResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		log.NewError(req.OperationID, "only app manager can convert group ", req.OpUserID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if req.GroupType != constant.SuperGroup {
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "groupType must be super group, a super group can not be converted back"}}, nil
+	}
+	if req.HistoryCount < 0 || req.HistoryCount > constant.GroupConversionHistoryMax {
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid historyCount"}}, nil
+	}
+	if req.HistoryCount == 0 {
+		req.HistoryCount = constant.GroupConversionHistoryDefault
+	}
+	conversion, err := imdb.GetGroupConversion(req.GroupID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		conversion = nil
+	} else if err != nil {
+		log.NewError(req.OperationID, "GetGroupConversion failed ", err.Error(), req.GroupID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	locked := false
+	if conversion != nil && conversion.Status == constant.GroupConversionRunning {
+		if locked, err = db.DB.IsGroupConversionLocked(req.GroupID); err != nil {
+			log.NewError(req.OperationID, "IsGroupConversionLocked failed ", err.Error(), req.GroupID)
+			return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
+	switch groupConversionAction(conversion, locked, req.GroupType) {
+	case groupConversionBusy:
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group conversion is running"}}, nil
+	case groupConversionTakeOver:
+		log.NewInfo(req.OperationID, "take over group conversion ", req.GroupID, conversion.Step, conversion.CopiedCount)
+		go runGroupConversion(req.OperationID, req.GroupID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{}, Conversion: groupConversionDBCopyPb(conversion)}, nil
+	case groupConversionResume:
+		if err := imdb.UpdateGroupConversion(req.GroupID, map[string]interface{}{"status": constant.GroupConversionRunning, "err_msg": ""}); err != nil {
+			log.NewError(req.OperationID, "UpdateGroupConversion failed ", err.Error(), req.GroupID)
+			return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+		conversion.Status, conversion.ErrMsg = constant.GroupConversionRunning, ""
+		log.NewInfo(req.OperationID, "resume group conversion ", req.GroupID, conversion.Step, conversion.CopiedCount)
+		go runGroupConversion(req.OperationID, req.GroupID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{}, Conversion: groupConversionDBCopyPb(conversion)}, nil
+	}
+	groupInfo, err := imdb.GetGroupInfoByGroupID(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInfoByGroupID failed ", err.Error(), req.GroupID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if groupInfo.Status == constant.GroupStatusDismissed || groupInfo.GroupType != constant.NormalGroup {
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group can not be converted to the type"}}, nil
+	}
+	conversion = &db.GroupConversion{GroupID: req.GroupID, FromGroupType: groupInfo.GroupType, ToGroupType: req.GroupType,
+		Status: constant.GroupConversionRunning, Step: constant.GroupConversionStepMembers, HistoryCount: req.HistoryCount,
+		HistoryBefore: db.GetCurrentTimestampByMill(), OpUserID: req.OpUserID}
+	if err := imdb.SaveGroupConversion(conversion); err != nil {
+		log.NewError(req.OperationID, "SaveGroupConversion failed ", err.Error(), req.GroupID)
+		return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	go runGroupConversion(req.OperationID, req.GroupID)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", req.GroupID, conversion.FromGroupType, conversion.ToGroupType)
+	return &pbGroup.ConvertGroupTypeResp{CommonResp: &pbGroup.CommonResp{}, Conversion: groupConversionDBCopyPb(conversion)}, nil
+}
+
+func (s *groupServer) GetGroupConversion(_ context.Context, req *pbGroup.GetGroupConversionReq) (*pbGroup.GetGroupConversionResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		return &pbGroup.GetGroupConversionResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	conversion, err := imdb.GetGroupConversion(req.GroupID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbGroup.GetGroupConversionResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group was never converted"}}, nil
+	}
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupConversion failed ", err.Error(), req.GroupID)
+		return &pbGroup.GetGroupConversionResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	return &pbGroup.GetGroupConversionResp{CommonResp: &pbGroup.CommonResp{}, Conversion: groupConversionDBCopyPb(conversion)}, nil
+}
+
+// resumeGroupConversions keeps picking up the running jobs nobody holds the lease of, such as those of a stopped group rpc
+func resumeGroupConversions() {
+	for {
+		operationID := utils.OperationIDGenerator()
+		conversions, err := imdb.GetRunningGroupConversions()
+		if err != nil {
+			log.NewError(operationID, "GetRunningGroupConversions failed ", err.Error())
+		}
+		for _, conversion := range conversions {
+			if locked, err := db.DB.IsGroupConversionLocked(conversion.GroupID); err != nil || locked {
+				continue
+			}
+			log.NewInfo(operationID, "resume group conversion ", conversion.GroupID, conversion.Step, conversion.CopiedCount)
+			go runGroupConversion(operationID, conversion.GroupID)
+		}
+		time.Sleep(groupJobResumeInterval)
+	}
+}
+
+// runGroupConversion runs the remaining steps of the job while it holds the lease of the job,
+// every finished step is saved so the job resumes after it
+func runGroupConversion(operationID, groupID string) {
+	owner := utils.OperationIDGenerator()
+	ok, err := db.DB.LockGroupConversion(groupID, owner, groupConversionLeaseTTL)
+	if err != nil || !ok {
+		log.NewWarn(operationID, "group conversion is run by another instance ", groupID, err)
+		return
+	}
+	lease := keepJobLease(operationID, groupID, groupConversionLeaseTTL, func() (bool, error) {
+		return db.DB.ExtendGroupConversionLock(groupID, owner, groupConversionLeaseTTL)
+	})
+	defer func() {
+		lease.release()
+		if err := db.DB.UnlockGroupConversion(groupID, owner); err != nil {
+			log.NewError(operationID, "UnlockGroupConversion failed ", err.Error(), groupID)
+		}
+	}()
+	// the previous holder of the lease may have moved the job on
+	conversion, err := imdb.GetGroupConversion(groupID)
+	if err != nil {
+		log.NewError(operationID, "GetGroupConversion failed ", err.Error(), groupID)
+		return
+	}
+	if conversion.Status != constant.GroupConversionRunning {
+		return
+	}
+	// jobs converting back to a normal group were started before that was refused, they would drop the super group history
+	if conversion.ToGroupType != constant.SuperGroup {
+		if err := imdb.UpdateGroupConversion(groupID, map[string]interface{}{"status": constant.GroupConversionFailed, "err_msg": "a super group can not be converted back"}); err != nil {
+			log.NewError(operationID, "UpdateGroupConversion failed ", err.Error(), groupID)
+		}
+		return
+	}
+	for conversion.Step <= constant.GroupConversionStepCatchUp {
+		if lease.Lost() {
+			log.NewWarn(operationID, "group conversion left to another instance ", groupID, conversion.Step)
+			return
+		}
+		var err error
+		switch conversion.Step {
+		case constant.GroupConversionStepMembers:
+			err = convertGroupMembers(operationID, conversion)
+		case constant.GroupConversionStepHistory:
+			err = convertGroupHistory(operationID, conversion, lease)
+		case constant.GroupConversionStepSwitch:
+			err = switchGroupType(operationID, conversion)
+		case constant.GroupConversionStepNotify:
+			notifyGroupTypeChanged(operationID, conversion)
+		case constant.GroupConversionStepCatchUp:
+			err = catchUpGroupHistory(operationID, conversion, lease)
+		}
+		if err == errGroupJobLeaseLost {
+			log.NewWarn(operationID, "group conversion left to another instance ", groupID, conversion.Step)
+			return
+		}
+		if err != nil {
+			log.NewError(operationID, "group conversion failed ", err.Error(), groupID, conversion.Step)
+			errMsg := err.Error()
+			if len(errMsg) > 255 {
+				errMsg = errMsg[:255]
+			}
+			if err := imdb.UpdateGroupConversion(groupID, map[string]interface{}{"status": constant.GroupConversionFailed, "err_msg": errMsg}); err != nil {
+				log.NewError(operationID, "UpdateGroupConversion failed ", err.Error(), groupID)
+			}
+			return
+		}
+		conversion.Step++
+		args := map[string]interface{}{"step": conversion.Step}
+		if conversion.Step > constant.GroupConversionStepCatchUp {
+			conversion.Status = constant.GroupConversionDone
+			args["status"] = conversion.Status
+		}
+		if err := imdb.UpdateGroupConversion(groupID, args); err != nil {
+			log.NewError(operationID, "UpdateGroupConversion failed ", err.Error(), groupID, conversion.Step)
+			return
+		}
+	}
+	log.NewInfo(operationID, "group conversion done ", groupID, conversion.ToGroupType, conversion.CopiedCount)
+}
+
+// convertGroupMembers puts every member into the membership of the super group, members already there are skipped
+func convertGroupMembers(operationID string, conversion *db.GroupConversion) error {
+	memberIDList, err := imdb.GetGroupMemberIDListByGroupID(conversion.GroupID)
+	if err != nil {
+		return err
+	}
+	if _, err = db.DB.GetSuperGroup(conversion.GroupID); err == mongo.ErrNoDocuments {
+		return db.DB.CreateSuperGroup(conversion.GroupID, memberIDList, len(memberIDList))
+	} else if err != nil {
+		return err
+	}
+	return db.DB.AddUserToSuperGroup(conversion.GroupID, memberIDList)
+}
+
+// mergeGroupHistory merges the copies of group messages read from the timelines of members into one history, oldest
+// first. A message is kept once and only the newest count are kept, count 0 keeps all of them.
+func mergeGroupHistory(timelines [][]*open_im_sdk.MsgData, count int) []*open_im_sdk.MsgData {
+	seen := make(map[string]bool)
+	var msgList []*open_im_sdk.MsgData
+	for _, timeline := range timelines {
+		for _, msg := range timeline {
+			if seen[msg.ServerMsgID] {
+				continue
+			}
+			seen[msg.ServerMsgID] = true
+			msgList = append(msgList, msg)
+		}
+	}
+	sort.Slice(msgList, func(i, j int) bool {
+		if msgList[i].SendTime != msgList[j].SendTime {
+			return msgList[i].SendTime < msgList[j].SendTime
+		}
+		return msgList[i].ServerMsgID < msgList[j].ServerMsgID
+	})
+	if count > 0 && len(msgList) > count {
+		msgList = msgList[len(msgList)-count:]
+	}
+	return msgList
+}
+
+// readGroupHistory reads the newest count messages of a normal group sent in [after, before) (ms) from the timelines
+// of all its members, so a message one member never got or deleted is still found and no member has to be the owner
+func readGroupHistory(groupID string, after, before int64, count int) ([]*open_im_sdk.MsgData, error) {
+	memberIDList, err := imdb.GetGroupMemberIDListByGroupID(groupID)
+	if err != nil {
+		return nil, err
+	}
+	var msgList []*open_im_sdk.MsgData
+	for _, userID := range memberIDList {
+		timeline, err := db.DB.GetGroupMsgFromUserChat(userID, groupID, after, before, count)
+		if err != nil {
+			return nil, err
+		}
+		msgList = mergeGroupHistory([][]*open_im_sdk.MsgData{msgList, timeline}, count)
+	}
+	return msgList, nil
+}
+
+// convertGroupHistory copies the newest messages of a normal group into the super group documents after the
+// current max seq of the group, then moves the max seq past them so new messages continue where history ends
+func convertGroupHistory(operationID string, conversion *db.GroupConversion, lease *jobLease) error {
+	if conversion.CopiedCount == 0 {
+		maxSeq, err := db.DB.GetGroupMaxSeq(conversion.GroupID)
+		if err != nil && err != go_redis.Nil {
+			return err
+		}
+		conversion.BaseSeq = int64(maxSeq)
+		if err := imdb.UpdateGroupConversion(conversion.GroupID, map[string]interface{}{"base_seq": conversion.BaseSeq}); err != nil {
+			return err
+		}
+	}
+	msgList, err := readGroupHistory(conversion.GroupID, 0, conversion.HistoryBefore, int(conversion.HistoryCount))
+	if err != nil {
+		return err
+	}
+	err = copyToSuperGroup(operationID, conversion.GroupID, lease, msgList, uint64(conversion.BaseSeq), int(conversion.CopiedCount), func(copied int) error {
+		conversion.CopiedCount = int32(copied)
+		return imdb.UpdateGroupConversion(conversion.GroupID, map[string]interface{}{"copied_count": conversion.CopiedCount})
+	})
+	if err != nil {
+		return err
+	}
+	return db.DB.SetGroupMaxSeq(conversion.GroupID, uint64(conversion.BaseSeq)+uint64(len(msgList)))
+}
+
+// waitGroupMembersDrained waits until the timeline of every member holds a message sent after the switch. The notifications
+// of the notify step are the drain markers: a marker goes through the same partition as the group messages of its
+// member, so once it is stored every group message sent to the member before the switch is stored too.
+func waitGroupMembersDrained(operationID string, conversion *db.GroupConversion, lease *jobLease) error {
+	memberIDList, err := imdb.GetGroupMemberIDListByGroupID(conversion.GroupID)
+	if err != nil {
+		return err
+	}
+	deadline := utils.UnixMillSecondToTime(conversion.SwitchTime).Add(groupConversionDrainTimeout)
+	for {
+		var undrained []string
+		for _, userID := range memberIDList {
+			drained, err := db.DB.HasUserChatMsgSince(userID, conversion.SwitchTime)
+			if err != nil {
+				return err
+			}
+			if !drained {
+				undrained = append(undrained, userID)
+			}
+		}
+		if len(undrained) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			log.NewWarn(operationID, "group members not drained, their timelines are read as they are ", conversion.GroupID, undrained)
+			return nil
+		}
+		if lease.Lost() {
+			return errGroupJobLeaseLost
+		}
+		memberIDList = undrained
+		time.Sleep(groupConversionDrainInterval)
+	}
+}
+
+// catchUpGroupHistory copies the messages the normal group got after HistoryBefore until the switch to seqs taken
+// after the switch, they may follow newer messages in seq order but none of them is lost
+func catchUpGroupHistory(operationID string, conversion *db.GroupConversion, lease *jobLease) error {
+	// the seqs are only taken once every member drained, a resumed job finds the same messages
+	if conversion.TailCount == 0 {
+		if err := waitGroupMembersDrained(operationID, conversion, lease); err != nil {
+			return err
+		}
+	}
+	msgList, err := readGroupHistory(conversion.GroupID, conversion.HistoryBefore, conversion.SwitchTime, 0)
+	if err != nil {
+		return err
+	}
+	if conversion.TailCount == 0 {
+		if len(msgList) == 0 {
+			return nil
+		}
+		lastSeq, err := db.DB.IncrGroupMaxSeqBy(conversion.GroupID, uint64(len(msgList)))
+		if err != nil {
+			return err
+		}
+		conversion.TailBaseSeq, conversion.TailCount = int64(lastSeq)-int64(len(msgList)), int32(len(msgList))
+		if err := imdb.UpdateGroupConversion(conversion.GroupID, map[string]interface{}{"tail_base_seq": conversion.TailBaseSeq, "tail_count": conversion.TailCount}); err != nil {
+			return err
+		}
+	}
+	// messages that showed up after the seqs were taken are newer than all of them
+	if len(msgList) > int(conversion.TailCount) {
+		msgList = msgList[:conversion.TailCount]
+	}
+	return copyToSuperGroup(operationID, conversion.GroupID, lease, msgList, uint64(conversion.TailBaseSeq), 0, func(int) error { return nil })
+}
+
+// storedCopiedCount is how many messages are copied to the seqs after baseSeq given the highest of them stored,
+// msgList[i] is stored at baseSeq+1+i and storedSeq is baseSeq when none is stored
+func storedCopiedCount(baseSeq, storedSeq uint64, copied int) int {
+	if storedSeq > baseSeq && int(storedSeq-baseSeq) > copied {
+		return int(storedSeq - baseSeq)
+	}
+	return copied
+}
+
+// copyToSuperGroup writes msgList to the documents of a super group at the seqs after baseSeq in batches from copied on.
+// A batch stored before a restart is found by the seqs already in the documents and skipped instead of pushed again.
+func copyToSuperGroup(operationID, groupID string, lease *jobLease, msgList []*open_im_sdk.MsgData, baseSeq uint64, copied int, saveCopied func(copied int) error) error {
+	if len(msgList) == 0 {
+		return nil
+	}
+	storedSeq, err := db.DB.GetSuperGroupMaxSeqInRange(groupID, baseSeq+1, baseSeq+uint64(len(msgList)))
+	if err != nil {
+		return err
+	}
+	if stored := storedCopiedCount(baseSeq, storedSeq, copied); stored > copied {
+		log.NewInfo(operationID, "skip messages stored before restart ", groupID, copied, stored)
+		copied = stored
+		if err := saveCopied(copied); err != nil {
+			return err
+		}
+	}
+	for start := copied; start < len(msgList); start += groupConversionBatchSize {
+		if lease.Lost() {
+			return errGroupJobLeaseLost
+		}
+		end := start + groupConversionBatchSize
+		if end > len(msgList) {
+			end = len(msgList)
+		}
+		var msgToMQList []*pbMsg.MsgDataToMQ
+		for _, msg := range msgList[start:end] {
+			msg.SessionType = constant.SuperGroupChatType
+			msgToMQList = append(msgToMQList, &pbMsg.MsgDataToMQ{OperationID: operationID, MsgData: msg})
+		}
+		if err := db.DB.BatchInsertChat2DB(groupID, msgToMQList, operationID, baseSeq+uint64(start)); err != nil {
+			return err
+		}
+		if err := saveCopied(end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// switchGroupType changes the type of the group, from here on messages take the new storage path.
+// SwitchTime is taken once the type changed, group messages sent before it went to the timelines of the members.
+func switchGroupType(operationID string, conversion *db.GroupConversion) error {
+	if err := imdb.UpdateGroupInfoDefaultZero(conversion.GroupID, map[string]interface{}{"group_type": conversion.ToGroupType}); err != nil {
+		return err
+	}
+	if err := rocksCache.DelGroupInfoFromCache(conversion.GroupID); err != nil {
+		return err
+	}
+	if err := rocksCache.DelGroupMemberIDListFromCache(conversion.GroupID); err != nil {
+		log.NewError(operationID, "DelGroupMemberIDListFromCache failed ", err.Error(), conversion.GroupID)
+	}
+	if err := rocksCache.DelGroupMemberListHashFromCache(conversion.GroupID); err != nil {
+		log.NewError(operationID, "DelGroupMemberListHashFromCache failed ", err.Error(), conversion.GroupID)
+	}
+	memberIDList, err := imdb.GetGroupMemberIDListByGroupID(conversion.GroupID)
+	if err != nil {
+		return err
+	}
+	for _, userID := range memberIDList {
+		if err := rocksCache.DelJoinedGroupIDListFromCache(userID); err != nil {
+			log.NewError(operationID, "DelJoinedGroupIDListFromCache failed ", err.Error(), userID)
+		}
+		if err := rocksCache.DelJoinedSuperGroupIDListFromCache(userID); err != nil {
+			log.NewError(operationID, "DelJoinedSuperGroupIDListFromCache failed ", err.Error(), userID)
+		}
+	}
+	conversion.SwitchTime = db.GetCurrentTimestampByMill()
+	return imdb.UpdateGroupConversion(conversion.GroupID, map[string]interface{}{"switch_time": conversion.SwitchTime})
+}
+
+// notifyGroupTypeChanged tells every member about the new type, the notifications are also the drain markers of the catch up
+func notifyGroupTypeChanged(operationID string, conversion *db.GroupConversion) {
+	memberIDList, err := imdb.GetGroupMemberIDListByGroupID(conversion.GroupID)
+	if err != nil {
+		log.NewError(operationID, "GetGroupMemberIDListByGroupID failed ", err.Error(), conversion.GroupID)
+		return
+	}
+	for _, userID := range memberIDList {
+		chat.GroupTypeChangedNotification(operationID, conversion.OpUserID, conversion.GroupID, userID)
+		chat.SuperGroupNotification(operationID, userID, userID)
+	}
+}
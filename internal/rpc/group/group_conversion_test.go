@@ -0,0 +1,51 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MergeGroupHistory(t *testing.T) {
+	msg := func(serverMsgID string, sendTime int64) *open_im_sdk.MsgData {
+		return &open_im_sdk.MsgData{ServerMsgID: serverMsgID, SendTime: sendTime}
+	}
+	// the owner missed m2, another member joined after m1
+	owner := []*open_im_sdk.MsgData{msg("m1", 100), msg("m3", 300), msg("m4", 300)}
+	member := []*open_im_sdk.MsgData{msg("m2", 200), msg("m3", 300), msg("m4", 300)}
+	var serverMsgIDList []string
+	for _, v := range mergeGroupHistory([][]*open_im_sdk.MsgData{owner, member}, 0) {
+		serverMsgIDList = append(serverMsgIDList, v.ServerMsgID)
+	}
+	assert.Equal(t, []string{"m1", "m2", "m3", "m4"}, serverMsgIDList)
+
+	newest := mergeGroupHistory([][]*open_im_sdk.MsgData{member, owner}, 2)
+	assert.Len(t, newest, 2)
+	assert.Equal(t, "m3", newest[0].ServerMsgID)
+	assert.Equal(t, "m4", newest[1].ServerMsgID)
+	assert.Empty(t, mergeGroupHistory(nil, 10))
+}
+
+func Test_StoredCopiedCount(t *testing.T) {
+	// msgList[i] is stored at seq 100+1+i
+	assert.Equal(t, 0, storedCopiedCount(100, 100, 0))
+	assert.Equal(t, 3, storedCopiedCount(100, 103, 0))
+	// the saved count is ahead of what the documents show
+	assert.Equal(t, 5, storedCopiedCount(100, 103, 5))
+	assert.Equal(t, 2, storedCopiedCount(0, 2, 1))
+}
+
+func Test_GroupConversionAction(t *testing.T) {
+	assert.Equal(t, groupConversionStart, groupConversionAction(nil, false, constant.SuperGroup))
+	running := &db.GroupConversion{Status: constant.GroupConversionRunning, ToGroupType: constant.SuperGroup}
+	assert.Equal(t, groupConversionBusy, groupConversionAction(running, true, constant.SuperGroup))
+	assert.Equal(t, groupConversionTakeOver, groupConversionAction(running, false, constant.SuperGroup))
+	failed := &db.GroupConversion{Status: constant.GroupConversionFailed, ToGroupType: constant.SuperGroup, Step: constant.GroupConversionStepHistory}
+	assert.Equal(t, groupConversionResume, groupConversionAction(failed, false, constant.SuperGroup))
+	assert.Equal(t, groupConversionStart, groupConversionAction(failed, false, constant.NormalGroup))
+	done := &db.GroupConversion{Status: constant.GroupConversionDone, ToGroupType: constant.SuperGroup}
+	assert.Equal(t, groupConversionStart, groupConversionAction(done, false, constant.SuperGroup))
+}
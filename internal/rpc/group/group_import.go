@@ -0,0 +1,436 @@
+package group
+
+import (
+	chat "Open_IM/internal/rpc/msg"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	groupImportLeaseTTL      = 30 * time.Second
+	groupImportProgressBatch = 50
+	groupImportPurgeInterval = time.Hour
+	groupImportPurgeBatch    = 100
+)
+
+func groupImportJobDBCopyPb(job *db.GroupImportJob, results []*db.GroupImportResult) *pbGroup.GroupImportJob {
+	pbJob := &pbGroup.GroupImportJob{JobID: job.JobID, IdempotencyKey: job.IdempotencyKey, Status: job.Status, Silent: job.Silent, Total: job.Total,
+		Succeeded: job.Succeeded, Failed: job.Failed, OpUserID: job.OpUserID, CreateTime: job.CreateTime.Unix(), UpdateTime: job.UpdateTime.Unix()}
+	for _, v := range results {
+		pbJob.Results = append(pbJob.Results, &pbGroup.ImportGroupResult{GroupID: v.GroupID, ErrCode: v.ErrCode, ErrMsg: v.ErrMsg})
+	}
+	return pbJob
+}
+
+// getGroupImportJobInfo loads the job with its results again, the results of a running job are those of the groups done so far
+func getGroupImportJobInfo(jobID string) (*pbGroup.GroupImportJob, error) {
+	job, err := imdb.GetGroupImportJob(jobID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := imdb.GetGroupImportResults(jobID)
+	if err != nil {
+		return nil, err
+	}
+	return groupImportJobDBCopyPb(job, results), nil
+}
+
+// ImportGroups creates groups with their members and roles for migrations and directory sync. Group IDs are fixed when the
+// job is saved, so a resumed job never creates a group twice. Small imports finish within the call, larger ones are
+// followed through GetGroupImportJob.
+func (s *groupServer) ImportGroups(_ context.Context, req *pbGroup.ImportGroupsReq) (*pbGroup.ImportGroupsResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.IdempotencyKey, req.Silent, len(req.Groups))
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		log.NewError(req.OperationID, "only app manager can import groups ", req.OpUserID)
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if len(req.Groups) == 0 || len(req.Groups) > constant.GroupImportMaxGroups || len(req.IdempotencyKey) > 128 {
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid number of groups or idempotencyKey"}}, nil
+	}
+	memberNum := 0
+	for _, group := range req.Groups {
+		memberNum += len(group.Members)
+	}
+	if memberNum > constant.GroupImportMaxTotalMembers {
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "too many members in one import"}}, nil
+	}
+	if req.IdempotencyKey != "" {
+		job, err := imdb.GetGroupImportJobByIdempotencyKey(req.IdempotencyKey)
+		if err == nil {
+			log.NewInfo(req.OperationID, "group import already submitted ", req.IdempotencyKey, job.JobID)
+			return s.groupImportResp(req.OperationID, job.JobID), nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.NewError(req.OperationID, "GetGroupImportJobByIdempotencyKey failed ", err.Error(), req.IdempotencyKey)
+			return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
+	groupIDs := make(map[string]bool)
+	for i, group := range req.Groups {
+		if group.GroupInfo == nil {
+			return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "groupInfo is empty"}}, nil
+		}
+		if group.GroupInfo.GroupID == "" {
+			groupID := utils.Md5(req.OperationID + strconv.FormatInt(time.Now().UnixNano(), 10) + strconv.Itoa(i))
+			bi := big.NewInt(0)
+			bi.SetString(groupID[0:8], 16)
+			group.GroupInfo.GroupID = bi.String()
+		}
+		if groupIDs[group.GroupInfo.GroupID] {
+			return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "duplicate groupID " + group.GroupInfo.GroupID}}, nil
+		}
+		groupIDs[group.GroupInfo.GroupID] = true
+	}
+	data, err := json.Marshal(req.Groups)
+	if err != nil {
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: err.Error()}}, nil
+	}
+	job := &db.GroupImportJob{JobID: utils.Md5(req.OperationID + strconv.FormatInt(time.Now().UnixNano(), 10)), IdempotencyKey: req.IdempotencyKey,
+		Status: constant.GroupImportRunning, Silent: req.Silent, Total: int32(len(req.Groups)), Groups: string(data), OpUserID: req.OpUserID}
+	if job.IdempotencyKey == "" {
+		job.IdempotencyKey = job.JobID
+	}
+	if err := imdb.CreateGroupImportJob(job); err != nil {
+		// a concurrent retry with the same key saved its job first
+		if existing, err2 := imdb.GetGroupImportJobByIdempotencyKey(job.IdempotencyKey); err2 == nil {
+			return s.groupImportResp(req.OperationID, existing.JobID), nil
+		}
+		log.NewError(req.OperationID, "CreateGroupImportJob failed ", err.Error(), job.IdempotencyKey)
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if len(req.Groups) <= constant.GroupImportSyncMax {
+		runGroupImportJob(req.OperationID, job.JobID)
+	} else {
+		go runGroupImportJob(req.OperationID, job.JobID)
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", job.JobID, job.Total)
+	return s.groupImportResp(req.OperationID, job.JobID), nil
+}
+
+func (s *groupServer) groupImportResp(operationID, jobID string) *pbGroup.ImportGroupsResp {
+	job, err := getGroupImportJobInfo(jobID)
+	if err != nil {
+		log.NewError(operationID, "getGroupImportJobInfo failed ", err.Error(), jobID)
+		return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}
+	}
+	return &pbGroup.ImportGroupsResp{CommonResp: &pbGroup.CommonResp{}, Job: job}
+}
+
+func (s *groupServer) GetGroupImportJob(_ context.Context, req *pbGroup.GetGroupImportJobReq) (*pbGroup.GetGroupImportJobResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	jobID := req.JobID
+	if jobID == "" {
+		job, err := imdb.GetGroupImportJobByIdempotencyKey(req.IdempotencyKey)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group import job not found"}}, nil
+		}
+		if err != nil {
+			log.NewError(req.OperationID, "GetGroupImportJobByIdempotencyKey failed ", err.Error(), req.IdempotencyKey)
+			return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+		jobID = job.JobID
+	}
+	job, err := getGroupImportJobInfo(jobID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group import job not found"}}, nil
+	}
+	if err != nil {
+		log.NewError(req.OperationID, "getGroupImportJobInfo failed ", err.Error(), jobID)
+		return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	return &pbGroup.GetGroupImportJobResp{CommonResp: &pbGroup.CommonResp{}, Job: job}, nil
+}
+
+// resumeGroupImportJobs keeps picking up the running imports nobody holds the lease of, such as those of a stopped group rpc,
+// and purges the finished imports older than the retention
+func resumeGroupImportJobs() {
+	var lastPurge time.Time
+	for {
+		operationID := utils.OperationIDGenerator()
+		jobIDList, err := imdb.GetRunningGroupImportJobIDList()
+		if err != nil {
+			log.NewError(operationID, "GetRunningGroupImportJobIDList failed ", err.Error())
+		}
+		for _, jobID := range jobIDList {
+			if locked, err := db.DB.IsGroupImportLocked(jobID); err != nil || locked {
+				continue
+			}
+			log.NewInfo(operationID, "resume group import ", jobID)
+			go runGroupImportJob(operationID, jobID)
+		}
+		if time.Since(lastPurge) >= groupImportPurgeInterval {
+			purgeGroupImportJobs(operationID)
+			lastPurge = time.Now()
+		}
+		time.Sleep(groupJobResumeInterval)
+	}
+}
+
+// purgeGroupImportJobs deletes the finished imports older than the retention together with their results
+func purgeGroupImportJobs(operationID string) {
+	days := config.Current().GroupImport.RetentionDays
+	if days <= 0 {
+		return
+	}
+	before := time.Now().AddDate(0, 0, -days)
+	for {
+		n, err := imdb.DeleteGroupImportJobs(constant.GroupImportDone, before, groupImportPurgeBatch)
+		if err != nil {
+			log.NewError(operationID, "DeleteGroupImportJobs failed ", err.Error())
+			return
+		}
+		if n < groupImportPurgeBatch {
+			return
+		}
+	}
+}
+
+// runGroupImportJob imports the groups of the job that have no result yet while it holds the lease of the job
+func runGroupImportJob(operationID, jobID string) {
+	owner := utils.OperationIDGenerator()
+	ok, err := db.DB.LockGroupImport(jobID, owner, groupImportLeaseTTL)
+	if err != nil || !ok {
+		log.NewWarn(operationID, "group import is run by another instance ", jobID, err)
+		return
+	}
+	lease := keepJobLease(operationID, jobID, groupImportLeaseTTL, func() (bool, error) {
+		return db.DB.ExtendGroupImportLock(jobID, owner, groupImportLeaseTTL)
+	})
+	defer func() {
+		lease.release()
+		if err := db.DB.UnlockGroupImport(jobID, owner); err != nil {
+			log.NewError(operationID, "UnlockGroupImport failed ", err.Error(), jobID)
+		}
+	}()
+	job, err := imdb.GetGroupImportJob(jobID)
+	if err != nil {
+		log.NewError(operationID, "GetGroupImportJob failed ", err.Error(), jobID)
+		return
+	}
+	if job.Status != constant.GroupImportRunning {
+		return
+	}
+	var groups []*pbGroup.ImportGroup
+	if err := json.Unmarshal([]byte(job.Groups), &groups); err != nil {
+		log.NewError(operationID, "Unmarshal import groups failed ", err.Error(), job.JobID)
+		if err := imdb.UpdateGroupImportJob(job.JobID, map[string]interface{}{"status": constant.GroupImportDone, "failed": job.Total, "import_groups": ""}); err != nil {
+			log.NewError(operationID, "UpdateGroupImportJob failed ", err.Error(), job.JobID)
+		}
+		return
+	}
+	results, err := imdb.GetGroupImportResults(job.JobID)
+	if err != nil {
+		log.NewError(operationID, "GetGroupImportResults failed ", err.Error(), job.JobID)
+		return
+	}
+	done := make(map[string]bool)
+	job.Succeeded, job.Failed = 0, 0
+	for _, result := range results {
+		done[result.GroupID] = true
+		if result.ErrCode == 0 {
+			job.Succeeded++
+		} else {
+			job.Failed++
+		}
+	}
+	for i, group := range groups {
+		if done[group.GroupInfo.GroupID] {
+			continue
+		}
+		if lease.Lost() {
+			log.NewWarn(operationID, "group import left to another instance ", job.JobID, job.Succeeded, job.Failed)
+			return
+		}
+		if result := importGroup(operationID, job, int32(i), group); result.ErrCode == 0 {
+			job.Succeeded++
+		} else {
+			job.Failed++
+		}
+		if (i+1)%groupImportProgressBatch == 0 {
+			if err := imdb.UpdateGroupImportJob(job.JobID, map[string]interface{}{"succeeded": job.Succeeded, "failed": job.Failed}); err != nil {
+				log.NewError(operationID, "UpdateGroupImportJob failed ", err.Error(), job.JobID)
+			}
+		}
+	}
+	// the groups are only kept to resume the job, a finished job drops them
+	job.Status = constant.GroupImportDone
+	if err := imdb.UpdateGroupImportJob(job.JobID, map[string]interface{}{"status": job.Status, "succeeded": job.Succeeded, "failed": job.Failed, "import_groups": ""}); err != nil {
+		log.NewError(operationID, "UpdateGroupImportJob failed ", err.Error(), job.JobID)
+		return
+	}
+	log.NewInfo(operationID, "group import done ", job.JobID, job.Succeeded, job.Failed)
+}
+
+// importGroup creates one group of the job and records its result. Member callbacks are not called for imported groups.
+func importGroup(operationID string, job *db.GroupImportJob, seq int32, group *pbGroup.ImportGroup) *db.GroupImportResult {
+	result := &db.GroupImportResult{JobID: job.JobID, GroupID: group.GroupInfo.GroupID, Seq: seq}
+	fail := func(errCode int32, errMsg string) *db.GroupImportResult {
+		if len(errMsg) > 255 {
+			errMsg = errMsg[:255]
+		}
+		result.ErrCode, result.ErrMsg = errCode, errMsg
+		if err := imdb.InsertGroupImportResult(result); err != nil {
+			log.NewError(operationID, "InsertGroupImportResult failed ", err.Error(), job.JobID, result.GroupID)
+		}
+		return result
+	}
+	if err := checkImportGroup(group, maxGroupCustomRoles); err != nil {
+		return fail(constant.ErrArgs.ErrCode, err.Error())
+	}
+	userIDList := []string{group.OwnerUserID}
+	for _, member := range group.Members {
+		if member.UserID != group.OwnerUserID {
+			userIDList = append(userIDList, member.UserID)
+		}
+	}
+	users, err := imdb.GetUsersByUserIDList(userIDList)
+	if err != nil {
+		log.NewError(operationID, "GetUsersByUserIDList failed ", err.Error(), result.GroupID)
+		return fail(constant.ErrDB.ErrCode, constant.ErrDB.ErrMsg)
+	}
+	userMap := make(map[string]*db.User)
+	for _, user := range users {
+		userMap[user.UserID] = user
+	}
+	for _, userID := range userIDList {
+		if _, ok := userMap[userID]; !ok {
+			return fail(constant.ErrArgs.ErrCode, "user not exist "+userID)
+		}
+	}
+	now := time.Now()
+	groupInfo := db.Group{}
+	utils.CopyStructFields(&groupInfo, group.GroupInfo)
+	groupInfo.CreatorUserID = job.OpUserID
+	groupInfo.CreateTime = now
+	groupInfo.Status = constant.GroupOk
+	if groupInfo.NotificationUpdateTime.Unix() < 0 {
+		groupInfo.NotificationUpdateTime = utils.UnixSecondToTime(0)
+	}
+	var roles []*db.GroupRole
+	for _, role := range group.Roles {
+		name := role.Name
+		if name == "" {
+			name = role.RoleID
+		}
+		roles = append(roles, &db.GroupRole{GroupID: groupInfo.GroupID, RoleID: role.RoleID, Name: name, Capabilities: role.Capabilities, CreateTime: now, UpdateTime: now})
+	}
+	members := []*db.GroupMember{newImportGroupMember(job, groupInfo.GroupID, userMap[group.OwnerUserID], constant.GroupOwner, "", now)}
+	for _, member := range group.Members {
+		if member.UserID == group.OwnerUserID {
+			continue
+		}
+		roleLevel := member.RoleLevel
+		if roleLevel == 0 {
+			roleLevel = constant.GroupOrdinaryUsers
+		}
+		members = append(members, newImportGroupMember(job, groupInfo.GroupID, userMap[member.UserID], roleLevel, member.RoleID, now))
+	}
+	if err := imdb.ImportGroup(&groupInfo, roles, members, result); err != nil {
+		log.NewError(operationID, "ImportGroup failed ", err.Error(), result.GroupID)
+		return fail(constant.ErrDB.ErrCode, err.Error())
+	}
+	if err := rocksCache.DelGroupInfoFromCache(groupInfo.GroupID); err != nil {
+		log.NewError(operationID, "DelGroupInfoFromCache failed ", err.Error(), groupInfo.GroupID)
+	}
+	if err := rocksCache.DelGroupMemberIDListFromCache(groupInfo.GroupID); err != nil {
+		log.NewError(operationID, "DelGroupMemberIDListFromCache failed ", err.Error(), groupInfo.GroupID)
+	}
+	if err := rocksCache.DelGroupMemberNumFromCache(groupInfo.GroupID); err != nil {
+		log.NewError(operationID, "DelGroupMemberNumFromCache failed ", err.Error(), groupInfo.GroupID)
+	}
+	for _, userID := range userIDList {
+		if err := rocksCache.DelJoinedGroupIDListFromCache(userID); err != nil {
+			log.NewError(operationID, "DelJoinedGroupIDListFromCache failed ", err.Error(), userID)
+		}
+	}
+	if !job.Silent {
+		chat.GroupCreatedNotification(operationID, job.OpUserID, groupInfo.GroupID, userIDList)
+	}
+	return result
+}
+
+func newImportGroupMember(job *db.GroupImportJob, groupID string, user *db.User, roleLevel int32, roleID string, joinTime time.Time) *db.GroupMember {
+	return &db.GroupMember{GroupID: groupID, UserID: user.UserID, Nickname: user.Nickname, FaceURL: user.FaceURL, RoleLevel: roleLevel,
+		JoinTime: joinTime, JoinSource: constant.JoinByAdmin, InviterUserID: job.OpUserID, OperatorUserID: job.OpUserID,
+		MuteEndTime: time.Unix(int64(time.Now().Second()), 0), RoleID: roleID}
+}
+
+// checkImportGroup validates a group of a bulk import before anything of it is written.
+// The owner may be listed among the members, its role level there is ignored.
+func checkImportGroup(group *pbGroup.ImportGroup, maxCustomRoles int) error {
+	if group.GroupInfo == nil {
+		return errors.New("groupInfo is empty")
+	}
+	if group.GroupInfo.GroupType != constant.NormalGroup && group.GroupInfo.GroupType != constant.WorkingGroup {
+		return errors.New("only normal and working groups can be imported")
+	}
+	if group.OwnerUserID == "" {
+		return errors.New("ownerUserID is empty")
+	}
+	if len(group.Members) > constant.GroupImportMaxMembers {
+		return fmt.Errorf("more than %d members", constant.GroupImportMaxMembers)
+	}
+	customRoles := make(map[string]bool)
+	builtinRoles := make(map[string]bool)
+	for _, role := range group.Roles {
+		if role.RoleID == "" || len(role.RoleID) > 64 || role.RoleID == constant.GroupRoleOwner {
+			return errors.New("roleID must be 1 to 64 bytes and not owner")
+		}
+		if role.Capabilities&^constant.GroupCapAll != 0 {
+			return fmt.Errorf("unknown capabilities of role %s", role.RoleID)
+		}
+		if customRoles[role.RoleID] || builtinRoles[role.RoleID] {
+			return fmt.Errorf("duplicate role %s", role.RoleID)
+		}
+		if _, ok := constant.DefaultGroupRoleCapabilities[role.RoleID]; ok {
+			builtinRoles[role.RoleID] = true
+		} else {
+			customRoles[role.RoleID] = true
+		}
+	}
+	if len(customRoles) > maxCustomRoles {
+		return errors.New("too many custom roles in group")
+	}
+	userIDs := make(map[string]bool)
+	for _, member := range group.Members {
+		if member.UserID == "" || userIDs[member.UserID] {
+			return fmt.Errorf("empty or duplicate member %s", member.UserID)
+		}
+		userIDs[member.UserID] = true
+		if member.UserID == group.OwnerUserID {
+			continue
+		}
+		switch member.RoleLevel {
+		case 0, constant.GroupOrdinaryUsers:
+		case constant.GroupAdmin:
+			if member.RoleID != "" {
+				return fmt.Errorf("admin %s can not have a custom role", member.UserID)
+			}
+		default:
+			return fmt.Errorf("invalid roleLevel of member %s", member.UserID)
+		}
+		if member.RoleID != "" && !customRoles[member.RoleID] {
+			return fmt.Errorf("role %s of member %s is not a custom role of the group", member.RoleID, member.UserID)
+		}
+	}
+	return nil
+}
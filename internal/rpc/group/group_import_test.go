@@ -0,0 +1,34 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	pbGroup "Open_IM/pkg/proto/group"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CheckImportGroup(t *testing.T) {
+	group := &pbGroup.ImportGroup{
+		GroupInfo:   &open_im_sdk.GroupInfo{GroupName: "sales", GroupType: constant.WorkingGroup},
+		OwnerUserID: "u1",
+		Roles:       []*pbGroup.GroupRole{{RoleID: "lead", Capabilities: constant.GroupCapKick}, {RoleID: constant.GroupRoleMember}},
+		Members: []*pbGroup.ImportGroupMember{{UserID: "u1", RoleLevel: constant.GroupOwner}, {UserID: "u2", RoleLevel: constant.GroupAdmin},
+			{UserID: "u3", RoleID: "lead"}, {UserID: "u4", RoleLevel: constant.GroupOrdinaryUsers}},
+	}
+	assert.Nil(t, checkImportGroup(group, 20))
+	assert.NotNil(t, checkImportGroup(group, 0))
+
+	group.Members = append(group.Members, &pbGroup.ImportGroupMember{UserID: "u3"})
+	assert.NotNil(t, checkImportGroup(group, 20))
+	// only the owner may be owner and members can only take custom roles
+	group.Members[4] = &pbGroup.ImportGroupMember{UserID: "u5", RoleLevel: constant.GroupOwner}
+	assert.NotNil(t, checkImportGroup(group, 20))
+	group.Members[4] = &pbGroup.ImportGroupMember{UserID: "u5", RoleID: constant.GroupRoleMember}
+	assert.NotNil(t, checkImportGroup(group, 20))
+
+	group.Members = group.Members[:4]
+	group.GroupInfo.GroupType = constant.SuperGroup
+	assert.NotNil(t, checkImportGroup(group, 20))
+}
@@ -0,0 +1,219 @@
+package group
+
+import (
+	chat "Open_IM/internal/rpc/msg"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+const maxGroupCustomRoles = 20
+
+func isBuiltinGroupRole(roleID string) bool {
+	_, ok := constant.DefaultGroupRoleCapabilities[roleID]
+	return ok
+}
+
+// getGroupMemberCapabilities is where every group permission is decided, app managers hold all capabilities
+func getGroupMemberCapabilities(groupID, userID string) (int64, error) {
+	if token_verify.IsManagerUserID(userID) {
+		return constant.GroupCapAll, nil
+	}
+	member, err := rocksCache.GetGroupMemberInfoFromCache(groupID, userID)
+	if err != nil {
+		return 0, utils.Wrap(err, "GetGroupMemberInfoFromCache failed")
+	}
+	groupRoles, err := rocksCache.GetGroupRoleCapabilitiesFromCache(groupID)
+	if err != nil {
+		return 0, utils.Wrap(err, "GetGroupRoleCapabilitiesFromCache failed")
+	}
+	return constant.GroupRoleCapabilities(constant.GroupMemberRole(member.RoleLevel, member.RoleID), groupRoles), nil
+}
+
+// hasGroupCapability reports whether userID holds all of capabilities in groupID
+func hasGroupCapability(groupID, userID string, capabilities int64) (bool, error) {
+	held, err := getGroupMemberCapabilities(groupID, userID)
+	if err != nil {
+		return false, err
+	}
+	return held&capabilities == capabilities, nil
+}
+
+// canManageGroupRoles allows the app manager and the owner, admins could otherwise grant themselves more
+func (s *groupServer) canManageGroupRoles(groupID, opUserID string) bool {
+	opFlag, err := s.getGroupUserLevel(groupID, opUserID)
+	return err == nil && (opFlag == 1 || opFlag == 2)
+}
+
+func (s *groupServer) SetGroupRole(_ context.Context, req *pbGroup.SetGroupRoleReq) (*pbGroup.SetGroupRoleResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageGroupRoles(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to set group role ", req.GroupID, req.OpUserID)
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if req.RoleID == "" || len(req.RoleID) > 64 || req.RoleID == constant.GroupRoleOwner {
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "roleID must be 1 to 64 bytes and not owner"}}, nil
+	}
+	if req.Capabilities&^constant.GroupCapAll != 0 {
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "unknown capabilities"}}, nil
+	}
+	groupInfo, err := imdb.GetGroupInfoByGroupID(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInfoByGroupID failed ", err.Error(), req.GroupID)
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if groupInfo.Status == constant.GroupStatusDismissed {
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrStatus.ErrCode, ErrMsg: "group status is dismissed"}}, nil
+	}
+	roles, err := imdb.GetGroupRoles(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupRoles failed ", err.Error(), req.GroupID)
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	var custom int
+	exists := false
+	for _, role := range roles {
+		if !isBuiltinGroupRole(role.RoleID) {
+			custom++
+		}
+		exists = exists || role.RoleID == req.RoleID
+	}
+	if !exists && !isBuiltinGroupRole(req.RoleID) && custom >= maxGroupCustomRoles {
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "too many custom roles in group"}}, nil
+	}
+	role := &db.GroupRole{GroupID: req.GroupID, RoleID: req.RoleID, Name: req.Name, Capabilities: req.Capabilities}
+	if role.Name == "" {
+		role.Name = req.RoleID
+	}
+	if err := imdb.SetGroupRole(role); err != nil {
+		log.NewError(req.OperationID, "SetGroupRole failed ", err.Error(), role)
+		return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if err := rocksCache.DelGroupRolesFromCache(req.GroupID); err != nil {
+		log.NewError(req.OperationID, "DelGroupRolesFromCache failed ", err.Error(), req.GroupID)
+	}
+	chat.GroupRoleChangedNotification(req.OperationID, req.OpUserID, req.GroupID)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", req.GroupID, req.RoleID)
+	return &pbGroup.SetGroupRoleResp{CommonResp: &pbGroup.CommonResp{}}, nil
+}
+
+// DeleteGroupRole removes a custom role, for admin and member it restores the default capabilities
+func (s *groupServer) DeleteGroupRole(_ context.Context, req *pbGroup.DeleteGroupRoleReq) (*pbGroup.DeleteGroupRoleResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageGroupRoles(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to delete group role ", req.GroupID, req.OpUserID)
+		return &pbGroup.DeleteGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	_, err := imdb.GetGroupRole(req.GroupID, req.RoleID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &pbGroup.DeleteGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group role not found"}}, nil
+	}
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupRole failed ", err.Error(), req.GroupID, req.RoleID)
+		return &pbGroup.DeleteGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	userIDList, err := imdb.DeleteGroupRole(req.GroupID, req.RoleID)
+	if err != nil {
+		log.NewError(req.OperationID, "DeleteGroupRole failed ", err.Error(), req.GroupID, req.RoleID)
+		return &pbGroup.DeleteGroupRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if err := rocksCache.DelGroupRolesFromCache(req.GroupID); err != nil {
+		log.NewError(req.OperationID, "DelGroupRolesFromCache failed ", err.Error(), req.GroupID)
+	}
+	for _, userID := range userIDList {
+		if err := rocksCache.DelGroupMemberInfoFromCache(req.GroupID, userID); err != nil {
+			log.NewError(req.OperationID, "DelGroupMemberInfoFromCache failed ", err.Error(), req.GroupID, userID)
+		}
+	}
+	chat.GroupRoleChangedNotification(req.OperationID, req.OpUserID, req.GroupID)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", req.GroupID, req.RoleID, len(userIDList))
+	return &pbGroup.DeleteGroupRoleResp{CommonResp: &pbGroup.CommonResp{}}, nil
+}
+
+// GetGroupRoles lists the built-in roles with the capabilities in effect followed by the custom roles
+func (s *groupServer) GetGroupRoles(_ context.Context, req *pbGroup.GetGroupRolesReq) (*pbGroup.GetGroupRolesResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !imdb.IsExistGroupMember(req.GroupID, req.OpUserID) && !token_verify.IsManagerUserID(req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to get group roles ", req.GroupID, req.OpUserID)
+		return &pbGroup.GetGroupRolesResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	roles, err := imdb.GetGroupRoles(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupRoles failed ", err.Error(), req.GroupID)
+		return &pbGroup.GetGroupRolesResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	resp := &pbGroup.GetGroupRolesResp{CommonResp: &pbGroup.CommonResp{}}
+	for _, roleID := range []string{constant.GroupRoleOwner, constant.GroupRoleAdmin, constant.GroupRoleMember} {
+		r := &pbGroup.GroupRole{GroupID: req.GroupID, RoleID: roleID, Name: roleID, Capabilities: constant.DefaultGroupRoleCapabilities[roleID], Builtin: true}
+		for _, role := range roles {
+			if role.RoleID == roleID {
+				r.Name, r.Capabilities, r.UpdateTime = role.Name, role.Capabilities, role.UpdateTime.Unix()
+			}
+		}
+		resp.Roles = append(resp.Roles, r)
+	}
+	for _, role := range roles {
+		if !isBuiltinGroupRole(role.RoleID) {
+			resp.Roles = append(resp.Roles, &pbGroup.GroupRole{GroupID: role.GroupID, RoleID: role.RoleID, Name: role.Name,
+				Capabilities: role.Capabilities, UpdateTime: role.UpdateTime.Unix()})
+		}
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", len(resp.Roles))
+	return resp, nil
+}
+
+// SetGroupMemberRole gives ordinary members a custom role, an empty roleID or member takes it back
+func (s *groupServer) SetGroupMemberRole(_ context.Context, req *pbGroup.SetGroupMemberRoleReq) (*pbGroup.SetGroupMemberRoleResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageGroupRoles(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to set group member role ", req.GroupID, req.OpUserID)
+		return &pbGroup.SetGroupMemberRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	roleID := req.RoleID
+	if roleID == constant.GroupRoleMember {
+		roleID = ""
+	}
+	if roleID != "" {
+		if isBuiltinGroupRole(roleID) {
+			return &pbGroup.SetGroupMemberRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "owner and admin are set through the member role level"}}, nil
+		}
+		_, err := imdb.GetGroupRole(req.GroupID, roleID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pbGroup.SetGroupMemberRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "group role not found"}}, nil
+		}
+		if err != nil {
+			log.NewError(req.OperationID, "GetGroupRole failed ", err.Error(), req.GroupID, roleID)
+			return &pbGroup.SetGroupMemberRoleResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+	}
+	resp := &pbGroup.SetGroupMemberRoleResp{CommonResp: &pbGroup.CommonResp{}}
+	for _, userID := range req.UserIDList {
+		member, err := imdb.GetGroupMemberInfoByGroupIDAndUserID(req.GroupID, userID)
+		if err != nil || member.RoleLevel != constant.GroupOrdinaryUsers {
+			log.NewError(req.OperationID, "not an ordinary member ", req.GroupID, userID, err)
+			resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: userID, Result: -1})
+			continue
+		}
+		if err := imdb.SetGroupMemberRoleID(req.GroupID, userID, roleID); err != nil {
+			log.NewError(req.OperationID, "SetGroupMemberRoleID failed ", err.Error(), req.GroupID, userID, roleID)
+			resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: userID, Result: -1})
+			continue
+		}
+		if err := rocksCache.DelGroupMemberInfoFromCache(req.GroupID, userID); err != nil {
+			log.NewError(req.OperationID, "DelGroupMemberInfoFromCache failed ", err.Error(), req.GroupID, userID)
+		}
+		resp.Id2ResultList = append(resp.Id2ResultList, &pbGroup.Id2Result{UserID: userID, Result: 0})
+		chat.GroupMemberInfoSetNotification(req.OperationID, req.OpUserID, req.GroupID, userID)
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", resp.String())
+	return resp, nil
+}
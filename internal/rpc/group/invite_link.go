@@ -0,0 +1,175 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func newInviteToken() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func toInviteLinkPb(link *db.GroupInviteLink) *pbGroup.GroupInviteLink {
+	var expireTime int64
+	if link.ExpireTime.Unix() > 0 {
+		expireTime = link.ExpireTime.Unix()
+	}
+	return &pbGroup.GroupInviteLink{Token: link.Token, GroupID: link.GroupID, CreatorUserID: link.CreatorUserID, ExpireTime: expireTime,
+		MaxUses: link.MaxUses, UseCount: link.UseCount, AutoApprove: link.AutoApprove, Status: link.Status, CreateTime: link.CreateTime.Unix()}
+}
+
+// canManageInviteLinks allows the app manager and the owner and admins of the group
+func (s *groupServer) canManageInviteLinks(groupID, opUserID string) bool {
+	opFlag, err := s.getGroupUserLevel(groupID, opUserID)
+	return err == nil && opFlag != 0
+}
+
+func (s *groupServer) CreateGroupInviteLink(_ context.Context, req *pbGroup.CreateGroupInviteLinkReq) (*pbGroup.CreateGroupInviteLinkResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageInviteLinks(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to create invite link ", req.GroupID, req.OpUserID)
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if req.ExpireSeconds < 0 || req.MaxUses < 0 {
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "expireSeconds and maxUses must not be negative"}}, nil
+	}
+	groupInfo, err := imdb.GetGroupInfoByGroupID(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInfoByGroupID failed ", err.Error(), req.GroupID)
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if groupInfo.Status == constant.GroupStatusDismissed {
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrStatus.ErrCode, ErrMsg: "group status is dismissed"}}, nil
+	}
+	// a super group has no direct join, a join by link always waits for an admin there
+	if req.AutoApprove && groupInfo.GroupType == constant.SuperGroup {
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "autoApprove is not supported by super groups"}}, nil
+	}
+	token, err := newInviteToken()
+	if err != nil {
+		log.NewError(req.OperationID, "newInviteToken failed ", err.Error())
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrInternal.ErrCode, ErrMsg: err.Error()}}, nil
+	}
+	now := time.Now()
+	link := &db.GroupInviteLink{Token: token, GroupID: req.GroupID, CreatorUserID: req.OpUserID, ExpireTime: utils.UnixSecondToTime(0),
+		MaxUses: req.MaxUses, AutoApprove: req.AutoApprove, Status: constant.GroupInviteLinkNormal, CreateTime: now}
+	if req.ExpireSeconds > 0 {
+		link.ExpireTime = now.Add(time.Duration(req.ExpireSeconds) * time.Second)
+	}
+	if err := imdb.InsertGroupInviteLink(link); err != nil {
+		log.NewError(req.OperationID, "InsertGroupInviteLink failed ", err.Error(), link)
+		return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", link.GroupID, link.Token)
+	return &pbGroup.CreateGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{}, Link: toInviteLinkPb(link)}, nil
+}
+
+func (s *groupServer) GetGroupInviteLinks(_ context.Context, req *pbGroup.GetGroupInviteLinksReq) (*pbGroup.GetGroupInviteLinksResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageInviteLinks(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to get invite links ", req.GroupID, req.OpUserID)
+		return &pbGroup.GetGroupInviteLinksResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	links, err := imdb.GetGroupInviteLinks(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInviteLinks failed ", err.Error(), req.GroupID)
+		return &pbGroup.GetGroupInviteLinksResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	resp := &pbGroup.GetGroupInviteLinksResp{CommonResp: &pbGroup.CommonResp{}}
+	for _, link := range links {
+		resp.Links = append(resp.Links, toInviteLinkPb(link))
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", len(resp.Links))
+	return resp, nil
+}
+
+func (s *groupServer) RevokeGroupInviteLink(_ context.Context, req *pbGroup.RevokeGroupInviteLinkReq) (*pbGroup.RevokeGroupInviteLinkResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageInviteLinks(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to revoke invite link ", req.GroupID, req.OpUserID)
+		return &pbGroup.RevokeGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	ok, err := imdb.RevokeGroupInviteLink(req.GroupID, req.Token)
+	if err != nil {
+		log.NewError(req.OperationID, "RevokeGroupInviteLink failed ", err.Error(), req.GroupID, req.Token)
+		return &pbGroup.RevokeGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if !ok {
+		return &pbGroup.RevokeGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invite link not found or already revoked"}}, nil
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ")
+	return &pbGroup.RevokeGroupInviteLinkResp{CommonResp: &pbGroup.CommonResp{}}, nil
+}
+
+// getInviteLink loads the link of req, a join carrying only the token is for the group of the link
+func getInviteLink(req *pbGroup.JoinGroupReq) (*db.GroupInviteLink, int32, string) {
+	link, err := imdb.GetGroupInviteLink(req.InviteToken)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, constant.ErrArgs.ErrCode, "invite link not found"
+	}
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInviteLink failed ", err.Error(), req.InviteToken)
+		return nil, constant.ErrDB.ErrCode, constant.ErrDB.ErrMsg
+	}
+	if req.GroupID == "" {
+		req.GroupID = link.GroupID
+	}
+	if link.GroupID != req.GroupID {
+		return nil, constant.ErrArgs.ErrCode, "invite link belongs to another group"
+	}
+	return link, 0, ""
+}
+
+// isInviteLinkUsable is false for a revoked, used up or expired link, an expire time at the unix epoch never expires
+func isInviteLinkUsable(link *db.GroupInviteLink, now time.Time) bool {
+	if link.Status != constant.GroupInviteLinkNormal || (link.MaxUses > 0 && link.UseCount >= link.MaxUses) {
+		return false
+	}
+	return !link.ExpireTime.After(time.Unix(0, 0)) || link.ExpireTime.After(now)
+}
+
+// checkInviteLink refuses a link that can not be used now, the member is recorded as joined by qr code invited by the creator of the link
+func checkInviteLink(req *pbGroup.JoinGroupReq, link *db.GroupInviteLink) (int32, string) {
+	if !isInviteLinkUsable(link, time.Now()) {
+		return constant.ErrArgs.ErrCode, "invite link is revoked, expired or used up"
+	}
+	req.JoinSource = constant.JoinByQRCode
+	req.InviterUserID = link.CreatorUserID
+	return 0, ""
+}
+
+// redeemInviteLink reserves a use of the link right before the member or the application is saved, so concurrent
+// joins can not use it beyond maxUses. It fails when they used the link up in between.
+func redeemInviteLink(req *pbGroup.JoinGroupReq, link *db.GroupInviteLink) (int32, string) {
+	ok, err := imdb.UseGroupInviteLink(link.Token, time.Now())
+	if err != nil {
+		log.NewError(req.OperationID, "UseGroupInviteLink failed ", err.Error(), link.Token)
+		return constant.ErrDB.ErrCode, constant.ErrDB.ErrMsg
+	}
+	if !ok {
+		return constant.ErrArgs.ErrCode, "invite link is revoked, expired or used up"
+	}
+	return 0, ""
+}
+
+// releaseInviteLink gives the use reserved by redeemInviteLink back when the join was not saved
+func releaseInviteLink(req *pbGroup.JoinGroupReq, link *db.GroupInviteLink) {
+	if err := imdb.ReleaseGroupInviteLink(link.Token); err != nil {
+		log.NewError(req.OperationID, "ReleaseGroupInviteLink failed ", err.Error(), link.Token)
+	}
+}
@@ -0,0 +1,40 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	pbGroup "Open_IM/pkg/proto/group"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsInviteLinkUsable(t *testing.T) {
+	now := time.Now()
+	never := time.Unix(0, 0)
+	link := func(status, maxUses, useCount int32, expireTime time.Time) *db.GroupInviteLink {
+		return &db.GroupInviteLink{Status: status, MaxUses: maxUses, UseCount: useCount, ExpireTime: expireTime}
+	}
+	assert.True(t, isInviteLinkUsable(link(constant.GroupInviteLinkNormal, 0, 100, never), now))
+	assert.True(t, isInviteLinkUsable(link(constant.GroupInviteLinkNormal, 3, 2, now.Add(time.Minute)), now))
+	assert.False(t, isInviteLinkUsable(link(constant.GroupInviteLinkRevoked, 0, 0, never), now))
+	// used up
+	assert.False(t, isInviteLinkUsable(link(constant.GroupInviteLinkNormal, 3, 3, never), now))
+	// expired, a link expiring right now is expired as well
+	assert.False(t, isInviteLinkUsable(link(constant.GroupInviteLinkNormal, 0, 0, now.Add(-time.Minute)), now))
+	assert.False(t, isInviteLinkUsable(link(constant.GroupInviteLinkNormal, 0, 0, now), now))
+}
+
+func Test_CheckInviteLink(t *testing.T) {
+	req := &pbGroup.JoinGroupReq{GroupID: "g1", OpUserID: "u1", JoinSource: constant.JoinBySearch}
+	errCode, _ := checkInviteLink(req, &db.GroupInviteLink{Status: constant.GroupInviteLinkNormal, MaxUses: 1, UseCount: 1, CreatorUserID: "u0"})
+	assert.Equal(t, constant.ErrArgs.ErrCode, errCode)
+	assert.Equal(t, int32(constant.JoinBySearch), req.JoinSource)
+	assert.Empty(t, req.InviterUserID)
+
+	errCode, _ = checkInviteLink(req, &db.GroupInviteLink{Status: constant.GroupInviteLinkNormal, CreatorUserID: "u0"})
+	assert.Equal(t, int32(0), errCode)
+	assert.Equal(t, int32(constant.JoinByQRCode), req.JoinSource)
+	assert.Equal(t, "u0", req.InviterUserID)
+}
@@ -0,0 +1,56 @@
+package group
+
+import (
+	"Open_IM/pkg/common/log"
+	"errors"
+	"sync/atomic"
+	"time"
+)
+
+// groupJobResumeInterval is how often running jobs whose lease lapsed are picked up again
+const groupJobResumeInterval = time.Minute
+
+var errGroupJobLeaseLost = errors.New("job lease lost")
+
+// jobLease renews the redis lease of a background job until it is released. Once the lease lapsed another
+// instance may take the job over, so the job stops at its next check of Lost.
+type jobLease struct {
+	lost     int32
+	released chan struct{}
+}
+
+func keepJobLease(operationID, jobID string, ttl time.Duration, renew func() (bool, error)) *jobLease {
+	lease := &jobLease{released: make(chan struct{})}
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+		renewed := time.Now()
+		for {
+			select {
+			case <-lease.released:
+				return
+			case <-ticker.C:
+			}
+			ok, err := renew()
+			if err != nil {
+				log.NewError(operationID, "renew job lease failed ", err.Error(), jobID)
+			} else if ok {
+				renewed = time.Now()
+			}
+			if (err == nil && !ok) || time.Since(renewed) >= ttl {
+				log.NewWarn(operationID, "job lease lost ", jobID)
+				atomic.StoreInt32(&lease.lost, 1)
+				return
+			}
+		}
+	}()
+	return lease
+}
+
+func (l *jobLease) Lost() bool {
+	return atomic.LoadInt32(&l.lost) == 1
+}
+
+func (l *jobLease) release() {
+	close(l.released)
+}
@@ -0,0 +1,199 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	pbGroup "Open_IM/pkg/proto/group"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	maxGroupJoinQuestions = 20
+	maxGroupJoinRules     = 500
+)
+
+// joinReview is the outcome of the questionnaire and rules of a group for one application
+type joinReview struct {
+	rule    *db.GroupJoinRule
+	answers string
+}
+
+func (s *groupServer) canManageJoinQuestionnaire(groupID, opUserID string) bool {
+	opFlag, err := s.getGroupUserLevel(groupID, opUserID)
+	return err == nil && opFlag != 0
+}
+
+func checkGroupJoinRule(rule *pbGroup.GroupJoinRule) bool {
+	if rule.Action != constant.GroupJoinRuleAccept && rule.Action != constant.GroupJoinRuleReject {
+		return false
+	}
+	switch rule.RuleType {
+	case constant.GroupJoinRuleDepartment, constant.GroupJoinRuleUser:
+		return rule.Value != ""
+	case constant.GroupJoinRuleAdminFriend, constant.GroupJoinRuleAnswers:
+		return true
+	}
+	return false
+}
+
+func (s *groupServer) SetGroupJoinQuestionnaire(_ context.Context, req *pbGroup.SetGroupJoinQuestionnaireReq) (*pbGroup.SetGroupJoinQuestionnaireResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !s.canManageJoinQuestionnaire(req.GroupID, req.OpUserID) {
+		log.NewError(req.OperationID, "no permission to set join questionnaire ", req.GroupID, req.OpUserID)
+		return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	if len(req.Questions) > maxGroupJoinQuestions || len(req.Rules) > maxGroupJoinRules {
+		return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "too many join questions or rules"}}, nil
+	}
+	var questions []*db.GroupJoinQuestion
+	questionIDs := make(map[string]bool)
+	for i, v := range req.Questions {
+		if v.QuestionID == "" {
+			v.QuestionID = utils.OperationIDGenerator()
+		}
+		if strings.TrimSpace(v.Question) == "" || len(v.Question) > 255 || len(v.Answer) > 255 || questionIDs[v.QuestionID] {
+			return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid join question " + v.QuestionID}}, nil
+		}
+		questionIDs[v.QuestionID] = true
+		questions = append(questions, &db.GroupJoinQuestion{GroupID: req.GroupID, QuestionID: v.QuestionID, Question: v.Question, Answer: v.Answer, Seq: int32(i)})
+	}
+	var rules []*db.GroupJoinRule
+	ruleIDs := make(map[string]bool)
+	for i, v := range req.Rules {
+		if v.RuleID == "" {
+			v.RuleID = utils.OperationIDGenerator()
+		}
+		if !checkGroupJoinRule(v) || ruleIDs[v.RuleID] {
+			return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid join rule " + v.RuleID}}, nil
+		}
+		ruleIDs[v.RuleID] = true
+		rules = append(rules, &db.GroupJoinRule{GroupID: req.GroupID, RuleID: v.RuleID, RuleType: v.RuleType, Action: v.Action, Value: v.Value, Seq: int32(i)})
+	}
+	if err := imdb.SetGroupJoinQuestionnaire(req.GroupID, questions, rules); err != nil {
+		log.NewError(req.OperationID, "SetGroupJoinQuestionnaire failed ", err.Error(), req.GroupID)
+		return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", len(questions), len(rules))
+	return &pbGroup.SetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{}}, nil
+}
+
+// GetGroupJoinQuestionnaire shows applicants the questions only, expected answers and rules are for the owner and admins
+func (s *groupServer) GetGroupJoinQuestionnaire(_ context.Context, req *pbGroup.GetGroupJoinQuestionnaireReq) (*pbGroup.GetGroupJoinQuestionnaireResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	questions, err := imdb.GetGroupJoinQuestions(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupJoinQuestions failed ", err.Error(), req.GroupID)
+		return &pbGroup.GetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	manager := s.canManageJoinQuestionnaire(req.GroupID, req.OpUserID)
+	resp := &pbGroup.GetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{}}
+	for _, v := range questions {
+		question := &pbGroup.GroupJoinQuestion{QuestionID: v.QuestionID, Question: v.Question}
+		if manager {
+			question.Answer = v.Answer
+		}
+		resp.Questions = append(resp.Questions, question)
+	}
+	if manager {
+		rules, err := imdb.GetGroupJoinRules(req.GroupID)
+		if err != nil {
+			log.NewError(req.OperationID, "GetGroupJoinRules failed ", err.Error(), req.GroupID)
+			return &pbGroup.GetGroupJoinQuestionnaireResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+		for _, v := range rules {
+			resp.Rules = append(resp.Rules, &pbGroup.GroupJoinRule{RuleID: v.RuleID, RuleType: v.RuleType, Action: v.Action, Value: v.Value})
+		}
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", len(resp.Questions), len(resp.Rules))
+	return resp, nil
+}
+
+// reviewJoinApplication checks the answers of req and runs the rules of the group on the applicant.
+// A direct join skips the questions and answer rules, rejecting rules still apply to it.
+func reviewJoinApplication(req *pbGroup.JoinGroupReq, directly bool) (*joinReview, int32, string) {
+	review := &joinReview{}
+	questions, err := imdb.GetGroupJoinQuestions(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupJoinQuestions failed ", err.Error(), req.GroupID)
+		return nil, constant.ErrDB.ErrCode, constant.ErrDB.ErrMsg
+	}
+	rules, err := imdb.GetGroupJoinRules(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupJoinRules failed ", err.Error(), req.GroupID)
+		return nil, constant.ErrDB.ErrCode, constant.ErrDB.ErrMsg
+	}
+	answers := make(map[string]string)
+	for _, v := range req.JoinAnswers {
+		answers[v.QuestionID] = v.Answer
+	}
+	facts := &groupJoinFacts{UserID: req.OpUserID}
+	if !directly && len(questions) > 0 {
+		var joinAnswers []*open_im_sdk.GroupJoinAnswer
+		for _, v := range questions {
+			if strings.TrimSpace(answers[v.QuestionID]) == "" {
+				return nil, constant.ErrArgs.ErrCode, "answer every join question"
+			}
+			joinAnswers = append(joinAnswers, &open_im_sdk.GroupJoinAnswer{QuestionID: v.QuestionID, Question: v.Question, Answer: answers[v.QuestionID]})
+		}
+		data, _ := json.Marshal(joinAnswers)
+		review.answers = string(data)
+	}
+	var checked []*db.GroupJoinRule
+	for _, rule := range rules {
+		switch rule.RuleType {
+		case constant.GroupJoinRuleDepartment:
+			if facts.DepartmentIDList == nil {
+				facts.DepartmentIDList = applicantDepartmentIDList(req.OperationID, req.OpUserID)
+			}
+		case constant.GroupJoinRuleAdminFriend:
+			if !facts.IsAdminFriend {
+				if facts.IsAdminFriend, err = imdb.IsFriendOfGroupOwnerAdmin(req.GroupID, req.OpUserID); err != nil {
+					log.NewError(req.OperationID, "IsFriendOfGroupOwnerAdmin failed ", err.Error(), req.GroupID, req.OpUserID)
+				}
+			}
+		case constant.GroupJoinRuleAnswers:
+			if directly {
+				continue
+			}
+			facts.AnswersCorrect = groupJoinAnswersCorrect(questions, answers)
+		}
+		checked = append(checked, rule)
+	}
+	review.rule = evaluateGroupJoinRules(checked, facts)
+	return review, 0, ""
+}
+
+// applicantDepartmentIDList is the departments of userID and every department above them
+func applicantDepartmentIDList(operationID, userID string) []string {
+	departmentIDList := []string{}
+	err, members := imdb.GetUserInDepartment(userID)
+	if err != nil {
+		log.NewError(operationID, "GetUserInDepartment failed ", err.Error(), userID)
+		return departmentIDList
+	}
+	for _, member := range members {
+		departmentIDList = append(departmentIDList, member.DepartmentID)
+		parentIDList, err := imdb.GetDepartmentParentIDList(member.DepartmentID)
+		if err != nil {
+			log.NewError(operationID, "GetDepartmentParentIDList failed ", err.Error(), member.DepartmentID)
+			continue
+		}
+		departmentIDList = append(departmentIDList, parentIDList...)
+	}
+	return departmentIDList
+}
+
+// recordJoinReview keeps the application a rule handled so admins can see it and its answers
+func recordJoinReview(req *pbGroup.JoinGroupReq, review *joinReview, handleResult int32) error {
+	groupRequest := db.GroupRequest{UserID: req.OpUserID, GroupID: req.GroupID, HandleResult: handleResult, ReqMsg: req.ReqMessage,
+		HandledMsg: "handled by join rule " + review.rule.RuleID, HandledTime: time.Now(), JoinSource: req.JoinSource,
+		InviterUserID: req.InviterUserID, Answers: review.answers}
+	return imdb.InsertIntoGroupRequest(groupRequest)
+}
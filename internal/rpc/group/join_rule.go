@@ -0,0 +1,59 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"Open_IM/pkg/utils"
+	"strings"
+)
+
+// groupJoinFacts are what the join rules of a group test an applicant against,
+// DepartmentIDList holds the departments of the applicant and every department above them
+type groupJoinFacts struct {
+	UserID           string
+	DepartmentIDList []string
+	IsAdminFriend    bool
+	AnswersCorrect   bool
+}
+
+func groupJoinRuleMatches(rule *db.GroupJoinRule, facts *groupJoinFacts) bool {
+	switch rule.RuleType {
+	case constant.GroupJoinRuleDepartment:
+		return utils.IsContain(rule.Value, facts.DepartmentIDList)
+	case constant.GroupJoinRuleAdminFriend:
+		return facts.IsAdminFriend
+	case constant.GroupJoinRuleAnswers:
+		return facts.AnswersCorrect
+	case constant.GroupJoinRuleUser:
+		return rule.Value == facts.UserID
+	}
+	return false
+}
+
+// evaluateGroupJoinRules returns the first matching reject rule, else the first matching accept rule, nil when none matches
+func evaluateGroupJoinRules(rules []*db.GroupJoinRule, facts *groupJoinFacts) *db.GroupJoinRule {
+	for _, action := range []int32{constant.GroupJoinRuleReject, constant.GroupJoinRuleAccept} {
+		for _, rule := range rules {
+			if rule.Action == action && groupJoinRuleMatches(rule, facts) {
+				return rule
+			}
+		}
+	}
+	return nil
+}
+
+// groupJoinAnswersCorrect reports whether every question with an expected answer was given it, case and surrounding spaces aside.
+// It is false when no question has an expected answer, there is nothing an answers rule could accept.
+func groupJoinAnswersCorrect(questions []*db.GroupJoinQuestion, answers map[string]string) bool {
+	checked := false
+	for _, question := range questions {
+		if question.Answer == "" {
+			continue
+		}
+		if !strings.EqualFold(strings.TrimSpace(answers[question.QuestionID]), strings.TrimSpace(question.Answer)) {
+			return false
+		}
+		checked = true
+	}
+	return checked
+}
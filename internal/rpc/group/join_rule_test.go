@@ -0,0 +1,36 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_EvaluateGroupJoinRules(t *testing.T) {
+	rules := []*db.GroupJoinRule{
+		{RuleID: "sales", RuleType: constant.GroupJoinRuleDepartment, Action: constant.GroupJoinRuleAccept, Value: "sales"},
+		{RuleID: "friends", RuleType: constant.GroupJoinRuleAdminFriend, Action: constant.GroupJoinRuleAccept},
+		{RuleID: "blacklist", RuleType: constant.GroupJoinRuleUser, Action: constant.GroupJoinRuleReject, Value: "spammer"},
+	}
+	assert.Nil(t, evaluateGroupJoinRules(rules, &groupJoinFacts{UserID: "alice"}))
+	assert.Equal(t, "sales", evaluateGroupJoinRules(rules, &groupJoinFacts{UserID: "alice", DepartmentIDList: []string{"emea", "sales"}}).RuleID)
+	assert.Equal(t, "friends", evaluateGroupJoinRules(rules, &groupJoinFacts{UserID: "alice", IsAdminFriend: true}).RuleID)
+	// reject rules win over accept rules listed before them
+	assert.Equal(t, "blacklist", evaluateGroupJoinRules(rules, &groupJoinFacts{UserID: "spammer", IsAdminFriend: true}).RuleID)
+	assert.Nil(t, evaluateGroupJoinRules(nil, &groupJoinFacts{UserID: "alice", IsAdminFriend: true}))
+}
+
+func Test_GroupJoinAnswersCorrect(t *testing.T) {
+	questions := []*db.GroupJoinQuestion{
+		{QuestionID: "q1", Question: "Which team are you on?"},
+		{QuestionID: "q2", Question: "What is the project codeword?", Answer: "Blue Falcon"},
+	}
+	assert.True(t, groupJoinAnswersCorrect(questions, map[string]string{"q1": "ops", "q2": " blue falcon "}))
+	assert.False(t, groupJoinAnswersCorrect(questions, map[string]string{"q1": "ops", "q2": "red falcon"}))
+	assert.False(t, groupJoinAnswersCorrect(questions, map[string]string{"q1": "ops"}))
+	// nothing to check is not a correct answer
+	assert.False(t, groupJoinAnswersCorrect(questions[:1], map[string]string{"q1": "ops"}))
+	assert.False(t, groupJoinAnswersCorrect(nil, nil))
+}
@@ -0,0 +1,30 @@
+package group
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// encodeGroupMemberCursor points after the member who joined at joinTime, members are ordered by join time then user ID
+func encodeGroupMemberCursor(joinTime time.Time, userID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(joinTime.UnixNano(), 10) + ":" + userID))
+}
+
+func decodeGroupMemberCursor(cursor string) (time.Time, string, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	parts := strings.SplitN(string(b), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return time.Time{}, "", errors.New("malformed cursor")
+	}
+	nano, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	return time.Unix(0, nano), parts[1], nil
+}
@@ -0,0 +1,22 @@
+package group
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GroupMemberCursor(t *testing.T) {
+	joinTime := time.Date(2022, 8, 1, 14, 30, 0, 123000000, time.UTC)
+	cursor := encodeGroupMemberCursor(joinTime, "user:1")
+	afterJoinTime, afterUserID, err := decodeGroupMemberCursor(cursor)
+	assert.Nil(t, err)
+	assert.True(t, joinTime.Equal(afterJoinTime))
+	assert.Equal(t, "user:1", afterUserID)
+
+	_, _, err = decodeGroupMemberCursor("not a cursor")
+	assert.NotNil(t, err)
+	_, _, err = decodeGroupMemberCursor(encodeGroupMemberCursor(joinTime, ""))
+	assert.NotNil(t, err)
+}
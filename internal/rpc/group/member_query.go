@@ -0,0 +1,239 @@
+package group
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	cp "Open_IM/pkg/common/utils"
+	pbGroup "Open_IM/pkg/proto/group"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultGroupMemberQuerySize = 100
+	mirrorBatchSize             = 1000
+	superGroupBackfillLeaseTTL  = time.Minute
+)
+
+// mirrorSuperGroupMembers adds group_members rows for new members of a super group. The member list of a super group
+// lives in mongo, the rows only make its members searchable and pageable like those of a normal group.
+func mirrorSuperGroupMembers(operationID, groupID, inviterUserID string, joinSource int32, userIDList []string) error {
+	if len(userIDList) == 0 {
+		return nil
+	}
+	existing := make(map[string]bool)
+	for start := 0; start < len(userIDList); start += mirrorBatchSize {
+		end := start + mirrorBatchSize
+		if end > len(userIDList) {
+			end = len(userIDList)
+		}
+		members, err := imdb.GetGroupMemberByUserIDList(groupID, userIDList[start:end])
+		if err != nil {
+			log.NewError(operationID, "GetGroupMemberByUserIDList failed ", err.Error(), groupID)
+			return err
+		}
+		for _, member := range members {
+			existing[member.UserID] = true
+		}
+	}
+	var missing []string
+	for _, userID := range userIDList {
+		if !existing[userID] {
+			existing[userID] = true
+			missing = append(missing, userID)
+		}
+	}
+	for start := 0; start < len(missing); start += mirrorBatchSize {
+		end := start + mirrorBatchSize
+		if end > len(missing) {
+			end = len(missing)
+		}
+		users, err := imdb.GetUsersByUserIDList(missing[start:end])
+		if err != nil {
+			log.NewError(operationID, "GetUsersByUserIDList failed ", err.Error(), groupID)
+			return err
+		}
+		var members []*db.GroupMember
+		for _, user := range users {
+			members = append(members, &db.GroupMember{GroupID: groupID, UserID: user.UserID, Nickname: user.Nickname, FaceURL: user.FaceURL,
+				RoleLevel: constant.GroupOrdinaryUsers, JoinSource: joinSource, InviterUserID: inviterUserID, OperatorUserID: inviterUserID})
+		}
+		if len(members) == 0 {
+			continue
+		}
+		if err := imdb.BatchInsertIntoGroupMember(members); err != nil {
+			log.NewError(operationID, "BatchInsertIntoGroupMember failed ", err.Error(), groupID, len(members))
+			return err
+		}
+	}
+	if err := rocksCache.DelGroupMemberNumFromCache(groupID); err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), groupID)
+	}
+	return nil
+}
+
+// unmirrorSuperGroupMembers drops the rows of members who left a super group
+func unmirrorSuperGroupMembers(operationID, groupID string, userIDList []string) {
+	if len(userIDList) == 0 {
+		return
+	}
+	if err := imdb.DeleteGroupMembers(groupID, userIDList); err != nil {
+		log.NewError(operationID, "DeleteGroupMembers failed ", err.Error(), groupID, userIDList)
+		return
+	}
+	for _, userID := range userIDList {
+		if err := rocksCache.DelGroupMemberInfoFromCache(groupID, userID); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), groupID, userID)
+		}
+	}
+	if err := rocksCache.DelGroupMemberNumFromCache(groupID); err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), groupID)
+	}
+}
+
+// unmirrorSuperGroup drops the rows of every member of a dismissed super group and the caches built from them
+func unmirrorSuperGroup(operationID, groupID string, memberIDList []string) error {
+	if err := imdb.DeleteGroupMemberByGroupID(groupID); err != nil {
+		return utils.Wrap(err, "DeleteGroupMemberByGroupID failed")
+	}
+	for _, userID := range memberIDList {
+		if err := rocksCache.DelGroupMemberInfoFromCache(groupID, userID); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), groupID, userID)
+		}
+		if err := rocksCache.DelJoinedSuperGroupIDListFromCache(userID); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), userID)
+		}
+		if err := rocksCache.DelJoinedGroupIDListFromCache(userID); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), userID)
+		}
+	}
+	if err := rocksCache.DelGroupMemberNumFromCache(groupID); err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), err.Error(), groupID)
+	}
+	return nil
+}
+
+// backfillSuperGroupMembers mirrors the members of the super groups created before their members were mirrored.
+// It is a one-time migration of the whole cluster, a later start skips it once an instance finished it.
+func backfillSuperGroupMembers() {
+	operationID := utils.OperationIDGenerator()
+	owner := utils.OperationIDGenerator()
+	ok, err := db.DB.LockSuperGroupMemberBackfill(owner, superGroupBackfillLeaseTTL)
+	if err != nil || !ok {
+		return
+	}
+	lease := keepJobLease(operationID, "super group member backfill", superGroupBackfillLeaseTTL, func() (bool, error) {
+		return db.DB.ExtendSuperGroupMemberBackfillLock(owner, superGroupBackfillLeaseTTL)
+	})
+	defer lease.release()
+	groupIDList, err := imdb.GetGroupIDListByGroupType(constant.SuperGroup)
+	if err != nil {
+		log.NewError(operationID, "GetGroupIDListByGroupType failed ", err.Error())
+		return
+	}
+	log.NewInfo(operationID, "backfill super group members ", len(groupIDList))
+	for _, groupID := range groupIDList {
+		if lease.Lost() {
+			return
+		}
+		superGroup, err := db.DB.GetSuperGroup(groupID)
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			log.NewError(operationID, "GetSuperGroup failed ", err.Error(), groupID)
+			return
+		}
+		if err := mirrorSuperGroupMembers(operationID, groupID, "", constant.JoinByInvitation, superGroup.MemberIDList); err != nil {
+			return
+		}
+	}
+	if err := db.DB.FinishSuperGroupMemberBackfill(); err != nil {
+		log.NewError(operationID, "FinishSuperGroupMemberBackfill failed ", err.Error())
+		return
+	}
+	log.NewInfo(operationID, "backfill super group members finished ", len(groupIDList))
+}
+
+func (s *groupServer) QueryGroupMembers(_ context.Context, req *pbGroup.QueryGroupMembersReq) (*pbGroup.QueryGroupMembersResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	groupInfo, err := rocksCache.GetGroupInfoFromCache(req.GroupID)
+	if err != nil {
+		log.NewError(req.OperationID, "GetGroupInfoFromCache failed ", err.Error(), req.GroupID)
+		return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		isMember := false
+		if groupInfo.GroupType == constant.SuperGroup {
+			// the rows of a super group may not be backfilled yet, the super groups a user joined live in mongo
+			joinedGroupIDList, err := rocksCache.GetJoinedSuperGroupListFromCache(req.OpUserID)
+			if err != nil {
+				log.NewError(req.OperationID, "GetJoinedSuperGroupListFromCache failed ", err.Error(), req.OpUserID)
+				return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+			}
+			isMember = utils.IsContain(req.GroupID, joinedGroupIDList)
+		} else {
+			isMember = imdb.IsExistGroupMember(req.GroupID, req.OpUserID)
+		}
+		if !isMember {
+			log.NewError(req.OperationID, "not in group ", req.GroupID, req.OpUserID)
+			return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+		}
+	}
+	if req.Count < 0 || req.Count > constant.GroupMemberQueryMaxSize || req.MuteStatus < constant.GroupMemberMuteAny || req.MuteStatus > constant.GroupMemberNotMuted {
+		return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid count or muteStatus"}}, nil
+	}
+	query := &imdb.GroupMemberQuery{GroupID: req.GroupID, Keyword: req.Keyword, Roles: req.Roles, MuteStatus: req.MuteStatus, Count: int(req.Count)}
+	if query.Count == 0 {
+		query.Count = defaultGroupMemberQuerySize
+	}
+	if req.JoinTimeBegin > 0 {
+		query.JoinTimeBegin = time.Unix(req.JoinTimeBegin, 0)
+	}
+	if req.JoinTimeEnd > 0 {
+		query.JoinTimeEnd = time.Unix(req.JoinTimeEnd, 0)
+	}
+	if req.Cursor != "" {
+		if query.AfterJoinTime, query.AfterUserID, err = decodeGroupMemberCursor(req.Cursor); err != nil {
+			return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "invalid cursor"}}, nil
+		}
+	}
+	members, err := imdb.QueryGroupMembers(query)
+	if err != nil {
+		log.NewError(req.OperationID, "QueryGroupMembers failed ", err.Error(), req.GroupID)
+		return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	resp := &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{}}
+	for _, member := range members {
+		var node open_im_sdk.GroupMemberFullInfo
+		cp.GroupMemberDBCopyOpenIM(&node, member)
+		resp.Members = append(resp.Members, &node)
+	}
+	if len(members) == query.Count {
+		last := members[len(members)-1]
+		resp.NextCursor = encodeGroupMemberCursor(last.JoinTime, last.UserID)
+	}
+	// the counts cover the whole group and are only worked out for the first page
+	if req.Cursor == "" {
+		roleCount, err := imdb.GetGroupMemberRoleCount(req.GroupID)
+		if err != nil {
+			log.NewError(req.OperationID, "GetGroupMemberRoleCount failed ", err.Error(), req.GroupID)
+			return &pbGroup.QueryGroupMembersResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+		}
+		for role, count := range roleCount {
+			resp.RoleCounts = append(resp.RoleCounts, &pbGroup.GroupRoleMemberCount{Role: role, Count: count})
+		}
+		sort.Slice(resp.RoleCounts, func(i, j int) bool { return resp.RoleCounts[i].Role < resp.RoleCounts[j].Role })
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", len(resp.Members), resp.NextCursor)
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package group
+
+import (
+	chat "Open_IM/internal/rpc/msg"
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	pbGroup "Open_IM/pkg/proto/group"
+	"Open_IM/pkg/utils"
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// succeedGroupOwner hands the group of an owner who quit, was removed, blocked or deleted to the next member picked by
+// the configured succession order. It returns the new owner, empty when succession is disabled or nobody can succeed.
+func succeedGroupOwner(operationID, groupID, oldOwnerUserID, opUserID string) (string, error) {
+	if !config.Config.GroupOwnerSuccession.Enable {
+		return "", nil
+	}
+	groupInfo, err := imdb.GetGroupInfoByGroupID(groupID)
+	if err != nil {
+		return "", utils.Wrap(err, "GetGroupInfoByGroupID failed")
+	}
+	if groupInfo.Status == constant.GroupStatusDismissed {
+		return "", nil
+	}
+	owner, err := imdb.GetGroupOwnerInfoByGroupID(groupID)
+	if err == nil && owner.UserID != oldOwnerUserID {
+		log.NewInfo(operationID, "group already has an owner ", groupID, owner.UserID)
+		return owner.UserID, nil
+	}
+	var successor *db.GroupMember
+	for _, roleLevel := range constant.GroupSuccessionRoleLevels(config.Config.GroupOwnerSuccession.Order) {
+		member, err := imdb.GetGroupSuccessor(groupID, roleLevel, oldOwnerUserID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", utils.Wrap(err, "GetGroupSuccessor failed")
+		}
+		successor = member
+		break
+	}
+	if successor == nil {
+		log.NewWarn(operationID, "no member can succeed the group owner ", groupID, oldOwnerUserID)
+		return "", nil
+	}
+	err = imdb.SucceedGroupOwner(groupID, oldOwnerUserID, successor)
+	if errors.Is(err, imdb.ErrGroupHasOwner) {
+		owner, err := imdb.GetGroupOwnerInfoByGroupID(groupID)
+		if err != nil {
+			return "", utils.Wrap(err, "GetGroupOwnerInfoByGroupID failed")
+		}
+		log.NewInfo(operationID, "group got an owner meanwhile ", groupID, owner.UserID)
+		return owner.UserID, nil
+	}
+	if err != nil {
+		return "", utils.Wrap(err, "SucceedGroupOwner failed")
+	}
+	for _, userID := range []string{oldOwnerUserID, successor.UserID} {
+		if err := rocksCache.DelGroupMemberInfoFromCache(groupID, userID); err != nil {
+			log.NewError(operationID, "DelGroupMemberInfoFromCache failed ", err.Error(), groupID, userID)
+		}
+	}
+	log.NewInfo(operationID, "group owner succeeded ", groupID, oldOwnerUserID, successor.UserID)
+	chat.GroupOwnerTransferredNotification(&pbGroup.TransferGroupOwnerReq{GroupID: groupID, OldOwnerUserID: oldOwnerUserID,
+		NewOwnerUserID: successor.UserID, OperationID: operationID, OpUserID: opUserID})
+	return successor.UserID, nil
+}
+
+// SucceedGroupOwner lets the user service and the cron task repair a group whose owner was blocked or deleted
+func (s *groupServer) SucceedGroupOwner(_ context.Context, req *pbGroup.SucceedGroupOwnerReq) (*pbGroup.SucceedGroupOwnerResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc args ", req.String())
+	if !token_verify.IsManagerUserID(req.OpUserID) {
+		log.NewError(req.OperationID, "only app manager can succeed group owner ", req.OpUserID)
+		return &pbGroup.SucceedGroupOwnerResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrAccess.ErrCode, ErrMsg: constant.ErrAccess.ErrMsg}}, nil
+	}
+	newOwnerUserID, err := succeedGroupOwner(req.OperationID, req.GroupID, req.OldOwnerUserID, req.OpUserID)
+	if err != nil {
+		log.NewError(req.OperationID, "succeedGroupOwner failed ", err.Error(), req.GroupID, req.OldOwnerUserID)
+		return &pbGroup.SucceedGroupOwnerResp{CommonResp: &pbGroup.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", req.GroupID, newOwnerUserID)
+	return &pbGroup.SucceedGroupOwnerResp{CommonResp: &pbGroup.CommonResp{}, NewOwnerUserID: newOwnerUserID}, nil
+}
@@ -50,9 +50,9 @@ func ConversationSetPrivateNotification(operationID, sendID, recvID string, isPr
 	var tips open_im_sdk.TipsComm
 	var tipsMsg string
 	if isPrivateChat == true {
-		tipsMsg = config.Config.Notification.ConversationSetPrivate.DefaultTips.OpenTips
+		tipsMsg = config.Current().Notification.ConversationSetPrivate.DefaultTips.OpenTips
 	} else {
-		tipsMsg = config.Config.Notification.ConversationSetPrivate.DefaultTips.CloseTips
+		tipsMsg = config.Current().Notification.ConversationSetPrivate.DefaultTips.CloseTips
 	}
 	tips.DefaultTips = tipsMsg
 	SetConversationNotification(operationID, sendID, recvID, constant.ConversationPrivateChatNotification, conversationSetPrivateTips, tips)
@@ -65,7 +65,7 @@ func ConversationChangeNotification(operationID, userID string) {
 		UserID: userID,
 	}
 	var tips open_im_sdk.TipsComm
-	tips.DefaultTips = config.Config.Notification.ConversationOptUpdate.DefaultTips.Tips
+	tips.DefaultTips = config.Current().Notification.ConversationOptUpdate.DefaultTips.Tips
 	SetConversationNotification(operationID, userID, userID, constant.ConversationOptChangeNotification, ConversationChangedTips, tips)
 }
 
@@ -78,6 +78,6 @@ func ConversationUnreadChangeNotification(operationID, userID, conversationID st
 		UpdateUnreadCountTime: updateUnreadCountTime,
 	}
 	var tips open_im_sdk.TipsComm
-	tips.DefaultTips = config.Config.Notification.ConversationOptUpdate.DefaultTips.Tips
+	tips.DefaultTips = config.Current().Notification.ConversationOptUpdate.DefaultTips.Tips
 	SetConversationNotification(operationID, userID, userID, constant.ConversationUnreadNotification, ConversationChangedTips, tips)
 }
@@ -48,7 +48,7 @@ func friendNotification(commID *pbFriend.CommID, contentType int32, m proto.Mess
 		log.Error(commID.OperationID, "getFromToUserNickname failed ", err.Error(), commID.FromUserID, commID.ToUserID)
 		return
 	}
-	cn := config.Config.Notification
+	cn := config.Current().Notification
 	switch contentType {
 	case constant.FriendApplicationNotification:
 		tips.DefaultTips = fromUserNickname + cn.FriendApplication.DefaultTips.Tips
@@ -70,6 +70,8 @@ func friendNotification(commID *pbFriend.CommID, contentType int32, m proto.Mess
 		tips.DefaultTips = cn.UserInfoUpdated.DefaultTips.Tips
 	case constant.FriendInfoUpdatedNotification:
 		tips.DefaultTips = cn.FriendInfoUpdated.DefaultTips.Tips + toUserNickname
+	case constant.FriendCategoryChangedNotification:
+		tips.DefaultTips = cn.FriendCategoryChanged.DefaultTips.Tips
 	default:
 		log.Error(commID.OperationID, "contentType failed ", contentType)
 		return
@@ -172,3 +174,11 @@ func FriendInfoUpdatedNotification(operationID, changedUserID string, needNotifi
 	commID := pbFriend.CommID{FromUserID: opUserID, ToUserID: needNotifiedUserID, OpUserID: opUserID, OperationID: operationID}
 	friendNotification(&commID, constant.FriendInfoUpdatedNotification, &selfInfoUpdatedTips)
 }
+
+// FriendCategoryChangedNotification tells the other devices of the owner that a category was created, renamed or deleted,
+// friendUserIDList are the friends a deleted category held
+func FriendCategoryChangedNotification(operationID, ownerUserID, categoryID string, friendUserIDList []string, opUserID string) {
+	tips := open_im_sdk.FriendCategoryChangedTips{OwnerUserID: ownerUserID, CategoryID: categoryID, FriendUserIDList: friendUserIDList}
+	commID := pbFriend.CommID{FromUserID: opUserID, ToUserID: ownerUserID, OpUserID: opUserID, OperationID: operationID}
+	friendNotification(&commID, constant.FriendCategoryChangedNotification, &tips)
+}
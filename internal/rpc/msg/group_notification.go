@@ -137,7 +137,7 @@ func groupNotification(contentType int32, m proto.Message, sendID, groupID, recv
 		toNickname = to.Nickname
 	}
 
-	cn := config.Config.Notification
+	cn := config.Current().Notification
 	switch contentType {
 	case constant.GroupCreatedNotification:
 		tips.DefaultTips = nickname + " " + cn.GroupCreated.DefaultTips.Tips
@@ -175,6 +175,10 @@ func groupNotification(contentType int32, m proto.Message, sendID, groupID, recv
 		tips.DefaultTips = toNickname + "" + cn.GroupMemberSetToAdmin.DefaultTips.Tips
 	case constant.GroupMemberSetToOrdinaryUserNotification:
 		tips.DefaultTips = toNickname + "" + cn.GroupMemberSetToOrdinary.DefaultTips.Tips
+	case constant.GroupRoleChangedNotification:
+		tips.DefaultTips = nickname + " " + cn.GroupRoleChanged.DefaultTips.Tips
+	case constant.GroupTypeChangedNotification:
+		tips.DefaultTips = cn.GroupTypeChanged.DefaultTips.Tips
 	default:
 		log.Error(operationID, "contentType failed ", contentType)
 		return
@@ -362,6 +366,37 @@ func GroupMemberRoleLevelChangeNotification(operationID, opUserID, groupID, grou
 	groupNotification(notificationType, &tips, opUserID, groupID, "", operationID)
 }
 
+// GroupRoleChangedNotification tells the group a role or its capabilities changed, members fetch the roles again
+func GroupRoleChangedNotification(operationID, opUserID, groupID string) {
+	tips := open_im_sdk.GroupInfoSetTips{Group: &open_im_sdk.GroupInfo{},
+		OpUser: &open_im_sdk.GroupMemberFullInfo{}}
+	if err := setGroupInfo(groupID, tips.Group); err != nil {
+		log.Error(operationID, "setGroupInfo failed ", err.Error(), groupID)
+		return
+	}
+	if err := setOpUserInfo(opUserID, groupID, tips.OpUser); err != nil {
+		log.Error(operationID, "setOpUserInfo failed ", err.Error(), opUserID, groupID)
+		return
+	}
+	groupNotification(constant.GroupRoleChangedNotification, &tips, opUserID, groupID, "", operationID)
+}
+
+// GroupTypeChangedNotification tells a member the group moved between normal and super group storage.
+// It goes to the member directly, the client leaves the old conversation type and syncs the group again.
+func GroupTypeChangedNotification(operationID, opUserID, groupID, recvUserID string) {
+	tips := open_im_sdk.GroupInfoSetTips{Group: &open_im_sdk.GroupInfo{},
+		OpUser: &open_im_sdk.GroupMemberFullInfo{}}
+	if err := setGroupInfo(groupID, tips.Group); err != nil {
+		log.Error(operationID, "setGroupInfo failed ", err.Error(), groupID)
+		return
+	}
+	if err := setOpUserInfo(opUserID, groupID, tips.OpUser); err != nil {
+		log.Error(operationID, "setOpUserInfo failed ", err.Error(), opUserID, groupID)
+		return
+	}
+	groupNotification(constant.GroupTypeChangedNotification, &tips, opUserID, "", recvUserID, operationID)
+}
+
 func GroupMemberCancelMutedNotification(operationID, opUserID, groupID, groupMemberUserID string) {
 	tips := open_im_sdk.GroupMemberCancelMutedTips{Group: &open_im_sdk.GroupInfo{},
 		OpUser: &open_im_sdk.GroupMemberFullInfo{}, MutedUser: &open_im_sdk.GroupMemberFullInfo{}}
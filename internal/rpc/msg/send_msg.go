@@ -28,6 +28,7 @@ import (
 
 	go_redis "github.com/go-redis/redis/v8"
 	"github.com/golang/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 // When the number of group members is greater than this value，Online users will be sent first，Guaranteed service availability
@@ -120,6 +121,96 @@ func groupIsMuted(groupID string) (bool, error) {
 	return false, nil
 }
 
+// groupMsgCapabilities are the group capabilities needed to send msg
+func groupMsgCapabilities(msg *sdk_ws.MsgData) int64 {
+	capabilities := constant.GroupCapSendMsg
+	switch msg.ContentType {
+	case constant.Typing, constant.HasReadReceipt, constant.GroupHasReadReceipt:
+		return 0
+	case constant.Picture, constant.Voice, constant.Video, constant.File:
+		capabilities |= constant.GroupCapSendMedia
+	}
+	if utils.IsContain(constant.AtAllString, msg.AtUserIDList) {
+		capabilities |= constant.GroupCapAtAll
+	}
+	return capabilities
+}
+
+// hasGroupCapability reports whether userID holds all of capabilities in groupID, app managers hold all of them
+func hasGroupCapability(groupID, userID string, capabilities int64) (bool, error) {
+	if token_verify.IsManagerUserID(userID) {
+		return true, nil
+	}
+	member, err := rocksCache.GetGroupMemberInfoFromCache(groupID, userID)
+	if err != nil {
+		return false, utils.Wrap(err, "GetGroupMemberInfoFromCache failed")
+	}
+	groupRoles, err := rocksCache.GetGroupRoleCapabilitiesFromCache(groupID)
+	if err != nil {
+		return false, utils.Wrap(err, "GetGroupRoleCapabilitiesFromCache failed")
+	}
+	held := constant.GroupRoleCapabilities(constant.GroupMemberRole(member.RoleLevel, member.RoleID), groupRoles)
+	return held&capabilities == capabilities, nil
+}
+
+// groupMsgRetryAfter is the ex of a rate limited message, wait is rounded up to whole seconds
+func groupMsgRetryAfter(wait time.Duration) string {
+	seconds := int64((wait + time.Second - 1) / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	return "{\"retryAfter\":" + utils.Int64ToString(seconds) + "}"
+}
+
+// groupMsgRateLimit enforces the slow mode and daily quota of group, owners and admins are exempt.
+// ex carries the seconds to wait before sending again.
+func groupMsgRateLimit(operationID string, group *db.Group, msg *sdk_ws.MsgData) (ok bool, errCode int32, errMsg, ex string) {
+	if group.SlowModeSeconds <= 0 && group.DailyMsgQuota <= 0 {
+		return true, 0, "", ""
+	}
+	if groupMsgCapabilities(msg) == 0 || msg.ContentType >= constant.NotificationBegin || token_verify.IsManagerUserID(msg.SendID) {
+		return true, 0, "", ""
+	}
+	if _, isAdmin, err := userIsMuteAndIsAdminInGroup(group.GroupID, msg.SendID); err == nil && isAdmin {
+		return true, 0, "", ""
+	}
+	now := time.Now()
+	day := now.Format("20060102")
+	quotaCounted := false
+	if group.DailyMsgQuota > 0 {
+		count, err := db.DB.IncrGroupDailyMsgCount(group.GroupID, msg.SendID, day)
+		if err != nil {
+			log.NewError(operationID, "IncrGroupDailyMsgCount failed ", err.Error(), group.GroupID, msg.SendID)
+			return true, 0, "", ""
+		}
+		if count > int(group.DailyMsgQuota) {
+			if err := db.DB.DecrGroupDailyMsgCount(group.GroupID, msg.SendID, day); err != nil {
+				log.NewError(operationID, "DecrGroupDailyMsgCount failed ", err.Error(), group.GroupID, msg.SendID)
+			}
+			ex = groupMsgRetryAfter(utils.UntilNextDay(now))
+			return false, constant.ErrGroupMsgQuota.ErrCode, constant.ErrGroupMsgQuota.ErrMsg + " " + ex, ex
+		}
+		quotaCounted = true
+	}
+	if group.SlowModeSeconds > 0 {
+		taken, wait, err := db.DB.TakeGroupSlowModeSlot(group.GroupID, msg.SendID, time.Duration(group.SlowModeSeconds)*time.Second)
+		if err != nil {
+			log.NewError(operationID, "TakeGroupSlowModeSlot failed ", err.Error(), group.GroupID, msg.SendID)
+			return true, 0, "", ""
+		}
+		if !taken {
+			if quotaCounted {
+				if err := db.DB.DecrGroupDailyMsgCount(group.GroupID, msg.SendID, day); err != nil {
+					log.NewError(operationID, "DecrGroupDailyMsgCount failed ", err.Error(), group.GroupID, msg.SendID)
+				}
+			}
+			ex = groupMsgRetryAfter(wait)
+			return false, constant.ErrGroupSlowMode.ErrCode, constant.ErrGroupSlowMode.ErrMsg + " " + ex, ex
+		}
+	}
+	return true, 0, "", ""
+}
+
 func (rpc *rpcChat) messageVerification(data *pbChat.SendMsgReq) (bool, int32, string, []string) {
 	switch data.MsgData.SessionType {
 	case constant.SingleChatType:
@@ -203,6 +294,14 @@ func (rpc *rpcChat) messageVerification(data *pbChat.SendMsgReq) (bool, int32, s
 		if isMute {
 			return false, 224, "you are muted", nil
 		}
+		allowed, err := hasGroupCapability(data.MsgData.GroupID, data.MsgData.SendID, groupMsgCapabilities(data.MsgData))
+		if err != nil {
+			errMsg := data.OperationID + err.Error()
+			return false, 223, errMsg, nil
+		}
+		if !allowed {
+			return false, 226, "no permission to send this message", nil
+		}
 		if isAdmin {
 			return true, 0, "", userIDList
 		}
@@ -250,6 +349,28 @@ func (rpc *rpcChat) messageVerification(data *pbChat.SendMsgReq) (bool, int32, s
 			}
 		}
 		if groupInfo.GroupType == constant.SuperGroup {
+			if token_verify.IsManagerUserID(data.MsgData.SendID) {
+				return true, 0, "", nil
+			}
+			if data.MsgData.ContentType <= constant.NotificationEnd && data.MsgData.ContentType >= constant.NotificationBegin {
+				return true, 0, "", nil
+			}
+			capabilities := groupMsgCapabilities(data.MsgData)
+			allowed, err := hasGroupCapability(data.MsgData.GroupID, data.MsgData.SendID, capabilities)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// the sender joined before super group members were mirrored, it holds the member role
+				var groupRoles map[string]int64
+				groupRoles, err = rocksCache.GetGroupRoleCapabilitiesFromCache(data.MsgData.GroupID)
+				held := constant.GroupRoleCapabilities(constant.GroupRoleMember, groupRoles)
+				allowed = held&capabilities == capabilities
+			}
+			if err != nil {
+				errMsg := data.OperationID + err.Error()
+				return false, 223, errMsg, nil
+			}
+			if !allowed {
+				return false, 226, "no permission to send this message", nil
+			}
 			return true, 0, "", nil
 		} else {
 			userIDList, err := utils2.GetGroupMemberUserIDList(data.MsgData.GroupID, data.OperationID)
@@ -277,6 +398,14 @@ func (rpc *rpcChat) messageVerification(data *pbChat.SendMsgReq) (bool, int32, s
 			if isMute {
 				return false, 224, "you are muted", nil
 			}
+			allowed, err := hasGroupCapability(data.MsgData.GroupID, data.MsgData.SendID, groupMsgCapabilities(data.MsgData))
+			if err != nil {
+				errMsg := data.OperationID + err.Error()
+				return false, 223, errMsg, nil
+			}
+			if !allowed {
+				return false, 226, "no permission to send this message", nil
+			}
 			if isAdmin {
 				return true, 0, "", userIDList
 			}
@@ -447,6 +576,15 @@ func (rpc *rpcChat) SendMsg(_ context.Context, pb *pbChat.SendMsgReq) (*pbChat.S
 			promePkg.PromeInc(promePkg.GroupChatMsgProcessFailedCounter)
 			return returnMsg(&replay, pb, errCode, errMsg, "", 0, "")
 		}
+		if groupInfo, err := rocksCache.GetGroupInfoFromCache(pb.MsgData.GroupID); err != nil {
+			log.NewError(pb.OperationID, "GetGroupInfoFromCache failed ", err.Error(), pb.MsgData.GroupID)
+		} else if groupInfo.GroupType == constant.SuperGroup {
+			promePkg.PromeInc(promePkg.GroupChatMsgProcessFailedCounter)
+			return returnMsg(&replay, pb, constant.ErrGroupTypeChanged.ErrCode, constant.ErrGroupTypeChanged.ErrMsg, "", 0, "")
+		} else if ok, errCode, errMsg, ex := groupMsgRateLimit(pb.OperationID, groupInfo, pb.MsgData); !ok {
+			promePkg.PromeInc(promePkg.GroupChatMsgProcessFailedCounter)
+			return returnMsg(&replay, pb, errCode, errMsg, "", 0, ex)
+		}
 		log.Debug(pb.OperationID, "GetGroupAllMember userID list", memberUserIDList, "len: ", len(memberUserIDList))
 		var addUidList []string
 		switch pb.MsgData.ContentType {
@@ -615,6 +753,15 @@ func (rpc *rpcChat) SendMsg(_ context.Context, pb *pbChat.SendMsgReq) (*pbChat.S
 			promePkg.PromeInc(promePkg.WorkSuperGroupChatMsgProcessFailedCounter)
 			return returnMsg(&replay, pb, errCode, errMsg, "", 0, "")
 		}
+		if groupInfo, err := rocksCache.GetGroupInfoFromCache(pb.MsgData.GroupID); err != nil {
+			log.NewError(pb.OperationID, "GetGroupInfoFromCache failed ", err.Error(), pb.MsgData.GroupID)
+		} else if groupInfo.GroupType == constant.NormalGroup {
+			promePkg.PromeInc(promePkg.WorkSuperGroupChatMsgProcessFailedCounter)
+			return returnMsg(&replay, pb, constant.ErrGroupTypeChanged.ErrCode, constant.ErrGroupTypeChanged.ErrMsg, "", 0, "")
+		} else if ok, errCode, errMsg, ex := groupMsgRateLimit(pb.OperationID, groupInfo, pb.MsgData); !ok {
+			promePkg.PromeInc(promePkg.WorkSuperGroupChatMsgProcessFailedCounter)
+			return returnMsg(&replay, pb, errCode, errMsg, "", 0, ex)
+		}
 		msgToMQSingle.MsgData = pb.MsgData
 		log.NewInfo(msgToMQSingle.OperationID, msgToMQSingle)
 		err1 := rpc.sendMsgToWriter(&msgToMQSingle, msgToMQSingle.MsgData.GroupID, constant.OnlineStatus)
@@ -787,228 +934,250 @@ func Notification(n *NotificationMsg) {
 		msg.RecvID = ""
 		msg.GroupID = n.RecvID
 	}
-	offlineInfo.IOSBadgeCount = config.Config.IOSPush.BadgeCount
-	offlineInfo.IOSPushSound = config.Config.IOSPush.PushSound
+	offlineInfo.IOSBadgeCount = config.Current().IOSPush.BadgeCount
+	offlineInfo.IOSPushSound = config.Current().IOSPush.PushSound
 	switch msg.ContentType {
 	case constant.GroupCreatedNotification:
-		pushSwitch = config.Config.Notification.GroupCreated.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupCreated.OfflinePush.Title
-		desc = config.Config.Notification.GroupCreated.OfflinePush.Desc
-		ex = config.Config.Notification.GroupCreated.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupCreated.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupCreated.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupCreated.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupCreated.OfflinePush.Title
+		desc = config.Current().Notification.GroupCreated.OfflinePush.Desc
+		ex = config.Current().Notification.GroupCreated.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupCreated.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupCreated.Conversation.UnreadCount
 	case constant.GroupInfoSetNotification:
-		pushSwitch = config.Config.Notification.GroupInfoSet.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupInfoSet.OfflinePush.Title
-		desc = config.Config.Notification.GroupInfoSet.OfflinePush.Desc
-		ex = config.Config.Notification.GroupInfoSet.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupInfoSet.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupInfoSet.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupInfoSet.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupInfoSet.OfflinePush.Title
+		desc = config.Current().Notification.GroupInfoSet.OfflinePush.Desc
+		ex = config.Current().Notification.GroupInfoSet.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupInfoSet.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupInfoSet.Conversation.UnreadCount
 	case constant.JoinGroupApplicationNotification:
-		pushSwitch = config.Config.Notification.JoinGroupApplication.OfflinePush.PushSwitch
-		title = config.Config.Notification.JoinGroupApplication.OfflinePush.Title
-		desc = config.Config.Notification.JoinGroupApplication.OfflinePush.Desc
-		ex = config.Config.Notification.JoinGroupApplication.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.JoinGroupApplication.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.JoinGroupApplication.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.JoinGroupApplication.OfflinePush.PushSwitch
+		title = config.Current().Notification.JoinGroupApplication.OfflinePush.Title
+		desc = config.Current().Notification.JoinGroupApplication.OfflinePush.Desc
+		ex = config.Current().Notification.JoinGroupApplication.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.JoinGroupApplication.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.JoinGroupApplication.Conversation.UnreadCount
 	case constant.MemberQuitNotification:
-		pushSwitch = config.Config.Notification.MemberQuit.OfflinePush.PushSwitch
-		title = config.Config.Notification.MemberQuit.OfflinePush.Title
-		desc = config.Config.Notification.MemberQuit.OfflinePush.Desc
-		ex = config.Config.Notification.MemberQuit.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.MemberQuit.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.MemberQuit.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.MemberQuit.OfflinePush.PushSwitch
+		title = config.Current().Notification.MemberQuit.OfflinePush.Title
+		desc = config.Current().Notification.MemberQuit.OfflinePush.Desc
+		ex = config.Current().Notification.MemberQuit.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.MemberQuit.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.MemberQuit.Conversation.UnreadCount
 	case constant.GroupApplicationAcceptedNotification:
-		pushSwitch = config.Config.Notification.GroupApplicationAccepted.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupApplicationAccepted.OfflinePush.Title
-		desc = config.Config.Notification.GroupApplicationAccepted.OfflinePush.Desc
-		ex = config.Config.Notification.GroupApplicationAccepted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupApplicationAccepted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupApplicationAccepted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupApplicationAccepted.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupApplicationAccepted.OfflinePush.Title
+		desc = config.Current().Notification.GroupApplicationAccepted.OfflinePush.Desc
+		ex = config.Current().Notification.GroupApplicationAccepted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupApplicationAccepted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupApplicationAccepted.Conversation.UnreadCount
 	case constant.GroupApplicationRejectedNotification:
-		pushSwitch = config.Config.Notification.GroupApplicationRejected.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupApplicationRejected.OfflinePush.Title
-		desc = config.Config.Notification.GroupApplicationRejected.OfflinePush.Desc
-		ex = config.Config.Notification.GroupApplicationRejected.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupApplicationRejected.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupApplicationRejected.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupApplicationRejected.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupApplicationRejected.OfflinePush.Title
+		desc = config.Current().Notification.GroupApplicationRejected.OfflinePush.Desc
+		ex = config.Current().Notification.GroupApplicationRejected.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupApplicationRejected.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupApplicationRejected.Conversation.UnreadCount
 	case constant.GroupOwnerTransferredNotification:
-		pushSwitch = config.Config.Notification.GroupOwnerTransferred.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupOwnerTransferred.OfflinePush.Title
-		desc = config.Config.Notification.GroupOwnerTransferred.OfflinePush.Desc
-		ex = config.Config.Notification.GroupOwnerTransferred.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupOwnerTransferred.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupOwnerTransferred.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupOwnerTransferred.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupOwnerTransferred.OfflinePush.Title
+		desc = config.Current().Notification.GroupOwnerTransferred.OfflinePush.Desc
+		ex = config.Current().Notification.GroupOwnerTransferred.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupOwnerTransferred.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupOwnerTransferred.Conversation.UnreadCount
 	case constant.MemberKickedNotification:
-		pushSwitch = config.Config.Notification.MemberKicked.OfflinePush.PushSwitch
-		title = config.Config.Notification.MemberKicked.OfflinePush.Title
-		desc = config.Config.Notification.MemberKicked.OfflinePush.Desc
-		ex = config.Config.Notification.MemberKicked.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.MemberKicked.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.MemberKicked.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.MemberKicked.OfflinePush.PushSwitch
+		title = config.Current().Notification.MemberKicked.OfflinePush.Title
+		desc = config.Current().Notification.MemberKicked.OfflinePush.Desc
+		ex = config.Current().Notification.MemberKicked.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.MemberKicked.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.MemberKicked.Conversation.UnreadCount
 	case constant.MemberInvitedNotification:
-		pushSwitch = config.Config.Notification.MemberInvited.OfflinePush.PushSwitch
-		title = config.Config.Notification.MemberInvited.OfflinePush.Title
-		desc = config.Config.Notification.MemberInvited.OfflinePush.Desc
-		ex = config.Config.Notification.MemberInvited.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.MemberInvited.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.MemberInvited.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.MemberInvited.OfflinePush.PushSwitch
+		title = config.Current().Notification.MemberInvited.OfflinePush.Title
+		desc = config.Current().Notification.MemberInvited.OfflinePush.Desc
+		ex = config.Current().Notification.MemberInvited.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.MemberInvited.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.MemberInvited.Conversation.UnreadCount
 	case constant.MemberEnterNotification:
-		pushSwitch = config.Config.Notification.MemberEnter.OfflinePush.PushSwitch
-		title = config.Config.Notification.MemberEnter.OfflinePush.Title
-		desc = config.Config.Notification.MemberEnter.OfflinePush.Desc
-		ex = config.Config.Notification.MemberEnter.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.MemberEnter.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.MemberEnter.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.MemberEnter.OfflinePush.PushSwitch
+		title = config.Current().Notification.MemberEnter.OfflinePush.Title
+		desc = config.Current().Notification.MemberEnter.OfflinePush.Desc
+		ex = config.Current().Notification.MemberEnter.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.MemberEnter.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.MemberEnter.Conversation.UnreadCount
 	case constant.UserInfoUpdatedNotification:
-		pushSwitch = config.Config.Notification.UserInfoUpdated.OfflinePush.PushSwitch
-		title = config.Config.Notification.UserInfoUpdated.OfflinePush.Title
-		desc = config.Config.Notification.UserInfoUpdated.OfflinePush.Desc
-		ex = config.Config.Notification.UserInfoUpdated.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.UserInfoUpdated.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.UserInfoUpdated.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.UserInfoUpdated.OfflinePush.PushSwitch
+		title = config.Current().Notification.UserInfoUpdated.OfflinePush.Title
+		desc = config.Current().Notification.UserInfoUpdated.OfflinePush.Desc
+		ex = config.Current().Notification.UserInfoUpdated.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.UserInfoUpdated.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.UserInfoUpdated.Conversation.UnreadCount
 	case constant.FriendApplicationNotification:
-		pushSwitch = config.Config.Notification.FriendApplication.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendApplication.OfflinePush.Title
-		desc = config.Config.Notification.FriendApplication.OfflinePush.Desc
-		ex = config.Config.Notification.FriendApplication.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendApplication.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendApplication.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendApplication.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendApplication.OfflinePush.Title
+		desc = config.Current().Notification.FriendApplication.OfflinePush.Desc
+		ex = config.Current().Notification.FriendApplication.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendApplication.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendApplication.Conversation.UnreadCount
 	case constant.FriendApplicationApprovedNotification:
-		pushSwitch = config.Config.Notification.FriendApplicationApproved.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendApplicationApproved.OfflinePush.Title
-		desc = config.Config.Notification.FriendApplicationApproved.OfflinePush.Desc
-		ex = config.Config.Notification.FriendApplicationApproved.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendApplicationApproved.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendApplicationApproved.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendApplicationApproved.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendApplicationApproved.OfflinePush.Title
+		desc = config.Current().Notification.FriendApplicationApproved.OfflinePush.Desc
+		ex = config.Current().Notification.FriendApplicationApproved.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendApplicationApproved.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendApplicationApproved.Conversation.UnreadCount
 	case constant.FriendApplicationRejectedNotification:
-		pushSwitch = config.Config.Notification.FriendApplicationRejected.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendApplicationRejected.OfflinePush.Title
-		desc = config.Config.Notification.FriendApplicationRejected.OfflinePush.Desc
-		ex = config.Config.Notification.FriendApplicationRejected.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendApplicationRejected.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendApplicationRejected.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendApplicationRejected.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendApplicationRejected.OfflinePush.Title
+		desc = config.Current().Notification.FriendApplicationRejected.OfflinePush.Desc
+		ex = config.Current().Notification.FriendApplicationRejected.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendApplicationRejected.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendApplicationRejected.Conversation.UnreadCount
 	case constant.FriendAddedNotification:
-		pushSwitch = config.Config.Notification.FriendAdded.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendAdded.OfflinePush.Title
-		desc = config.Config.Notification.FriendAdded.OfflinePush.Desc
-		ex = config.Config.Notification.FriendAdded.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendAdded.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendAdded.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendAdded.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendAdded.OfflinePush.Title
+		desc = config.Current().Notification.FriendAdded.OfflinePush.Desc
+		ex = config.Current().Notification.FriendAdded.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendAdded.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendAdded.Conversation.UnreadCount
 	case constant.FriendDeletedNotification:
-		pushSwitch = config.Config.Notification.FriendDeleted.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendDeleted.OfflinePush.Title
-		desc = config.Config.Notification.FriendDeleted.OfflinePush.Desc
-		ex = config.Config.Notification.FriendDeleted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendDeleted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendDeleted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendDeleted.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendDeleted.OfflinePush.Title
+		desc = config.Current().Notification.FriendDeleted.OfflinePush.Desc
+		ex = config.Current().Notification.FriendDeleted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendDeleted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendDeleted.Conversation.UnreadCount
 	case constant.FriendRemarkSetNotification:
-		pushSwitch = config.Config.Notification.FriendRemarkSet.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendRemarkSet.OfflinePush.Title
-		desc = config.Config.Notification.FriendRemarkSet.OfflinePush.Desc
-		ex = config.Config.Notification.FriendRemarkSet.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendRemarkSet.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendRemarkSet.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendRemarkSet.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendRemarkSet.OfflinePush.Title
+		desc = config.Current().Notification.FriendRemarkSet.OfflinePush.Desc
+		ex = config.Current().Notification.FriendRemarkSet.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendRemarkSet.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendRemarkSet.Conversation.UnreadCount
 	case constant.BlackAddedNotification:
-		pushSwitch = config.Config.Notification.BlackAdded.OfflinePush.PushSwitch
-		title = config.Config.Notification.BlackAdded.OfflinePush.Title
-		desc = config.Config.Notification.BlackAdded.OfflinePush.Desc
-		ex = config.Config.Notification.BlackAdded.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.BlackAdded.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.BlackAdded.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.BlackAdded.OfflinePush.PushSwitch
+		title = config.Current().Notification.BlackAdded.OfflinePush.Title
+		desc = config.Current().Notification.BlackAdded.OfflinePush.Desc
+		ex = config.Current().Notification.BlackAdded.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.BlackAdded.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.BlackAdded.Conversation.UnreadCount
 	case constant.BlackDeletedNotification:
-		pushSwitch = config.Config.Notification.BlackDeleted.OfflinePush.PushSwitch
-		title = config.Config.Notification.BlackDeleted.OfflinePush.Title
-		desc = config.Config.Notification.BlackDeleted.OfflinePush.Desc
-		ex = config.Config.Notification.BlackDeleted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.BlackDeleted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.BlackDeleted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.BlackDeleted.OfflinePush.PushSwitch
+		title = config.Current().Notification.BlackDeleted.OfflinePush.Title
+		desc = config.Current().Notification.BlackDeleted.OfflinePush.Desc
+		ex = config.Current().Notification.BlackDeleted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.BlackDeleted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.BlackDeleted.Conversation.UnreadCount
 	case constant.ConversationOptChangeNotification:
-		pushSwitch = config.Config.Notification.ConversationOptUpdate.OfflinePush.PushSwitch
-		title = config.Config.Notification.ConversationOptUpdate.OfflinePush.Title
-		desc = config.Config.Notification.ConversationOptUpdate.OfflinePush.Desc
-		ex = config.Config.Notification.ConversationOptUpdate.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.ConversationOptUpdate.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.ConversationOptUpdate.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.ConversationOptUpdate.OfflinePush.PushSwitch
+		title = config.Current().Notification.ConversationOptUpdate.OfflinePush.Title
+		desc = config.Current().Notification.ConversationOptUpdate.OfflinePush.Desc
+		ex = config.Current().Notification.ConversationOptUpdate.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.ConversationOptUpdate.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.ConversationOptUpdate.Conversation.UnreadCount
 
 	case constant.GroupDismissedNotification:
-		pushSwitch = config.Config.Notification.GroupDismissed.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupDismissed.OfflinePush.Title
-		desc = config.Config.Notification.GroupDismissed.OfflinePush.Desc
-		ex = config.Config.Notification.GroupDismissed.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupDismissed.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupDismissed.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupDismissed.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupDismissed.OfflinePush.Title
+		desc = config.Current().Notification.GroupDismissed.OfflinePush.Desc
+		ex = config.Current().Notification.GroupDismissed.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupDismissed.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupDismissed.Conversation.UnreadCount
 
 	case constant.GroupMutedNotification:
-		pushSwitch = config.Config.Notification.GroupMuted.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupMuted.OfflinePush.Title
-		desc = config.Config.Notification.GroupMuted.OfflinePush.Desc
-		ex = config.Config.Notification.GroupMuted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupMuted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupMuted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupMuted.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupMuted.OfflinePush.Title
+		desc = config.Current().Notification.GroupMuted.OfflinePush.Desc
+		ex = config.Current().Notification.GroupMuted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupMuted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupMuted.Conversation.UnreadCount
 
 	case constant.GroupCancelMutedNotification:
-		pushSwitch = config.Config.Notification.GroupCancelMuted.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupCancelMuted.OfflinePush.Title
-		desc = config.Config.Notification.GroupCancelMuted.OfflinePush.Desc
-		ex = config.Config.Notification.GroupCancelMuted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupCancelMuted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupCancelMuted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupCancelMuted.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupCancelMuted.OfflinePush.Title
+		desc = config.Current().Notification.GroupCancelMuted.OfflinePush.Desc
+		ex = config.Current().Notification.GroupCancelMuted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupCancelMuted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupCancelMuted.Conversation.UnreadCount
 
 	case constant.GroupMemberMutedNotification:
-		pushSwitch = config.Config.Notification.GroupMemberMuted.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupMemberMuted.OfflinePush.Title
-		desc = config.Config.Notification.GroupMemberMuted.OfflinePush.Desc
-		ex = config.Config.Notification.GroupMemberMuted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupMemberMuted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupMemberMuted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupMemberMuted.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupMemberMuted.OfflinePush.Title
+		desc = config.Current().Notification.GroupMemberMuted.OfflinePush.Desc
+		ex = config.Current().Notification.GroupMemberMuted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupMemberMuted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupMemberMuted.Conversation.UnreadCount
 
 	case constant.GroupMemberCancelMutedNotification:
-		pushSwitch = config.Config.Notification.GroupMemberCancelMuted.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupMemberCancelMuted.OfflinePush.Title
-		desc = config.Config.Notification.GroupMemberCancelMuted.OfflinePush.Desc
-		ex = config.Config.Notification.GroupMemberCancelMuted.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupMemberCancelMuted.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupMemberCancelMuted.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupMemberCancelMuted.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupMemberCancelMuted.OfflinePush.Title
+		desc = config.Current().Notification.GroupMemberCancelMuted.OfflinePush.Desc
+		ex = config.Current().Notification.GroupMemberCancelMuted.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupMemberCancelMuted.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupMemberCancelMuted.Conversation.UnreadCount
 
 	case constant.GroupMemberInfoSetNotification:
-		pushSwitch = config.Config.Notification.GroupMemberInfoSet.OfflinePush.PushSwitch
-		title = config.Config.Notification.GroupMemberInfoSet.OfflinePush.Title
-		desc = config.Config.Notification.GroupMemberInfoSet.OfflinePush.Desc
-		ex = config.Config.Notification.GroupMemberInfoSet.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.GroupMemberInfoSet.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.GroupMemberInfoSet.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.GroupMemberInfoSet.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupMemberInfoSet.OfflinePush.Title
+		desc = config.Current().Notification.GroupMemberInfoSet.OfflinePush.Desc
+		ex = config.Current().Notification.GroupMemberInfoSet.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupMemberInfoSet.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupMemberInfoSet.Conversation.UnreadCount
+
+	case constant.GroupRoleChangedNotification:
+		pushSwitch = config.Current().Notification.GroupRoleChanged.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupRoleChanged.OfflinePush.Title
+		desc = config.Current().Notification.GroupRoleChanged.OfflinePush.Desc
+		ex = config.Current().Notification.GroupRoleChanged.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupRoleChanged.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupRoleChanged.Conversation.UnreadCount
+	case constant.GroupTypeChangedNotification:
+		pushSwitch = config.Current().Notification.GroupTypeChanged.OfflinePush.PushSwitch
+		title = config.Current().Notification.GroupTypeChanged.OfflinePush.Title
+		desc = config.Current().Notification.GroupTypeChanged.OfflinePush.Desc
+		ex = config.Current().Notification.GroupTypeChanged.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.GroupTypeChanged.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.GroupTypeChanged.Conversation.UnreadCount
 
 	case constant.OrganizationChangedNotification:
-		pushSwitch = config.Config.Notification.OrganizationChanged.OfflinePush.PushSwitch
-		title = config.Config.Notification.OrganizationChanged.OfflinePush.Title
-		desc = config.Config.Notification.OrganizationChanged.OfflinePush.Desc
-		ex = config.Config.Notification.OrganizationChanged.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.OrganizationChanged.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.OrganizationChanged.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.OrganizationChanged.OfflinePush.PushSwitch
+		title = config.Current().Notification.OrganizationChanged.OfflinePush.Title
+		desc = config.Current().Notification.OrganizationChanged.OfflinePush.Desc
+		ex = config.Current().Notification.OrganizationChanged.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.OrganizationChanged.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.OrganizationChanged.Conversation.UnreadCount
 
 	case constant.WorkMomentNotification:
-		pushSwitch = config.Config.Notification.WorkMomentsNotification.OfflinePush.PushSwitch
-		title = config.Config.Notification.WorkMomentsNotification.OfflinePush.Title
-		desc = config.Config.Notification.WorkMomentsNotification.OfflinePush.Desc
-		ex = config.Config.Notification.WorkMomentsNotification.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.WorkMomentsNotification.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.WorkMomentsNotification.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.WorkMomentsNotification.OfflinePush.PushSwitch
+		title = config.Current().Notification.WorkMomentsNotification.OfflinePush.Title
+		desc = config.Current().Notification.WorkMomentsNotification.OfflinePush.Desc
+		ex = config.Current().Notification.WorkMomentsNotification.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.WorkMomentsNotification.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.WorkMomentsNotification.Conversation.UnreadCount
 
 	case constant.ConversationPrivateChatNotification:
-		pushSwitch = config.Config.Notification.ConversationSetPrivate.OfflinePush.PushSwitch
-		title = config.Config.Notification.ConversationSetPrivate.OfflinePush.Title
-		desc = config.Config.Notification.ConversationSetPrivate.OfflinePush.Desc
-		ex = config.Config.Notification.ConversationSetPrivate.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.ConversationSetPrivate.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.ConversationSetPrivate.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.ConversationSetPrivate.OfflinePush.PushSwitch
+		title = config.Current().Notification.ConversationSetPrivate.OfflinePush.Title
+		desc = config.Current().Notification.ConversationSetPrivate.OfflinePush.Desc
+		ex = config.Current().Notification.ConversationSetPrivate.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.ConversationSetPrivate.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.ConversationSetPrivate.Conversation.UnreadCount
 	case constant.FriendInfoUpdatedNotification:
-		pushSwitch = config.Config.Notification.FriendInfoUpdated.OfflinePush.PushSwitch
-		title = config.Config.Notification.FriendInfoUpdated.OfflinePush.Title
-		desc = config.Config.Notification.FriendInfoUpdated.OfflinePush.Desc
-		ex = config.Config.Notification.FriendInfoUpdated.OfflinePush.Ext
-		reliabilityLevel = config.Config.Notification.FriendInfoUpdated.Conversation.ReliabilityLevel
-		unReadCount = config.Config.Notification.FriendInfoUpdated.Conversation.UnreadCount
+		pushSwitch = config.Current().Notification.FriendInfoUpdated.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendInfoUpdated.OfflinePush.Title
+		desc = config.Current().Notification.FriendInfoUpdated.OfflinePush.Desc
+		ex = config.Current().Notification.FriendInfoUpdated.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendInfoUpdated.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendInfoUpdated.Conversation.UnreadCount
+	case constant.FriendCategoryChangedNotification:
+		pushSwitch = config.Current().Notification.FriendCategoryChanged.OfflinePush.PushSwitch
+		title = config.Current().Notification.FriendCategoryChanged.OfflinePush.Title
+		desc = config.Current().Notification.FriendCategoryChanged.OfflinePush.Desc
+		ex = config.Current().Notification.FriendCategoryChanged.OfflinePush.Ext
+		reliabilityLevel = config.Current().Notification.FriendCategoryChanged.Conversation.ReliabilityLevel
+		unReadCount = config.Current().Notification.FriendCategoryChanged.Conversation.UnreadCount
 	case constant.DeleteMessageNotification:
 		reliabilityLevel = constant.ReliableNotificationNoMsg
 	case constant.ConversationUnreadNotification, constant.SuperGroupUpdateNotification:
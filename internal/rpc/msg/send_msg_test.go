@@ -0,0 +1,33 @@
+package msg
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	sdk_ws "Open_IM/pkg/proto/sdk_ws"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GroupMsgRetryAfter(t *testing.T) {
+	assert.Equal(t, "{\"retryAfter\":1}", groupMsgRetryAfter(0))
+	assert.Equal(t, "{\"retryAfter\":1}", groupMsgRetryAfter(200*time.Millisecond))
+	assert.Equal(t, "{\"retryAfter\":3}", groupMsgRetryAfter(2001*time.Millisecond))
+	assert.Equal(t, "{\"retryAfter\":30}", groupMsgRetryAfter(30*time.Second))
+}
+
+func Test_GroupMsgRateLimitExempt(t *testing.T) {
+	// none of these reach the slow mode slot or the daily count
+	unlimited := &db.Group{GroupID: "g1"}
+	ok, errCode, _, ex := groupMsgRateLimit("", unlimited, &sdk_ws.MsgData{SendID: "u1", ContentType: constant.Text})
+	assert.True(t, ok)
+	assert.Zero(t, errCode)
+	assert.Empty(t, ex)
+
+	limited := &db.Group{GroupID: "g1", SlowModeSeconds: 10, DailyMsgQuota: 1}
+	ok, _, _, _ = groupMsgRateLimit("", limited, &sdk_ws.MsgData{SendID: "u1", ContentType: constant.Typing})
+	assert.True(t, ok)
+	ok, _, _, _ = groupMsgRateLimit("", limited, &sdk_ws.MsgData{SendID: "u1", ContentType: constant.GroupInfoSetNotification})
+	assert.True(t, ok)
+}
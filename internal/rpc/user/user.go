@@ -13,6 +13,7 @@ import (
 	"Open_IM/pkg/grpc-etcdv3/getcdv3"
 	pbConversation "Open_IM/pkg/proto/conversation"
 	pbFriend "Open_IM/pkg/proto/friend"
+	pbGroup "Open_IM/pkg/proto/group"
 	sdkws "Open_IM/pkg/proto/sdk_ws"
 	pbUser "Open_IM/pkg/proto/user"
 	"Open_IM/pkg/utils"
@@ -21,6 +22,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	grpcPrometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 
@@ -185,6 +187,12 @@ func (s *userServer) BatchSetConversations(ctx context.Context, req *pbUser.Batc
 			resp.Failed = append(resp.Failed, v.ConversationID)
 			continue
 		}
+		// the push path rebuilds the mute until time from the db on a miss
+		if err := db.DB.DelUsersConversationMuteUntilTime([]string{req.OwnerUserID}, v.ConversationID); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "DelUsersConversationMuteUntilTime failed", err.Error(), v.ConversationID)
+			resp.Failed = append(resp.Failed, v.ConversationID)
+			continue
+		}
 		if isUpdate {
 			err = rocksCache.DelConversationFromCache(v.OwnerUserID, v.ConversationID)
 		} else {
@@ -313,6 +321,12 @@ func (s *userServer) SetConversation(ctx context.Context, req *pbUser.SetConvers
 		resp.CommonResp = &pbUser.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}
 		return resp, nil
 	}
+	// the push path rebuilds the mute until time from the db on a miss
+	if err := db.DB.DelUsersConversationMuteUntilTime([]string{req.Conversation.OwnerUserID}, req.Conversation.ConversationID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "DelUsersConversationMuteUntilTime failed", err.Error(), req.Conversation.ConversationID)
+		resp.CommonResp = &pbUser.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}
+		return resp, nil
+	}
 	if isUpdate {
 		err = rocksCache.DelConversationFromCache(req.Conversation.OwnerUserID, req.Conversation.ConversationID)
 	} else {
@@ -538,6 +552,45 @@ func (s *userServer) SetGlobalRecvMessageOpt(ctx context.Context, req *pbUser.Se
 	return &pbUser.SetGlobalRecvMessageOptResp{CommonResp: &pbUser.CommonResp{}}, nil
 }
 
+func (s *userServer) SetDoNotDisturb(ctx context.Context, req *pbUser.SetDoNotDisturbReq) (*pbUser.SetDoNotDisturbResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req.String())
+	if req.DoNotDisturb == nil {
+		return &pbUser.SetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: "doNotDisturb is nil"}}, nil
+	}
+	if req.DoNotDisturb.Enable {
+		if _, err := utils.IsInDailyPeriod(req.DoNotDisturb.StartTime, req.DoNotDisturb.EndTime, req.DoNotDisturb.TimeZone, time.Now()); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "IsInDailyPeriod failed ", err.Error(), req.DoNotDisturb.String())
+			return &pbUser.SetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{ErrCode: constant.ErrArgs.ErrCode, ErrMsg: err.Error()}}, nil
+		}
+	}
+	doNotDisturb := db.UserDoNotDisturb{UserID: req.UserID}
+	utils.CopyStructFields(&doNotDisturb, req.DoNotDisturb)
+	if err := imdb.SetUserDoNotDisturb(doNotDisturb); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "SetUserDoNotDisturb failed ", err.Error(), doNotDisturb)
+		return &pbUser.SetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	if err := rocksCache.DelUserDoNotDisturbFromCache(req.UserID); err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "DelUserDoNotDisturbFromCache failed ", err.Error(), req.UserID)
+		return &pbUser.SetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: err.Error()}}, nil
+	}
+	chat.UserInfoUpdatedNotification(req.OperationID, req.UserID, req.UserID)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return")
+	return &pbUser.SetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{}}, nil
+}
+
+func (s *userServer) GetDoNotDisturb(ctx context.Context, req *pbUser.GetDoNotDisturbReq) (*pbUser.GetDoNotDisturbResp, error) {
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req.String())
+	doNotDisturb, err := rocksCache.GetUserDoNotDisturbFromCache(req.UserID)
+	if err != nil {
+		log.NewError(req.OperationID, utils.GetSelfFuncName(), "GetUserDoNotDisturbFromCache failed ", err.Error(), req.UserID)
+		return &pbUser.GetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{ErrCode: constant.ErrDB.ErrCode, ErrMsg: constant.ErrDB.ErrMsg}}, nil
+	}
+	resp := &pbUser.GetDoNotDisturbResp{CommonResp: &pbUser.CommonResp{}, DoNotDisturb: &pbUser.DoNotDisturb{}}
+	utils.CopyStructFields(resp.DoNotDisturb, doNotDisturb)
+	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "rpc return ", resp.String())
+	return resp, nil
+}
+
 func (s *userServer) SyncJoinedGroupMemberFaceURL(userID string, faceURL string, operationID string, opUserID string) {
 	joinedGroupIDList, err := rocksCache.GetJoinedGroupIDListFromCache(userID)
 	if err != nil {
@@ -704,10 +757,52 @@ func (s *userServer) BlockUser(ctx context.Context, req *pbUser.BlockUserReq) (*
 		resp.CommonResp.ErrMsg = err.Error()
 		return resp, nil
 	}
+	for platformID := range constant.PlatformID2Name {
+		if err := token_verify.RevokeRefreshTokens(req.UserID, platformID); err != nil {
+			log.NewError(req.OperationID, utils.GetSelfFuncName(), "RevokeRefreshTokens", err.Error(), req.UserID, platformID)
+			resp.CommonResp.ErrCode = constant.ErrDB.ErrCode
+			resp.CommonResp.ErrMsg = err.Error()
+			return resp, nil
+		}
+	}
+	if config.Config.GroupOwnerSuccession.Enable {
+		succeedOwnedGroups(req.OperationID, req.UserID)
+	}
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "resp: ", resp.String())
 	return resp, nil
 }
 
+// succeedOwnedGroups hands every group owned by a blocked user to a successor, failures are left to the cron repair
+func succeedOwnedGroups(operationID, userID string) {
+	groupIDList, err := imdb.GetOwnedGroupIDList(userID)
+	if err != nil {
+		log.NewError(operationID, "GetOwnedGroupIDList failed ", err.Error(), userID)
+		return
+	}
+	if len(groupIDList) == 0 {
+		return
+	}
+	etcdConn := getcdv3.GetDefaultConn(config.Config.Etcd.EtcdSchema, strings.Join(config.Config.Etcd.EtcdAddr, ","), config.Config.RpcRegisterName.OpenImGroupName, operationID)
+	if etcdConn == nil {
+		log.NewError(operationID, "getcdv3.GetDefaultConn == nil", userID)
+		return
+	}
+	client := pbGroup.NewGroupClient(etcdConn)
+	for _, groupID := range groupIDList {
+		rpcResp, err := client.SucceedGroupOwner(context.Background(), &pbGroup.SucceedGroupOwnerReq{GroupID: groupID, OldOwnerUserID: userID,
+			OpUserID: config.Config.Manager.AppManagerUid[0], OperationID: operationID})
+		if err != nil {
+			log.NewError(operationID, "SucceedGroupOwner failed ", err.Error(), groupID, userID)
+			continue
+		}
+		if rpcResp.CommonResp.ErrCode != 0 {
+			log.NewError(operationID, "SucceedGroupOwner failed ", rpcResp.CommonResp.ErrMsg, groupID, userID)
+			continue
+		}
+		log.NewInfo(operationID, "group owner succeeded ", groupID, userID, rpcResp.NewOwnerUserID)
+	}
+}
+
 func (s *userServer) UnBlockUser(ctx context.Context, req *pbUser.UnBlockUserReq) (*pbUser.UnBlockUserResp, error) {
 	log.NewInfo(req.OperationID, utils.GetSelfFuncName(), "req: ", req.String())
 	resp := &pbUser.UnBlockUserResp{CommonResp: &pbUser.CommonResp{}}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"Open_IM/pkg/common/constant"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AdminRoleHasPermission(t *testing.T) {
+	assert.True(t, constant.AdminRoleHasPermission(constant.AdminRoleSuperAdmin, constant.PermAdminManage))
+	assert.True(t, constant.AdminRoleHasPermission(constant.AdminRoleSupport, constant.PermMessageRead))
+	assert.False(t, constant.AdminRoleHasPermission(constant.AdminRoleSupport, constant.PermUserWrite))
+	assert.False(t, constant.AdminRoleHasPermission(constant.AdminRoleSupport, constant.PermRegisterWrite))
+	assert.False(t, constant.AdminRoleHasPermission(constant.AdminRoleAuditor, constant.PermMessageWrite))
+	assert.False(t, constant.AdminRoleHasPermission(0, constant.PermUserRead))
+}
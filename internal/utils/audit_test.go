@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"Open_IM/pkg/common/audit"
+	"Open_IM/pkg/common/db"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AuditMaskParams(t *testing.T) {
+	masked := audit.MaskParams([]byte(`{"adminID":"openIM123","secret":"tuoyun","extra":{"newPassword":"123456"}}`))
+	assert.Contains(t, masked, `"adminID":"openIM123"`)
+	assert.NotContains(t, masked, "tuoyun")
+	assert.NotContains(t, masked, "123456")
+
+	assert.Equal(t, "not json", audit.MaskParams([]byte("not json")))
+}
+
+func Test_AuditComputeHash(t *testing.T) {
+	first := &db.AuditLog{Actor: "openIM123", Action: "/cms/user/block_user", Target: "user1", CreateTime: time.UnixMilli(1660000000000)}
+	first.Hash = audit.ComputeHash(first)
+	second := &db.AuditLog{Actor: "openIM123", Action: "/cms/user/unblock_user", Target: "user1", PrevHash: first.Hash,
+		CreateTime: time.UnixMilli(1660000001000)}
+	second.Hash = audit.ComputeHash(second)
+	assert.NotEqual(t, first.Hash, second.Hash)
+
+	tampered := *first
+	tampered.Target = "user2"
+	assert.NotEqual(t, first.Hash, audit.ComputeHash(&tampered))
+
+	// fields are length prefixed so moving bytes between them changes the hash
+	shifted := *first
+	shifted.Actor, shifted.Action = "openIM123/cms", "/user/block_user"
+	assert.NotEqual(t, first.Hash, audit.ComputeHash(&shifted))
+
+	relinked := *second
+	relinked.PrevHash = audit.ComputeHash(&tampered)
+	assert.NotEqual(t, second.Hash, audit.ComputeHash(&relinked))
+}
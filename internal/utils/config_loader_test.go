@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"Open_IM/pkg/common/config"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ConfigExpand(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "mysql_password"), []byte("s3cret\n"), 0600))
+	os.Setenv("OPENIM_TEST_HOST", "10.0.0.1")
+	defer os.Unsetenv("OPENIM_TEST_HOST")
+	os.Unsetenv("OPENIM_TEST_UNSET")
+
+	value, err := config.Expand("${OPENIM_TEST_HOST}:3306", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.1:3306", value)
+
+	value, err = config.Expand("${OPENIM_TEST_UNSET:-openIM}", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "openIM", value)
+
+	value, err = config.Expand("${file:mysql_password}", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "s3cret", value)
+
+	value, err = config.Expand("pa$${word}", dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "pa${word}", value)
+
+	_, err = config.Expand("${OPENIM_TEST_UNSET}", dir)
+	assert.NotNil(t, err)
+	_, err = config.Expand("${file:missing}", dir)
+	assert.NotNil(t, err)
+	_, err = config.Expand("${OPENIM_TEST_HOST", dir)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"Open_IM/pkg/utils"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsInDailyPeriod(t *testing.T) {
+	// 2022-08-01 14:30 UTC is 22:30 in Asia/Shanghai
+	now := time.Date(2022, 8, 1, 14, 30, 0, 0, time.UTC)
+
+	in, err := utils.IsInDailyPeriod("22:00", "08:00", "Asia/Shanghai", now)
+	assert.Nil(t, err)
+	assert.True(t, in)
+
+	in, err = utils.IsInDailyPeriod("22:00", "08:00", "UTC", now)
+	assert.Nil(t, err)
+	assert.False(t, in)
+
+	in, err = utils.IsInDailyPeriod("14:00", "15:00", "UTC", now)
+	assert.Nil(t, err)
+	assert.True(t, in)
+
+	in, err = utils.IsInDailyPeriod("14:30", "14:30", "UTC", now)
+	assert.NotNil(t, err)
+	assert.False(t, in)
+
+	_, err = utils.IsInDailyPeriod("25:00", "08:00", "UTC", now)
+	assert.NotNil(t, err)
+
+	_, err = utils.IsInDailyPeriod("22:00", "08:00", "Mars/Olympus", now)
+	assert.NotNil(t, err)
+}
+
+func Test_UntilNextDay(t *testing.T) {
+	now := time.Date(2022, 8, 1, 23, 59, 30, 0, time.UTC)
+	assert.Equal(t, 30*time.Second, utils.UntilNextDay(now))
+
+	now = time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 24*time.Hour, utils.UntilNextDay(now))
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	assert.Nil(t, err)
+	assert.Equal(t, 90*time.Minute, utils.UntilNextDay(time.Date(2022, 8, 1, 22, 30, 0, 0, loc)))
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"Open_IM/pkg/common/multi_terminal_login"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSession(sessionID string, platformID int, loginTime int64) *multi_terminal_login.Session {
+	return &multi_terminal_login.Session{SessionID: sessionID, LoginSession: db.LoginSession{PlatformID: platformID, LoginTime: loginTime}}
+}
+
+func endedSessionIDs(sessions []*multi_terminal_login.Session) []string {
+	var sessionIDs []string
+	for _, v := range sessions {
+		sessionIDs = append(sessionIDs, v.SessionID)
+	}
+	return sessionIDs
+}
+
+func Test_MultiLoginEvaluate(t *testing.T) {
+	policy := config.MultiLoginPolicy{
+		constant.TerminalMobile: {MaxPerPlatform: 1},
+		constant.TerminalPC:     {MaxSessions: 1, Kick: []string{constant.WebPlatformStr}},
+		constant.WebPlatformStr: {MaxSessions: 2},
+	}
+	sessions := []*multi_terminal_login.Session{
+		newTestSession("ios", constant.IOSPlatformID, 1),
+		newTestSession("android", constant.AndroidPlatformID, 2),
+		newTestSession("windows", constant.WindowsPlatformID, 3),
+		newTestSession("web1", constant.WebPlatformID, 4),
+		newTestSession("web2", constant.WebPlatformID, 5),
+		newTestSession("ipad", constant.IPadPlatformID, 6),
+	}
+
+	ended := multi_terminal_login.Evaluate(policy, sessions, newTestSession("ios2", constant.IOSPlatformID, 10))
+	assert.Equal(t, []string{"ios"}, endedSessionIDs(ended))
+
+	ended = multi_terminal_login.Evaluate(policy, sessions, newTestSession("osx", constant.OSXPlatformID, 10))
+	assert.ElementsMatch(t, []string{"windows", "web1", "web2"}, endedSessionIDs(ended))
+
+	// the oldest web session makes room for the new one
+	ended = multi_terminal_login.Evaluate(policy, sessions, newTestSession("web3", constant.WebPlatformID, 10))
+	assert.Equal(t, []string{"web1"}, endedSessionIDs(ended))
+
+	// unlisted classes are not limited
+	ended = multi_terminal_login.Evaluate(policy, sessions, newTestSession("ipad2", constant.IPadPlatformID, 10))
+	assert.Empty(t, ended)
+
+	// a session that already exists does not count against itself
+	ended = multi_terminal_login.Evaluate(policy, sessions, newTestSession("windows", constant.WindowsPlatformID, 3))
+	assert.ElementsMatch(t, []string{"web1", "web2"}, endedSessionIDs(ended))
+}
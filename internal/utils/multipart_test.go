@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"Open_IM/pkg/utils"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetPartNum(t *testing.T) {
+	assert.Equal(t, 0, utils.GetPartNum(0, 5))
+	assert.Equal(t, 0, utils.GetPartNum(10, 0))
+	assert.Equal(t, 1, utils.GetPartNum(5, 5))
+	assert.Equal(t, 2, utils.GetPartNum(6, 5))
+	assert.Equal(t, 2, utils.GetPartNum(10, 5))
+}
+
+func Test_IsSha256Hex(t *testing.T) {
+	assert.True(t, utils.IsSha256Hex("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+	assert.False(t, utils.IsSha256Hex("E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855"))
+	assert.False(t, utils.IsSha256Hex("e3b0c44298fc1c149afbf4c8996fb924"))
+	assert.False(t, utils.IsSha256Hex("../b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+}
+
+func Test_GetHashObjectName(t *testing.T) {
+	hash := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	assert.Equal(t, "hash/"+hash+".mp4", utils.GetHashObjectName(hash, "video.MP4"))
+	assert.Equal(t, "hash/"+hash, utils.GetHashObjectName(hash, "noext"))
+}
@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/oidc"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+// mockIdP is a minimal identity provider, Authorize stands in for the user signing in
+type mockIdP struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	clientID string
+
+	mu    sync.Mutex
+	codes map[string]url.Values
+}
+
+func newMockIdP(t *testing.T, clientID string) *mockIdP {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	idp := &mockIdP{key: key, clientID: clientID, codes: make(map[string]url.Values)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"userinfo_endpoint":      idp.URL + "/userinfo",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kty": "RSA", "kid": "k1", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		idp.mu.Lock()
+		auth, ok := idp.codes[r.PostForm.Get("code")]
+		delete(idp.codes, r.PostForm.Get("code"))
+		idp.mu.Unlock()
+		if !ok || r.PostForm.Get("client_id") != clientID || r.PostForm.Get("redirect_uri") != auth.Get("redirect_uri") ||
+			oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != auth.Get("code_challenge") {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access-" + auth.Get("nonce"),
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     idp.sign(t, jwt.MapClaims{"iss": idp.URL, "aud": clientID, "sub": "u1001", "nonce": auth.Get("nonce"), "name": "Alice"}),
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"sub": "u1001", "email": "alice@example.com",
+			"org": map[string]interface{}{"department": "R&D"}})
+	})
+	idp.Server = httptest.NewServer(mux)
+	return idp
+}
+
+func (idp *mockIdP) sign(t *testing.T, claims jwt.MapClaims) string {
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(5 * time.Minute).Unix()
+	}
+	claims["iat"] = time.Now().Unix()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(idp.key)
+	assert.Nil(t, err)
+	return signed
+}
+
+// Authorize returns the code the provider redirects back with
+func (idp *mockIdP) Authorize(t *testing.T, authURL string) (code, state string) {
+	u, err := url.Parse(authURL)
+	assert.Nil(t, err)
+	q := u.Query()
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, idp.clientID, q.Get("client_id"))
+	code = "code-" + q.Get("state")
+	idp.mu.Lock()
+	idp.codes[code] = q
+	idp.mu.Unlock()
+	return code, q.Get("state")
+}
+
+func newTestProvider(idp *mockIdP) *oidc.Provider {
+	cfg := config.OIDCProvider{Name: "mock", Issuer: idp.URL, ClientID: "openim", RedirectURL: "http://127.0.0.1:10002/auth/oidc/callback", UserIDPrefix: "mock_"}
+	cfg.Claims.Department = "org.department"
+	return oidc.NewProvider(cfg)
+}
+
+func Test_OIDCCodeFlow(t *testing.T) {
+	idp := newMockIdP(t, "openim")
+	defer idp.Close()
+	provider := newTestProvider(idp)
+
+	verifier, _ := oidc.RandomString(32)
+	authURL, err := provider.AuthCodeURL("state1", "nonce1", verifier)
+	assert.Nil(t, err)
+	code, state := idp.Authorize(t, authURL)
+	assert.Equal(t, "state1", state)
+
+	token, err := provider.Exchange(code, verifier)
+	assert.Nil(t, err)
+	claims, err := provider.VerifyIDToken(token.IDToken, "nonce1")
+	assert.Nil(t, err)
+	userInfo, err := provider.UserInfo(token.AccessToken, "u1001")
+	assert.Nil(t, err)
+	for k, v := range userInfo {
+		if _, ok := claims[k]; !ok {
+			claims[k] = v
+		}
+	}
+	profile, err := provider.MapClaims(claims)
+	assert.Nil(t, err)
+	assert.Equal(t, &oidc.Profile{Subject: "u1001", UserID: "mock_u1001", Nickname: "Alice", Email: "alice@example.com", Department: "R&D"}, profile)
+
+	// codes are single use
+	_, err = provider.Exchange(code, verifier)
+	assert.NotNil(t, err)
+}
+
+func Test_OIDCRejectsWrongVerifier(t *testing.T) {
+	idp := newMockIdP(t, "openim")
+	defer idp.Close()
+	provider := newTestProvider(idp)
+
+	verifier, _ := oidc.RandomString(32)
+	authURL, err := provider.AuthCodeURL("state1", "nonce1", verifier)
+	assert.Nil(t, err)
+	code, _ := idp.Authorize(t, authURL)
+	other, _ := oidc.RandomString(32)
+	_, err = provider.Exchange(code, other)
+	assert.NotNil(t, err)
+}
+
+func Test_OIDCVerifyIDToken(t *testing.T) {
+	idp := newMockIdP(t, "openim")
+	defer idp.Close()
+	provider := newTestProvider(idp)
+
+	_, err := provider.VerifyIDToken(idp.sign(t, jwt.MapClaims{"iss": idp.URL, "aud": "openim", "sub": "u1", "nonce": "n"}), "n")
+	assert.Nil(t, err)
+	_, err = provider.VerifyIDToken(idp.sign(t, jwt.MapClaims{"iss": idp.URL, "aud": "openim", "sub": "u1", "nonce": "n"}), "other")
+	assert.Equal(t, oidc.ErrNonceMismatch, err)
+	_, err = provider.VerifyIDToken(idp.sign(t, jwt.MapClaims{"iss": idp.URL, "aud": "other", "sub": "u1", "nonce": "n"}), "n")
+	assert.NotNil(t, err)
+	_, err = provider.VerifyIDToken(idp.sign(t, jwt.MapClaims{"iss": "https://evil.example.com", "aud": "openim", "sub": "u1", "nonce": "n"}), "n")
+	assert.NotNil(t, err)
+	_, err = provider.VerifyIDToken(idp.sign(t, jwt.MapClaims{"iss": idp.URL, "aud": "openim", "sub": "u1", "nonce": "n", "exp": time.Now().Add(-time.Hour).Unix()}), "n")
+	assert.NotNil(t, err)
+
+	// signed by a key the provider does not publish
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	forged := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{"iss": idp.URL, "aud": "openim", "sub": "u1", "nonce": "n", "exp": time.Now().Add(time.Minute).Unix()})
+	forged.Header["kid"] = "k1"
+	signed, _ := forged.SignedString(otherKey)
+	_, err = provider.VerifyIDToken(signed, "n")
+	assert.NotNil(t, err)
+
+	// hmac with the public modulus must not be accepted
+	hs := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"iss": idp.URL, "aud": "openim", "sub": "u1", "nonce": "n", "exp": time.Now().Add(time.Minute).Unix()})
+	signed, _ = hs.SignedString(idp.key.N.Bytes())
+	_, err = provider.VerifyIDToken(signed, "n")
+	assert.NotNil(t, err)
+}
+
+func Test_OIDCUserInfoSubjectMismatch(t *testing.T) {
+	idp := newMockIdP(t, "openim")
+	defer idp.Close()
+	provider := newTestProvider(idp)
+
+	_, err := provider.UserInfo("token", "someone-else")
+	assert.NotNil(t, err)
+}
+
+func Test_OIDCProviderRequiresUserIDPrefix(t *testing.T) {
+	providers := config.Config.OIDC.Providers
+	defer func() { config.Config.OIDC.Providers = providers }()
+	config.Config.OIDC.Providers = []config.OIDCProvider{{Name: "no_prefix", Issuer: "https://idp.example.com"}}
+
+	_, err := oidc.GetProvider("no_prefix")
+	assert.Equal(t, oidc.ErrNoUserIDPrefix, err)
+}
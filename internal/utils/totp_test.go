@@ -0,0 +1,45 @@
+package utils
+
+import (
+	"Open_IM/pkg/utils"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// secret of the RFC 6238 test vectors, "12345678901234567890" in base32
+const rfcTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func Test_TOTPCode(t *testing.T) {
+	for unix, code := range map[int64]string{59: "287082", 1111111109: "081804", 1234567890: "005924", 2000000000: "279037"} {
+		result, err := utils.TOTPCode(rfcTOTPSecret, time.Unix(unix, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, code, result)
+	}
+}
+
+func Test_VerifyTOTP(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	assert.True(t, utils.VerifyTOTP(rfcTOTPSecret, "005924", now, 1))
+	previous, _ := utils.TOTPCode(rfcTOTPSecret, now.Add(-30*time.Second))
+	assert.True(t, utils.VerifyTOTP(rfcTOTPSecret, previous, now, 1))
+	assert.False(t, utils.VerifyTOTP(rfcTOTPSecret, previous, now, 0))
+	assert.False(t, utils.VerifyTOTP(rfcTOTPSecret, "000000", now, 1))
+	assert.False(t, utils.VerifyTOTP("not base32!", "005924", now, 1))
+
+	step, ok := utils.VerifyTOTPStep(rfcTOTPSecret, previous, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, now.Unix()/30-1, step)
+}
+
+func Test_GenerateTOTPSecret(t *testing.T) {
+	secret, err := utils.GenerateTOTPSecret()
+	assert.Nil(t, err)
+	assert.Len(t, secret, 32)
+	code, err := utils.TOTPCode(secret, time.Now())
+	assert.Nil(t, err)
+	assert.True(t, utils.VerifyTOTP(secret, code, time.Now(), 1))
+	assert.True(t, strings.HasPrefix(utils.TOTPURI("OpenIM", "user@example.com", secret), "otpauth://totp/OpenIM:user@example.com?"))
+}
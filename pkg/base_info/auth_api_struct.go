@@ -17,9 +17,11 @@ type UserRegisterReq struct {
 }
 
 type UserTokenInfo struct {
-	UserID      string `json:"userID"`
-	Token       string `json:"token"`
-	ExpiredTime int64  `json:"expiredTime"`
+	UserID             string `json:"userID"`
+	Token              string `json:"token"`
+	ExpiredTime        int64  `json:"expiredTime"`
+	RefreshToken       string `json:"refreshToken,omitempty"`
+	RefreshExpiredTime int64  `json:"refreshExpiredTime,omitempty"`
 }
 type UserRegisterResp struct {
 	CommResp
@@ -39,6 +41,18 @@ type UserTokenResp struct {
 	UserToken UserTokenInfo `json:"data"`
 }
 
+type RefreshTokenReq struct {
+	Platform     int32  `json:"platform" binding:"required,min=1,max=12"`
+	UserID       string `json:"userID" binding:"required,min=1,max=64"`
+	RefreshToken string `json:"refreshToken" binding:"required"`
+	OperationID  string `json:"operationID" binding:"required"`
+}
+
+type RefreshTokenResp struct {
+	CommResp
+	UserToken UserTokenInfo `json:"data"`
+}
+
 type ForceLogoutReq struct {
 	Platform    int32  `json:"platform" binding:"required,min=1,max=12"`
 	FromUserID  string `json:"fromUserID" binding:"required,min=1,max=64"`
@@ -49,6 +63,35 @@ type ForceLogoutResp struct {
 	CommResp
 }
 
+type GetUserSessionsReq struct {
+	UserID      string `json:"userID" binding:"max=64"`
+	OperationID string `json:"operationID" binding:"required"`
+}
+
+type UserSession struct {
+	SessionID  string `json:"sessionID"`
+	PlatformID int32  `json:"platformID"`
+	Platform   string `json:"platform"`
+	LoginIP    string `json:"loginIP"`
+	LoginTime  int64  `json:"loginTime"`
+	Current    bool   `json:"current"`
+}
+
+type GetUserSessionsResp struct {
+	CommResp
+	Sessions []*UserSession `json:"data"`
+}
+
+type RevokeUserSessionReq struct {
+	UserID      string `json:"userID" binding:"max=64"`
+	SessionID   string `json:"sessionID" binding:"required"`
+	OperationID string `json:"operationID" binding:"required"`
+}
+
+type RevokeUserSessionResp struct {
+	CommResp
+}
+
 type ParseTokenReq struct {
 	OperationID string `json:"operationID" binding:"required"`
 }
@@ -67,3 +110,36 @@ type ParseTokenResp struct {
 	Data       map[string]interface{} `json:"data" swaggerignore:"true"`
 	ExpireTime ExpireTime             `json:"-"`
 }
+
+type OIDCAuthorizeReq struct {
+	Provider    string `json:"provider" form:"provider" binding:"required"`
+	Platform    int32  `json:"platform" form:"platform" binding:"required,min=1,max=12"`
+	RedirectURI string `json:"redirectURI" form:"redirectURI" binding:"required"`
+	OperationID string `json:"operationID" form:"operationID"`
+}
+
+type OIDCAuthorizeResp struct {
+	CommResp
+	Data struct {
+		AuthURL string `json:"authURL"`
+		State   string `json:"state"`
+	} `json:"data"`
+}
+
+type OIDCCallbackReq struct {
+	Code             string `json:"code" form:"code"`
+	State            string `json:"state" form:"state" binding:"required"`
+	Error            string `json:"error" form:"error"`
+	ErrorDescription string `json:"error_description" form:"error_description"`
+}
+
+type OIDCTokenReq struct {
+	Code        string `json:"code" binding:"required"`
+	RedirectURI string `json:"redirectURI" binding:"required"`
+	OperationID string `json:"operationID" binding:"required"`
+}
+
+type OIDCTokenResp struct {
+	CommResp
+	UserToken UserTokenInfo `json:"data"`
+}
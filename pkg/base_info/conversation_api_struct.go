@@ -47,6 +47,7 @@ type Conversation struct {
 	GroupAtType           int32  `json:"groupAtType"`
 	IsNotInGroup          bool   `json:"isNotInGroup"`
 	UpdateUnreadCountTime int64  `json:"updateUnreadCountTime"`
+	MuteUntilTime         int64  `json:"muteUntilTime"`
 	AttachedInfo          string `json:"attachedInfo"`
 	Ex                    string `json:"ex"`
 }
@@ -134,3 +134,89 @@ type GetSelfApplyListResp struct {
 	FriendRequestList []*open_im_sdk.FriendRequest `json:"-"`
 	Data              []map[string]interface{}     `json:"data" swaggerignore:"true"`
 }
+
+type FriendCategory struct {
+	CategoryID       string   `json:"categoryID"`
+	Name             string   `json:"name"`
+	FriendUserIDList []string `json:"friendUserIDList"`
+	CreateTime       int64    `json:"createTime"`
+	UpdateTime       int64    `json:"updateTime"`
+}
+
+type SetFriendCategoryReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	FromUserID  string `json:"fromUserID" binding:"required"`
+	CategoryID  string `json:"categoryID"`
+	Name        string `json:"name" binding:"required"`
+}
+type SetFriendCategoryResp struct {
+	CommResp
+	Category *FriendCategory `json:"data"`
+}
+
+type DeleteFriendCategoryReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	FromUserID  string `json:"fromUserID" binding:"required"`
+	CategoryID  string `json:"categoryID" binding:"required"`
+}
+type DeleteFriendCategoryResp struct {
+	CommResp
+}
+
+type SetFriendCategoriesReq struct {
+	ParamsCommFriend
+	CategoryIDList []string `json:"categoryIDList"`
+}
+type SetFriendCategoriesResp struct {
+	CommResp
+}
+
+type SetFriendStarredReq struct {
+	ParamsCommFriend
+	Starred bool `json:"starred"`
+}
+type SetFriendStarredResp struct {
+	CommResp
+}
+
+type GetFriendCategoriesReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	FromUserID  string `json:"fromUserID" binding:"required"`
+}
+type GetFriendCategoriesResp struct {
+	CommResp
+	Data struct {
+		Categories              []*FriendCategory `json:"categories"`
+		StarredFriendUserIDList []string          `json:"starredFriendUserIDList"`
+	} `json:"data"`
+}
+
+type FriendRecommendation struct {
+	UserID          string `json:"userID"`
+	Nickname        string `json:"nickname"`
+	FaceURL         string `json:"faceURL"`
+	Gender          int32  `json:"gender"`
+	MutualFriendNum int32  `json:"mutualFriendNum"`
+	SharedGroupNum  int32  `json:"sharedGroupNum"`
+	SameDepartment  bool   `json:"sameDepartment"`
+	Score           int64  `json:"score"`
+}
+
+type GetFriendRecommendationsReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	FromUserID  string `json:"fromUserID" binding:"required"`
+	Count       int32  `json:"count" binding:"omitempty,min=0"`
+}
+type GetFriendRecommendationsResp struct {
+	CommResp
+	Data []*FriendRecommendation `json:"data"`
+}
+
+type SetFriendRecommendOptReq struct {
+	OperationID  string `json:"operationID" binding:"required"`
+	FromUserID   string `json:"fromUserID" binding:"required"`
+	RecommendOpt *int32 `json:"recommendOpt" binding:"required,oneof=0 1"`
+}
+type SetFriendRecommendOptResp struct {
+	CommResp
+}
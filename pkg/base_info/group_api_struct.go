@@ -185,11 +185,13 @@ type ApplicationGroupResponseResp struct {
 }
 
 type JoinGroupReq struct {
-	GroupID       string `json:"groupID" binding:"required"`
-	ReqMessage    string `json:"reqMessage"`
-	OperationID   string `json:"operationID" binding:"required"`
-	JoinSource    int32  `json:"joinSource"`
-	InviterUserID string `json:"inviterUserID"`
+	GroupID       string                         `json:"groupID" binding:"required_without=InviteToken"`
+	ReqMessage    string                         `json:"reqMessage"`
+	OperationID   string                         `json:"operationID" binding:"required"`
+	JoinSource    int32                          `json:"joinSource"`
+	InviterUserID string                         `json:"inviterUserID"`
+	InviteToken   string                         `json:"inviteToken"`
+	JoinAnswers   []*open_im_sdk.GroupJoinAnswer `json:"joinAnswers"`
 }
 
 type JoinGroupResp struct {
@@ -215,6 +217,8 @@ type SetGroupInfoReq struct {
 	NeedVerification  *int32 `json:"needVerification"`
 	LookMemberInfo    *int32 `json:"lookMemberInfo"`
 	ApplyMemberFriend *int32 `json:"applyMemberFriend"`
+	SlowModeSeconds   *int32 `json:"slowModeSeconds"`
+	DailyMsgQuota     *int32 `json:"dailyMsgQuota"`
 }
 
 type SetGroupInfoResp struct {
@@ -309,3 +313,238 @@ type GetGroupAbstractInfoResp struct {
 	GroupMemberNumber   int32  `json:"groupMemberNumber"`
 	GroupMemberListHash uint64 `json:"groupMemberListHash"`
 }
+
+type GroupInviteLink struct {
+	Token         string `json:"token"`
+	GroupID       string `json:"groupID"`
+	CreatorUserID string `json:"creatorUserID"`
+	ExpireTime    int64  `json:"expireTime"`
+	MaxUses       int32  `json:"maxUses"`
+	UseCount      int32  `json:"useCount"`
+	AutoApprove   bool   `json:"autoApprove"`
+	Status        int32  `json:"status"`
+	CreateTime    int64  `json:"createTime"`
+}
+
+type CreateGroupInviteLinkReq struct {
+	OperationID   string `json:"operationID" binding:"required"`
+	GroupID       string `json:"groupID" binding:"required"`
+	ExpireSeconds int64  `json:"expireSeconds" binding:"gte=0"`
+	MaxUses       int32  `json:"maxUses" binding:"gte=0"`
+	AutoApprove   bool   `json:"autoApprove"`
+}
+
+type CreateGroupInviteLinkResp struct {
+	CommResp
+	Link *GroupInviteLink `json:"data"`
+}
+
+type GetGroupInviteLinksReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+}
+
+type GetGroupInviteLinksResp struct {
+	CommResp
+	Links []*GroupInviteLink `json:"data"`
+}
+
+type RevokeGroupInviteLinkReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+	Token       string `json:"token" binding:"required"`
+}
+
+type RevokeGroupInviteLinkResp struct {
+	CommResp
+}
+
+type GroupRole struct {
+	GroupID      string `json:"groupID"`
+	RoleID       string `json:"roleID"`
+	Name         string `json:"name"`
+	Capabilities int64  `json:"capabilities"`
+	Builtin      bool   `json:"builtin"`
+	UpdateTime   int64  `json:"updateTime"`
+}
+
+type SetGroupRoleReq struct {
+	OperationID  string `json:"operationID" binding:"required"`
+	GroupID      string `json:"groupID" binding:"required"`
+	RoleID       string `json:"roleID" binding:"required,max=64"`
+	Name         string `json:"name"`
+	Capabilities int64  `json:"capabilities" binding:"gte=0"`
+}
+
+type SetGroupRoleResp struct {
+	CommResp
+}
+
+type DeleteGroupRoleReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+	RoleID      string `json:"roleID" binding:"required"`
+}
+
+type DeleteGroupRoleResp struct {
+	CommResp
+}
+
+type GetGroupRolesReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+}
+
+type GetGroupRolesResp struct {
+	CommResp
+	Roles []*GroupRole `json:"data"`
+}
+
+type SetGroupMemberRoleReq struct {
+	OperationID string   `json:"operationID" binding:"required"`
+	GroupID     string   `json:"groupID" binding:"required"`
+	UserIDList  []string `json:"userIDList" binding:"required"`
+	RoleID      string   `json:"roleID"`
+}
+
+type SetGroupMemberRoleResp struct {
+	CommResp
+	UserIDResultList []*UserIDResult `json:"data"`
+}
+
+type GroupJoinQuestion struct {
+	QuestionID string `json:"questionID" binding:"max=64"`
+	Question   string `json:"question" binding:"required,max=255"`
+	Answer     string `json:"answer" binding:"max=255"`
+}
+
+type GroupJoinRule struct {
+	RuleID   string `json:"ruleID" binding:"max=64"`
+	RuleType int32  `json:"ruleType" binding:"required"`
+	Action   int32  `json:"action" binding:"required"`
+	Value    string `json:"value" binding:"max=64"`
+}
+
+type SetGroupJoinQuestionnaireReq struct {
+	OperationID string               `json:"operationID" binding:"required"`
+	GroupID     string               `json:"groupID" binding:"required"`
+	Questions   []*GroupJoinQuestion `json:"questions" binding:"dive"`
+	Rules       []*GroupJoinRule     `json:"rules" binding:"dive"`
+}
+
+type SetGroupJoinQuestionnaireResp struct {
+	CommResp
+}
+
+type GetGroupJoinQuestionnaireReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+}
+
+type GroupJoinQuestionnaire struct {
+	Questions []*GroupJoinQuestion `json:"questions"`
+	Rules     []*GroupJoinRule     `json:"rules"`
+}
+
+type GetGroupJoinQuestionnaireResp struct {
+	CommResp
+	Questionnaire GroupJoinQuestionnaire `json:"data"`
+}
+
+type QueryGroupMembersReq struct {
+	OperationID   string   `json:"operationID" binding:"required"`
+	GroupID       string   `json:"groupID" binding:"required"`
+	Keyword       string   `json:"keyword"`
+	Roles         []string `json:"roles"`
+	MuteStatus    int32    `json:"muteStatus" binding:"omitempty,oneof=0 1 2"`
+	JoinTimeBegin int64    `json:"joinTimeBegin"`
+	JoinTimeEnd   int64    `json:"joinTimeEnd"`
+	Cursor        string   `json:"cursor"`
+	Count         int32    `json:"count" binding:"omitempty,min=1,max=1000"`
+}
+
+type GroupRoleMemberCount struct {
+	Role  string `json:"role"`
+	Count int64  `json:"count"`
+}
+
+type QueryGroupMembersResp struct {
+	CommResp
+	MemberList []*open_im_sdk.GroupMemberFullInfo `json:"-"`
+	Data       struct {
+		Members    []map[string]interface{} `json:"members" swaggerignore:"true"`
+		NextCursor string                   `json:"nextCursor"`
+		RoleCounts []*GroupRoleMemberCount  `json:"roleCounts"`
+	} `json:"data"`
+}
+
+type ImportGroupMember struct {
+	UserID    string `json:"userID" binding:"required"`
+	RoleLevel int32  `json:"roleLevel"`
+	RoleID    string `json:"roleID"`
+}
+
+type ImportGroupRole struct {
+	RoleID       string `json:"roleID" binding:"required,max=64"`
+	Name         string `json:"name"`
+	Capabilities int64  `json:"capabilities" binding:"gte=0"`
+}
+
+type ImportGroup struct {
+	GroupID           string               `json:"groupID"`
+	GroupName         string               `json:"groupName"`
+	Notification      string               `json:"notification"`
+	Introduction      string               `json:"introduction"`
+	FaceURL           string               `json:"faceURL"`
+	Ex                string               `json:"ex"`
+	GroupType         int32                `json:"groupType"`
+	NeedVerification  int32                `json:"needVerification"`
+	LookMemberInfo    int32                `json:"lookMemberInfo"`
+	ApplyMemberFriend int32                `json:"applyMemberFriend"`
+	OwnerUserID       string               `json:"ownerUserID" binding:"required"`
+	MemberList        []*ImportGroupMember `json:"memberList"`
+	Roles             []*ImportGroupRole   `json:"roles"`
+}
+
+type ImportGroupsReq struct {
+	OperationID    string         `json:"operationID" binding:"required"`
+	GroupList      []*ImportGroup `json:"groupList" binding:"required"`
+	Silent         bool           `json:"silent"`
+	IdempotencyKey string         `json:"idempotencyKey" binding:"max=128"`
+}
+
+type ImportGroupResult struct {
+	GroupID string `json:"groupID"`
+	ErrCode int32  `json:"errCode"`
+	ErrMsg  string `json:"errMsg"`
+}
+
+type GroupImportJob struct {
+	JobID          string               `json:"jobID"`
+	IdempotencyKey string               `json:"idempotencyKey"`
+	Status         int32                `json:"status"`
+	Silent         bool                 `json:"silent"`
+	Total          int32                `json:"total"`
+	Succeeded      int32                `json:"succeeded"`
+	Failed         int32                `json:"failed"`
+	Results        []*ImportGroupResult `json:"results"`
+	OpUserID       string               `json:"opUserID"`
+	CreateTime     int64                `json:"createTime"`
+	UpdateTime     int64                `json:"updateTime"`
+}
+
+type ImportGroupsResp struct {
+	CommResp
+	Job *GroupImportJob `json:"data"`
+}
+
+type GetGroupImportJobReq struct {
+	OperationID    string `json:"operationID" binding:"required"`
+	JobID          string `json:"jobID"`
+	IdempotencyKey string `json:"idempotencyKey"`
+}
+
+type GetGroupImportJobResp struct {
+	CommResp
+	Job *GroupImportJob `json:"data"`
+}
@@ -121,3 +121,78 @@ type SetAppBadgeReq struct {
 type SetAppBadgeResp struct {
 	CommResp
 }
+
+type MinioInitiateMultipartUploadReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	FileName    string `json:"fileName" binding:"required"`
+	FileType    int    `json:"fileType" binding:"required"`
+	Size        int64  `json:"size" binding:"required,min=1"`
+	Hash        string `json:"hash" binding:"required"`
+	ClientMsgID string `json:"clientMsgID"`
+}
+
+type MinioInitiateMultipartUpload struct {
+	Exist    bool   `json:"exist"`
+	UploadID string `json:"uploadID,omitempty"`
+	PartSize int64  `json:"partSize,omitempty"`
+	PartNum  int    `json:"partNum,omitempty"`
+	URL      string `json:"URL,omitempty"`
+	NewName  string `json:"newName,omitempty"`
+}
+
+type MinioInitiateMultipartUploadResp struct {
+	CommResp
+	Data MinioInitiateMultipartUpload `json:"data"`
+}
+
+type MinioUploadPartReq struct {
+	OperationID string `form:"operationID" binding:"required"`
+	UploadID    string `form:"uploadID" binding:"required"`
+	PartNumber  int    `form:"partNumber" binding:"required,min=1"`
+}
+
+type MinioUploadPart struct {
+	PartNumber int    `json:"partNumber"`
+	ETag       string `json:"eTag"`
+	Size       int64  `json:"size"`
+}
+
+type MinioUploadPartResp struct {
+	CommResp
+	Data MinioUploadPart `json:"data"`
+}
+
+type MinioListPartsReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	UploadID    string `json:"uploadID" binding:"required"`
+}
+
+type MinioListParts struct {
+	PartSize int64              `json:"partSize"`
+	PartNum  int                `json:"partNum"`
+	Parts    []*MinioUploadPart `json:"parts"`
+}
+
+type MinioListPartsResp struct {
+	CommResp
+	Data MinioListParts `json:"data"`
+}
+
+type MinioCompleteMultipartUploadReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	UploadID    string `json:"uploadID" binding:"required"`
+}
+
+type MinioCompleteMultipartUploadResp struct {
+	CommResp
+	Data MinioUploadFile `json:"data"`
+}
+
+type MinioAbortMultipartUploadReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+	UploadID    string `json:"uploadID" binding:"required"`
+}
+
+type MinioAbortMultipartUploadResp struct {
+	CommResp
+}
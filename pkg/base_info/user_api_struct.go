@@ -29,6 +29,27 @@ type UpdateUserInfoResp struct {
 	CommResp
 }
 
+type DoNotDisturb struct {
+	Enable    bool   `json:"enable"`
+	StartTime string `json:"startTime" binding:"required_if=Enable true"`
+	EndTime   string `json:"endTime" binding:"required_if=Enable true"`
+	TimeZone  string `json:"timeZone" binding:"required_if=Enable true"`
+}
+type SetDoNotDisturbReq struct {
+	DoNotDisturb
+	OperationID string `json:"operationID" binding:"required"`
+}
+type SetDoNotDisturbResp struct {
+	CommResp
+}
+type GetDoNotDisturbReq struct {
+	OperationID string `json:"operationID" binding:"required"`
+}
+type GetDoNotDisturbResp struct {
+	CommResp
+	DoNotDisturb DoNotDisturb `json:"data"`
+}
+
 type GetSelfUserInfoReq struct {
 	OperationID string `json:"operationID" binding:"required"`
 	UserID      string `json:"userID" binding:"required"`
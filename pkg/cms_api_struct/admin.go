@@ -12,9 +12,11 @@ type AdminLoginRequest struct {
 }
 
 type AdminLoginResponse struct {
-	Token    string `json:"token"`
-	UserName string `json:"userName"`
-	FaceURL  string `json:"faceURL"`
+	Token       string   `json:"token"`
+	UserName    string   `json:"userName"`
+	FaceURL     string   `json:"faceURL"`
+	Role        int32    `json:"role"`
+	Permissions []string `json:"permissions"`
 }
 
 type GetUserTokenRequest struct {
@@ -54,3 +56,66 @@ type GetUserRegisterAddFriendIDListResponse struct {
 	Users []*server_api_params.UserInfo `json:"users"`
 	base_info.ResponsePagination
 }
+
+type AdminAccountInfo struct {
+	AdminID       string `json:"adminID"`
+	Nickname      string `json:"nickname"`
+	FaceURL       string `json:"faceURL"`
+	Role          int32  `json:"role"`
+	Status        int32  `json:"status"`
+	CreatorUserID string `json:"creatorUserID"`
+	CreateTime    int64  `json:"createTime"`
+}
+
+type GetAdminsRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	base_info.RequestPagination
+}
+
+type GetAdminsResponse struct {
+	Admins    []*AdminAccountInfo `json:"admins"`
+	AdminNums int32               `json:"adminNums"`
+	base_info.ResponsePagination
+}
+
+type AddAdminRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	AdminID     string `json:"adminID" binding:"required,max=64"`
+	Password    string `json:"password" binding:"required,min=6"`
+	Nickname    string `json:"nickname"`
+	FaceURL     string `json:"faceURL"`
+	Role        int32  `json:"role" binding:"required"`
+}
+
+type AddAdminResponse struct {
+}
+
+type UpdateAdminRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	AdminID     string `json:"adminID" binding:"required"`
+	Password    string `json:"password"`
+	Nickname    string `json:"nickname"`
+	FaceURL     string `json:"faceURL"`
+	Role        int32  `json:"role"`
+	Status      *int32 `json:"status"`
+}
+
+type UpdateAdminResponse struct {
+}
+
+type DeleteAdminRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	AdminID     string `json:"adminID" binding:"required"`
+}
+
+type DeleteAdminResponse struct {
+}
+
+type ChangeAdminPasswordRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	OldPassword string `json:"oldPassword" binding:"required"`
+	NewPassword string `json:"newPassword" binding:"required,min=6"`
+}
+
+type ChangeAdminPasswordResponse struct {
+}
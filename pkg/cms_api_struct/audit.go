@@ -0,0 +1,51 @@
+package cms_api_struct
+
+type AuditLogInfo struct {
+	ID          int64  `json:"id"`
+	Actor       string `json:"actor"`
+	Action      string `json:"action"`
+	Target      string `json:"target"`
+	Params      string `json:"params"`
+	SourceIP    string `json:"sourceIP"`
+	OperationID string `json:"operationID"`
+	ErrCode     int32  `json:"errCode"`
+	ErrMsg      string `json:"errMsg"`
+	CreateTime  int64  `json:"createTime"`
+	PrevHash    string `json:"prevHash"`
+	Hash        string `json:"hash"`
+}
+
+type AuditLogFilter struct {
+	Actor     string `json:"actor"`
+	Action    string `json:"action"`
+	Target    string `json:"target"`
+	StartTime int64  `json:"startTime"`
+	EndTime   int64  `json:"endTime"`
+}
+
+type GetAuditLogsRequest struct {
+	OperationID string `json:"operationID"`
+	AuditLogFilter
+	RequestPagination
+}
+
+type GetAuditLogsResponse struct {
+	AuditLogs    []*AuditLogInfo `json:"auditLogs"`
+	AuditLogNums int32           `json:"auditLogNums"`
+	ResponsePagination
+}
+
+type ExportAuditLogsRequest struct {
+	OperationID string `json:"operationID"`
+	AuditLogFilter
+}
+
+type VerifyAuditLogsRequest struct {
+	OperationID string `json:"operationID"`
+}
+
+type VerifyAuditLogsResponse struct {
+	Intact   bool  `json:"intact"`
+	Checked  int64 `json:"checked"`
+	BrokenID int64 `json:"brokenID"`
+}
@@ -0,0 +1,38 @@
+package cms_api_struct
+
+type CallbackDeliveryInfo struct {
+	DeliveryID      string `json:"deliveryID"`
+	Command         string `json:"command"`
+	Url             string `json:"url"`
+	Body            string `json:"body"`
+	Async           bool   `json:"async"`
+	Status          int32  `json:"status"`
+	Attempts        int32  `json:"attempts"`
+	NextAttemptTime int64  `json:"nextAttemptTime"`
+	ResponseStatus  int32  `json:"responseStatus"`
+	LastError       string `json:"lastError"`
+	CostMs          int64  `json:"costMs"`
+	CreateTime      int64  `json:"createTime"`
+	UpdateTime      int64  `json:"updateTime"`
+}
+
+type GetCallbackDeliveriesRequest struct {
+	OperationID string `json:"operationID"`
+	Command     string `json:"command"`
+	// -1 all, 0 pending, 1 succeeded, 2 failed
+	Status    int32 `json:"status"`
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
+	RequestPagination
+}
+
+type GetCallbackDeliveriesResponse struct {
+	Deliveries   []*CallbackDeliveryInfo `json:"deliveries"`
+	DeliveryNums int32                   `json:"deliveryNums"`
+	ResponsePagination
+}
+
+type RedeliverCallbackRequest struct {
+	OperationID string `json:"operationID"`
+	DeliveryID  string `json:"deliveryID" binding:"required"`
+}
@@ -62,3 +62,31 @@ type GetGroupMembersResponse struct {
 	ResponsePagination
 	MemberNums int `json:"memberNums"`
 }
+
+type GroupConversionInfo struct {
+	GroupID       string `json:"groupID"`
+	FromGroupType int32  `json:"fromGroupType"`
+	ToGroupType   int32  `json:"toGroupType"`
+	// 1 running, 2 done, 3 failed
+	Status       int32  `json:"status"`
+	Step         int32  `json:"step"`
+	HistoryCount int32  `json:"historyCount"`
+	CopiedCount  int32  `json:"copiedCount"`
+	OpUserID     string `json:"opUserID"`
+	ErrMsg       string `json:"errMsg"`
+	CreateTime   int64  `json:"createTime"`
+	UpdateTime   int64  `json:"updateTime"`
+}
+
+type ConvertGroupTypeRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+	// 0 normal group, 1 super group, only normal groups can be converted to super groups
+	GroupType    int32 `json:"groupType" binding:"oneof=0 1"`
+	HistoryCount int32 `json:"historyCount" binding:"min=0,max=5000"`
+}
+
+type GetGroupConversionRequest struct {
+	OperationID string `json:"operationID" binding:"required"`
+	GroupID     string `json:"groupID" binding:"required"`
+}
@@ -46,3 +46,36 @@ type GetChatLogsResp struct {
 	ChatLogsNum int        `json:"logNums"`
 	ResponsePagination
 }
+
+type GetDeadLettersReq struct {
+	Partition   int32  `json:"partition"`
+	Offset      int64  `json:"offset"`
+	ShowNumber  int    `json:"showNumber" binding:"required"`
+	OperationID string `json:"operationID"`
+}
+
+type DeadLetter struct {
+	Partition    int32  `json:"partition"`
+	Offset       int64  `json:"offset"`
+	Topic        string `json:"topic"`
+	SrcPartition int32  `json:"srcPartition"`
+	SrcOffset    int64  `json:"srcOffset"`
+	Key          string `json:"key"`
+	Value        []byte `json:"value"`
+	Handler      string `json:"handler"`
+	ErrMsg       string `json:"errMsg"`
+	Attempt      int32  `json:"attempt"`
+	CreateTime   int64  `json:"createTime"`
+}
+
+type GetDeadLettersResp struct {
+	DeadLetters []*DeadLetter `json:"deadLetters"`
+	NextOffset  int64         `json:"nextOffset"`
+}
+
+type RedriveDeadLetterReq struct {
+	Partition   int32  `json:"partition"`
+	Offset      int64  `json:"offset" binding:"min=0"`
+	Force       bool   `json:"force"`
+	OperationID string `json:"operationID"`
+}
@@ -0,0 +1,220 @@
+package audit
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/utils"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+const verifyBatchSize = 1000
+
+var sensitiveKeys = []string{"password", "secret", "token"}
+
+// ComputeHash covers every recorded field and the hash of the previous entry
+func ComputeHash(auditLog *db.AuditLog) string {
+	h := sha256.New()
+	for _, field := range []string{auditLog.PrevHash, auditLog.Actor, auditLog.Action, auditLog.Target, auditLog.Params, auditLog.SourceIP,
+		auditLog.OperationID, strconv.Itoa(int(auditLog.ErrCode)), auditLog.ErrMsg, strconv.FormatInt(auditLog.CreateTime.UnixNano()/1e6, 10)} {
+		h.Write([]byte(strconv.Itoa(len(field))))
+		h.Write([]byte{':'})
+		h.Write([]byte(field))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func Record(auditLog *db.AuditLog) error {
+	// mysql keeps milliseconds at most, the hash must survive the round trip
+	auditLog.CreateTime = time.UnixMilli(time.Now().UnixMilli())
+	if maxLength := config.Config.Audit.ParamsMaxLength; maxLength > 0 && len(auditLog.Params) > maxLength {
+		auditLog.Params = auditLog.Params[:maxLength]
+	}
+	if len(auditLog.ErrMsg) > 255 {
+		auditLog.ErrMsg = auditLog.ErrMsg[:255]
+	}
+	return imdb.InsertAuditLog(auditLog, chainShard(), ComputeHash)
+}
+
+// chainShard spreads writers over the configured chains, entries of one chain are serialised
+func chainShard() int32 {
+	if shards := config.Config.Audit.ChainShards; shards > 1 {
+		return rand.Int31n(int32(shards))
+	}
+	return 0
+}
+
+// VerifyChain walks the chain of every shard and returns the id of the first entry that was modified,
+// or whose predecessor was deleted, 0 if every chain is intact
+func VerifyChain() (checked int64, brokenID int64, err error) {
+	shards, err := imdb.GetAuditLogShards()
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, shard := range shards {
+		n, brokenID, err := verifyShard(shard)
+		checked += n
+		if err != nil || brokenID != 0 {
+			return checked, brokenID, err
+		}
+	}
+	return checked, 0, nil
+}
+
+// verifyShard also compares the end of the chain with its head, so deleting the newest entries is noticed.
+// The head is read first, entries written during the walk are after it and left out.
+func verifyShard(shard int32) (checked int64, brokenID int64, err error) {
+	chain, err := imdb.GetAuditLogChain(shard)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, 0, err
+	}
+	// a shard not written since the chain was sharded has no head
+	hasHead := err == nil
+	var lastID int64
+	var prevHash string
+	passedHead := false
+	for !passedHead {
+		auditLogs, err := imdb.GetAuditLogsAfterID(shard, lastID, verifyBatchSize)
+		if err != nil {
+			return checked, 0, err
+		}
+		for i := range auditLogs {
+			auditLog := &auditLogs[i]
+			if hasHead && auditLog.ID > chain.LastID {
+				passedHead = true
+				break
+			}
+			if auditLog.PrevHash != prevHash || ComputeHash(auditLog) != auditLog.Hash {
+				return checked, auditLog.ID, nil
+			}
+			prevHash = auditLog.Hash
+			lastID = auditLog.ID
+			checked++
+		}
+		if len(auditLogs) < verifyBatchSize {
+			break
+		}
+	}
+	if hasHead && (chain.LastID != lastID || chain.LastHash != prevHash) {
+		return checked, chain.LastID, nil
+	}
+	return checked, 0, nil
+}
+
+// MaskParams hides credentials in a json request body
+func MaskParams(body []byte) string {
+	var params map[string]interface{}
+	if err := json.Unmarshal(body, &params); err != nil {
+		return string(body)
+	}
+	maskMap(params)
+	return utils.StructToJsonString(params)
+}
+
+func maskMap(params map[string]interface{}) {
+	for k, v := range params {
+		lowerKey := strings.ToLower(k)
+		for _, sensitiveKey := range sensitiveKeys {
+			if strings.Contains(lowerKey, sensitiveKey) {
+				params[k] = "***"
+			}
+		}
+		if m, ok := v.(map[string]interface{}); ok {
+			maskMap(m)
+		}
+	}
+}
+
+func getTarget(params map[string]interface{}, targetFields []string) string {
+	var targets []string
+	for _, field := range targetFields {
+		switch v := params[field].(type) {
+		case nil:
+		case string:
+			targets = append(targets, v)
+		case []interface{}:
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					targets = append(targets, s)
+				}
+			}
+		default:
+			targets = append(targets, utils.StructToJsonString(v))
+		}
+	}
+	return strings.Join(targets, ",")
+}
+
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// Middleware records the request of a privileged route, the action is the route path and targetFields name
+// the json fields that identify what it was applied to. The actor is the userID set by an earlier auth
+// middleware or taken from the token.
+func Middleware(targetFields ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.Config.Audit.Enable {
+			c.Next()
+			return
+		}
+		action := c.FullPath()
+		body, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			log.NewError("", utils.GetSelfFuncName(), "read body failed", action, err.Error())
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+
+		c.Next()
+
+		var params map[string]interface{}
+		_ = json.Unmarshal(body, &params)
+		auditLog := &db.AuditLog{
+			Actor:    c.GetString("userID"),
+			Action:   action,
+			Target:   getTarget(params, targetFields),
+			Params:   MaskParams(body),
+			SourceIP: c.ClientIP(),
+		}
+		if operationID, ok := params["operationID"].(string); ok {
+			auditLog.OperationID = operationID
+		}
+		if auditLog.Actor == "" {
+			_, auditLog.Actor, _ = token_verify.GetUserIDFromToken(c.Request.Header.Get("token"), auditLog.OperationID)
+		}
+		var resp struct {
+			ErrCode int32  `json:"errCode"`
+			ErrMsg  string `json:"errMsg"`
+		}
+		if err := json.Unmarshal(recorder.body.Bytes(), &resp); err == nil {
+			auditLog.ErrCode, auditLog.ErrMsg = resp.ErrCode, resp.ErrMsg
+		} else if c.Writer.Status() >= 400 {
+			auditLog.ErrCode = int32(c.Writer.Status())
+		}
+		if err := Record(auditLog); err != nil {
+			log.NewError(auditLog.OperationID, utils.GetSelfFuncName(), "record audit log failed", action, auditLog.Actor, err.Error())
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package callback
+
+import (
+	cbApi "Open_IM/pkg/call_back_struct"
+	"Open_IM/pkg/common/constant"
+	pbCallback "Open_IM/pkg/proto/callback"
+	"context"
+	"encoding/json"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func Test_CallbackSignature(t *testing.T) {
+	now := time.Now()
+	body := []byte(`{"callbackCommand":"callbackUserOnlineCommand","userID":"u1"}`)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	signature := Sign("secret", now.Unix(), body)
+
+	assert.Nil(t, Verify("secret", ts, signature, body, time.Minute, now))
+	assert.Equal(t, ErrSignatureInvalid, Verify("other", ts, signature, body, time.Minute, now))
+	assert.Equal(t, ErrSignatureInvalid, Verify("secret", ts, signature, []byte(`{"userID":"u2"}`), time.Minute, now))
+	assert.Equal(t, ErrSignatureInvalid, Verify("secret", strconv.FormatInt(now.Unix()+1, 10), signature, body, time.Minute, now))
+	// a captured request replayed later is refused even with a valid signature
+	assert.Equal(t, ErrTimestampExpired, Verify("secret", ts, signature, body, time.Minute, now.Add(2*time.Minute)))
+	assert.Equal(t, ErrSignatureInvalid, Verify("secret", "abc", signature, body, time.Minute, now))
+}
+
+func Test_CallbackBackoff(t *testing.T) {
+	base, max := 5*time.Second, time.Minute
+	assert.Equal(t, 5*time.Second, Backoff(1, base, max))
+	assert.Equal(t, 10*time.Second, Backoff(2, base, max))
+	assert.Equal(t, 40*time.Second, Backoff(4, base, max))
+	assert.Equal(t, time.Minute, Backoff(5, base, max))
+	assert.Equal(t, time.Minute, Backoff(100, base, max))
+}
+
+func Test_CallbackCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := NewCircuitBreaker(2, 30*time.Second)
+	url := "http://127.0.0.1:8080/callback"
+
+	ok, _ := b.Allow(url, now)
+	assert.True(t, ok)
+	b.Report(url, false, now)
+	ok, _ = b.Allow(url, now)
+	assert.True(t, ok)
+	b.Report(url, false, now)
+
+	ok, retryAt := b.Allow(url, now.Add(time.Second))
+	assert.False(t, ok)
+	assert.Equal(t, now.Add(30*time.Second), retryAt)
+	ok, _ = b.Allow("http://other/callback", now)
+	assert.True(t, ok)
+
+	// once open elapses a single probe goes through
+	later := now.Add(31 * time.Second)
+	ok, _ = b.Allow(url, later)
+	assert.True(t, ok)
+	ok, _ = b.Allow(url, later)
+	assert.False(t, ok)
+	b.Report(url, false, later)
+	ok, _ = b.Allow(url, later.Add(time.Second))
+	assert.False(t, ok)
+
+	b.Report(url, true, later.Add(time.Minute))
+	ok, _ = b.Allow(url, later.Add(time.Minute))
+	assert.True(t, ok)
+
+	disabled := NewCircuitBreaker(0, time.Second)
+	disabled.Report(url, false, now)
+	ok, _ = disabled.Allow(url, now)
+	assert.True(t, ok)
+}
+
+// moderationServer only implements the methods the test calls
+type moderationServer struct {
+	pbCallback.CallbackServer
+}
+
+func (*moderationServer) CallbackBeforeSendSingleMsg(_ context.Context, req *pbCallback.CallbackSendMsgReq) (*pbCallback.CommonCallbackResp, error) {
+	if req.Content == "spam" {
+		return &pbCallback.CommonCallbackResp{ActionCode: 1, ErrCode: 5001, ErrMsg: "blocked", OperationID: req.OperationID}, nil
+	}
+	return &pbCallback.CommonCallbackResp{OperationID: req.OperationID}, nil
+}
+
+func (*moderationServer) CallbackMsgModify(_ context.Context, req *pbCallback.CallbackSendMsgReq) (*pbCallback.CallbackMsgModifyResp, error) {
+	return &pbCallback.CallbackMsgModifyResp{OperationID: req.OperationID, Content: wrapperspb.String("***"), AtUserIDList: req.AtUserList}, nil
+}
+
+func (*moderationServer) CallbackUserOnline(context.Context, *pbCallback.CallbackUserStatusReq) (*pbCallback.CommonCallbackResp, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+// the grpc messages are filled from and read into call_back_struct through their shared json names
+func Test_CallbackGrpcJsonCompatible(t *testing.T) {
+	req := cbApi.CallbackBeforeSendSingleMsgReq{CommonCallbackReq: cbApi.CommonCallbackReq{SendID: "u1", OperationID: "op", CreateTime: 1700000000000,
+		Seq: 7, AtUserIDList: []string{"u3"}, SenderFaceURL: "http://face", Content: "hello"}, RecvID: "u2"}
+	body, err := json.Marshal(req)
+	assert.Nil(t, err)
+	var pbReq pbCallback.CallbackSendMsgReq
+	assert.Nil(t, jsonToProto(body, &pbReq))
+	assert.Equal(t, "u1", pbReq.SendID)
+	assert.Equal(t, "u2", pbReq.RecvID)
+	assert.Equal(t, int64(1700000000000), pbReq.CreateTime)
+	assert.Equal(t, uint32(7), pbReq.Seq)
+	assert.Equal(t, []string{"u3"}, pbReq.AtUserList)
+	assert.Equal(t, "http://face", pbReq.FaceURL)
+
+	body, err = protoToJson(&pbCallback.CallbackMsgModifyResp{ActionCode: 0, ErrCode: 0, OperationID: "op", Content: wrapperspb.String("***")})
+	assert.Nil(t, err)
+	resp := cbApi.CallbackMsgModifyCommandResp{CommonCallbackResp: &cbApi.CommonCallbackResp{}}
+	assert.Nil(t, json.Unmarshal(body, &resp))
+	assert.Equal(t, "***", *resp.Content)
+	assert.Equal(t, "op", resp.OperationID)
+	// fields the receiver leaves out stay nil and keep the message untouched
+	assert.Nil(t, resp.RecvID)
+	assert.Nil(t, resp.Options)
+}
+
+// verifySignature is the check a receiver runs, the signature covers the request as encoded on the wire
+func verifySignature(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		payload, err := marshalDeterministic(req.(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+		if err := Verify(secret, md.Get(metadataTimestamp)[0], md.Get(metadataSignature)[0], payload, time.Minute, time.Now()); err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+func Test_CallbackGrpcService(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(verifySignature("secret")))
+	pbCallback.RegisterCallbackServer(server, &moderationServer{})
+	go server.Serve(listener)
+	defer server.Stop()
+
+	url := grpcScheme + listener.Addr().String()
+
+	_, body, err := sendGrpc(url, constant.CallbackBeforeSendSingleMsgCommand, "secret", "d1", 1, []byte(`{"operationID":"op","content":"spam"}`), time.Second)
+	assert.Nil(t, err)
+	var resp pbCallback.CommonCallbackResp
+	assert.Nil(t, jsonToProto(body, &resp))
+	assert.Equal(t, int32(1), resp.ActionCode)
+	assert.Equal(t, int32(5001), resp.ErrCode)
+
+	_, body, err = sendGrpc(url, constant.CallbackMsgModifyCommand, "secret", "d2", 1, []byte(`{"operationID":"op","atUserList":["u3"]}`), time.Second)
+	assert.Nil(t, err)
+	var modify pbCallback.CallbackMsgModifyResp
+	assert.Nil(t, jsonToProto(body, &modify))
+	assert.Equal(t, "***", modify.GetContent().GetValue())
+	assert.Equal(t, []string{"u3"}, modify.AtUserIDList)
+
+	// a receiver with another secret refuses the request
+	code, _, err := sendGrpc(url, constant.CallbackBeforeSendSingleMsgCommand, "other", "d3", 1, []byte(`{"operationID":"op"}`), time.Second)
+	assert.NotNil(t, err)
+	assert.Equal(t, int(codes.Unauthenticated), code)
+
+	code, _, err = sendGrpc(url, constant.CallbackUserOnlineCommand, "secret", "d4", 1, []byte(`{"userID":"u1"}`), time.Second)
+	assert.NotNil(t, err)
+	assert.Equal(t, int(codes.Unimplemented), code)
+}
@@ -0,0 +1,246 @@
+package callback
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	imdb "Open_IM/pkg/common/db/mysql_model/im_mysql_model"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	workerBatch       = 100
+	workerConcurrency = 16
+	workerIdle        = time.Second
+	// a claimed delivery is handed to another worker if this one dies
+	workerLockFor  = 5 * time.Minute
+	defaultTimeout = 5 * time.Second
+	purgeInterval  = time.Hour
+	purgeBatch     = 1000
+	// sync deliveries are recorded in the background, beyond this many waiting records new ones are dropped
+	recordQueueSize = 1024
+)
+
+var (
+	breakerOnce  sync.Once
+	breaker      *CircuitBreaker
+	workerOnce   sync.Once
+	workerWake   = make(chan struct{}, 1)
+	recorderOnce sync.Once
+	recordQueue  = make(chan *db.CallbackDelivery, recordQueueSize)
+)
+
+func circuitBreaker() *CircuitBreaker {
+	breakerOnce.Do(func() {
+		cfg := config.Current().Callback.CircuitBreaker
+		breaker = NewCircuitBreaker(cfg.FailureThreshold, time.Duration(cfg.OpenSeconds)*time.Second)
+	})
+	return breaker
+}
+
+func eventURL(command string) (string, bool, time.Duration) {
+	url, timeout := config.Current().Callback.CallbackUrl, defaultTimeout
+	event, ok := config.CallbackEvent(command)
+	if !ok {
+		return url, false, timeout
+	}
+	if event.Url != "" {
+		url = event.Url
+	}
+	if event.Transport == "grpc" {
+		target := config.Current().Callback.CallbackGrpcAddr
+		if event.Url != "" {
+			target = event.Url
+		}
+		url = grpcScheme + strings.TrimPrefix(target, grpcScheme)
+	}
+	if event.CallbackTimeOut > 0 {
+		timeout = time.Duration(event.CallbackTimeOut) * time.Second
+	}
+	return url, event.Async, timeout
+}
+
+func newDelivery(command, url string, body []byte, async bool) *db.CallbackDelivery {
+	now := time.Now()
+	return &db.CallbackDelivery{DeliveryID: utils.OperationIDGenerator(), Command: command, Url: url, Body: string(body), Async: async,
+		Status: constant.CallbackDeliveryPending, NextAttemptTime: now, LockedUntil: now, CreateTime: now, UpdateTime: now}
+}
+
+// StartDeliveryWorker runs the queue worker and the purge of old deliveries. Only the cms api runs it, the queue
+// is polled while an event is async, otherwise the worker waits for WakeDeliveryWorker.
+func StartDeliveryWorker() {
+	workerOnce.Do(func() {
+		go deliveryWorker()
+		go purgeWorker()
+	})
+}
+
+// WakeDeliveryWorker makes the worker check the queue now, e.g. after a redelivery
+func WakeDeliveryWorker() {
+	select {
+	case workerWake <- struct{}{}:
+	default:
+	}
+}
+
+// recordDelivery queues the record of a sync delivery for the recorder, the caller doesn't wait for mysql
+func recordDelivery(delivery *db.CallbackDelivery) {
+	recorderOnce.Do(func() { go deliveryRecorder() })
+	select {
+	case recordQueue <- delivery:
+	default:
+		log.NewError(delivery.DeliveryID, "callback delivery record queue full, drop ", delivery.Command, delivery.Status)
+	}
+}
+
+func deliveryRecorder() {
+	for delivery := range recordQueue {
+		if err := imdb.InsertCallbackDelivery(delivery); err != nil {
+			log.NewError(delivery.DeliveryID, "InsertCallbackDelivery failed ", delivery.Command, err.Error())
+		}
+	}
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// Post sends the callback of command and decodes the reply into output.
+// Events configured async are queued instead and output is left untouched.
+func Post(command string, input, output interface{}, timeOut int) error {
+	url, async, timeout := eventURL(command)
+	body, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+	if async {
+		return imdb.InsertCallbackDelivery(newDelivery(command, url, body, true))
+	}
+	if timeOut > 0 {
+		timeout = time.Duration(timeOut) * time.Second
+	}
+	if ok, _ := circuitBreaker().Allow(url, time.Now()); !ok {
+		return ErrCircuitOpen
+	}
+	delivery := newDelivery(command, url, body, false)
+	start := time.Now()
+	status, result, err := send(url, command, config.Current().Callback.Secret, delivery.DeliveryID, 1, body, timeout)
+	circuitBreaker().Report(url, err == nil, time.Now())
+	if err != nil || config.Current().Callback.LogSyncDeliveries {
+		delivery.Attempts, delivery.ResponseStatus, delivery.CostMs = 1, int32(status), time.Since(start).Milliseconds()
+		delivery.Status = constant.CallbackDeliverySucceeded
+		if err != nil {
+			delivery.Status, delivery.LastError = constant.CallbackDeliveryFailed, truncate(err.Error(), 1024)
+		}
+		recordDelivery(delivery)
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(result, output)
+}
+
+// deliveryWorker sends queued deliveries, the claim keeps several cms instances apart
+func deliveryWorker() {
+	for {
+		deliveries, err := imdb.ClaimDueCallbackDeliveries(time.Now(), workerLockFor, workerBatch)
+		if err != nil {
+			log.NewError("", "ClaimDueCallbackDeliveries failed ", err.Error())
+		}
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, workerConcurrency)
+		for _, delivery := range deliveries {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(delivery *db.CallbackDelivery) {
+				defer func() { <-sem; wg.Done() }()
+				deliverQueued(delivery)
+			}(delivery)
+		}
+		wg.Wait()
+		if len(deliveries) < workerBatch {
+			waitDeliveries()
+		}
+	}
+}
+
+func waitDeliveries() {
+	if !config.HasAsyncCallback() {
+		select {
+		case <-workerWake:
+		case <-time.After(purgeInterval):
+		}
+		return
+	}
+	select {
+	case <-workerWake:
+	case <-time.After(workerIdle):
+	}
+}
+
+// purgeWorker deletes delivered and failed deliveries once they are older than the retention
+func purgeWorker() {
+	for {
+		retention := config.Current().Callback.Retention
+		purgeDeliveries(constant.CallbackDeliverySucceeded, retention.SucceededDays)
+		purgeDeliveries(constant.CallbackDeliveryFailed, retention.FailedDays)
+		time.Sleep(purgeInterval)
+	}
+}
+
+func purgeDeliveries(status int32, days int) {
+	if days <= 0 {
+		return
+	}
+	before := time.Now().AddDate(0, 0, -days)
+	for {
+		n, err := imdb.DeleteCallbackDeliveries(status, before, purgeBatch)
+		if err != nil {
+			log.NewError("", "DeleteCallbackDeliveries failed ", status, err.Error())
+			return
+		}
+		if n < int64(purgeBatch) {
+			return
+		}
+	}
+}
+
+func deliverQueued(delivery *db.CallbackDelivery) {
+	now := time.Now()
+	if ok, retryAt := circuitBreaker().Allow(delivery.Url, now); !ok {
+		// an open circuit postpones the delivery without spending an attempt
+		if err := imdb.UpdateCallbackDelivery(delivery.ID, map[string]interface{}{"next_attempt_time": retryAt, "locked_until": now,
+			"last_error": ErrCircuitOpen.Error()}); err != nil {
+			log.NewError(delivery.DeliveryID, "UpdateCallbackDelivery failed ", err.Error())
+		}
+		return
+	}
+	_, _, timeout := eventURL(delivery.Command)
+	attempt := delivery.Attempts + 1
+	status, _, err := send(delivery.Url, delivery.Command, config.Current().Callback.Secret, delivery.DeliveryID, attempt, []byte(delivery.Body), timeout)
+	circuitBreaker().Report(delivery.Url, err == nil, time.Now())
+	args := map[string]interface{}{"attempts": attempt, "response_status": status, "cost_ms": time.Since(now).Milliseconds(), "locked_until": time.Now()}
+	if err == nil {
+		args["status"], args["last_error"] = constant.CallbackDeliverySucceeded, ""
+	} else {
+		retry := config.Current().Callback.Retry
+		args["last_error"] = truncate(err.Error(), 1024)
+		if int(attempt) >= retry.MaxAttempts {
+			args["status"] = constant.CallbackDeliveryFailed
+		} else {
+			args["next_attempt_time"] = time.Now().Add(Backoff(attempt, time.Duration(retry.BaseInterval)*time.Second, time.Duration(retry.MaxInterval)*time.Second))
+		}
+		log.NewWarn(delivery.DeliveryID, "callback delivery failed ", delivery.Command, delivery.Url, attempt, err.Error())
+	}
+	if err := imdb.UpdateCallbackDelivery(delivery.ID, args); err != nil {
+		log.NewError(delivery.DeliveryID, "UpdateCallbackDelivery failed ", err.Error())
+	}
+}
@@ -0,0 +1,203 @@
+package callback
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	pbCallback "Open_IM/pkg/proto/callback"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcScheme prefixes the target of a callback sent to the callback.Callback grpc service
+const grpcScheme = "grpc://"
+
+// the metadata keys carry what the http headers carry, the signature covers the timestamp and the
+// protobuf encoded request exactly as it is sent
+const (
+	metadataTimestamp  = "x-openim-timestamp"
+	metadataSignature  = "x-openim-signature"
+	metadataDeliveryID = "x-openim-delivery-id"
+	metadataAttempt    = "x-openim-delivery-attempt"
+)
+
+type grpcMethod struct {
+	name    string
+	newReq  func() proto.Message
+	newResp func() proto.Message
+}
+
+func sendMsgMethod(name string, newResp func() proto.Message) grpcMethod {
+	return grpcMethod{name: "/callback.Callback/" + name, newReq: func() proto.Message { return &pbCallback.CallbackSendMsgReq{} }, newResp: newResp}
+}
+
+func userStatusMethod(name string) grpcMethod {
+	return grpcMethod{name: "/callback.Callback/" + name, newReq: func() proto.Message { return &pbCallback.CallbackUserStatusReq{} }, newResp: commonResp}
+}
+
+func beforePushMethod(name string) grpcMethod {
+	return grpcMethod{name: "/callback.Callback/" + name, newReq: func() proto.Message { return &pbCallback.CallbackBeforePushReq{} },
+		newResp: func() proto.Message { return &pbCallback.CallbackBeforePushResp{} }}
+}
+
+func commonResp() proto.Message { return &pbCallback.CommonCallbackResp{} }
+
+func msgModifyResp() proto.Message { return &pbCallback.CallbackMsgModifyResp{} }
+
+// grpcMethods maps a callback command to its method, the messages share the json names of call_back_struct
+var grpcMethods = map[string]grpcMethod{
+	constant.CallbackBeforeSendSingleMsgCommand:  sendMsgMethod("CallbackBeforeSendSingleMsg", commonResp),
+	constant.CallbackAfterSendSingleMsgCommand:   sendMsgMethod("CallbackAfterSendSingleMsg", commonResp),
+	constant.CallbackBeforeSendGroupMsgCommand:   sendMsgMethod("CallbackBeforeSendGroupMsg", commonResp),
+	constant.CallbackAfterSendGroupMsgCommand:    sendMsgMethod("CallbackAfterSendGroupMsg", commonResp),
+	constant.CallbackAfterConsumeGroupMsgCommand: sendMsgMethod("CallbackAfterConsumeGroupMsg", commonResp),
+	constant.CallbackMsgModifyCommand:            sendMsgMethod("CallbackMsgModify", msgModifyResp),
+	constant.CallbackUserOnlineCommand:           userStatusMethod("CallbackUserOnline"),
+	constant.CallbackUserOfflineCommand:          userStatusMethod("CallbackUserOffline"),
+	constant.CallbackUserKickOffCommand:          userStatusMethod("CallbackUserKickOff"),
+	constant.CallbackOfflinePushCommand:          beforePushMethod("CallbackOfflinePush"),
+	constant.CallbackOnlinePushCommand:           beforePushMethod("CallbackOnlinePush"),
+	constant.CallbackSuperGroupOnlinePushCommand: beforePushMethod("CallbackSuperGroupOnlinePush"),
+}
+
+// marshalDeterministic encodes m the same way every time, the signature covers these bytes
+func marshalDeterministic(m proto.Message) ([]byte, error) {
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	if err := b.Marshal(m); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// jsonToProto fills m from the json of call_back_struct, jsonpb reads the wrapper fields from plain values
+func jsonToProto(body []byte, m proto.Message) error {
+	return (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(body), m)
+}
+
+// protoToJson writes m with the json keys of call_back_struct, unset wrapper fields are left out
+func protoToJson(m proto.Message) ([]byte, error) {
+	s, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(m)
+	return []byte(s), err
+}
+
+// rawCodec sends requests that are already encoded, so the bytes on the wire are the signed bytes
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	return b, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("rawCodec: unexpected type %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+func (rawCodec) Name() string { return "proto" }
+
+var (
+	connLock sync.Mutex
+	conns    = make(map[string]*grpc.ClientConn)
+)
+
+func transportCredentials() (grpc.DialOption, string, error) {
+	tlsConfig := config.Current().Callback.CallbackGrpcTLS
+	if !tlsConfig.Enable {
+		return grpc.WithInsecure(), "", nil
+	}
+	c := &tls.Config{ServerName: tlsConfig.ServerName, MinVersion: tls.VersionTLS12}
+	if tlsConfig.CAFile != "" {
+		pem, err := ioutil.ReadFile(tlsConfig.CAFile)
+		if err != nil {
+			return nil, "", err
+		}
+		c.RootCAs = x509.NewCertPool()
+		if !c.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, "", fmt.Errorf("no certificate in %s", tlsConfig.CAFile)
+		}
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(c)), "tls:" + tlsConfig.CAFile + ":" + tlsConfig.ServerName + ":", nil
+}
+
+// grpcConn returns the shared connection to target, grpc reconnects it in the background when the receiver restarts.
+// Connections are kept per tls setting, a reloaded setting dials a new one.
+func grpcConn(target string) (*grpc.ClientConn, error) {
+	creds, key, err := transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	key += target
+	connLock.Lock()
+	defer connLock.Unlock()
+	if conn, ok := conns[key]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.Dial(target, creds,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{Time: 30 * time.Second, Timeout: 10 * time.Second, PermitWithoutStream: true}))
+	if err != nil {
+		return nil, err
+	}
+	conns[key] = conn
+	return conn, nil
+}
+
+// sendGrpc calls the method of command with the json body and returns the reply as json,
+// the status is the grpc code so a delivery log tells both transports apart
+func sendGrpc(url, command, secret, deliveryID string, attempt int32, body []byte, timeout time.Duration) (int, []byte, error) {
+	method, ok := grpcMethods[command]
+	if !ok {
+		return 0, nil, fmt.Errorf("callback %s has no grpc method", command)
+	}
+	req, resp := method.newReq(), method.newResp()
+	if err := jsonToProto(body, req); err != nil {
+		return 0, nil, err
+	}
+	payload, err := marshalDeterministic(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	conn, err := grpcConn(strings.TrimPrefix(url, grpcScheme))
+	if err != nil {
+		return 0, nil, err
+	}
+	md := metadata.Pairs(metadataDeliveryID, deliveryID, metadataAttempt, strconv.Itoa(int(attempt)))
+	if secret != "" {
+		timestamp := time.Now().Unix()
+		md.Set(metadataTimestamp, strconv.FormatInt(timestamp, 10))
+		md.Set(metadataSignature, Sign(secret, timestamp, payload))
+	}
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), timeout)
+	defer cancel()
+	var reply []byte
+	if err := conn.Invoke(ctx, method.name, payload, &reply, grpc.ForceCodec(rawCodec{})); err != nil {
+		return int(status.Code(err)), nil, err
+	}
+	if err := proto.Unmarshal(reply, resp); err != nil {
+		return 0, nil, err
+	}
+	result, err := protoToJson(resp)
+	return 0, result, err
+}
@@ -0,0 +1,165 @@
+package callback
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	urlLib "net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	HeaderTimestamp  = "X-OpenIM-Timestamp"
+	HeaderSignature  = "X-OpenIM-Signature"
+	HeaderDeliveryID = "X-OpenIM-Delivery-ID"
+	HeaderAttempt    = "X-OpenIM-Delivery-Attempt"
+	signaturePrefix  = "sha256="
+)
+
+var (
+	ErrCircuitOpen      = errors.New("callback receiver is unavailable, circuit open")
+	ErrSignatureInvalid = errors.New("callback signature invalid")
+	ErrTimestampExpired = errors.New("callback timestamp outside tolerance")
+)
+
+// Sign is the signature header value of a request body sent at timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify is what a receiver runs on the headers, requests older than tolerance are replays
+func Verify(secret, timestamp, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrSignatureInvalid
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+		return ErrTimestampExpired
+	}
+	if !hmac.Equal([]byte(Sign(secret, ts, body)), []byte(signature)) {
+		return ErrSignatureInvalid
+	}
+	return nil
+}
+
+// Backoff is the wait before retry attempt+1, doubling from base up to max
+func Backoff(attempt int32, base, max time.Duration) time.Duration {
+	d := base
+	for i := int32(1); i < attempt && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// CircuitBreaker stops calling a receiver after threshold consecutive failures,
+// once open elapses a single probe decides whether it closes again
+type CircuitBreaker struct {
+	mu        sync.Mutex
+	circuits  map[string]*circuit
+	threshold int
+	open      time.Duration
+}
+
+func NewCircuitBreaker(threshold int, open time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{circuits: make(map[string]*circuit), threshold: threshold, open: open}
+}
+
+// Allow reports whether a request to key may be sent, retryAt is when to ask again otherwise
+func (b *CircuitBreaker) Allow(key string, now time.Time) (ok bool, retryAt time.Time) {
+	if b.threshold <= 0 {
+		return true, now
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[key]
+	if c == nil || c.failures < b.threshold {
+		return true, now
+	}
+	if now.Before(c.openUntil) {
+		return false, c.openUntil
+	}
+	if c.probing {
+		return false, now.Add(b.open)
+	}
+	c.probing = true
+	return true, now
+}
+
+func (b *CircuitBreaker) Report(key string, success bool, now time.Time) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c := b.circuits[key]
+	if success {
+		delete(b.circuits, key)
+		return
+	}
+	if c == nil {
+		c = &circuit{}
+		b.circuits[key] = c
+	}
+	c.failures++
+	c.probing = false
+	if c.failures >= b.threshold {
+		c.openUntil = now.Add(b.open)
+	}
+}
+
+// send posts a signed callback, any status but 2xx is a failure
+func send(url, command, secret, deliveryID string, attempt int32, body []byte, timeout time.Duration) (int, []byte, error) {
+	if strings.HasPrefix(url, grpcScheme) {
+		return sendGrpc(url, command, secret, deliveryID, attempt, body, timeout)
+	}
+	v := urlLib.Values{}
+	v.Set("callbackCommand", command)
+	req, err := http.NewRequest(http.MethodPost, url+"?"+v.Encode(), bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Close = true
+	req.Header.Add("content-type", "application/json; charset=utf-8")
+	req.Header.Set(HeaderDeliveryID, deliveryID)
+	req.Header.Set(HeaderAttempt, strconv.Itoa(int(attempt)))
+	if secret != "" {
+		timestamp := time.Now().Unix()
+		req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+	result, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, result, fmt.Errorf("callback receiver returned %s", resp.Status)
+	}
+	return resp.StatusCode, result, nil
+}
@@ -2,12 +2,12 @@ package config
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
-
-	"gopkg.in/yaml.v3"
+	"strings"
+	"time"
 )
 
 var (
@@ -20,10 +20,53 @@ const ConfName = "openIMConf"
 
 var Config config
 
+// callBackConfig is one callback event, Url overrides Callback.CallbackUrl and Async queues the event instead of waiting for the receiver.
+// Transport grpc calls the callback.Callback service at Url or Callback.CallbackGrpcAddr instead of posting json.
 type callBackConfig struct {
-	Enable                 bool `yaml:"enable"`
-	CallbackTimeOut        int  `yaml:"callbackTimeOut"`
-	CallbackFailedContinue bool `yaml:"callbackFailedContinue"`
+	Enable                 bool   `yaml:"enable"`
+	CallbackTimeOut        int    `yaml:"callbackTimeOut"`
+	CallbackFailedContinue bool   `yaml:"callbackFailedContinue"`
+	Url                    string `yaml:"url"`
+	Async                  bool   `yaml:"async"`
+	Transport              string `yaml:"transport"`
+}
+
+// SigningKey is a token signing key, the private key is only needed by services that issue tokens
+type SigningKey struct {
+	Kid            string `yaml:"kid"`
+	PrivateKey     string `yaml:"privateKey"`
+	PrivateKeyFile string `yaml:"privateKeyFile"`
+	PublicKey      string `yaml:"publicKey"`
+	PublicKeyFile  string `yaml:"publicKeyFile"`
+	ActiveFrom     int64  `yaml:"activeFrom"`
+}
+
+// MultiLoginRule limits the sessions of one platform class, a session beyond a limit ends the oldest one
+type MultiLoginRule struct {
+	MaxSessions    int      `yaml:"maxSessions"`
+	MaxPerPlatform int      `yaml:"maxPerPlatform"`
+	Kick           []string `yaml:"kick"`
+}
+
+// MultiLoginPolicy maps a platform class (PC, Mobile, Web, or the platform name for unclassified platforms) to its rule
+type MultiLoginPolicy map[string]MultiLoginRule
+
+// OIDCProvider is an OpenID Connect identity provider users can sign in with
+type OIDCProvider struct {
+	Name         string   `yaml:"name"`
+	Issuer       string   `yaml:"issuer"`
+	ClientID     string   `yaml:"clientID"`
+	ClientSecret string   `yaml:"clientSecret"`
+	RedirectURL  string   `yaml:"redirectURL"`
+	Scopes       []string `yaml:"scopes"`
+	UserIDPrefix string   `yaml:"userIDPrefix"`
+	Claims       struct {
+		UserID     string `yaml:"userID"`
+		Nickname   string `yaml:"nickname"`
+		FaceURL    string `yaml:"faceURL"`
+		Email      string `yaml:"email"`
+		Department string `yaml:"department"`
+	} `yaml:"claims"`
 }
 
 type config struct {
@@ -75,6 +118,12 @@ type config struct {
 			EndpointInnerEnable bool   `yaml:"endpointInnerEnable"`
 			StorageTime         int    `yaml:"storageTime"`
 			IsDistributedMod    bool   `yaml:"isDistributedMod"`
+			Multipart           struct {
+				PartSize     int64 `yaml:"partSize"`
+				MaxFileSize  int64 `yaml:"maxFileSize"`
+				UserQuota    int64 `yaml:"userQuota"`
+				UploadExpire int   `yaml:"uploadExpire"`
+			} `yaml:"multipart"`
 		} `yaml:"minio"`
 		Aws struct {
 			AccessKeyID     string `yaml:"accessKeyID"`
@@ -233,6 +282,10 @@ type config struct {
 			AppSecret string `yaml:"appSecret"`
 			Enable    bool   `yaml:"enable"`
 		}
+		DoNotDisturb struct {
+			AtMentionOverride bool `yaml:"atMentionOverride"`
+			SignalOverride    bool `yaml:"signalOverride"`
+		} `yaml:"doNotDisturb"`
 	}
 	Manager struct {
 		AppManagerUid          []string `yaml:"appManagerUid"`
@@ -263,6 +316,21 @@ type config struct {
 			Addr  []string `yaml:"addr"`
 			Topic string   `yaml:"topic"`
 		}
+		DeadLetter struct {
+			Addr       []string `yaml:"addr"`
+			Topic      string   `yaml:"topic"`
+			MaxAttempt int32    `yaml:"maxAttempt"`
+		}
+		Producer struct {
+			Async          bool   `yaml:"async"`
+			Idempotent     bool   `yaml:"idempotent"`
+			LingerMs       int    `yaml:"lingerMs"`
+			BatchSize      int    `yaml:"batchSize"`
+			BatchBytes     int    `yaml:"batchBytes"`
+			SpillDir       string `yaml:"spillDir"`
+			SpillMaxBytes  int64  `yaml:"spillMaxBytes"`
+			ReplayInterval int    `yaml:"replayInterval"`
+		} `yaml:"producer"`
 		ConsumerGroupID struct {
 			MsgToRedis  string `yaml:"msgToTransfer"`
 			MsgToMongo  string `yaml:"msgToMongo"`
@@ -279,10 +347,38 @@ type config struct {
 	GroupMessageHasReadReceiptEnable  bool   `yaml:"groupMessageHasReadReceiptEnable"`
 	SingleMessageHasReadReceiptEnable bool   `yaml:"singleMessageHasReadReceiptEnable"`
 
+	MultiLogin struct {
+		Enable  bool                       `yaml:"enable"`
+		Default MultiLoginPolicy           `yaml:"default"`
+		Tiers   map[int32]MultiLoginPolicy `yaml:"tiers"`
+	} `yaml:"multiLogin"`
+
+	ConfigReload struct {
+		Enable   bool `yaml:"enable"`
+		Interval int  `yaml:"interval"`
+	} `yaml:"configReload"`
+
 	TokenPolicy struct {
 		AccessSecret string `yaml:"accessSecret"`
 		AccessExpire int64  `yaml:"accessExpire"`
-	}
+		RefreshToken struct {
+			Enable              bool  `yaml:"enable"`
+			AccessExpireMinutes int64 `yaml:"accessExpireMinutes"`
+			RefreshExpire       int64 `yaml:"refreshExpire"`
+		} `yaml:"refreshToken"`
+		Signing struct {
+			Algorithm   string       `yaml:"algorithm"`
+			AcceptHS256 bool         `yaml:"acceptHS256"`
+			Keys        []SigningKey `yaml:"keys"`
+		} `yaml:"signing"`
+	}
+	OIDC struct {
+		Enable             bool           `yaml:"enable"`
+		StateTTL           int            `yaml:"stateTTL"`
+		CodeTTL            int            `yaml:"codeTTL"`
+		ClientRedirectURIs []string       `yaml:"clientRedirectURIs"`
+		Providers          []OIDCProvider `yaml:"providers"`
+	} `yaml:"oidc"`
 	MessageVerify struct {
 		FriendVerify *bool `yaml:"friendVerify"`
 	}
@@ -293,7 +389,13 @@ type config struct {
 	}
 
 	Callback struct {
-		CallbackUrl                        string         `yaml:"callbackUrl"`
+		CallbackUrl      string `yaml:"callbackUrl"`
+		CallbackGrpcAddr string `yaml:"callbackGrpcAddr"`
+		CallbackGrpcTLS  struct {
+			Enable     bool   `yaml:"enable"`
+			CAFile     string `yaml:"caFile"`
+			ServerName string `yaml:"serverName"`
+		} `yaml:"callbackGrpcTLS"`
 		CallbackBeforeSendSingleMsg        callBackConfig `yaml:"callbackBeforeSendSingleMsg"`
 		CallbackAfterSendSingleMsg         callBackConfig `yaml:"callbackAfterSendSingleMsg"`
 		CallbackBeforeSendGroupMsg         callBackConfig `yaml:"callbackBeforeSendGroupMsg"`
@@ -310,6 +412,22 @@ type config struct {
 		CallbackBeforeCreateGroup          callBackConfig `yaml:"callbackBeforeCreateGroup"`
 		CallbackBeforeMemberJoinGroup      callBackConfig `yaml:"callbackBeforeMemberJoinGroup"`
 		CallbackBeforeSetGroupMemberInfo   callBackConfig `yaml:"callbackBeforeSetGroupMemberInfo"`
+
+		Secret string `yaml:"secret"`
+		Retry  struct {
+			MaxAttempts  int `yaml:"maxAttempts"`
+			BaseInterval int `yaml:"baseInterval"`
+			MaxInterval  int `yaml:"maxInterval"`
+		} `yaml:"retry"`
+		CircuitBreaker struct {
+			FailureThreshold int `yaml:"failureThreshold"`
+			OpenSeconds      int `yaml:"openSeconds"`
+		} `yaml:"circuitBreaker"`
+		LogSyncDeliveries bool `yaml:"logSyncDeliveries"`
+		Retention         struct {
+			SucceededDays int `yaml:"succeededDays"`
+			FailedDays    int `yaml:"failedDays"`
+		} `yaml:"retention"`
 	} `yaml:"callback"`
 	Notification struct {
 		///////////////////////group/////////////////////////////
@@ -417,6 +535,16 @@ type config struct {
 			OfflinePush  POfflinePush  `yaml:"offlinePush"`
 			DefaultTips  PDefaultTips  `yaml:"defaultTips"`
 		} `yaml:"groupMemberSetToOrdinaryUser"`
+		GroupRoleChanged struct {
+			Conversation PConversation `yaml:"conversation"`
+			OfflinePush  POfflinePush  `yaml:"offlinePush"`
+			DefaultTips  PDefaultTips  `yaml:"defaultTips"`
+		} `yaml:"groupRoleChanged"`
+		GroupTypeChanged struct {
+			Conversation PConversation `yaml:"conversation"`
+			OfflinePush  POfflinePush  `yaml:"offlinePush"`
+			DefaultTips  PDefaultTips  `yaml:"defaultTips"`
+		} `yaml:"groupTypeChanged"`
 		OrganizationChanged struct {
 			Conversation PConversation `yaml:"conversation"`
 			OfflinePush  POfflinePush  `yaml:"offlinePush"`
@@ -479,6 +607,11 @@ type config struct {
 			OfflinePush  POfflinePush  `yaml:"offlinePush"`
 			DefaultTips  PDefaultTips  `yaml:"defaultTips"`
 		} `yaml:"friendInfoUpdated"`
+		FriendCategoryChanged struct {
+			Conversation PConversation `yaml:"conversation"`
+			OfflinePush  POfflinePush  `yaml:"offlinePush"`
+			DefaultTips  PDefaultTips  `yaml:"defaultTips"`
+		} `yaml:"friendCategoryChanged"`
 
 		ConversationOptUpdate struct {
 			Conversation PConversation `yaml:"conversation"`
@@ -546,6 +679,17 @@ type config struct {
 		JoinDepartmentGroups                    bool     `yaml:"joinDepartmentGroups"`
 		OaNotification                          bool     `yaml:"oaNotification"`
 		CreateOrganizationUserAndJoinDepartment bool     `yaml:"createOrganizationUserAndJoinDepartment"`
+		StubSender                              bool     `yaml:"stubSender"`
+		LoginLock                               struct {
+			MaxFailedAttempts int `yaml:"maxFailedAttempts"`
+			LockSeconds       int `yaml:"lockSeconds"`
+		} `yaml:"loginLock"`
+		TwoFactor struct {
+			Issuer            string `yaml:"issuer"`
+			ChallengeTTL      int    `yaml:"challengeTTL"`
+			TrustedDeviceDays int    `yaml:"trustedDeviceDays"`
+			RecoveryCodeNum   int    `yaml:"recoveryCodeNum"`
+		} `yaml:"twoFactor"`
 	}
 	WorkMoment struct {
 		OnlyFriendCanSee bool `yaml:"onlyFriendCanSee"`
@@ -571,6 +715,31 @@ type config struct {
 		RealTimeCommPrometheusPort    []int `yaml:"realTimeCommPrometheusPort"`
 		MessageTransferPrometheusPort []int `yaml:"messageTransferPrometheusPort"`
 	} `yaml:"prometheus"`
+	Audit struct {
+		Enable          bool `yaml:"enable"`
+		ParamsMaxLength int  `yaml:"paramsMaxLength"`
+		ExportMaxNum    int  `yaml:"exportMaxNum"`
+		ChainShards     int  `yaml:"chainShards"`
+	} `yaml:"audit"`
+	GroupOwnerSuccession struct {
+		Enable         bool     `yaml:"enable"`
+		Order          []string `yaml:"order"`
+		RepairCronTime string   `yaml:"repairCronTime"`
+	} `yaml:"groupOwnerSuccession"`
+	GroupImport struct {
+		RetentionDays int `yaml:"retentionDays"`
+	} `yaml:"groupImport"`
+	FriendRecommendation struct {
+		MutualFriendWeight     int64 `yaml:"mutualFriendWeight"`
+		SharedGroupWeight      int64 `yaml:"sharedGroupWeight"`
+		SameDepartmentWeight   int64 `yaml:"sameDepartmentWeight"`
+		MaxGroupMemberNum      int   `yaml:"maxGroupMemberNum"`
+		MaxDepartmentMemberNum int   `yaml:"maxDepartmentMemberNum"`
+		MaxFriendScanNum       int   `yaml:"maxFriendScanNum"`
+		MaxGroupScanNum        int   `yaml:"maxGroupScanNum"`
+		MaxNum                 int   `yaml:"maxNum"`
+		RefreshInterval        int   `yaml:"refreshInterval"`
+	} `yaml:"friendRecommendation"`
 }
 type PConversation struct {
 	ReliabilityLevel int  `yaml:"reliabilityLevel"`
@@ -641,7 +810,8 @@ type usualConfig struct {
 
 var UsualConfig usualConfig
 
-func unmarshalConfig(config interface{}, configName string) {
+// configFile resolves configName under $CONFIG_NAME/config, falling back to Root/config, or ../config when the env is not set
+func configFile(configName string) string {
 	var env string
 	if configName == "config.yaml" {
 		env = "CONFIG_NAME"
@@ -650,105 +820,155 @@ func unmarshalConfig(config interface{}, configName string) {
 	}
 	cfgName := os.Getenv(env)
 	if len(cfgName) != 0 {
-		bytes, err := ioutil.ReadFile(filepath.Join(cfgName, "config", configName))
-		if err != nil {
-			bytes, err = ioutil.ReadFile(filepath.Join(Root, "config", configName))
-			if err != nil {
-				panic(err.Error() + " config: " + filepath.Join(cfgName, "config", configName))
-			}
-		} else {
+		path := filepath.Join(cfgName, "config", configName)
+		if _, err := os.Stat(path); err == nil {
 			Root = cfgName
+			return path
 		}
-		if err = yaml.Unmarshal(bytes, config); err != nil {
-			panic(err.Error())
-		}
-	} else {
-		bytes, err := ioutil.ReadFile(fmt.Sprintf("../config/%s", configName))
-		if err != nil {
-			panic(err.Error() + configName)
-		}
-		if err = yaml.Unmarshal(bytes, config); err != nil {
-			panic(err.Error())
-		}
+		return filepath.Join(Root, "config", configName)
 	}
+	return fmt.Sprintf("../config/%s", configName)
 }
 
-func init() {
-	unmarshalConfig(&Config, "config.yaml")
-	unmarshalConfig(&UsualConfig, "usualConfig.yaml")
-	if Config.Etcd.UserName == "" {
-		Config.Etcd.UserName = UsualConfig.Etcd.UserName
+func mergeUsualConfig(c *config, u *usualConfig) {
+	if c.Etcd.UserName == "" {
+		c.Etcd.UserName = u.Etcd.UserName
+	}
+	if c.Etcd.Password == "" {
+		c.Etcd.Password = u.Etcd.Password
+	}
+	if c.Etcd.Secret == "" {
+		c.Etcd.Secret = u.Etcd.Secret
 	}
-	if Config.Etcd.Password == "" {
-		Config.Etcd.Password = UsualConfig.Etcd.Password
+
+	if c.Mysql.DBUserName == "" {
+		c.Mysql.DBUserName = u.Mysql.DBUserName
 	}
-	if Config.Etcd.Secret == "" {
-		Config.Etcd.Secret = UsualConfig.Etcd.Secret
+	if c.Mysql.DBPassword == "" {
+		c.Mysql.DBPassword = u.Mysql.DBPassword
 	}
 
-	if Config.Mysql.DBUserName == "" {
-		Config.Mysql.DBUserName = UsualConfig.Mysql.DBUserName
+	if c.Redis.DBUserName == "" {
+		c.Redis.DBUserName = u.Redis.DBUserName
 	}
-	if Config.Mysql.DBPassword == "" {
-		Config.Mysql.DBPassword = UsualConfig.Mysql.DBPassword
+	if c.Redis.DBPassWord == "" {
+		c.Redis.DBPassWord = u.Redis.DBPassword
 	}
 
-	if Config.Redis.DBUserName == "" {
-		Config.Redis.DBUserName = UsualConfig.Redis.DBUserName
+	if c.Mongo.DBUserName == "" {
+		c.Mongo.DBUserName = u.Mongo.DBUserName
 	}
-	if Config.Redis.DBPassWord == "" {
-		Config.Redis.DBPassWord = UsualConfig.Redis.DBPassword
+	if c.Mongo.DBPassword == "" {
+		c.Mongo.DBPassword = u.Mongo.DBPassword
 	}
 
-	if Config.Mongo.DBUserName == "" {
-		Config.Mongo.DBUserName = UsualConfig.Mongo.DBUserName
+	if c.Kafka.SASLUserName == "" {
+		c.Kafka.SASLUserName = u.Kafka.SASLUserName
 	}
-	if Config.Mongo.DBPassword == "" {
-		Config.Mongo.DBPassword = UsualConfig.Mongo.DBPassword
+	if c.Kafka.SASLPassword == "" {
+		c.Kafka.SASLPassword = u.Kafka.SASLPassword
 	}
 
-	if Config.Kafka.SASLUserName == "" {
-		Config.Kafka.SASLUserName = UsualConfig.Kafka.SASLUserName
+	if c.Credential.Minio.AccessKeyID == "" {
+		c.Credential.Minio.AccessKeyID = u.Credential.Minio.AccessKeyID
+	}
+	if c.Credential.Minio.SecretAccessKey == "" {
+		c.Credential.Minio.SecretAccessKey = u.Credential.Minio.SecretAccessKey
+	}
+	if c.Credential.Minio.Endpoint == "" {
+		c.Credential.Minio.Endpoint = u.Credential.Minio.Endpoint
 	}
-	if Config.Kafka.SASLPassword == "" {
-		Config.Kafka.SASLPassword = UsualConfig.Kafka.SASLPassword
+
+	if c.MessageVerify.FriendVerify == nil {
+		c.MessageVerify.FriendVerify = &u.Messageverify.FriendVerify
 	}
 
-	if Config.Credential.Minio.AccessKeyID == "" {
-		Config.Credential.Minio.AccessKeyID = UsualConfig.Credential.Minio.AccessKeyID
+	if c.Push.Getui.MasterSecret == "" {
+		c.Push.Getui.MasterSecret = u.Push.Getui.MasterSecret
+	}
+	if c.Push.Getui.AppKey == "" {
+		c.Push.Getui.AppKey = u.Push.Getui.AppKey
+	}
+	if c.Push.Getui.PushUrl == "" {
+		c.Push.Getui.PushUrl = u.Push.Getui.PushUrl
 	}
-	if Config.Credential.Minio.SecretAccessKey == "" {
-		Config.Credential.Minio.SecretAccessKey = UsualConfig.Credential.Minio.SecretAccessKey
+	if c.Push.Getui.Enable == nil {
+		c.Push.Getui.Enable = &u.Push.Getui.Enable
 	}
-	if Config.Credential.Minio.Endpoint == "" {
-		Config.Credential.Minio.Endpoint = UsualConfig.Credential.Minio.Endpoint
+
+	if c.Secret == "" {
+		c.Secret = u.Secret
 	}
 
-	if Config.MessageVerify.FriendVerify == nil {
-		Config.MessageVerify.FriendVerify = &UsualConfig.Messageverify.FriendVerify
+	if c.TokenPolicy.AccessExpire == 0 {
+		c.TokenPolicy.AccessExpire = u.Tokenpolicy.AccessExpire
+	}
+	if c.TokenPolicy.AccessSecret == "" {
+		c.TokenPolicy.AccessSecret = u.Tokenpolicy.AccessSecret
 	}
+}
 
-	if Config.Push.Getui.MasterSecret == "" {
-		Config.Push.Getui.MasterSecret = UsualConfig.Push.Getui.MasterSecret
+func load() (*config, *usualConfig, error) {
+	c, u := &config{}, &usualConfig{}
+	if err := unmarshalConfig(c, configFile("config.yaml")); err != nil {
+		return nil, nil, err
 	}
-	if Config.Push.Getui.AppKey == "" {
-		Config.Push.Getui.AppKey = UsualConfig.Push.Getui.AppKey
+	if err := unmarshalConfig(u, configFile("usualConfig.yaml")); err != nil {
+		return nil, nil, err
 	}
-	if Config.Push.Getui.PushUrl == "" {
-		Config.Push.Getui.PushUrl = UsualConfig.Push.Getui.PushUrl
+	mergeUsualConfig(c, u)
+	if err := c.validate(); err != nil {
+		return nil, nil, err
 	}
-	if Config.Push.Getui.Enable == nil {
-		Config.Push.Getui.Enable = &UsualConfig.Push.Getui.Enable
+	return c, u, nil
+}
+
+// Load reads config.yaml and usualConfig.yaml into Config, a failed load leaves Config unchanged
+func Load() error {
+	c, u, err := load()
+	if err != nil {
+		return err
 	}
+	Config, UsualConfig = *c, *u
+	current.Store(&Config)
+	return nil
+}
 
-	if Config.Secret == "" {
-		Config.Secret = UsualConfig.Secret
+// Current returns the running config including the sections Reload replaced,
+// the reloadable sections must be read through it, Config keeps the values loaded at start
+func Current() *config {
+	return current.Load().(*config)
+}
+
+func init() {
+	if err := Load(); err != nil {
+		fmt.Fprintln(os.Stderr, "load config failed:", err.Error())
+		os.Exit(1)
 	}
+	if Config.ConfigReload.Enable {
+		go watchConfig(time.Duration(Config.ConfigReload.Interval) * time.Second)
+	}
+}
 
-	if Config.TokenPolicy.AccessExpire == 0 {
-		Config.TokenPolicy.AccessExpire = UsualConfig.Tokenpolicy.AccessExpire
+// CallbackEvent returns the settings of the callback sent with command, e.g. callbackUserOnlineCommand reads callbackUserOnline
+func CallbackEvent(command string) (callBackConfig, bool) {
+	name := strings.TrimSuffix(command, "Command")
+	callbacks := reflect.ValueOf(Current().Callback)
+	for i := 0; i < callbacks.NumField(); i++ {
+		if cb, ok := callbacks.Field(i).Interface().(callBackConfig); ok && strings.EqualFold(yamlName(callbacks.Type().Field(i)), name) {
+			return cb, true
+		}
 	}
-	if Config.TokenPolicy.AccessSecret == "" {
-		Config.TokenPolicy.AccessSecret = UsualConfig.Tokenpolicy.AccessSecret
+	return callBackConfig{}, false
+}
+
+// HasAsyncCallback reports whether an enabled callback event is queued instead of waited for
+func HasAsyncCallback() bool {
+	callbacks := reflect.ValueOf(Current().Callback)
+	for i := 0; i < callbacks.NumField(); i++ {
+		if cb, ok := callbacks.Field(i).Interface().(callBackConfig); ok && cb.Enable && cb.Async {
+			return true
+		}
 	}
+	return false
 }
@@ -0,0 +1,229 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// unmarshalConfig decodes a yaml file after substituting references in its values:
+//
+//	${NAME}            environment variable NAME, an unset variable is an error
+//	${NAME:-default}   environment variable NAME, default when it is unset or empty
+//	${file:path}       content of the file, relative paths are resolved against the config directory
+//	$${...}            a literal ${...}
+//
+// Only values are substituted so secrets never have to be quoted for yaml.
+func unmarshalConfig(config interface{}, path string) error {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(bytes, &root); err != nil {
+		return fmt.Errorf("%s: %s", path, err.Error())
+	}
+	var errs []string
+	expandNode(&root, filepath.Dir(path), &errs)
+	if len(errs) > 0 {
+		return fmt.Errorf("%s:\n  %s", path, strings.Join(errs, "\n  "))
+	}
+	if err := root.Decode(config); err != nil {
+		return fmt.Errorf("%s: %s", path, err.Error())
+	}
+	return nil
+}
+
+func expandNode(node *yaml.Node, dir string, errs *[]string) {
+	switch node.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, n := range node.Content {
+			expandNode(n, dir, errs)
+		}
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			expandNode(node.Content[i], dir, errs)
+		}
+	case yaml.ScalarNode:
+		if !strings.Contains(node.Value, "${") {
+			return
+		}
+		value, err := Expand(node.Value, dir)
+		if err != nil {
+			*errs = append(*errs, fmt.Sprintf("line %d: %s", node.Line, err.Error()))
+			return
+		}
+		node.Value = value
+		// a plain scalar is resolved again so ${PORT} can fill an int
+		if node.Style == 0 {
+			node.Tag = ""
+		}
+	}
+}
+
+// Expand substitutes the ${...} references in s
+func Expand(s, dir string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			b.WriteString(s)
+			return b.String(), nil
+		}
+		if i > 0 && s[i-1] == '$' {
+			b.WriteString(s[:i-1] + "${")
+			s = s[i+2:]
+			continue
+		}
+		end := strings.IndexByte(s[i:], '}')
+		if end < 0 {
+			return "", errors.New("unterminated ${ in " + s)
+		}
+		value, err := resolveRef(s[i+2:i+end], dir)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s[:i] + value)
+		s = s[i+end+1:]
+	}
+}
+
+func resolveRef(ref, dir string) (string, error) {
+	if strings.HasPrefix(ref, "file:") {
+		path := strings.TrimPrefix(ref, "file:")
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		bytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(bytes), "\r\n"), nil
+	}
+	name, def, hasDefault := ref, "", false
+	if i := strings.Index(ref, ":-"); i >= 0 {
+		name, def, hasDefault = ref[:i], ref[i+2:], true
+	}
+	value, ok := os.LookupEnv(name)
+	if hasDefault && value == "" {
+		return def, nil
+	}
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return value, nil
+}
+
+// asyncCallbacks are the events whose reply is ignored, only they can be queued
+var asyncCallbacks = map[string]bool{"callbackAfterSendSingleMsg": true, "callbackAfterSendGroupMsg": true,
+	"callbackAfterConsumeGroupMsg": true, "callbackUserOnline": true, "callbackUserOffline": true, "callbackUserKickOff": true}
+
+// grpcCallbacks are the events the callback.Callback grpc service serves
+var grpcCallbacks = map[string]bool{"callbackBeforeSendSingleMsg": true, "callbackAfterSendSingleMsg": true, "callbackBeforeSendGroupMsg": true,
+	"callbackAfterSendGroupMsg": true, "callbackAfterConsumeGroupMsg": true, "callbackMsgModify": true, "callbackUserOnline": true,
+	"callbackUserOffline": true, "callbackUserKickOff": true, "callbackOfflinePush": true, "callbackOnlinePush": true, "callbackSuperGroupOnlinePush": true}
+
+// validate reports every invalid setting at once so a broken deployment is fixed in one round
+func (c *config) validate() error {
+	var errs []string
+	required := func(ok bool, field string) {
+		if !ok {
+			errs = append(errs, field+" is required")
+		}
+	}
+	required(len(c.Mysql.DBAddress) > 0, "mysql.dbMysqlAddress")
+	required(c.Mysql.DBDatabaseName != "", "mysql.dbMysqlDatabaseName")
+	required(c.Mongo.DBUri != "" || len(c.Mongo.DBAddress) > 0, "mongo.dbUri or mongo.dbAddress")
+	required(len(c.Redis.DBAddress) > 0, "redis.dbAddress")
+	required(c.Etcd.EtcdSchema != "", "etcd.etcdSchema")
+	required(len(c.Etcd.EtcdAddr) > 0, "etcd.etcdAddr")
+	required(len(c.Kafka.Ws2mschat.Addr) > 0, "kafka.ws2mschat.addr")
+	// an async send is acknowledged before the broker has it, only the spill keeps a failed one
+	required(!c.Kafka.Producer.Async || c.Kafka.Producer.SpillDir != "", "kafka.producer.spillDir when kafka.producer.async is enabled")
+
+	if c.Log.RemainLogLevel > 6 {
+		errs = append(errs, fmt.Sprintf("log.remainLogLevel must be between 0 and 6, got %d", c.Log.RemainLogLevel))
+	}
+	if c.ConfigReload.Enable && c.ConfigReload.Interval <= 0 {
+		errs = append(errs, "configReload.interval must be positive")
+	}
+	switch c.TokenPolicy.Signing.Algorithm {
+	case "", "HS256", "RS256", "EdDSA":
+	default:
+		errs = append(errs, "tokenpolicy.signing.algorithm must be HS256, RS256 or EdDSA, got "+c.TokenPolicy.Signing.Algorithm)
+	}
+	if c.TokenPolicy.RefreshToken.Enable && c.TokenPolicy.RefreshToken.AccessExpireMinutes <= 0 {
+		errs = append(errs, "tokenpolicy.refreshToken.accessExpireMinutes must be positive when refreshToken is enabled")
+	}
+
+	queued := false
+	callbacks := reflect.ValueOf(c.Callback)
+	for i := 0; i < callbacks.NumField(); i++ {
+		cb, ok := callbacks.Field(i).Interface().(callBackConfig)
+		if !ok {
+			continue
+		}
+		name := "callback." + yamlName(callbacks.Type().Field(i))
+		switch cb.Transport {
+		case "", "http":
+			if cb.Enable && cb.Url == "" && c.Callback.CallbackUrl == "" {
+				errs = append(errs, "callback.callbackUrl or "+name+".url is required when "+name+" is enabled")
+			}
+		case "grpc":
+			if !grpcCallbacks[yamlName(callbacks.Type().Field(i))] {
+				errs = append(errs, name+".transport grpc is not supported, the callback service has no method for it")
+			}
+			if cb.Enable && cb.Url == "" && c.Callback.CallbackGrpcAddr == "" {
+				errs = append(errs, "callback.callbackGrpcAddr or "+name+".url is required when "+name+" uses grpc")
+			}
+		default:
+			errs = append(errs, name+".transport must be http or grpc, got "+cb.Transport)
+		}
+		if cb.CallbackTimeOut < 0 {
+			errs = append(errs, name+".callbackTimeOut must not be negative")
+		}
+		if cb.Async && !asyncCallbacks[yamlName(callbacks.Type().Field(i))] {
+			errs = append(errs, name+".async is only supported by after and notice events, the server waits for the reply of "+name)
+		}
+		queued = queued || cb.Enable && cb.Async
+	}
+	if queued && (c.Callback.Retry.MaxAttempts <= 0 || c.Callback.Retry.BaseInterval <= 0 || c.Callback.Retry.MaxInterval < c.Callback.Retry.BaseInterval) {
+		errs = append(errs, "callback.retry needs positive maxAttempts and baseInterval and a maxInterval not below baseInterval")
+	}
+
+	if c.OIDC.Enable {
+		required(len(c.OIDC.ClientRedirectURIs) != 0, "oidc.clientRedirectURIs")
+		names := make(map[string]bool)
+		for i, p := range c.OIDC.Providers {
+			field := fmt.Sprintf("oidc.providers[%d]", i)
+			required(p.Name != "", field+".name")
+			required(p.Issuer != "", field+".issuer")
+			required(p.ClientID != "", field+".clientID")
+			required(p.RedirectURL != "", field+".redirectURL")
+			if names[p.Name] {
+				errs = append(errs, field+".name "+p.Name+" is duplicated")
+			}
+			names[p.Name] = true
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("invalid config:\n  " + strings.Join(errs, "\n  "))
+	}
+	return nil
+}
+
+// yamlName is the key a struct field is read from
+func yamlName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+	return name
+}
@@ -0,0 +1,103 @@
+package config
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// reloadableSections are read per use, so replacing them takes effect without a restart
+var reloadableSections = map[string]bool{"Callback": true, "Notification": true, "IOSPush": true}
+
+var (
+	reloadLock  sync.Mutex
+	reloadHooks []func(sections []string)
+	// current holds the *config readers get from Current, Reload publishes a new copy instead of writing in place
+	current atomic.Value
+)
+
+// OnReload registers f to run after config.yaml changes were applied, sections are the changed yaml keys
+func OnReload(f func(sections []string)) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+	reloadHooks = append(reloadHooks, f)
+}
+
+func fileHash(path string) ([sha256.Size]byte, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(bytes), nil
+}
+
+func watchConfig(interval time.Duration) {
+	path := configFile("config.yaml")
+	last, _ := fileHash(path)
+	for range time.Tick(interval) {
+		hash, err := fileHash(path)
+		if err != nil || hash == last {
+			continue
+		}
+		last = hash
+		changed, restart, err := Reload()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "reload config failed, keep the running config:", err.Error())
+			continue
+		}
+		if len(changed) > 0 {
+			fmt.Fprintln(os.Stdout, "config reloaded:", strings.Join(changed, ", "))
+		}
+		if len(restart) > 0 {
+			fmt.Fprintln(os.Stderr, "config changes need a restart to take effect:", strings.Join(restart, ", "))
+		}
+	}
+}
+
+// Reload reads the config files again and applies the reloadable sections and the log level,
+// restart lists the changed sections that only take effect after a restart.
+// The applied sections are published as a new copy through Current, a running config is never written.
+func Reload() (changed, restart []string, err error) {
+	c, _, err := load()
+	if err != nil {
+		return nil, nil, err
+	}
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+	published := *Current()
+	cur, next := reflect.ValueOf(&published).Elem(), reflect.ValueOf(c).Elem()
+	for i := 0; i < cur.NumField(); i++ {
+		field := cur.Type().Field(i)
+		if reflect.DeepEqual(cur.Field(i).Interface(), next.Field(i).Interface()) {
+			continue
+		}
+		switch {
+		case reloadableSections[field.Name]:
+			cur.Field(i).Set(next.Field(i))
+			changed = append(changed, yamlName(field))
+		case field.Name == "Log":
+			if published.Log.RemainLogLevel != c.Log.RemainLogLevel {
+				published.Log.RemainLogLevel = c.Log.RemainLogLevel
+				changed = append(changed, "log.remainLogLevel")
+			}
+			if !reflect.DeepEqual(published.Log, c.Log) {
+				restart = append(restart, yamlName(field))
+			}
+		default:
+			restart = append(restart, yamlName(field))
+		}
+	}
+	if len(changed) > 0 {
+		current.Store(&published)
+		for _, f := range reloadHooks {
+			f(changed)
+		}
+	}
+	return changed, restart, nil
+}
@@ -68,6 +68,7 @@ const (
 	BlackAddedNotification                = 1207 //add_black
 	BlackDeletedNotification              = 1208 //remove_black
 	FriendInfoUpdatedNotification         = 1209
+	FriendCategoryChangedNotification     = 1210
 
 	ConversationOptChangeNotification = 1300 // change conversation opt
 
@@ -96,6 +97,8 @@ const (
 	GroupMemberInfoSetNotification           = 1516
 	GroupMemberSetToAdminNotification        = 1517
 	GroupMemberSetToOrdinaryUserNotification = 1518
+	GroupRoleChangedNotification             = 1519
+	GroupTypeChangedNotification             = 1520
 
 	SignalingNotificationBegin = 1600
 	SignalingNotification      = 1601
@@ -236,6 +239,10 @@ const (
 	//callback callbackHandleCode
 	CallbackHandleSuccess = 0
 	CallbackHandleFailed  = 1
+	//callback delivery status
+	CallbackDeliveryPending   = 0
+	CallbackDeliverySucceeded = 1
+	CallbackDeliveryFailed    = 2
 
 	// minioUpload
 	OtherType = 1
@@ -296,6 +303,7 @@ const (
 	FieldEx            = 7
 	FieldUnread        = 8
 	FieldBurnDuration  = 9
+	FieldMuteUntilTime = 10
 )
 
 const (
@@ -316,6 +324,50 @@ const (
 	Female = 2
 )
 
+// cms admin roles and the permissions they grant
+const (
+	AdminRoleSuperAdmin = 1
+	AdminRoleSupport    = 2
+	AdminRoleAuditor    = 3
+
+	AdminStatusNormal   = 0
+	AdminStatusDisabled = 1
+
+	PermStatisticsRead = "statistics:read"
+	PermUserRead       = "user:read"
+	PermUserWrite      = "user:write"
+	PermUserToken      = "user:token"
+	PermGroupRead      = "group:read"
+	PermFriendRead     = "friend:read"
+	PermMessageRead    = "message:read"
+	PermMessageWrite   = "message:write"
+	PermRegisterRead   = "register:read"
+	PermRegisterWrite  = "register:write"
+	PermAdminManage    = "admin:manage"
+	PermAuditRead      = "audit:read"
+	PermCallbackRead   = "callback:read"
+	PermCallbackWrite  = "callback:write"
+	PermGroupWrite     = "group:write"
+)
+
+var AdminRolePermissions = map[int32][]string{
+	AdminRoleSuperAdmin: {PermStatisticsRead, PermUserRead, PermUserWrite, PermUserToken, PermGroupRead, PermFriendRead,
+		PermMessageRead, PermMessageWrite, PermRegisterRead, PermRegisterWrite, PermAdminManage, PermAuditRead,
+		PermCallbackRead, PermCallbackWrite, PermGroupWrite},
+	AdminRoleSupport: {PermUserRead, PermGroupRead, PermFriendRead, PermMessageRead},
+	AdminRoleAuditor: {PermStatisticsRead, PermUserRead, PermGroupRead, PermFriendRead, PermMessageRead, PermRegisterRead, PermAuditRead,
+		PermCallbackRead},
+}
+
+func AdminRoleHasPermission(role int32, permission string) bool {
+	for _, v := range AdminRolePermissions[role] {
+		if v == permission {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	UnreliableNotification    = 1
 	ReliableNotificationNoMsg = 2
@@ -328,6 +380,152 @@ const (
 	Directly                            = 2 //直接进群
 )
 
+const (
+	GroupInviteLinkNormal  = 0
+	GroupInviteLinkRevoked = 1
+)
+
+// group capabilities, a member holds the capabilities of its group role
+const (
+	GroupCapSendMsg int64 = 1 << iota
+	GroupCapSendMedia
+	GroupCapAtAll
+	GroupCapInvite
+	GroupCapPin
+	GroupCapEditInfo
+	GroupCapKick
+	GroupCapMute
+
+	GroupCapAll = GroupCapSendMsg | GroupCapSendMedia | GroupCapAtAll | GroupCapInvite | GroupCapPin | GroupCapEditInfo | GroupCapKick | GroupCapMute
+)
+
+// built-in group roles, a group may change the capabilities of admin and member and add custom roles
+const (
+	GroupRoleOwner  = "owner"
+	GroupRoleAdmin  = "admin"
+	GroupRoleMember = "member"
+)
+
+var DefaultGroupRoleCapabilities = map[string]int64{
+	GroupRoleOwner:  GroupCapAll,
+	GroupRoleAdmin:  GroupCapAll,
+	GroupRoleMember: GroupCapSendMsg | GroupCapSendMedia | GroupCapAtAll | GroupCapInvite,
+}
+
+// GroupMemberRole is the role of a member, a custom role only replaces the member role
+func GroupMemberRole(roleLevel int32, roleID string) string {
+	switch {
+	case roleLevel == GroupOwner:
+		return GroupRoleOwner
+	case roleLevel == GroupAdmin:
+		return GroupRoleAdmin
+	case roleID != "":
+		return roleID
+	default:
+		return GroupRoleMember
+	}
+}
+
+// GroupRoleCapabilities resolves role against the roles a group defined, an unknown role falls back to member
+func GroupRoleCapabilities(role string, groupRoles map[string]int64) int64 {
+	if role == GroupRoleOwner {
+		return GroupCapAll
+	}
+	if capabilities, ok := groupRoles[role]; ok {
+		return capabilities
+	}
+	if capabilities, ok := DefaultGroupRoleCapabilities[role]; ok {
+		return capabilities
+	}
+	if capabilities, ok := groupRoles[GroupRoleMember]; ok {
+		return capabilities
+	}
+	return DefaultGroupRoleCapabilities[GroupRoleMember]
+}
+
+// GroupSuccessionRoleLevels turns the configured succession order into role levels, unknown roles are skipped
+// and an empty order falls back to admins, then members
+func GroupSuccessionRoleLevels(order []string) []int32 {
+	if len(order) == 0 {
+		order = []string{GroupRoleAdmin, GroupRoleMember}
+	}
+	var roleLevels []int32
+	for _, role := range order {
+		switch role {
+		case GroupRoleAdmin:
+			roleLevels = append(roleLevels, GroupAdmin)
+		case GroupRoleMember:
+			roleLevels = append(roleLevels, GroupOrdinaryUsers)
+		}
+	}
+	return roleLevels
+}
+
+// group join rules, reject rules are checked before accept rules and an application matching none waits for an admin
+const (
+	GroupJoinRuleDepartment  = 1 // the applicant is in the department of value or one below it
+	GroupJoinRuleAdminFriend = 2 // the applicant is a friend of the owner or an admin
+	GroupJoinRuleAnswers     = 3 // every question with an expected answer was answered correctly
+	GroupJoinRuleUser        = 4 // the applicant is the user of value, rejecting is a blacklist entry
+
+	GroupJoinRuleAccept = 1
+	GroupJoinRuleReject = 2
+)
+
+// mute status filter of a group member query
+const (
+	GroupMemberMuteAny      = 0
+	GroupMemberMuted        = 1
+	GroupMemberNotMuted     = 2
+	GroupMemberQueryMaxSize = 1000
+)
+
+// group slow mode allows one message per interval per member, the daily quota counts messages per local day
+const (
+	GroupSlowModeMaxSeconds = 86400
+	GroupDailyMsgQuotaMax   = 100000
+)
+
+// a group conversion moves a group between write diffusion and read diffusion storage in resumable steps
+const (
+	GroupConversionRunning = 1
+	GroupConversionDone    = 2
+	GroupConversionFailed  = 3
+
+	GroupConversionStepMembers = 1
+	GroupConversionStepHistory = 2
+	GroupConversionStepSwitch  = 3
+	GroupConversionStepNotify  = 4
+	GroupConversionStepCatchUp = 5
+
+	GroupConversionHistoryDefault = 1000
+	GroupConversionHistoryMax     = 5000
+)
+
+// a group import creates groups with their members and roles in bulk, one transaction per group
+const (
+	GroupImportRunning = 1
+	GroupImportDone    = 2
+
+	GroupImportMaxGroups  = 1000
+	GroupImportMaxMembers = 10000
+	// the members of one import together, which keeps the request within GroupRPCRecvSize
+	GroupImportMaxTotalMembers = 100000
+	// imports up to this many groups finish within the call, larger ones run in the background
+	GroupImportSyncMax = 20
+)
+
+const (
+	FriendCategoryMaxNum     = 100
+	FriendCategoryNameMaxLen = 64
+)
+
+const (
+	// users.recommend_opt
+	FriendRecommendAllowed    = 0
+	FriendRecommendNotAllowed = 1
+)
+
 const (
 	GroupRPCRecvSize = 30
 	GroupRPCSendSize = 30
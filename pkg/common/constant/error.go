@@ -24,6 +24,8 @@ var (
 	ErrTokenKicked              = ErrInfo{706, TokenUserKickedMsg.Error()}
 	ErrTokenDifferentPlatformID = ErrInfo{707, TokenDifferentPlatformIDMsg.Error()}
 	ErrTokenDifferentUserID     = ErrInfo{708, TokenDifferentUserIDMsg.Error()}
+	ErrRefreshTokenInvalid      = ErrInfo{709, RefreshTokenInvalidMsg.Error()}
+	ErrRefreshTokenReused       = ErrInfo{710, RefreshTokenReusedMsg.Error()}
 
 	ErrAccess                = ErrInfo{ErrCode: 801, ErrMsg: AccessMsg.Error()}
 	ErrDB                    = ErrInfo{ErrCode: 802, ErrMsg: DBMsg.Error()}
@@ -34,6 +36,10 @@ var (
 	ErrMessageHasReadDisable = ErrInfo{ErrCode: 811, ErrMsg: "message has read disable"}
 	ErrInternal              = ErrInfo{ErrCode: 812, ErrMsg: "internal error"}
 	ErrWsConnNotExist        = ErrInfo{ErrCode: 813, ErrMsg: "ws conn not exist"}
+	ErrGroupSlowMode         = ErrInfo{ErrCode: 814, ErrMsg: "group slow mode, try again later"}
+	ErrGroupMsgQuota         = ErrInfo{ErrCode: 815, ErrMsg: "group daily message quota exceeded"}
+	ErrGroupJoinRejected     = ErrInfo{ErrCode: 816, ErrMsg: "join group application rejected"}
+	ErrGroupTypeChanged      = ErrInfo{ErrCode: 817, ErrMsg: "group type changed, send with the session type of the group"}
 )
 
 var (
@@ -46,6 +52,8 @@ var (
 	TokenUserKickedMsg          = errors.New("user has been kicked")
 	TokenDifferentPlatformIDMsg = errors.New("different platformID")
 	TokenDifferentUserIDMsg     = errors.New("different userID")
+	RefreshTokenInvalidMsg      = errors.New("refresh token is invalid or expired, please log in again")
+	RefreshTokenReusedMsg       = errors.New("refresh token has been used, all tokens of the session are revoked")
 	AccessMsg                   = errors.New("no permission")
 	StatusMsg                   = errors.New("status is abnormal")
 	DBMsg                       = errors.New("db failed")
@@ -72,6 +80,9 @@ const (
 	RegisterLimit        = 10012
 	LoginLimit           = 10013
 	InvitationError      = 10014
+	AccountLocked        = 10015
+	TwoFactorRequired    = 10016
+	TwoFactorCodeErr     = 10017
 	DatabaseError        = 10002
 	ServerError          = 10004
 	HttpError            = 10005
@@ -0,0 +1,34 @@
+package constant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GroupMemberRole(t *testing.T) {
+	assert.Equal(t, GroupRoleOwner, GroupMemberRole(GroupOwner, "moderator"))
+	assert.Equal(t, GroupRoleAdmin, GroupMemberRole(GroupAdmin, "moderator"))
+	assert.Equal(t, "moderator", GroupMemberRole(GroupOrdinaryUsers, "moderator"))
+	assert.Equal(t, GroupRoleMember, GroupMemberRole(GroupOrdinaryUsers, ""))
+}
+
+func Test_GroupRoleCapabilities(t *testing.T) {
+	readOnly := map[string]int64{GroupRoleMember: 0, "moderator": GroupCapSendMsg | GroupCapMute}
+	assert.Equal(t, GroupCapAll, GroupRoleCapabilities(GroupRoleOwner, map[string]int64{GroupRoleOwner: 0}))
+	assert.Equal(t, GroupCapAll, GroupRoleCapabilities(GroupRoleAdmin, readOnly))
+	assert.Equal(t, int64(0), GroupRoleCapabilities(GroupRoleMember, readOnly))
+	assert.Equal(t, GroupCapSendMsg|GroupCapMute, GroupRoleCapabilities("moderator", readOnly))
+	// a deleted custom role falls back to the member role of the group
+	assert.Equal(t, int64(0), GroupRoleCapabilities("deleted", readOnly))
+	assert.Equal(t, DefaultGroupRoleCapabilities[GroupRoleMember], GroupRoleCapabilities("deleted", nil))
+	assert.Zero(t, GroupRoleCapabilities(GroupRoleMember, nil)&GroupCapKick)
+}
+
+func Test_GroupSuccessionRoleLevels(t *testing.T) {
+	assert.Equal(t, []int32{GroupAdmin, GroupOrdinaryUsers}, GroupSuccessionRoleLevels(nil))
+	assert.Equal(t, []int32{GroupOrdinaryUsers}, GroupSuccessionRoleLevels([]string{GroupRoleMember}))
+	// the owner can not succeed itself and custom roles are members
+	assert.Equal(t, []int32{GroupAdmin}, GroupSuccessionRoleLevels([]string{GroupRoleOwner, "moderator", GroupRoleAdmin}))
+	assert.Empty(t, GroupSuccessionRoleLevels([]string{"moderator"}))
+}
@@ -4,6 +4,7 @@ import (
 	"Open_IM/pkg/common/config"
 	"Open_IM/pkg/common/constant"
 	log2 "Open_IM/pkg/common/log"
+	pbFriend "Open_IM/pkg/proto/friend"
 	pbChat "Open_IM/pkg/proto/msg"
 	pbRtc "Open_IM/pkg/proto/rtc"
 	pbCommon "Open_IM/pkg/proto/sdk_ws"
@@ -26,7 +27,10 @@ const (
 	appleDeviceToken              = "DEVICE_TOKEN"
 	userMinSeq                    = "REDIS_USER_MIN_SEQ:"
 	uidPidToken                   = "UID_PID_TOKEN_STATUS:"
+	uidPidRefreshToken            = "UID_PID_REFRESH_TOKEN:"
+	uidPidRefreshTokenUsed        = "UID_PID_REFRESH_TOKEN_USED:"
 	conversationReceiveMessageOpt = "CON_RECV_MSG_OPT:"
+	conversationMuteUntilTime     = "CON_MUTE_UNTIL_TIME:"
 	getuiToken                    = "GETUI_TOKEN"
 	getuiTaskID                   = "GETUI_TASK_ID"
 	messageCache                  = "MESSAGE_CACHE:"
@@ -40,6 +44,20 @@ const (
 	sendMsgFailedFlag             = "SEND_MSG_FAILED_FLAG:"
 	userBadgeUnreadCountSum       = "USER_BADGE_UNREAD_COUNT_SUM:"
 	exTypeKeyLocker               = "EX_LOCK:"
+	multipartUpload               = "MULTIPART_UPLOAD:"
+	userLoginSession              = "USER_LOGIN_SESSION:"
+	userLoginLock                 = "USER_LOGIN_LOCK:"
+	loginFailedCount              = "LOGIN_FAILED_COUNT:"
+	twoFactorChallenge            = "TWO_FACTOR_CHALLENGE:"
+	oidcLoginState                = "OIDC_LOGIN_STATE:"
+	oidcLoginCode                 = "OIDC_LOGIN_CODE:"
+	groupSlowMode                 = "GROUP_SLOW_MODE:"
+	groupDailyMsgCount            = "GROUP_DAILY_MSG_COUNT:"
+	groupConversionLock           = "GROUP_CONVERSION_LOCK:"
+	groupImportLock               = "GROUP_IMPORT_LOCK:"
+	superGroupMemberBackfill      = "SUPER_GROUP_MEMBER_BACKFILL"
+	friendRecommendation          = "FRIEND_RECOMMENDATION:"
+	transferStoredBatch           = "TRANSFER_STORED_BATCH:"
 
 	//temp
 	superGroupUserNotRecvOfflineMsgOptTemp = "SG_RECV_MSG_OPT_TEMP:"
@@ -63,6 +81,210 @@ func (d *DataBases) GetAccountCode(account string) (string, error) {
 	return d.RDB.Get(context.Background(), key).Result()
 }
 
+func (d *DataBases) GetLoginFailedCount(account string) (int, error) {
+	key := loginFailedCount + account
+	count, err := d.RDB.Get(context.Background(), key).Int()
+	if err == go_redis.Nil {
+		return 0, nil
+	}
+	return count, err
+}
+
+// IncrLoginFailedCount counts a failed attempt, every failure restarts the lock period
+func (d *DataBases) IncrLoginFailedCount(account string, ttl int) (int, error) {
+	key := loginFailedCount + account
+	pipe := d.RDB.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, time.Duration(ttl)*time.Second)
+	_, err := pipe.Exec(context.Background())
+	return int(incr.Val()), err
+}
+
+func (d *DataBases) ResetLoginFailedCount(account string) error {
+	key := loginFailedCount + account
+	return d.RDB.Del(context.Background(), key).Err()
+}
+
+// TakeGroupSlowModeSlot lets userID speak once per interval in groupID, wait is how long is left when the slot is taken
+func (d *DataBases) TakeGroupSlowModeSlot(groupID, userID string, interval time.Duration) (ok bool, wait time.Duration, err error) {
+	key := groupSlowMode + groupID + ":" + userID
+	ok, err = d.RDB.SetNX(context.Background(), key, 1, interval).Result()
+	if err != nil || ok {
+		return ok, 0, err
+	}
+	wait, err = d.RDB.PTTL(context.Background(), key).Result()
+	return false, wait, err
+}
+
+// IncrGroupDailyMsgCount counts the messages userID sent to groupID on day
+func (d *DataBases) IncrGroupDailyMsgCount(groupID, userID, day string) (int, error) {
+	key := groupDailyMsgCount + groupID + ":" + userID + ":" + day
+	pipe := d.RDB.TxPipeline()
+	incr := pipe.Incr(context.Background(), key)
+	pipe.Expire(context.Background(), key, 48*time.Hour)
+	_, err := pipe.Exec(context.Background())
+	return int(incr.Val()), err
+}
+
+func (d *DataBases) DecrGroupDailyMsgCount(groupID, userID, day string) error {
+	key := groupDailyMsgCount + groupID + ":" + userID + ":" + day
+	return d.RDB.Decr(context.Background(), key).Err()
+}
+
+// the lease scripts only touch a key the caller still owns
+var (
+	renewLeaseScript   = go_redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("pexpire", KEYS[1], ARGV[2]) end return 0`)
+	releaseLeaseScript = go_redis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then return redis.call("del", KEYS[1]) end return 0`)
+)
+
+// LockGroupConversion leases the conversion job of a group to owner, the lease lapses after ttl unless it is renewed
+func (d *DataBases) LockGroupConversion(groupID, owner string, ttl time.Duration) (bool, error) {
+	key := groupConversionLock + groupID
+	return d.RDB.SetNX(context.Background(), key, owner, ttl).Result()
+}
+
+// ExtendGroupConversionLock renews the lease of owner, ok is false once owner lost it
+func (d *DataBases) ExtendGroupConversionLock(groupID, owner string, ttl time.Duration) (bool, error) {
+	key := groupConversionLock + groupID
+	n, err := renewLeaseScript.Run(context.Background(), d.RDB, []string{key}, owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+func (d *DataBases) UnlockGroupConversion(groupID, owner string) error {
+	key := groupConversionLock + groupID
+	return releaseLeaseScript.Run(context.Background(), d.RDB, []string{key}, owner).Err()
+}
+
+func (d *DataBases) IsGroupConversionLocked(groupID string) (bool, error) {
+	key := groupConversionLock + groupID
+	n, err := d.RDB.Exists(context.Background(), key).Result()
+	return n > 0, err
+}
+
+// LockSuperGroupMemberBackfill leases the backfill of super group member rows to owner, it fails for good once
+// FinishSuperGroupMemberBackfill was called
+func (d *DataBases) LockSuperGroupMemberBackfill(owner string, ttl time.Duration) (bool, error) {
+	return d.RDB.SetNX(context.Background(), superGroupMemberBackfill, owner, ttl).Result()
+}
+
+// ExtendSuperGroupMemberBackfillLock renews the lease of owner, ok is false once owner lost it
+func (d *DataBases) ExtendSuperGroupMemberBackfillLock(owner string, ttl time.Duration) (bool, error) {
+	n, err := renewLeaseScript.Run(context.Background(), d.RDB, []string{superGroupMemberBackfill}, owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+// FinishSuperGroupMemberBackfill keeps the backfill key without expiry so no instance runs the backfill again
+func (d *DataBases) FinishSuperGroupMemberBackfill() error {
+	return d.RDB.Set(context.Background(), superGroupMemberBackfill, "done", 0).Err()
+}
+
+// LockGroupImport leases an import job to owner, the lease lapses after ttl unless it is renewed
+func (d *DataBases) LockGroupImport(jobID, owner string, ttl time.Duration) (bool, error) {
+	key := groupImportLock + jobID
+	return d.RDB.SetNX(context.Background(), key, owner, ttl).Result()
+}
+
+// ExtendGroupImportLock renews the lease of owner, ok is false once owner lost it
+func (d *DataBases) ExtendGroupImportLock(jobID, owner string, ttl time.Duration) (bool, error) {
+	key := groupImportLock + jobID
+	n, err := renewLeaseScript.Run(context.Background(), d.RDB, []string{key}, owner, ttl.Milliseconds()).Int()
+	return n == 1, err
+}
+
+func (d *DataBases) UnlockGroupImport(jobID, owner string) error {
+	key := groupImportLock + jobID
+	return releaseLeaseScript.Run(context.Background(), d.RDB, []string{key}, owner).Err()
+}
+
+func (d *DataBases) IsGroupImportLocked(jobID string) (bool, error) {
+	key := groupImportLock + jobID
+	n, err := d.RDB.Exists(context.Background(), key).Result()
+	return n > 0, err
+}
+
+// TwoFactorChallenge is a login that passed the password check and waits for the second factor
+type TwoFactorChallenge struct {
+	UserID   string `json:"userID"`
+	Platform int32  `json:"platform"`
+	LoginIP  string `json:"loginIP"`
+}
+
+func (d *DataBases) SetTwoFactorChallenge(challengeID string, challenge *TwoFactorChallenge, ttl int) error {
+	key := twoFactorChallenge + challengeID
+	return d.RDB.Set(context.Background(), key, utils.StructToJsonString(challenge), time.Duration(ttl)*time.Second).Err()
+}
+
+func (d *DataBases) GetTwoFactorChallenge(challengeID string) (*TwoFactorChallenge, error) {
+	key := twoFactorChallenge + challengeID
+	result, err := d.RDB.Get(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+	challenge := &TwoFactorChallenge{}
+	err = utils.JsonStringToStruct(result, challenge)
+	return challenge, err
+}
+
+func (d *DataBases) DelTwoFactorChallenge(challengeID string) error {
+	key := twoFactorChallenge + challengeID
+	return d.RDB.Del(context.Background(), key).Err()
+}
+
+// OIDCLoginState is an sso login waiting for the identity provider callback
+type OIDCLoginState struct {
+	Provider     string `json:"provider"`
+	CodeVerifier string `json:"codeVerifier"`
+	Nonce        string `json:"nonce"`
+	Platform     int32  `json:"platform"`
+	RedirectURI  string `json:"redirectURI"`
+}
+
+// OIDCLoginCode is a finished sso login, the client exchanges the code for the IM token once
+type OIDCLoginCode struct {
+	UserID      string `json:"userID"`
+	Platform    int32  `json:"platform"`
+	LoginIP     string `json:"loginIP"`
+	RedirectURI string `json:"redirectURI"`
+}
+
+func (d *DataBases) SetOIDCLoginCode(code string, loginCode *OIDCLoginCode, ttl int) error {
+	key := oidcLoginCode + code
+	return d.RDB.Set(context.Background(), key, utils.StructToJsonString(loginCode), time.Duration(ttl)*time.Second).Err()
+}
+
+// TakeOIDCLoginCode gets and deletes the code in one transaction so it can only be exchanged once
+func (d *DataBases) TakeOIDCLoginCode(code string) (*OIDCLoginCode, error) {
+	key := oidcLoginCode + code
+	pipe := d.RDB.TxPipeline()
+	get := pipe.Get(context.Background(), key)
+	pipe.Del(context.Background(), key)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return nil, err
+	}
+	loginCode := &OIDCLoginCode{}
+	err := utils.JsonStringToStruct(get.Val(), loginCode)
+	return loginCode, err
+}
+
+func (d *DataBases) SetOIDCLoginState(state string, loginState *OIDCLoginState, ttl int) error {
+	key := oidcLoginState + state
+	return d.RDB.Set(context.Background(), key, utils.StructToJsonString(loginState), time.Duration(ttl)*time.Second).Err()
+}
+
+// TakeOIDCLoginState gets and deletes the state in one transaction so a callback can only be redeemed once
+func (d *DataBases) TakeOIDCLoginState(state string) (*OIDCLoginState, error) {
+	key := oidcLoginState + state
+	pipe := d.RDB.TxPipeline()
+	get := pipe.Get(context.Background(), key)
+	pipe.Del(context.Background(), key)
+	if _, err := pipe.Exec(context.Background()); err != nil {
+		return nil, err
+	}
+	loginState := &OIDCLoginState{}
+	err := utils.JsonStringToStruct(get.Val(), loginState)
+	return loginState, err
+}
+
 //Perform seq auto-increment operation of user messages
 func (d *DataBases) IncrUserSeq(uid string) (uint64, error) {
 	key := userIncrSeq + uid
@@ -118,6 +340,13 @@ func (d *DataBases) IncrGroupMaxSeq(groupID string) (uint64, error) {
 	return uint64(seq), err
 }
 
+// IncrGroupMaxSeqBy takes count seqs of the group at once and returns the last of them
+func (d *DataBases) IncrGroupMaxSeqBy(groupID string, count uint64) (uint64, error) {
+	key := groupMaxSeq + groupID
+	seq, err := d.RDB.IncrBy(context.Background(), key, int64(count)).Result()
+	return uint64(seq), err
+}
+
 func (d *DataBases) SetGroupMaxSeq(groupID string, maxSeq uint64) error {
 	key := groupMaxSeq + groupID
 	return d.RDB.Set(context.Background(), key, maxSeq, 0).Err()
@@ -200,6 +429,52 @@ func (d *DataBases) GetUserGlobalMsgRecvOpt(userID string) (int, error) {
 	}
 	return utils.StringToInt(result), err
 }
+// SetUsersConversationMuteUntilTime caches the mute until time of conversationID for each user, zero included,
+// the per user hash expires so a missed invalidation doesn't outlive expire
+func (d *DataBases) SetUsersConversationMuteUntilTime(muteUntilTimeMap map[string]int64, conversationID string, expire time.Duration) error {
+	ctx := context.Background()
+	pipe := d.RDB.Pipeline()
+	for userID, muteUntilTime := range muteUntilTimeMap {
+		pipe.HSet(ctx, conversationMuteUntilTime+userID, conversationID, muteUntilTime)
+		pipe.Expire(ctx, conversationMuteUntilTime+userID, expire)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DelUsersConversationMuteUntilTime drops the cached mute until time of conversationID, call it after the db is updated
+func (d *DataBases) DelUsersConversationMuteUntilTime(userIDList []string, conversationID string) error {
+	ctx := context.Background()
+	pipe := d.RDB.Pipeline()
+	for _, userID := range userIDList {
+		pipe.HDel(ctx, conversationMuteUntilTime+userID, conversationID)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// returns the cached mute until time(second) of conversationID for each user in userIDList, users not cached are omitted
+func (d *DataBases) GetUsersConversationMuteUntilTime(userIDList []string, conversationID string) (map[string]int64, error) {
+	ctx := context.Background()
+	pipe := d.RDB.Pipeline()
+	cmdList := make([]*go_redis.StringCmd, 0, len(userIDList))
+	for _, userID := range userIDList {
+		cmdList = append(cmdList, pipe.HGet(ctx, conversationMuteUntilTime+userID, conversationID))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != go_redis.Nil {
+		return nil, err
+	}
+	m := make(map[string]int64, len(userIDList))
+	for i, cmd := range cmdList {
+		muteUntilTime, err := cmd.Int64()
+		if err != nil {
+			continue
+		}
+		m[userIDList[i]] = muteUntilTime
+	}
+	return m, nil
+}
+
 func (d *DataBases) GetMessageListBySeq(userID string, seqList []uint32, operationID string) (seqMsg []*pbCommon.MsgData, failedSeqList []uint32, errResult error) {
 	for _, v := range seqList {
 		//MESSAGE_CACHE:169.254.225.224_reliability1653387820_0_1
@@ -526,3 +801,212 @@ func getMessageReactionExPrefix(clientMsgID string, sessionType int32) string {
 	}
 	return ""
 }
+
+// MultipartUpload is an unfinished multipart upload session
+type MultipartUpload struct {
+	UploadID    string `json:"uploadID"`
+	UserID      string `json:"userID"`
+	Hash        string `json:"hash"`
+	ObjectName  string `json:"objectName"`
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	PartSize    int64  `json:"partSize"`
+	PartNum     int    `json:"partNum"`
+	ClientMsgID string `json:"clientMsgID"`
+}
+
+func (d *DataBases) SetMultipartUpload(upload *MultipartUpload, expire time.Duration) error {
+	key := multipartUpload + upload.UploadID
+	return d.RDB.Set(context.Background(), key, utils.StructToJsonString(upload), expire).Err()
+}
+
+func (d *DataBases) GetMultipartUpload(uploadID string) (*MultipartUpload, error) {
+	key := multipartUpload + uploadID
+	result, err := d.RDB.Get(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+	upload := &MultipartUpload{}
+	err = utils.JsonStringToStruct(result, upload)
+	return upload, err
+}
+
+func (d *DataBases) DelMultipartUpload(uploadID string) error {
+	key := multipartUpload + uploadID
+	return d.RDB.Del(context.Background(), key).Err()
+}
+
+// RefreshToken is a rotating refresh token, tokens rotated from the same login share a FamilyID
+type RefreshToken struct {
+	FamilyID    string `json:"familyID"`
+	AccessToken string `json:"accessToken"`
+	ExpireTime  int64  `json:"expireTime"`
+}
+
+// AddRefreshToken stores a refresh token by its hash, the map and used set below are keyed by the hash too
+func (d *DataBases) AddRefreshToken(userID string, platformID int, refreshTokenHash string, info *RefreshToken, expire time.Duration) error {
+	key := uidPidRefreshToken + userID + ":" + constant.PlatformIDToName(platformID)
+	usedKey := uidPidRefreshTokenUsed + userID + ":" + constant.PlatformIDToName(platformID)
+	pipe := d.RDB.TxPipeline()
+	pipe.HSet(context.Background(), key, refreshTokenHash, utils.StructToJsonString(info))
+	pipe.Expire(context.Background(), key, expire)
+	pipe.Expire(context.Background(), usedKey, expire)
+	_, err := pipe.Exec(context.Background())
+	return err
+}
+
+func (d *DataBases) GetRefreshTokenMap(userID string, platformID int) (map[string]*RefreshToken, error) {
+	key := uidPidRefreshToken + userID + ":" + constant.PlatformIDToName(platformID)
+	m, err := d.RDB.HGetAll(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]*RefreshToken)
+	for k, v := range m {
+		info := &RefreshToken{}
+		if err := utils.JsonStringToStruct(v, info); err != nil {
+			log2.NewError("", "unmarshal refresh token failed", key, err.Error())
+			continue
+		}
+		mm[k] = info
+	}
+	return mm, nil
+}
+
+// MarkRefreshTokenUsed returns false if the refresh token was already used
+func (d *DataBases) MarkRefreshTokenUsed(userID string, platformID int, refreshToken string) (bool, error) {
+	key := uidPidRefreshTokenUsed + userID + ":" + constant.PlatformIDToName(platformID)
+	return d.RDB.HSetNX(context.Background(), key, refreshToken, 1).Result()
+}
+
+// GetUsedRefreshTokens returns the refresh tokens that were already rotated
+func (d *DataBases) GetUsedRefreshTokens(userID string, platformID int) (map[string]bool, error) {
+	key := uidPidRefreshTokenUsed + userID + ":" + constant.PlatformIDToName(platformID)
+	tokens, err := d.RDB.HKeys(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+	used := make(map[string]bool, len(tokens))
+	for _, v := range tokens {
+		used[v] = true
+	}
+	return used, nil
+}
+
+func (d *DataBases) DeleteRefreshTokens(userID string, platformID int, refreshTokens []string) error {
+	key := uidPidRefreshToken + userID + ":" + constant.PlatformIDToName(platformID)
+	usedKey := uidPidRefreshTokenUsed + userID + ":" + constant.PlatformIDToName(platformID)
+	pipe := d.RDB.TxPipeline()
+	pipe.HDel(context.Background(), key, refreshTokens...)
+	pipe.HDel(context.Background(), usedKey, refreshTokens...)
+	_, err := pipe.Exec(context.Background())
+	return err
+}
+
+// LoginSession is one login of a user, a rotated refresh token keeps its session and replaces Token
+type LoginSession struct {
+	PlatformID int    `json:"platformID"`
+	Token      string `json:"token"`
+	LoginIP    string `json:"loginIP"`
+	LoginTime  int64  `json:"loginTime"`
+	ExpireTime int64  `json:"expireTime"`
+}
+
+// LockUserLogin serialises the logins of a user, owner releases it with UnlockUserLogin or it lapses after ttl
+func (d *DataBases) LockUserLogin(userID, owner string, ttl time.Duration) (bool, error) {
+	key := userLoginLock + userID
+	return d.RDB.SetNX(context.Background(), key, owner, ttl).Result()
+}
+
+func (d *DataBases) UnlockUserLogin(userID, owner string) error {
+	key := userLoginLock + userID
+	return releaseLeaseScript.Run(context.Background(), d.RDB, []string{key}, owner).Err()
+}
+
+// SetLoginSession keeps the key until the longest living session expires
+func (d *DataBases) SetLoginSession(userID, sessionID string, session *LoginSession, expire time.Duration) error {
+	key := userLoginSession + userID
+	if err := d.RDB.HSet(context.Background(), key, sessionID, utils.StructToJsonString(session)).Err(); err != nil {
+		return err
+	}
+	ttl, err := d.RDB.TTL(context.Background(), key).Result()
+	if err != nil {
+		return err
+	}
+	if ttl < expire {
+		return d.RDB.Expire(context.Background(), key, expire).Err()
+	}
+	return nil
+}
+
+func (d *DataBases) GetLoginSessions(userID string) (map[string]*LoginSession, error) {
+	key := userLoginSession + userID
+	m, err := d.RDB.HGetAll(context.Background(), key).Result()
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]*LoginSession)
+	for k, v := range m {
+		session := &LoginSession{}
+		if err := utils.JsonStringToStruct(v, session); err != nil {
+			log2.NewError("", "unmarshal login session failed", key, err.Error())
+			continue
+		}
+		mm[k] = session
+	}
+	return mm, nil
+}
+
+func (d *DataBases) DeleteLoginSessions(userID string, sessionIDs []string) error {
+	key := userLoginSession + userID
+	return d.RDB.HDel(context.Background(), key, sessionIDs...).Err()
+}
+
+func (d *DataBases) SetFriendRecommendations(userID string, recommendations []*pbFriend.FriendRecommendation, expire time.Duration) error {
+	key := friendRecommendation + userID
+	return d.RDB.Set(context.Background(), key, utils.StructToJsonString(recommendations), expire).Err()
+}
+
+// GetFriendRecommendations returns the cached recommendations with the time left before they expire,
+// go_redis.Nil when nothing has been computed yet
+func (d *DataBases) GetFriendRecommendations(userID string) ([]*pbFriend.FriendRecommendation, time.Duration, error) {
+	key := friendRecommendation + userID
+	ctx := context.Background()
+	pipe := d.RDB.Pipeline()
+	getCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.TTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, 0, err
+	}
+	var recommendations []*pbFriend.FriendRecommendation
+	err := utils.JsonStringToStruct(getCmd.Val(), &recommendations)
+	return recommendations, ttlCmd.Val(), err
+}
+
+func (d *DataBases) DelFriendRecommendations(userID string) error {
+	key := friendRecommendation + userID
+	return d.RDB.Del(context.Background(), key).Err()
+}
+
+// SetTransferStoredOffset records the offset of the last message of aggregationID the transfer stored and pushed
+// from the batch starting at batchOffset, one hash per consumed batch
+func (d *DataBases) SetTransferStoredOffset(topic string, partition int32, batchOffset int64, aggregationID string, offset int64, expire time.Duration) error {
+	key := transferStoredBatch + topic + ":" + strconv.Itoa(int(partition)) + ":" + strconv.FormatInt(batchOffset, 10)
+	ctx := context.Background()
+	pipe := d.RDB.Pipeline()
+	pipe.HSet(ctx, key, aggregationID, offset)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetTransferStoredOffset returns the offset recorded by SetTransferStoredOffset, -1 when nothing of aggregationID was stored
+func (d *DataBases) GetTransferStoredOffset(topic string, partition int32, batchOffset int64, aggregationID string) (int64, error) {
+	key := transferStoredBatch + topic + ":" + strconv.Itoa(int(partition)) + ":" + strconv.FormatInt(batchOffset, 10)
+	offset, err := d.RDB.HGet(context.Background(), key, aggregationID).Int64()
+	if err == go_redis.Nil {
+		return -1, nil
+	}
+	return offset, err
+}
@@ -0,0 +1,121 @@
+package db
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
+	"Open_IM/pkg/utils"
+	"context"
+	"time"
+
+	go_redis "github.com/go-redis/redis/v8"
+	"github.com/golang/protobuf/proto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// GetGroupMsgFromUserChat reads the newest count messages of a normal group sent in [after, before) (ms) from the
+// timeline of one member, oldest first, count 0 reads all of them. Write diffusion keeps a copy of every group message there.
+func (d *DataBases) GetGroupMsgFromUserChat(userID, groupID string, after, before int64, count int) ([]*open_im_sdk.MsgData, error) {
+	maxSeq, err := d.GetUserMaxSeq(userID)
+	if err != nil && err != go_redis.Nil {
+		return nil, utils.Wrap(err, "")
+	}
+	c := d.mongoClient.Database(config.Config.Mongo.DBDatabase).Collection(cChat)
+	var msgList []*open_im_sdk.MsgData
+	full := func() bool { return count > 0 && len(msgList) >= count }
+	for suffix := int64(maxSeq / singleGocMsgNum); suffix >= 0 && !full(); suffix-- {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.Config.Mongo.DBTimeout)*time.Second)
+		var userChat UserChat
+		err := c.FindOne(ctx, bson.M{"uid": indexGen(userID, uint32(suffix))}).Decode(&userChat)
+		cancel()
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			return nil, utils.Wrap(err, "")
+		}
+		reachedAfter := false
+		for i := len(userChat.Msg) - 1; i >= 0 && !full(); i-- {
+			if userChat.Msg[i].SendTime == 0 || userChat.Msg[i].SendTime >= before {
+				continue
+			}
+			if userChat.Msg[i].SendTime < after {
+				reachedAfter = true
+				continue
+			}
+			msg := &open_im_sdk.MsgData{}
+			if err := proto.Unmarshal(userChat.Msg[i].Msg, msg); err != nil {
+				return nil, utils.Wrap(err, "")
+			}
+			if msg.SessionType == constant.GroupChatType && msg.GroupID == groupID {
+				msgList = append(msgList, msg)
+			}
+		}
+		// older documents only hold older messages
+		if reachedAfter {
+			break
+		}
+	}
+	for i, j := 0, len(msgList)-1; i < j; i, j = i+1, j-1 {
+		msgList[i], msgList[j] = msgList[j], msgList[i]
+	}
+	return msgList, nil
+}
+
+// HasUserChatMsgSince reports whether the newest message stored in the timeline of userID was sent at or after since (ms)
+func (d *DataBases) HasUserChatMsgSince(userID string, since int64) (bool, error) {
+	maxSeq, err := d.GetUserMaxSeq(userID)
+	if err != nil && err != go_redis.Nil {
+		return false, utils.Wrap(err, "")
+	}
+	c := d.mongoClient.Database(config.Config.Mongo.DBDatabase).Collection(cChat)
+	// the document of maxSeq may not be written yet, the newest stored message is then in an older one
+	for suffix := int64(maxSeq / singleGocMsgNum); suffix >= 0; suffix-- {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.Config.Mongo.DBTimeout)*time.Second)
+		var userChat UserChat
+		err := c.FindOne(ctx, bson.M{"uid": indexGen(userID, uint32(suffix))}).Decode(&userChat)
+		cancel()
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			return false, utils.Wrap(err, "")
+		}
+		for i := len(userChat.Msg) - 1; i >= 0; i-- {
+			if userChat.Msg[i].SendTime != 0 {
+				return userChat.Msg[i].SendTime >= since, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// GetSuperGroupMaxSeqInRange returns the highest seq in [begin, end] stored in the documents of a super group,
+// begin-1 when none of them is stored yet
+func (d *DataBases) GetSuperGroupMaxSeqInRange(groupID string, begin, end uint64) (uint64, error) {
+	c := d.mongoClient.Database(config.Config.Mongo.DBDatabase).Collection(cChat)
+	maxSeq := begin - 1
+	for suffix := begin / singleGocMsgNum; suffix <= end/singleGocMsgNum; suffix++ {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(config.Config.Mongo.DBTimeout)*time.Second)
+		var groupChat UserChat
+		err := c.FindOne(ctx, bson.M{"uid": indexGen(groupID, uint32(suffix))}).Decode(&groupChat)
+		cancel()
+		if err == mongo.ErrNoDocuments {
+			continue
+		}
+		if err != nil {
+			return 0, utils.Wrap(err, "")
+		}
+		for _, m := range groupChat.Msg {
+			msg := &open_im_sdk.MsgData{}
+			if err := proto.Unmarshal(m.Msg, msg); err != nil {
+				return 0, utils.Wrap(err, "")
+			}
+			if seq := uint64(msg.Seq); seq >= begin && seq <= end && seq > maxSeq {
+				maxSeq = seq
+			}
+		}
+	}
+	return maxSeq, nil
+}
@@ -38,6 +38,7 @@ type Friend struct {
 	AddSource      int32     `gorm:"column:add_source"`
 	OperatorUserID string    `gorm:"column:operator_user_id;size:64"`
 	Ex             string    `gorm:"column:ex;size:1024"`
+	Starred        bool      `gorm:"column:starred"`
 }
 
 // message FriendRequest{
@@ -102,6 +103,8 @@ type Group struct {
 	ApplyMemberFriend      int32     `gorm:"column:apply_member_friend" json:"applyMemberFriend"`
 	NotificationUpdateTime time.Time `gorm:"column:notification_update_time"`
 	NotificationUserID     string    `gorm:"column:notification_user_id;size:64"`
+	SlowModeSeconds        int32     `gorm:"column:slow_mode_seconds" json:"slowModeSeconds"`
+	DailyMsgQuota          int32     `gorm:"column:daily_msg_quota" json:"dailyMsgQuota"`
 }
 
 // message GroupMemberFullInfo {
@@ -117,16 +120,17 @@ type Group struct {
 // int32 AppMangerLevel = 7; //if >0
 // }  open_im_sdk.GroupMemberFullInfo(AppMangerLevel) > imdb.GroupMember
 type GroupMember struct {
-	GroupID        string    `gorm:"column:group_id;primary_key;size:64"`
-	UserID         string    `gorm:"column:user_id;primary_key;size:64"`
+	GroupID        string    `gorm:"column:group_id;primary_key;size:64;index:group_join_time,priority:1;index:group_role_level,priority:1"`
+	UserID         string    `gorm:"column:user_id;primary_key;size:64;index:group_join_time,priority:3"`
 	Nickname       string    `gorm:"column:nickname;size:255"`
 	FaceURL        string    `gorm:"column:user_group_face_url;size:255"`
-	RoleLevel      int32     `gorm:"column:role_level"`
-	JoinTime       time.Time `gorm:"column:join_time"`
+	RoleLevel      int32     `gorm:"column:role_level;index:group_role_level,priority:2"`
+	JoinTime       time.Time `gorm:"column:join_time;index:group_join_time,priority:2"`
 	JoinSource     int32     `gorm:"column:join_source"`
 	InviterUserID  string    `gorm:"column:inviter_user_id;size:64"`
 	OperatorUserID string    `gorm:"column:operator_user_id;size:64"`
 	MuteEndTime    time.Time `gorm:"column:mute_end_time"`
+	RoleID         string    `gorm:"column:role_id;size:64"`
 	Ex             string    `gorm:"column:ex;size:1024"`
 }
 
@@ -153,6 +157,7 @@ type GroupRequest struct {
 	JoinSource    int32     `gorm:"column:join_source"`
 	InviterUserID string    `gorm:"column:inviter_user_id;size:64"`
 	Ex            string    `gorm:"column:ex;size:1024"`
+	Answers       string    `gorm:"column:answers;type:text"`
 }
 
 // string UserID = 1;
@@ -179,6 +184,7 @@ type User struct {
 	CreateTime       time.Time `gorm:"column:create_time;index:create_time"`
 	AppMangerLevel   int32     `gorm:"column:app_manger_level"`
 	GlobalRecvMsgOpt int32     `gorm:"column:global_recv_msg_opt"`
+	RecommendOpt     int32     `gorm:"column:recommend_opt"`
 
 	status int32 `gorm:"column:status"`
 }
@@ -191,6 +197,20 @@ type UserIpRecord struct {
 	LoginTimes    int32     `gorm:"column:login_times"`
 }
 
+// do not disturb schedule, StartTime and EndTime are "15:04" in TimeZone
+type UserDoNotDisturb struct {
+	UserID     string    `gorm:"column:user_id;primary_key;size:64" json:"userID"`
+	Enable     bool      `gorm:"column:enable" json:"enable"`
+	StartTime  string    `gorm:"column:start_time;size:8" json:"startTime"`
+	EndTime    string    `gorm:"column:end_time;size:8" json:"endTime"`
+	TimeZone   string    `gorm:"column:time_zone;size:64" json:"timeZone"`
+	UpdateTime time.Time `gorm:"column:update_time" json:"updateTime"`
+}
+
+func (UserDoNotDisturb) TableName() string {
+	return "user_do_not_disturb"
+}
+
 // ip limit login
 type IpLimit struct {
 	Ip            string    `gorm:"column:ip;primary_key;size:15"`
@@ -267,6 +287,7 @@ type Conversation struct {
 	GroupAtType           int32  `gorm:"column:group_at_type" json:"groupAtType"`
 	IsNotInGroup          bool   `gorm:"column:is_not_in_group" json:"isNotInGroup"`
 	UpdateUnreadCountTime int64  `gorm:"column:update_unread_count_time" json:"updateUnreadCountTime"`
+	MuteUntilTime         int64  `gorm:"column:mute_until_time" json:"muteUntilTime"`
 	AttachedInfo          string `gorm:"column:attached_info;type:varchar(1024)" json:"attachedInfo"`
 	Ex                    string `gorm:"column:ex;type:varchar(1024)" json:"ex"`
 }
@@ -346,6 +367,35 @@ func (RegisterAddFriend) TableName() string {
 	return "register_add_friend"
 }
 
+// UploadObject is a stored file addressed by its content hash, identical files are stored once
+type UploadObject struct {
+	Hash        string    `gorm:"column:hash;primary_key;size:64" json:"hash"`
+	Bucket      string    `gorm:"column:bucket;size:64" json:"bucket"`
+	ObjectName  string    `gorm:"column:object_name;size:255" json:"objectName"`
+	Size        int64     `gorm:"column:size" json:"size"`
+	ContentType string    `gorm:"column:content_type;size:128" json:"contentType"`
+	CreateTime  time.Time `gorm:"column:create_time" json:"createTime"`
+}
+
+func (UploadObject) TableName() string {
+	return "upload_objects"
+}
+
+// UploadRecord links an uploaded object to the user and message it was uploaded for
+type UploadRecord struct {
+	ID          int64     `gorm:"column:id;primary_key;autoIncrement" json:"id"`
+	UserID      string    `gorm:"column:user_id;size:64;index:index_user_id" json:"userID"`
+	Hash        string    `gorm:"column:hash;size:64;index:index_hash" json:"hash"`
+	FileName    string    `gorm:"column:file_name;size:255" json:"fileName"`
+	Size        int64     `gorm:"column:size" json:"size"`
+	ClientMsgID string    `gorm:"column:client_msg_id;size:64" json:"clientMsgID"`
+	CreateTime  time.Time `gorm:"column:create_time" json:"createTime"`
+}
+
+func (UploadRecord) TableName() string {
+	return "upload_records"
+}
+
 type ClientInitConfig struct {
 	DiscoverPageURL string `gorm:"column:discover_page_url;size:64" json:"version"`
 }
@@ -353,3 +403,260 @@ type ClientInitConfig struct {
 func (ClientInitConfig) TableName() string {
 	return "client_init_config"
 }
+
+// AdminAccount is a cms admin, the password is stored as a bcrypt hash
+type AdminAccount struct {
+	AdminID       string    `gorm:"column:admin_id;primary_key;size:64"`
+	Password      string    `gorm:"column:password;size:255"`
+	Nickname      string    `gorm:"column:nickname;size:255"`
+	FaceURL       string    `gorm:"column:face_url;size:255"`
+	Role          int32     `gorm:"column:role"`
+	Status        int32     `gorm:"column:status"`
+	TokenVersion  int64     `gorm:"column:token_version"`
+	CreatorUserID string    `gorm:"column:creator_user_id;size:64"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+	UpdateTime    time.Time `gorm:"column:update_time"`
+}
+
+func (AdminAccount) TableName() string {
+	return "admin_accounts"
+}
+
+// AuditLog is an append-only record of a privileged action, each entry hashes the previous one of its shard
+// so that modified or deleted entries break the chain
+type AuditLog struct {
+	ID          int64     `gorm:"column:id;primary_key;AUTO_INCREMENT"`
+	Shard       int32     `gorm:"column:shard;index:index_shard"`
+	Actor       string    `gorm:"column:actor;size:64;index:index_actor"`
+	Action      string    `gorm:"column:action;size:128;index:index_action"`
+	Target      string    `gorm:"column:target;size:255"`
+	Params      string    `gorm:"column:params;type:text"`
+	SourceIP    string    `gorm:"column:source_ip;size:64"`
+	OperationID string    `gorm:"column:operation_id;size:128"`
+	ErrCode     int32     `gorm:"column:err_code"`
+	ErrMsg      string    `gorm:"column:err_msg;size:255"`
+	CreateTime  time.Time `gorm:"column:create_time;index:index_create_time"`
+	PrevHash    string    `gorm:"column:prev_hash;size:64"`
+	Hash        string    `gorm:"column:hash;size:64"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+// AuditLogChain is the head of the audit log chain of one shard, writers only lock the head of their shard
+type AuditLogChain struct {
+	Shard    int32  `gorm:"column:shard;primary_key;autoIncrement:false"`
+	LastID   int64  `gorm:"column:last_id"`
+	LastHash string `gorm:"column:last_hash;size:64"`
+}
+
+func (AuditLogChain) TableName() string {
+	return "audit_log_chains"
+}
+
+// TwoFactorAuth is the totp second factor of a demo account, recovery codes are stored as sha256 hashes
+type TwoFactorAuth struct {
+	UserID        string    `gorm:"column:user_id;primary_key;size:64"`
+	Secret        string    `gorm:"column:secret;size:64"`
+	Enabled       bool      `gorm:"column:enabled"`
+	RecoveryCodes string    `gorm:"column:recovery_codes;type:text"`
+	LastUsedStep  int64     `gorm:"column:last_used_step"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+	UpdateTime    time.Time `gorm:"column:update_time"`
+}
+
+func (TwoFactorAuth) TableName() string {
+	return "two_factor_auths"
+}
+
+// TrustedDevice skips the second factor, DeviceID is the sha256 of the device token the client keeps
+type TrustedDevice struct {
+	UserID     string    `gorm:"column:user_id;primary_key;size:64"`
+	DeviceID   string    `gorm:"column:device_id;primary_key;size:64"`
+	Platform   int32     `gorm:"column:platform"`
+	LoginIP    string    `gorm:"column:login_ip;size:64"`
+	CreateTime time.Time `gorm:"column:create_time"`
+	ExpireTime time.Time `gorm:"column:expire_time"`
+}
+
+func (TrustedDevice) TableName() string {
+	return "trusted_devices"
+}
+
+// OIDCIdentity links the subject of an identity provider to the user it created, sign ins only reach linked users
+type OIDCIdentity struct {
+	Provider   string    `gorm:"column:provider;primary_key;size:64"`
+	Subject    string    `gorm:"column:subject;primary_key;size:255"`
+	UserID     string    `gorm:"column:user_id;size:64;index:index_user_id"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (OIDCIdentity) TableName() string {
+	return "oidc_identities"
+}
+
+// CallbackDelivery is a callback request sent to a receiver, async deliveries wait here until they succeed or run out of attempts
+type CallbackDelivery struct {
+	ID              int64     `gorm:"column:id;primary_key;AUTO_INCREMENT"`
+	DeliveryID      string    `gorm:"column:delivery_id;size:64;uniqueIndex:index_delivery_id"`
+	Command         string    `gorm:"column:command;size:128;index:index_command"`
+	Url             string    `gorm:"column:url;size:512"`
+	Body            string    `gorm:"column:body;type:mediumtext"`
+	Async           bool      `gorm:"column:async"`
+	Status          int32     `gorm:"column:status;index:index_status_next_attempt,priority:1"`
+	Attempts        int32     `gorm:"column:attempts"`
+	NextAttemptTime time.Time `gorm:"column:next_attempt_time;index:index_status_next_attempt,priority:2"`
+	LockedUntil     time.Time `gorm:"column:locked_until"`
+	ResponseStatus  int32     `gorm:"column:response_status"`
+	LastError       string    `gorm:"column:last_error;size:1024"`
+	CostMs          int64     `gorm:"column:cost_ms"`
+	CreateTime      time.Time `gorm:"column:create_time;index:index_create_time"`
+	UpdateTime      time.Time `gorm:"column:update_time"`
+}
+
+func (CallbackDelivery) TableName() string {
+	return "callback_deliveries"
+}
+
+// GroupInviteLink lets whoever holds Token join GroupID, an ExpireTime at the unix epoch never expires and MaxUses 0 is unlimited
+type GroupInviteLink struct {
+	Token         string    `gorm:"column:token;primary_key;size:64"`
+	GroupID       string    `gorm:"column:group_id;size:64;index:index_group_id"`
+	CreatorUserID string    `gorm:"column:creator_user_id;size:64"`
+	ExpireTime    time.Time `gorm:"column:expire_time"`
+	MaxUses       int32     `gorm:"column:max_uses"`
+	UseCount      int32     `gorm:"column:use_count"`
+	AutoApprove   bool      `gorm:"column:auto_approve"`
+	Status        int32     `gorm:"column:status"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+	Ex            string    `gorm:"column:ex;size:1024"`
+}
+
+func (GroupInviteLink) TableName() string {
+	return "group_invite_links"
+}
+
+// GroupRole is a custom role of a group or its override of the built-in admin and member roles
+type GroupRole struct {
+	GroupID      string    `gorm:"column:group_id;primary_key;size:64"`
+	RoleID       string    `gorm:"column:role_id;primary_key;size:64"`
+	Name         string    `gorm:"column:name;size:255"`
+	Capabilities int64     `gorm:"column:capabilities"`
+	CreateTime   time.Time `gorm:"column:create_time"`
+	UpdateTime   time.Time `gorm:"column:update_time"`
+	Ex           string    `gorm:"column:ex;size:1024"`
+}
+
+func (GroupRole) TableName() string {
+	return "group_roles"
+}
+
+// GroupJoinQuestion is asked of applicants to the group, Answer is the expected answer and empty for a free answer
+type GroupJoinQuestion struct {
+	GroupID    string `gorm:"column:group_id;primary_key;size:64"`
+	QuestionID string `gorm:"column:question_id;primary_key;size:64"`
+	Question   string `gorm:"column:question;size:255"`
+	Answer     string `gorm:"column:answer;size:255"`
+	Seq        int32  `gorm:"column:seq"`
+}
+
+func (GroupJoinQuestion) TableName() string {
+	return "group_join_questions"
+}
+
+// GroupJoinRule accepts or rejects an application to the group without an admin
+type GroupJoinRule struct {
+	GroupID  string `gorm:"column:group_id;primary_key;size:64"`
+	RuleID   string `gorm:"column:rule_id;primary_key;size:64"`
+	RuleType int32  `gorm:"column:rule_type"`
+	Action   int32  `gorm:"column:action"`
+	Value    string `gorm:"column:value;size:64"`
+	Seq      int32  `gorm:"column:seq"`
+}
+
+func (GroupJoinRule) TableName() string {
+	return "group_join_rules"
+}
+
+// GroupConversion is the job that moves a group between write diffusion and read diffusion storage.
+// Step is the next step to run, CopiedCount the history messages already written so a restarted job resumes there.
+type GroupConversion struct {
+	GroupID       string    `gorm:"column:group_id;primary_key;size:64"`
+	FromGroupType int32     `gorm:"column:from_group_type"`
+	ToGroupType   int32     `gorm:"column:to_group_type"`
+	Status        int32     `gorm:"column:status;index:status"`
+	Step          int32     `gorm:"column:step"`
+	HistoryCount  int32     `gorm:"column:history_count"`
+	HistoryBefore int64     `gorm:"column:history_before"`
+	BaseSeq       int64     `gorm:"column:base_seq"`
+	CopiedCount   int32     `gorm:"column:copied_count"`
+	TailBaseSeq   int64     `gorm:"column:tail_base_seq"`
+	TailCount     int32     `gorm:"column:tail_count"`
+	SwitchTime    int64     `gorm:"column:switch_time"`
+	OpUserID      string    `gorm:"column:op_user_id;size:64"`
+	ErrMsg        string    `gorm:"column:err_msg;size:255"`
+	CreateTime    time.Time `gorm:"column:create_time"`
+	UpdateTime    time.Time `gorm:"column:update_time"`
+}
+
+func (GroupConversion) TableName() string {
+	return "group_conversions"
+}
+
+// GroupImportJob is a bulk import of groups, Groups keeps the requested groups as json so an interrupted job resumes.
+// IdempotencyKey is unique, a retried import with the same key returns this job instead of importing again.
+type GroupImportJob struct {
+	JobID          string    `gorm:"column:job_id;primary_key;size:64"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;size:128;uniqueIndex:idempotency_key"`
+	Status         int32     `gorm:"column:status;index:status"`
+	Silent         bool      `gorm:"column:silent"`
+	Total          int32     `gorm:"column:total"`
+	Succeeded      int32     `gorm:"column:succeeded"`
+	Failed         int32     `gorm:"column:failed"`
+	Groups         string    `gorm:"column:import_groups;type:longtext"`
+	OpUserID       string    `gorm:"column:op_user_id;size:64"`
+	CreateTime     time.Time `gorm:"column:create_time"`
+	UpdateTime     time.Time `gorm:"column:update_time"`
+}
+
+func (GroupImportJob) TableName() string {
+	return "group_import_jobs"
+}
+
+// GroupImportResult is the outcome of one group of an import, a created group and its result are written together
+type GroupImportResult struct {
+	JobID   string `gorm:"column:job_id;primary_key;size:64"`
+	GroupID string `gorm:"column:group_id;primary_key;size:64"`
+	Seq     int32  `gorm:"column:seq"`
+	ErrCode int32  `gorm:"column:err_code"`
+	ErrMsg  string `gorm:"column:err_msg;size:255"`
+}
+
+func (GroupImportResult) TableName() string {
+	return "group_import_results"
+}
+
+// FriendCategory is a contact category of a user, a friend can be in several categories of its owner
+type FriendCategory struct {
+	OwnerUserID string    `gorm:"column:owner_user_id;primary_key;size:64;uniqueIndex:owner_name,priority:1"`
+	CategoryID  string    `gorm:"column:category_id;primary_key;size:64"`
+	Name        string    `gorm:"column:name;size:255;uniqueIndex:owner_name,priority:2"`
+	CreateTime  time.Time `gorm:"column:create_time"`
+	UpdateTime  time.Time `gorm:"column:update_time"`
+}
+
+func (FriendCategory) TableName() string {
+	return "friend_categories"
+}
+
+type FriendCategoryMember struct {
+	OwnerUserID  string    `gorm:"column:owner_user_id;primary_key;size:64;index:owner_friend,priority:1"`
+	CategoryID   string    `gorm:"column:category_id;primary_key;size:64"`
+	FriendUserID string    `gorm:"column:friend_user_id;primary_key;size:64;index:owner_friend,priority:2"`
+	CreateTime   time.Time `gorm:"column:create_time"`
+}
+
+func (FriendCategoryMember) TableName() string {
+	return "friend_category_members"
+}
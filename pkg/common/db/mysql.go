@@ -76,7 +76,10 @@ func initMysqlDB() {
 		&GroupRequest{},
 		&User{},
 		&Black{}, &ChatLog{}, &Register{}, &Conversation{}, &AppVersion{}, &Department{}, &BlackList{}, &IpLimit{}, &UserIpLimit{}, &Invitation{}, &RegisterAddFriend{},
-		&ClientInitConfig{}, &UserIpRecord{})
+		&ClientInitConfig{}, &UserIpRecord{}, &UserDoNotDisturb{}, &UploadObject{}, &UploadRecord{}, &AdminAccount{}, &AuditLog{}, &AuditLogChain{},
+		&TwoFactorAuth{}, &TrustedDevice{}, &OIDCIdentity{}, &CallbackDelivery{}, &GroupInviteLink{}, &GroupRole{},
+		&GroupJoinQuestion{}, &GroupJoinRule{}, &GroupConversion{},
+		&GroupImportJob{}, &GroupImportResult{}, &FriendCategory{}, &FriendCategoryMember{})
 	db.Set("gorm:table_options", "CHARSET=utf8")
 	db.Set("gorm:table_options", "collation=utf8_unicode_ci")
 
@@ -143,6 +146,63 @@ func initMysqlDB() {
 	if !db.Migrator().HasTable(&UserIpRecord{}) {
 		db.Migrator().CreateTable(&UserIpRecord{})
 	}
+	if !db.Migrator().HasTable(&UserDoNotDisturb{}) {
+		db.Migrator().CreateTable(&UserDoNotDisturb{})
+	}
+	if !db.Migrator().HasTable(&UploadObject{}) {
+		db.Migrator().CreateTable(&UploadObject{})
+	}
+	if !db.Migrator().HasTable(&UploadRecord{}) {
+		db.Migrator().CreateTable(&UploadRecord{})
+	}
+	if !db.Migrator().HasTable(&AdminAccount{}) {
+		db.Migrator().CreateTable(&AdminAccount{})
+	}
+	if !db.Migrator().HasTable(&AuditLog{}) {
+		db.Migrator().CreateTable(&AuditLog{})
+	}
+	if !db.Migrator().HasTable(&AuditLogChain{}) {
+		db.Migrator().CreateTable(&AuditLogChain{})
+	}
+	if !db.Migrator().HasTable(&TwoFactorAuth{}) {
+		db.Migrator().CreateTable(&TwoFactorAuth{})
+	}
+	if !db.Migrator().HasTable(&TrustedDevice{}) {
+		db.Migrator().CreateTable(&TrustedDevice{})
+	}
+	if !db.Migrator().HasTable(&OIDCIdentity{}) {
+		db.Migrator().CreateTable(&OIDCIdentity{})
+	}
+	if !db.Migrator().HasTable(&CallbackDelivery{}) {
+		db.Migrator().CreateTable(&CallbackDelivery{})
+	}
+	if !db.Migrator().HasTable(&GroupInviteLink{}) {
+		db.Migrator().CreateTable(&GroupInviteLink{})
+	}
+	if !db.Migrator().HasTable(&GroupRole{}) {
+		db.Migrator().CreateTable(&GroupRole{})
+	}
+	if !db.Migrator().HasTable(&GroupJoinQuestion{}) {
+		db.Migrator().CreateTable(&GroupJoinQuestion{})
+	}
+	if !db.Migrator().HasTable(&GroupJoinRule{}) {
+		db.Migrator().CreateTable(&GroupJoinRule{})
+	}
+	if !db.Migrator().HasTable(&GroupConversion{}) {
+		db.Migrator().CreateTable(&GroupConversion{})
+	}
+	if !db.Migrator().HasTable(&GroupImportJob{}) {
+		db.Migrator().CreateTable(&GroupImportJob{})
+	}
+	if !db.Migrator().HasTable(&GroupImportResult{}) {
+		db.Migrator().CreateTable(&GroupImportResult{})
+	}
+	if !db.Migrator().HasTable(&FriendCategory{}) {
+		db.Migrator().CreateTable(&FriendCategory{})
+	}
+	if !db.Migrator().HasTable(&FriendCategoryMember{}) {
+		db.Migrator().CreateTable(&FriendCategoryMember{})
+	}
 	DB.MysqlDB.db = db
 }
 
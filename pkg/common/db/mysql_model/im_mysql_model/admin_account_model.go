@@ -0,0 +1,61 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+func HashAdminPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+func CheckAdminPassword(admin *db.AdminAccount, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)) == nil
+}
+
+func GetAdminAccount(adminID string) (*db.AdminAccount, error) {
+	var admin db.AdminAccount
+	err := db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Where("admin_id=?", adminID).Take(&admin).Error
+	return &admin, err
+}
+
+func GetAdminAccounts(showNumber, pageNumber int32) ([]db.AdminAccount, int64, error) {
+	var admins []db.AdminAccount
+	var count int64
+	if err := db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err := db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Order("create_time").Limit(int(showNumber)).Offset(int(showNumber * (pageNumber - 1))).Find(&admins).Error
+	return admins, count, err
+}
+
+func GetSuperAdminCount() (int64, error) {
+	var count int64
+	err := db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Where("role=? and status=?", constant.AdminRoleSuperAdmin, constant.AdminStatusNormal).Count(&count).Error
+	return count, err
+}
+
+func InsertAdminAccount(admin db.AdminAccount) error {
+	admin.CreateTime = time.Now()
+	admin.UpdateTime = admin.CreateTime
+	return db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Create(&admin).Error
+}
+
+func UpdateAdminAccount(adminID string, args map[string]interface{}) error {
+	args["update_time"] = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Where("admin_id=?", adminID).Updates(args).Error
+}
+
+// RevokeAdminTokens makes every cms token issued to the admin so far fail the version check
+func RevokeAdminTokens(args map[string]interface{}) {
+	args["token_version"] = gorm.Expr("token_version+?", 1)
+}
+
+func DeleteAdminAccount(adminID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("admin_accounts").Where("admin_id=?", adminID).Delete(&db.AdminAccount{}).Error
+}
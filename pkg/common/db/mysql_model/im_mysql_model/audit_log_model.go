@@ -0,0 +1,124 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"errors"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AuditLogFilter struct {
+	Actor     string
+	Action    string
+	Target    string
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// auditLogChains caches the shards whose chain head exists
+var auditLogChains sync.Map
+
+// ensureAuditLogChain creates the head of a shard, a table written before the chain was sharded
+// continues from its last entry
+func ensureAuditLogChain(shard int32) error {
+	if _, ok := auditLogChains.Load(shard); ok {
+		return nil
+	}
+	var count int64
+	if err := db.DB.MysqlDB.DefaultGormDB().Table("audit_log_chains").Where("shard=?", shard).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		var last db.AuditLog
+		err := db.DB.MysqlDB.DefaultGormDB().Table("audit_logs").Where("shard=?", shard).Order("id desc").Limit(1).Take(&last).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		chain := db.AuditLogChain{Shard: shard, LastID: last.ID, LastHash: last.Hash}
+		if err := db.DB.MysqlDB.DefaultGormDB().Table("audit_log_chains").Clauses(clause.OnConflict{DoNothing: true}).Create(&chain).Error; err != nil {
+			return err
+		}
+	}
+	auditLogChains.Store(shard, struct{}{})
+	return nil
+}
+
+// InsertAuditLog appends the entry to the chain of the shard, the head is locked so writers of one shard link in order
+// while writers of other shards go on
+func InsertAuditLog(auditLog *db.AuditLog, shard int32, hash func(auditLog *db.AuditLog) string) error {
+	if err := ensureAuditLogChain(shard); err != nil {
+		return err
+	}
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		var chain db.AuditLogChain
+		if err := tx.Table("audit_log_chains").Clauses(clause.Locking{Strength: "UPDATE"}).Where("shard=?", shard).Take(&chain).Error; err != nil {
+			return err
+		}
+		auditLog.Shard = shard
+		auditLog.PrevHash = chain.LastHash
+		auditLog.Hash = hash(auditLog)
+		if err := tx.Table("audit_logs").Create(auditLog).Error; err != nil {
+			return err
+		}
+		return tx.Table("audit_log_chains").Where("shard=?", shard).Updates(map[string]interface{}{"last_id": auditLog.ID, "last_hash": auditLog.Hash}).Error
+	})
+}
+
+func auditLogQuery(filter *AuditLogFilter) *gorm.DB {
+	query := db.DB.MysqlDB.DefaultGormDB().Table("audit_logs")
+	if filter.Actor != "" {
+		query = query.Where("actor=?", filter.Actor)
+	}
+	if filter.Action != "" {
+		query = query.Where("action=?", filter.Action)
+	}
+	if filter.Target != "" {
+		query = query.Where("target like ?", "%"+filter.Target+"%")
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("create_time>=?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("create_time<=?", filter.EndTime)
+	}
+	return query
+}
+
+func GetAuditLogs(filter *AuditLogFilter, showNumber, pageNumber int32) ([]db.AuditLog, int64, error) {
+	var auditLogs []db.AuditLog
+	var count int64
+	if err := auditLogQuery(filter).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err := auditLogQuery(filter).Order("id desc").Limit(int(showNumber)).Offset(int(showNumber * (pageNumber - 1))).Find(&auditLogs).Error
+	return auditLogs, count, err
+}
+
+// GetAuditLogsAfterID returns entries of the shard in chain order starting after id
+func GetAuditLogsAfterID(shard int32, id int64, limit int) ([]db.AuditLog, error) {
+	var auditLogs []db.AuditLog
+	err := db.DB.MysqlDB.DefaultGormDB().Table("audit_logs").Where("shard=? and id>?", shard, id).Order("id").Limit(limit).Find(&auditLogs).Error
+	return auditLogs, err
+}
+
+// GetAuditLogShards returns every shard that has entries
+func GetAuditLogShards() ([]int32, error) {
+	var shards []int32
+	err := db.DB.MysqlDB.DefaultGormDB().Table("audit_logs").Distinct("shard").Order("shard").Pluck("shard", &shards).Error
+	return shards, err
+}
+
+func GetAuditLogChain(shard int32) (*db.AuditLogChain, error) {
+	var chain db.AuditLogChain
+	err := db.DB.MysqlDB.DefaultGormDB().Table("audit_log_chains").Where("shard=?", shard).Take(&chain).Error
+	return &chain, err
+}
+
+func GetAuditLogsByFilter(filter *AuditLogFilter, limit int) ([]db.AuditLog, error) {
+	var auditLogs []db.AuditLog
+	err := auditLogQuery(filter).Order("id").Limit(limit).Find(&auditLogs).Error
+	return auditLogs, err
+}
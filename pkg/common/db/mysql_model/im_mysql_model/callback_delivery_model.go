@@ -0,0 +1,97 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type CallbackDeliveryFilter struct {
+	Command string
+	// Status below zero matches every status
+	Status    int32
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func InsertCallbackDelivery(delivery *db.CallbackDelivery) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").Create(delivery).Error
+}
+
+func UpdateCallbackDelivery(id int64, args map[string]interface{}) error {
+	args["update_time"] = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").Where("id=?", id).Updates(args).Error
+}
+
+// ClaimDueCallbackDeliveries locks due pending deliveries for lockFor, a delivery claimed by another worker is skipped
+func ClaimDueCallbackDeliveries(now time.Time, lockFor time.Duration, limit int) ([]*db.CallbackDelivery, error) {
+	var due []*db.CallbackDelivery
+	err := db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").
+		Where("status=? and next_attempt_time<=? and locked_until<=?", constant.CallbackDeliveryPending, now, now).
+		Order("next_attempt_time").Limit(limit).Find(&due).Error
+	if err != nil {
+		return nil, err
+	}
+	claimed := due[:0]
+	for _, delivery := range due {
+		result := db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").
+			Where("id=? and status=? and locked_until<=?", delivery.ID, constant.CallbackDeliveryPending, now).
+			Update("locked_until", now.Add(lockFor))
+		if result.Error != nil {
+			return claimed, result.Error
+		}
+		if result.RowsAffected == 1 {
+			claimed = append(claimed, delivery)
+		}
+	}
+	return claimed, nil
+}
+
+// DeleteCallbackDeliveries removes up to limit deliveries of status last updated before the time
+func DeleteCallbackDeliveries(status int32, before time.Time, limit int) (int64, error) {
+	result := db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").Where("status=? and update_time<?", status, before).
+		Limit(limit).Delete(&db.CallbackDelivery{})
+	return result.RowsAffected, result.Error
+}
+
+func callbackDeliveryQuery(filter *CallbackDeliveryFilter) *gorm.DB {
+	query := db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries")
+	if filter.Command != "" {
+		query = query.Where("command=?", filter.Command)
+	}
+	if filter.Status >= 0 {
+		query = query.Where("status=?", filter.Status)
+	}
+	if !filter.StartTime.IsZero() {
+		query = query.Where("create_time>=?", filter.StartTime)
+	}
+	if !filter.EndTime.IsZero() {
+		query = query.Where("create_time<=?", filter.EndTime)
+	}
+	return query
+}
+
+func GetCallbackDeliveries(filter *CallbackDeliveryFilter, showNumber, pageNumber int32) ([]*db.CallbackDelivery, int64, error) {
+	var deliveries []*db.CallbackDelivery
+	var count int64
+	if err := callbackDeliveryQuery(filter).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err := callbackDeliveryQuery(filter).Order("id desc").Limit(int(showNumber)).Offset(int(showNumber * (pageNumber - 1))).Find(&deliveries).Error
+	return deliveries, count, err
+}
+
+func GetCallbackDelivery(deliveryID string) (*db.CallbackDelivery, error) {
+	var delivery db.CallbackDelivery
+	err := db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").Where("delivery_id=?", deliveryID).Take(&delivery).Error
+	return &delivery, err
+}
+
+// RedeliverCallbackDelivery queues a delivery again with a fresh attempt budget
+func RedeliverCallbackDelivery(deliveryID string) error {
+	now := time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("callback_deliveries").Where("delivery_id=?", deliveryID).Updates(map[string]interface{}{
+		"status": constant.CallbackDeliveryPending, "attempts": 0, "async": true, "next_attempt_time": now, "locked_until": now, "update_time": now}).Error
+}
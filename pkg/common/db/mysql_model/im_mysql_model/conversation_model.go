@@ -19,7 +19,7 @@ func SetConversation(conversation db.Conversation) (bool, error) {
 		isUpdate = true
 		return isUpdate, db.DB.MysqlDB.DefaultGormDB().Model(conversation).Where("owner_user_id = ? and conversation_id = ?", conversation.OwnerUserID, conversation.ConversationID).
 			Updates(map[string]interface{}{"recv_msg_opt": conversation.RecvMsgOpt, "is_pinned": conversation.IsPinned, "is_private_chat": conversation.IsPrivateChat,
-				"group_at_type": conversation.GroupAtType, "is_not_in_group": conversation.IsNotInGroup}).Error
+				"group_at_type": conversation.GroupAtType, "is_not_in_group": conversation.IsNotInGroup, "mute_until_time": conversation.MuteUntilTime}).Error
 	}
 }
 func SetOneConversation(conversation db.Conversation) error {
@@ -0,0 +1,137 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrFriendCategoryNameExists = errors.New("category name already exists")
+	ErrFriendCategoryLimit      = errors.New("too many friend categories")
+)
+
+// lockFriendCategories serializes the category writes of an owner on its user row, so the checks before a write hold
+func lockFriendCategories(tx *gorm.DB, ownerUserID string) error {
+	var user db.User
+	return tx.Table("users").Clauses(clause.Locking{Strength: "UPDATE"}).Select("user_id").Where("user_id=?", ownerUserID).Take(&user).Error
+}
+
+func friendCategoryNameExists(tx *gorm.DB, ownerUserID, name, excludeCategoryID string) (bool, error) {
+	var count int64
+	err := tx.Table("friend_categories").Where("owner_user_id=? and name=? and category_id<>?", ownerUserID, name, excludeCategoryID).Count(&count).Error
+	return count > 0, err
+}
+
+func GetFriendCategories(ownerUserID string) ([]*db.FriendCategory, error) {
+	var categories []*db.FriendCategory
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friend_categories").Where("owner_user_id=?", ownerUserID).Order("create_time").Find(&categories).Error
+	return categories, err
+}
+
+func GetFriendCategory(ownerUserID, categoryID string) (*db.FriendCategory, error) {
+	var category db.FriendCategory
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friend_categories").Where("owner_user_id=? and category_id=?", ownerUserID, categoryID).Take(&category).Error
+	return &category, err
+}
+
+func GetFriendCategoryNum(ownerUserID string) (int64, error) {
+	var count int64
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friend_categories").Where("owner_user_id=?", ownerUserID).Count(&count).Error
+	return count, err
+}
+
+// CreateFriendCategory adds the category unless its name is taken or the owner has maxNum categories already
+func CreateFriendCategory(category *db.FriendCategory, maxNum int) error {
+	category.CreateTime = time.Now()
+	category.UpdateTime = category.CreateTime
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := lockFriendCategories(tx, category.OwnerUserID); err != nil {
+			return err
+		}
+		if exists, err := friendCategoryNameExists(tx, category.OwnerUserID, category.Name, category.CategoryID); err != nil {
+			return err
+		} else if exists {
+			return ErrFriendCategoryNameExists
+		}
+		var count int64
+		if err := tx.Table("friend_categories").Where("owner_user_id=?", category.OwnerUserID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count >= int64(maxNum) {
+			return ErrFriendCategoryLimit
+		}
+		return tx.Table("friend_categories").Create(category).Error
+	})
+}
+
+// UpdateFriendCategoryName renames the category unless another category of the owner has the name
+func UpdateFriendCategoryName(ownerUserID, categoryID, name string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := lockFriendCategories(tx, ownerUserID); err != nil {
+			return err
+		}
+		if exists, err := friendCategoryNameExists(tx, ownerUserID, name, categoryID); err != nil {
+			return err
+		} else if exists {
+			return ErrFriendCategoryNameExists
+		}
+		return tx.Table("friend_categories").Where("owner_user_id=? and category_id=?", ownerUserID, categoryID).
+			Updates(map[string]interface{}{"name": name, "update_time": time.Now()}).Error
+	})
+}
+
+// DeleteFriendCategory removes the category and its members, friendUserIDList are the friends that were in it
+func DeleteFriendCategory(ownerUserID, categoryID string) (friendUserIDList []string, err error) {
+	err = db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("friend_category_members").Where("owner_user_id=? and category_id=?", ownerUserID, categoryID).Pluck("friend_user_id", &friendUserIDList).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("friend_category_members").Where("owner_user_id=? and category_id=?", ownerUserID, categoryID).Delete(&db.FriendCategoryMember{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("friend_categories").Where("owner_user_id=? and category_id=?", ownerUserID, categoryID).Delete(&db.FriendCategory{}).Error
+	})
+	return friendUserIDList, err
+}
+
+func GetFriendCategoryMembers(ownerUserID string) ([]*db.FriendCategoryMember, error) {
+	var members []*db.FriendCategoryMember
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friend_category_members").Where("owner_user_id=?", ownerUserID).Order("create_time").Find(&members).Error
+	return members, err
+}
+
+// SetFriendCategories replaces the categories of the friend with categoryIDList
+func SetFriendCategories(ownerUserID, friendUserID string, categoryIDList []string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("friend_category_members").Where("owner_user_id=? and friend_user_id=?", ownerUserID, friendUserID).Delete(&db.FriendCategoryMember{}).Error; err != nil {
+			return err
+		}
+		if len(categoryIDList) == 0 {
+			return nil
+		}
+		now := time.Now()
+		members := make([]*db.FriendCategoryMember, 0, len(categoryIDList))
+		for _, categoryID := range categoryIDList {
+			members = append(members, &db.FriendCategoryMember{OwnerUserID: ownerUserID, CategoryID: categoryID, FriendUserID: friendUserID, CreateTime: now})
+		}
+		return tx.Table("friend_category_members").Create(members).Error
+	})
+}
+
+func DeleteFriendFromCategories(ownerUserID, friendUserID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("friend_category_members").Where("owner_user_id=? and friend_user_id=?", ownerUserID, friendUserID).Delete(&db.FriendCategoryMember{}).Error
+}
+
+func UpdateFriendStarred(ownerUserID, friendUserID string, starred bool) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("friends").Where("owner_user_id=? and friend_user_id=?", ownerUserID, friendUserID).Update("starred", starred).Error
+}
+
+func GetStarredFriendIDList(ownerUserID string) ([]string, error) {
+	var friendIDList []string
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friends").Where("owner_user_id=? and starred=?", ownerUserID, true).Pluck("friend_user_id", &friendIDList).Error
+	return friendIDList, err
+}
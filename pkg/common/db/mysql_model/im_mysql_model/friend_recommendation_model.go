@@ -0,0 +1,21 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+)
+
+// GetPendingFriendRequestUserIDList returns the users with an unhandled friend request from or to userID
+func GetPendingFriendRequestUserIDList(userID string) ([]string, error) {
+	var toUserIDList, fromUserIDList []string
+	if err := db.DB.MysqlDB.DefaultGormDB().Table("friend_requests").Where("from_user_id=? and handle_result=?", userID, 0).Pluck("to_user_id", &toUserIDList).Error; err != nil {
+		return nil, err
+	}
+	if err := db.DB.MysqlDB.DefaultGormDB().Table("friend_requests").Where("to_user_id=? and handle_result=?", userID, 0).Pluck("from_user_id", &fromUserIDList).Error; err != nil {
+		return nil, err
+	}
+	return append(toUserIDList, fromUserIDList...), nil
+}
+
+func UpdateUserRecommendOpt(userID string, recommendOpt int32) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("users").Where("user_id=?", userID).Update("recommend_opt", recommendOpt).Error
+}
@@ -0,0 +1,31 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"time"
+)
+
+func GetGroupConversion(groupID string) (*db.GroupConversion, error) {
+	var conversion db.GroupConversion
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_conversions").Where("group_id=?", groupID).Take(&conversion).Error
+	return &conversion, err
+}
+
+// SaveGroupConversion starts a conversion of the group, it replaces the finished job of an earlier conversion
+func SaveGroupConversion(conversion *db.GroupConversion) error {
+	conversion.CreateTime = time.Now()
+	conversion.UpdateTime = conversion.CreateTime
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_conversions").Save(conversion).Error
+}
+
+func UpdateGroupConversion(groupID string, args map[string]interface{}) error {
+	args["update_time"] = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_conversions").Where("group_id=?", groupID).Updates(args).Error
+}
+
+func GetRunningGroupConversions() ([]*db.GroupConversion, error) {
+	var conversions []*db.GroupConversion
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_conversions").Where("status=?", constant.GroupConversionRunning).Find(&conversions).Error
+	return conversions, err
+}
@@ -0,0 +1,85 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func CreateGroupImportJob(job *db.GroupImportJob) error {
+	job.CreateTime = time.Now()
+	job.UpdateTime = job.CreateTime
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_import_jobs").Create(job).Error
+}
+
+func GetGroupImportJob(jobID string) (*db.GroupImportJob, error) {
+	var job db.GroupImportJob
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_import_jobs").Where("job_id=?", jobID).Take(&job).Error
+	return &job, err
+}
+
+func GetGroupImportJobByIdempotencyKey(idempotencyKey string) (*db.GroupImportJob, error) {
+	var job db.GroupImportJob
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_import_jobs").Where("idempotency_key=?", idempotencyKey).Take(&job).Error
+	return &job, err
+}
+
+func UpdateGroupImportJob(jobID string, args map[string]interface{}) error {
+	args["update_time"] = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_import_jobs").Where("job_id=?", jobID).Updates(args).Error
+}
+
+func GetRunningGroupImportJobIDList() ([]string, error) {
+	var jobIDList []string
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_import_jobs").Where("status=?", constant.GroupImportRunning).Pluck("job_id", &jobIDList).Error
+	return jobIDList, err
+}
+
+// DeleteGroupImportJobs deletes up to limit jobs in status last updated before before together with their results
+func DeleteGroupImportJobs(status int32, before time.Time, limit int) (int, error) {
+	var jobIDList []string
+	err := db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("group_import_jobs").Where("status=? and update_time<?", status, before).Limit(limit).Pluck("job_id", &jobIDList).Error; err != nil {
+			return err
+		}
+		if len(jobIDList) == 0 {
+			return nil
+		}
+		if err := tx.Table("group_import_results").Where("job_id in (?)", jobIDList).Delete(&db.GroupImportResult{}).Error; err != nil {
+			return err
+		}
+		return tx.Table("group_import_jobs").Where("job_id in (?)", jobIDList).Delete(&db.GroupImportJob{}).Error
+	})
+	return len(jobIDList), err
+}
+
+func GetGroupImportResults(jobID string) ([]*db.GroupImportResult, error) {
+	var results []*db.GroupImportResult
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_import_results").Where("job_id=?", jobID).Order("seq").Find(&results).Error
+	return results, err
+}
+
+func InsertGroupImportResult(result *db.GroupImportResult) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_import_results").Create(result).Error
+}
+
+// ImportGroup creates the group with its roles and members and records result in one transaction,
+// a group either exists with all its members and a result or not at all
+func ImportGroup(group *db.Group, roles []*db.GroupRole, members []*db.GroupMember, result *db.GroupImportResult) error {
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("groups").Create(group).Error; err != nil {
+			return err
+		}
+		if len(roles) > 0 {
+			if err := tx.Table("group_roles").Create(roles).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Table("group_members").CreateInBatches(members, 1000).Error; err != nil {
+			return err
+		}
+		return tx.Table("group_import_results").Create(result).Error
+	})
+}
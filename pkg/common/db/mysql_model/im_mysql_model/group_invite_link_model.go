@@ -0,0 +1,44 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"time"
+)
+
+func InsertGroupInviteLink(link *db.GroupInviteLink) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_invite_links").Create(link).Error
+}
+
+func GetGroupInviteLink(token string) (*db.GroupInviteLink, error) {
+	var link db.GroupInviteLink
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_invite_links").Where("token=?", token).Take(&link).Error
+	if err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func GetGroupInviteLinks(groupID string) ([]*db.GroupInviteLink, error) {
+	var links []*db.GroupInviteLink
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_invite_links").Where("group_id=?", groupID).Order("create_time desc").Find(&links).Error
+	return links, err
+}
+
+func RevokeGroupInviteLink(groupID, token string) (bool, error) {
+	result := db.DB.MysqlDB.DefaultGormDB().Table("group_invite_links").Where("group_id=? and token=? and status=?", groupID, token, constant.GroupInviteLinkNormal).
+		Update("status", constant.GroupInviteLinkRevoked)
+	return result.RowsAffected == 1, result.Error
+}
+
+// UseGroupInviteLink counts one use of a link that is still valid at now, false means it is revoked, expired or used up
+func UseGroupInviteLink(token string, now time.Time) (bool, error) {
+	result := db.DB.MysqlDB.DefaultGormDB().Exec("update group_invite_links set use_count=use_count+1 where token=? and status=? and (max_uses=0 or use_count<max_uses) and (expire_time<=? or expire_time>?)",
+		token, constant.GroupInviteLinkNormal, time.Unix(0, 0), now)
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReleaseGroupInviteLink gives back a use counted by UseGroupInviteLink for a join that was not saved
+func ReleaseGroupInviteLink(token string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Exec("update group_invite_links set use_count=use_count-1 where token=? and use_count>0", token).Error
+}
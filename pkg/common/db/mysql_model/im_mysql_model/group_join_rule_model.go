@@ -0,0 +1,50 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+
+	"gorm.io/gorm"
+)
+
+func GetGroupJoinQuestions(groupID string) ([]*db.GroupJoinQuestion, error) {
+	var questions []*db.GroupJoinQuestion
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_join_questions").Where("group_id=?", groupID).Order("seq").Find(&questions).Error
+	return questions, err
+}
+
+func GetGroupJoinRules(groupID string) ([]*db.GroupJoinRule, error) {
+	var rules []*db.GroupJoinRule
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_join_rules").Where("group_id=?", groupID).Order("seq").Find(&rules).Error
+	return rules, err
+}
+
+// SetGroupJoinQuestionnaire replaces the questions and rules of the group
+func SetGroupJoinQuestionnaire(groupID string, questions []*db.GroupJoinQuestion, rules []*db.GroupJoinRule) error {
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("group_join_questions").Where("group_id=?", groupID).Delete(&db.GroupJoinQuestion{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("group_join_rules").Where("group_id=?", groupID).Delete(&db.GroupJoinRule{}).Error; err != nil {
+			return err
+		}
+		if len(questions) > 0 {
+			if err := tx.Table("group_join_questions").Create(questions).Error; err != nil {
+				return err
+			}
+		}
+		if len(rules) > 0 {
+			return tx.Table("group_join_rules").Create(rules).Error
+		}
+		return nil
+	})
+}
+
+// IsFriendOfGroupOwnerAdmin reports whether userID is in the friend list of the owner or an admin of the group
+func IsFriendOfGroupOwnerAdmin(groupID, userID string) (bool, error) {
+	var count int64
+	managers := db.DB.MysqlDB.DefaultGormDB().Table("group_members").Select("user_id").
+		Where("group_id=? and role_level in (?)", groupID, []int32{constant.GroupOwner, constant.GroupAdmin})
+	err := db.DB.MysqlDB.DefaultGormDB().Table("friends").Where("owner_user_id in (?) and friend_user_id=?", managers, userID).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,96 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"strings"
+	"time"
+)
+
+// GroupMemberQuery selects members of a group ordered by join time and user ID,
+// a page starts after AfterJoinTime and AfterUserID when AfterUserID is set.
+// Roles are role names as in constant.GroupMemberRole, zero times leave the join time unbounded.
+type GroupMemberQuery struct {
+	GroupID       string
+	Keyword       string
+	Roles         []string
+	MuteStatus    int32
+	JoinTimeBegin time.Time
+	JoinTimeEnd   time.Time
+	AfterJoinTime time.Time
+	AfterUserID   string
+	Count         int
+}
+
+// likeEscaper escapes the wildcards of a like pattern with the default escape character of mysql
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func groupMemberRoleCondition(roles []string) (string, []interface{}) {
+	var clauses []string
+	var args []interface{}
+	for _, role := range roles {
+		switch role {
+		case constant.GroupRoleOwner:
+			clauses, args = append(clauses, "role_level=?"), append(args, constant.GroupOwner)
+		case constant.GroupRoleAdmin:
+			clauses, args = append(clauses, "role_level=?"), append(args, constant.GroupAdmin)
+		case constant.GroupRoleMember:
+			clauses, args = append(clauses, "(role_level=? and role_id='')"), append(args, constant.GroupOrdinaryUsers)
+		default:
+			clauses, args = append(clauses, "(role_level=? and role_id=?)"), append(args, constant.GroupOrdinaryUsers, role)
+		}
+	}
+	return "(" + strings.Join(clauses, " or ") + ")", args
+}
+
+func QueryGroupMembers(q *GroupMemberQuery) ([]*db.GroupMember, error) {
+	tx := db.DB.MysqlDB.DefaultGormDB().Table("group_members").Where("group_id=?", q.GroupID)
+	if q.Keyword != "" {
+		tx = tx.Where("(user_id=? or nickname like ?)", q.Keyword, "%"+likeEscaper.Replace(q.Keyword)+"%")
+	}
+	if len(q.Roles) > 0 {
+		cond, args := groupMemberRoleCondition(q.Roles)
+		tx = tx.Where(cond, args...)
+	}
+	switch q.MuteStatus {
+	case constant.GroupMemberMuted:
+		tx = tx.Where("mute_end_time>?", time.Now())
+	case constant.GroupMemberNotMuted:
+		tx = tx.Where("mute_end_time<=?", time.Now())
+	}
+	if !q.JoinTimeBegin.IsZero() {
+		tx = tx.Where("join_time>=?", q.JoinTimeBegin)
+	}
+	if !q.JoinTimeEnd.IsZero() {
+		tx = tx.Where("join_time<?", q.JoinTimeEnd)
+	}
+	if q.AfterUserID != "" {
+		tx = tx.Where("(join_time>? or (join_time=? and user_id>?))", q.AfterJoinTime, q.AfterJoinTime, q.AfterUserID)
+	}
+	var members []*db.GroupMember
+	err := tx.Order("join_time, user_id").Limit(q.Count).Find(&members).Error
+	return members, err
+}
+
+// GetGroupMemberRoleCount counts the members of the group by role name
+func GetGroupMemberRoleCount(groupID string) (map[string]int64, error) {
+	var rows []struct {
+		RoleLevel int32
+		RoleID    string
+		Count     int64
+	}
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_members").Select("role_level, role_id, count(*) as count").
+		Where("group_id=?", groupID).Group("role_level, role_id").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	roleCount := make(map[string]int64)
+	for _, row := range rows {
+		roleCount[constant.GroupMemberRole(row.RoleLevel, row.RoleID)] += row.Count
+	}
+	return roleCount, nil
+}
+
+func DeleteGroupMembers(groupID string, userIDList []string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_members").Where("group_id=? and user_id in (?)", groupID, userIDList).Delete(&db.GroupMember{}).Error
+}
@@ -0,0 +1,53 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+func GetGroupRoles(groupID string) ([]*db.GroupRole, error) {
+	var roles []*db.GroupRole
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_roles").Where("group_id=?", groupID).Order("create_time").Find(&roles).Error
+	return roles, err
+}
+
+func GetGroupRole(groupID, roleID string) (*db.GroupRole, error) {
+	var role db.GroupRole
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_roles").Where("group_id=? and role_id=?", groupID, roleID).Take(&role).Error
+	if err != nil {
+		return nil, err
+	}
+	return &role, nil
+}
+
+// SetGroupRole creates the role or replaces its name and capabilities
+func SetGroupRole(role *db.GroupRole) error {
+	now := time.Now()
+	result := db.DB.MysqlDB.DefaultGormDB().Table("group_roles").Where("group_id=? and role_id=?", role.GroupID, role.RoleID).
+		Updates(map[string]interface{}{"name": role.Name, "capabilities": role.Capabilities, "update_time": now})
+	if result.Error != nil || result.RowsAffected == 1 {
+		return result.Error
+	}
+	role.CreateTime, role.UpdateTime = now, now
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_roles").Create(role).Error
+}
+
+// DeleteGroupRole removes the role and hands its members back the member role, userIDList are those members
+func DeleteGroupRole(groupID, roleID string) (userIDList []string, err error) {
+	err = db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("group_members").Where("group_id=? and role_id=?", groupID, roleID).Pluck("user_id", &userIDList).Error; err != nil {
+			return err
+		}
+		if err := tx.Table("group_members").Where("group_id=? and role_id=?", groupID, roleID).Update("role_id", "").Error; err != nil {
+			return err
+		}
+		return tx.Table("group_roles").Where("group_id=? and role_id=?", groupID, roleID).Delete(&db.GroupRole{}).Error
+	})
+	return userIDList, err
+}
+
+func SetGroupMemberRoleID(groupID, userID, roleID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("group_members").Where("group_id=? and user_id=?", groupID, userID).Update("role_id", roleID).Error
+}
@@ -0,0 +1,84 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrGroupHasOwner    = errors.New("group already has an owner")
+	ErrSuccessorChanged = errors.New("successor left the group or changed role")
+)
+
+// OrphanedGroup is a group without an owner who can still run it
+type OrphanedGroup struct {
+	GroupID     string `gorm:"column:group_id"`
+	OwnerUserID string `gorm:"column:owner_user_id"`
+}
+
+// GetGroupSuccessor returns the longest-tenured member of the group with roleLevel, blocked and deleted users are skipped
+func GetGroupSuccessor(groupID string, roleLevel int32, excludeUserID string) (*db.GroupMember, error) {
+	var member db.GroupMember
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_members").
+		Where("group_id=? and role_level=? and user_id<>?", groupID, roleLevel, excludeUserID).
+		Where("user_id in (?)", db.DB.MysqlDB.DefaultGormDB().Table("users").Select("user_id")).
+		Where("user_id not in (?)", db.DB.MysqlDB.DefaultGormDB().Table("black_lists").Select("uid").Where("end_disable_time > now()")).
+		Order("join_time asc, user_id asc").Take(&member).Error
+	return &member, err
+}
+
+// SucceedGroupOwner demotes oldOwnerUserID, when still the owner, and promotes successor in one transaction.
+// The owner rows of the group stay locked meanwhile, so a concurrent succession or transfer can not add a second owner.
+func SucceedGroupOwner(groupID, oldOwnerUserID string, successor *db.GroupMember) error {
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		var ownerUserIDList []string
+		if err := tx.Table("group_members").Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("group_id=? and role_level=?", groupID, constant.GroupOwner).Pluck("user_id", &ownerUserIDList).Error; err != nil {
+			return err
+		}
+		for _, ownerUserID := range ownerUserIDList {
+			if ownerUserID != oldOwnerUserID {
+				return ErrGroupHasOwner
+			}
+		}
+		if len(ownerUserIDList) > 0 {
+			if err := tx.Table("group_members").Where("group_id=? and user_id=? and role_level=?", groupID, oldOwnerUserID, constant.GroupOwner).
+				Update("role_level", constant.GroupOrdinaryUsers).Error; err != nil {
+				return err
+			}
+		}
+		result := tx.Table("group_members").Where("group_id=? and user_id=? and role_level=?", groupID, successor.UserID, successor.RoleLevel).
+			Update("role_level", constant.GroupOwner)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrSuccessorChanged
+		}
+		return nil
+	})
+}
+
+func GetOwnedGroupIDList(userID string) ([]string, error) {
+	var groupIDList []string
+	err := db.DB.MysqlDB.DefaultGormDB().Table("group_members").Where("user_id=? and role_level=?", userID, constant.GroupOwner).Pluck("group_id", &groupIDList).Error
+	return groupIDList, err
+}
+
+// GetOrphanedGroups returns the groups that have no owner, or whose owner is blocked or no longer exists
+func GetOrphanedGroups() ([]*OrphanedGroup, error) {
+	var groups []*OrphanedGroup
+	err := db.DB.MysqlDB.DefaultGormDB().Table("`groups` g").
+		Select("g.group_id, m.user_id as owner_user_id").
+		Joins("left join group_members m on m.group_id = g.group_id and m.role_level = ?", constant.GroupOwner).
+		Where("g.status <> ?", constant.GroupStatusDismissed).
+		Where("m.user_id is null or m.user_id not in (?) or m.user_id in (?)",
+			db.DB.MysqlDB.DefaultGormDB().Table("users").Select("user_id"),
+			db.DB.MysqlDB.DefaultGormDB().Table("black_lists").Select("uid").Where("end_disable_time > now()")).
+		Find(&groups).Error
+	return groups, err
+}
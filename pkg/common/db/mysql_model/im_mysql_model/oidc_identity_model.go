@@ -0,0 +1,20 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+func GetOIDCIdentity(provider, subject string) (*db.OIDCIdentity, error) {
+	var identity db.OIDCIdentity
+	err := db.DB.MysqlDB.DefaultGormDB().Table("oidc_identities").Where("provider=? and subject=?", provider, subject).Take(&identity).Error
+	return &identity, err
+}
+
+// InsertOIDCIdentity keeps the link of a concurrent first sign in, callers read the link back
+func InsertOIDCIdentity(identity *db.OIDCIdentity) error {
+	identity.CreateTime = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("oidc_identities").Clauses(clause.OnConflict{DoNothing: true}).Create(identity).Error
+}
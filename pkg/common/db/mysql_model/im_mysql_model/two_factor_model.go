@@ -0,0 +1,66 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"time"
+)
+
+func GetTwoFactorAuth(userID string) (*db.TwoFactorAuth, error) {
+	var twoFactorAuth db.TwoFactorAuth
+	err := db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Where("user_id=?", userID).Take(&twoFactorAuth).Error
+	return &twoFactorAuth, err
+}
+
+// SetTwoFactorAuth replaces the not yet enabled enrollment of a user
+func SetTwoFactorAuth(twoFactorAuth *db.TwoFactorAuth) error {
+	twoFactorAuth.CreateTime = time.Now()
+	twoFactorAuth.UpdateTime = twoFactorAuth.CreateTime
+	return db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Save(twoFactorAuth).Error
+}
+
+func UpdateTwoFactorAuth(userID string, args map[string]interface{}) error {
+	args["update_time"] = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Where("user_id=?", userID).Updates(args).Error
+}
+
+// UseTwoFactorStep records the totp time step of an accepted code, false if it or a later step was already used
+func UseTwoFactorStep(userID string, step int64) (bool, error) {
+	result := db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Where("user_id=? and last_used_step<?", userID, step).
+		Updates(map[string]interface{}{"last_used_step": step, "update_time": time.Now()})
+	return result.RowsAffected > 0, result.Error
+}
+
+// ReplaceRecoveryCodes stores newCodes only if the codes are still oldCodes, false if a concurrent call changed them first
+func ReplaceRecoveryCodes(userID, oldCodes, newCodes string) (bool, error) {
+	result := db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Where("user_id=? and recovery_codes=?", userID, oldCodes).
+		Updates(map[string]interface{}{"recovery_codes": newCodes, "update_time": time.Now()})
+	return result.RowsAffected > 0, result.Error
+}
+
+func DeleteTwoFactorAuth(userID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("two_factor_auths").Where("user_id=?", userID).Delete(&db.TwoFactorAuth{}).Error
+}
+
+func IsTrustedDevice(userID, deviceID string) bool {
+	var count int64
+	err := db.DB.MysqlDB.DefaultGormDB().Table("trusted_devices").Where("user_id=? and device_id=? and expire_time>?", userID, deviceID, time.Now()).Count(&count).Error
+	return err == nil && count > 0
+}
+
+func InsertTrustedDevice(trustedDevice *db.TrustedDevice) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("trusted_devices").Create(trustedDevice).Error
+}
+
+func GetTrustedDevices(userID string) ([]db.TrustedDevice, error) {
+	var trustedDevices []db.TrustedDevice
+	err := db.DB.MysqlDB.DefaultGormDB().Table("trusted_devices").Where("user_id=? and expire_time>?", userID, time.Now()).Order("create_time desc").Find(&trustedDevices).Error
+	return trustedDevices, err
+}
+
+func DeleteTrustedDevice(userID, deviceID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("trusted_devices").Where("user_id=? and device_id=?", userID, deviceID).Delete(&db.TrustedDevice{}).Error
+}
+
+func DeleteTrustedDevices(userID string) error {
+	return db.DB.MysqlDB.DefaultGormDB().Table("trusted_devices").Where("user_id=?", userID).Delete(&db.TrustedDevice{}).Error
+}
@@ -0,0 +1,63 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrUploadQuotaExceeded = errors.New("upload quota exceeded")
+
+// a missing object is returned with an empty hash
+func GetUploadObjectByHash(hash string) (db.UploadObject, error) {
+	var object db.UploadObject
+	err := db.DB.MysqlDB.DefaultGormDB().Model(&db.UploadObject{}).Where("hash=?", hash).Limit(1).Find(&object).Error
+	return object, err
+}
+
+// InsertUploadObject keeps the object a concurrent upload of the same content inserted first and returns the stored one
+func InsertUploadObject(object db.UploadObject) (db.UploadObject, error) {
+	object.CreateTime = time.Now()
+	if err := db.DB.MysqlDB.DefaultGormDB().Model(&db.UploadObject{}).Clauses(clause.OnConflict{DoNothing: true}).Create(&object).Error; err != nil {
+		return object, err
+	}
+	return GetUploadObjectByHash(object.Hash)
+}
+
+// UserUploadedHash reports whether userID has uploaded the content before, only then it may skip the upload
+func UserUploadedHash(userID, hash string) (bool, error) {
+	var count int64
+	err := db.DB.MysqlDB.DefaultGormDB().Model(&db.UploadRecord{}).Where("user_id=? and hash=?", userID, hash).Count(&count).Error
+	return count > 0, err
+}
+
+// InsertUploadRecord adds the record unless it takes the user over quota, a quota of 0 is unlimited.
+// The check and insert run under the user row lock so concurrent uploads can't overshoot the quota together.
+func InsertUploadRecord(record db.UploadRecord, quota int64) error {
+	record.CreateTime = time.Now()
+	return db.DB.MysqlDB.DefaultGormDB().Transaction(func(tx *gorm.DB) error {
+		if quota > 0 {
+			var user db.User
+			if err := tx.Table("users").Clauses(clause.Locking{Strength: "UPDATE"}).Select("user_id").Where("user_id=?", record.UserID).Take(&user).Error; err != nil {
+				return err
+			}
+			var size int64
+			if err := tx.Model(&db.UploadRecord{}).Where("user_id=?", record.UserID).Select("IFNULL(SUM(size), 0)").Row().Scan(&size); err != nil {
+				return err
+			}
+			if size+record.Size > quota {
+				return ErrUploadQuotaExceeded
+			}
+		}
+		return tx.Model(&db.UploadRecord{}).Create(&record).Error
+	})
+}
+
+func GetUserUploadSize(userID string) (int64, error) {
+	var size int64
+	err := db.DB.MysqlDB.DefaultGormDB().Model(&db.UploadRecord{}).Where("user_id=?", userID).Select("IFNULL(SUM(size), 0)").Row().Scan(&size)
+	return size, err
+}
@@ -0,0 +1,27 @@
+package im_mysql_model
+
+import (
+	"Open_IM/pkg/common/db"
+	"time"
+)
+
+func SetUserDoNotDisturb(doNotDisturb db.UserDoNotDisturb) error {
+	doNotDisturb.UpdateTime = time.Now()
+	m := map[string]interface{}{"enable": doNotDisturb.Enable, "start_time": doNotDisturb.StartTime, "end_time": doNotDisturb.EndTime,
+		"time_zone": doNotDisturb.TimeZone, "update_time": doNotDisturb.UpdateTime}
+	result := db.DB.MysqlDB.DefaultGormDB().Model(&db.UserDoNotDisturb{}).Where("user_id=?", doNotDisturb.UserID).Updates(m)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return db.DB.MysqlDB.DefaultGormDB().Model(&db.UserDoNotDisturb{}).Create(&doNotDisturb).Error
+	}
+	return nil
+}
+
+// an unset schedule is returned as a disabled one
+func GetUserDoNotDisturb(userID string) (db.UserDoNotDisturb, error) {
+	doNotDisturb := db.UserDoNotDisturb{UserID: userID}
+	err := db.DB.MysqlDB.DefaultGormDB().Model(&db.UserDoNotDisturb{}).Where("user_id=?", userID).Limit(1).Find(&doNotDisturb).Error
+	return doNotDisturb, err
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func Test_SetTokenMapByUidPid(t *testing.T) {
@@ -134,6 +135,52 @@ func Test_GetFcmToken(t *testing.T) {
 	fmt.Println("token is :", token)
 }
 
+func Test_TakeGroupSlowModeSlot(t *testing.T) {
+	groupID, userID := "test_slow_mode_group", "test_uid"
+	defer DB.RDB.Del(context.Background(), groupSlowMode+groupID+":"+userID)
+	ok, wait, err := DB.TakeGroupSlowModeSlot(groupID, userID, 2*time.Second)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Zero(t, wait)
+	// the slot is taken until the interval passed
+	ok, wait, err = DB.TakeGroupSlowModeSlot(groupID, userID, 2*time.Second)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.True(t, wait > 0 && wait <= 2*time.Second)
+	// other members have their own slot
+	ok, _, err = DB.TakeGroupSlowModeSlot(groupID, "test_uid2", 2*time.Second)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	DB.RDB.Del(context.Background(), groupSlowMode+groupID+":test_uid2")
+	time.Sleep(2100 * time.Millisecond)
+	ok, _, err = DB.TakeGroupSlowModeSlot(groupID, userID, 2*time.Second)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func Test_IncrGroupDailyMsgCount(t *testing.T) {
+	groupID, userID, day := "test_daily_count_group", "test_uid", "20260101"
+	key := groupDailyMsgCount + groupID + ":" + userID + ":" + day
+	defer DB.RDB.Del(context.Background(), key, groupDailyMsgCount+groupID+":"+userID+":20260102")
+	for i := 1; i <= 3; i++ {
+		count, err := DB.IncrGroupDailyMsgCount(groupID, userID, day)
+		assert.Nil(t, err)
+		assert.Equal(t, i, count)
+	}
+	ttl, err := DB.RDB.TTL(context.Background(), key).Result()
+	assert.Nil(t, err)
+	assert.True(t, ttl > 47*time.Hour)
+	// a refused message gives its count back
+	assert.Nil(t, DB.DecrGroupDailyMsgCount(groupID, userID, day))
+	count, err := DB.IncrGroupDailyMsgCount(groupID, userID, day)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	// the count starts over on the next day
+	count, err = DB.IncrGroupDailyMsgCount(groupID, userID, "20260102")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
+
 //func Test_GetGroupMemberList(t *testing.T) {
 //	groupID := "3791742301"
 //	list, err := DB.GetGroupMemberIDListFromCache(groupID)
@@ -35,6 +35,8 @@ const (
 	conversationIDListCache   = "CONVERSATION_ID_LIST_CACHE:"
 	extendMsgSetCache         = "EXTEND_MSG_SET_CACHE:"
 	extendMsgCache            = "EXTEND_MSG_CACHE:"
+	userDoNotDisturbCache     = "USER_DO_NOT_DISTURB_CACHE:"
+	groupRolesCache           = "GROUP_ROLES_CACHE:"
 )
 
 func DelKeys() {
@@ -593,3 +595,64 @@ func GetExtendMsg(sourceID string, sessionType int32, clientMsgID string, firstM
 func DelExtendMsg(ID string, index int32, clientMsgID string) error {
 	return utils.Wrap(db.DB.Rc.TagAsDeleted(extendMsgCache+clientMsgID), "DelExtendMsg err")
 }
+
+func GetUserDoNotDisturbFromCache(userID string) (*db.UserDoNotDisturb, error) {
+	getUserDoNotDisturb := func() (string, error) {
+		doNotDisturb, err := imdb.GetUserDoNotDisturb(userID)
+		if err != nil {
+			return "", utils.Wrap(err, "GetUserDoNotDisturb failed")
+		}
+		bytes, err := json.Marshal(doNotDisturb)
+		if err != nil {
+			return "", utils.Wrap(err, "Marshal failed")
+		}
+		return string(bytes), nil
+	}
+	doNotDisturbStr, err := db.DB.Rc.Fetch(userDoNotDisturbCache+userID, time.Second*30*60, getUserDoNotDisturb)
+	if err != nil {
+		return nil, utils.Wrap(err, "Fetch failed")
+	}
+	doNotDisturb := &db.UserDoNotDisturb{}
+	err = json.Unmarshal([]byte(doNotDisturbStr), doNotDisturb)
+	if err != nil {
+		return nil, utils.Wrap(err, "Unmarshal failed")
+	}
+	return doNotDisturb, nil
+}
+
+func DelUserDoNotDisturbFromCache(userID string) error {
+	return utils.Wrap(db.DB.Rc.TagAsDeleted(userDoNotDisturbCache+userID), "DelUserDoNotDisturbFromCache err")
+}
+
+// GetGroupRoleCapabilitiesFromCache maps the roles a group defined to their capabilities
+func GetGroupRoleCapabilitiesFromCache(groupID string) (map[string]int64, error) {
+	getGroupRoles := func() (string, error) {
+		roles, err := imdb.GetGroupRoles(groupID)
+		if err != nil {
+			return "", utils.Wrap(err, "GetGroupRoles failed")
+		}
+		capabilities := make(map[string]int64, len(roles))
+		for _, role := range roles {
+			capabilities[role.RoleID] = role.Capabilities
+		}
+		bytes, err := json.Marshal(capabilities)
+		if err != nil {
+			return "", utils.Wrap(err, "Marshal failed")
+		}
+		return string(bytes), nil
+	}
+	rolesStr, err := db.DB.Rc.Fetch(groupRolesCache+groupID, time.Second*30*60, getGroupRoles)
+	if err != nil {
+		return nil, utils.Wrap(err, "Fetch failed")
+	}
+	capabilities := make(map[string]int64)
+	err = json.Unmarshal([]byte(rolesStr), &capabilities)
+	if err != nil {
+		return nil, utils.Wrap(err, "Unmarshal failed")
+	}
+	return capabilities, nil
+}
+
+func DelGroupRolesFromCache(groupID string) error {
+	return utils.Wrap(db.DB.Rc.TagAsDeleted(groupRolesCache+groupID), "DelGroupRolesFromCache err")
+}
@@ -7,12 +7,46 @@
 package kafka
 
 import (
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Shopify/sarama"
 )
 
+// the wait before retrying a failed consume or batch doubles up to the max
+var (
+	consumeRetryBaseInterval = time.Second
+	consumeRetryMaxInterval  = 30 * time.Second
+)
+
+// retryBackoff is a capped exponential backoff
+type retryBackoff struct {
+	base time.Duration
+	max  time.Duration
+	next time.Duration
+}
+
+func newRetryBackoff(base, max time.Duration) *retryBackoff {
+	return &retryBackoff{base: base, max: max, next: base}
+}
+
+// Next returns the wait before the next retry
+func (b *retryBackoff) Next() time.Duration {
+	wait := b.next
+	b.next *= 2
+	if b.next > b.max {
+		b.next = b.max
+	}
+	return wait
+}
+
+func (b *retryBackoff) Reset() {
+	b.next = b.base
+}
+
 type MConsumerGroup struct {
 	sarama.ConsumerGroup
 	groupID string
@@ -44,10 +78,82 @@ func NewMConsumerGroup(consumerConfig *MConsumerGroupConfig, topics, addrs []str
 }
 func (mc *MConsumerGroup) RegisterHandleAndConsumer(handler sarama.ConsumerGroupHandler) {
 	ctx := context.Background()
+	go mc.logErrors()
+	backoff := newRetryBackoff(consumeRetryBaseInterval, consumeRetryMaxInterval)
 	for {
 		err := mc.ConsumerGroup.Consume(ctx, mc.topics, handler)
+		if err == sarama.ErrClosedConsumerGroup {
+			log.NewWarn("", utils.GetSelfFuncName(), "consumer group closed", mc.groupID, mc.topics)
+			return
+		}
 		if err != nil {
-			panic(err.Error())
+			// the session is left and rejoined, consumption restarts from the last marked offsets
+			wait := backoff.Next()
+			log.NewError("", utils.GetSelfFuncName(), "consume failed, retry later", mc.groupID, mc.topics, wait, err.Error())
+			time.Sleep(wait)
+			continue
+		}
+		backoff.Reset()
+	}
+}
+
+func (mc *MConsumerGroup) logErrors() {
+	for err := range mc.ConsumerGroup.Errors() {
+		log.NewError("", utils.GetSelfFuncName(), "consumer group error", mc.groupID, mc.topics, err.Error())
+	}
+}
+
+// BatchHandler handles a batch of messages from one partition, the batch is marked as consumed only when it returns nil
+type BatchHandler func(msgs []*sarama.ConsumerMessage) error
+
+// ConsumeClaimInBatches aggregates the messages of a claim and hands them to handle when batchSize messages
+// are pending or flushInterval elapsed. Pending messages are flushed before returning on a rebalance.
+// A failed batch is handled again with backoff until it succeeds, nothing is marked meanwhile. When the session
+// ends first the error is returned and the batch is redelivered to the member that gets the partition.
+func ConsumeClaimInBatches(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim, batchSize int, flushInterval time.Duration, handle BatchHandler) error {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	pending := make([]*sarama.ConsumerMessage, 0, batchSize)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		backoff := newRetryBackoff(consumeRetryBaseInterval, consumeRetryMaxInterval)
+		for {
+			err := handle(pending)
+			if err == nil {
+				break
+			}
+			wait := backoff.Next()
+			log.NewWarn("", utils.GetSelfFuncName(), "handle batch failed, retry later", claim.Topic(), claim.Partition(), len(pending), wait, err.Error())
+			select {
+			case <-sess.Context().Done():
+				return utils.Wrap(err, "")
+			case <-time.After(wait):
+			}
+		}
+		sess.MarkMessage(pending[len(pending)-1], "")
+		pending = make([]*sarama.ConsumerMessage, 0, batchSize)
+		return nil
+	}
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return flush()
+			}
+			pending = append(pending, msg)
+			if len(pending) >= batchSize {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case <-sess.Context().Done():
+			return flush()
 		}
 	}
 }
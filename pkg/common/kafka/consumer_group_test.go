@@ -0,0 +1,217 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTopic = "ws2ms_chat"
+
+type mockSession struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	mu     sync.Mutex
+	marked []int64
+}
+
+func newMockSession() *mockSession {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &mockSession{ctx: ctx, cancel: cancel}
+}
+
+func (s *mockSession) Claims() map[string][]int32 { return map[string][]int32{testTopic: {0}} }
+func (s *mockSession) MemberID() string           { return "member" }
+func (s *mockSession) GenerationID() int32        { return 1 }
+func (s *mockSession) MarkOffset(_ string, _ int32, offset int64, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.marked = append(s.marked, offset)
+}
+func (s *mockSession) Commit()                                          {}
+func (s *mockSession) ResetOffset(_ string, _ int32, _ int64, _ string) {}
+func (s *mockSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+func (s *mockSession) Context() context.Context { return s.ctx }
+
+func (s *mockSession) markedOffsets() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]int64(nil), s.marked...)
+}
+
+type mockClaim struct {
+	sarama.PartitionConsumer
+}
+
+func (c *mockClaim) Topic() string        { return testTopic }
+func (c *mockClaim) Partition() int32     { return 0 }
+func (c *mockClaim) InitialOffset() int64 { return sarama.OffsetOldest }
+
+// newMockClaim feeds a claim from a sarama mock partition consumer yielding count messages, offsets start at 1
+func newMockClaim(t *testing.T, count int) (*mockClaim, *mocks.PartitionConsumer) {
+	consumer := mocks.NewConsumer(t, nil)
+	expectation := consumer.ExpectConsumePartition(testTopic, 0, sarama.OffsetOldest)
+	for i := 0; i < count; i++ {
+		expectation.YieldMessage(&sarama.ConsumerMessage{Key: []byte("user"), Value: []byte("msg")})
+	}
+	pc, err := consumer.ConsumePartition(testTopic, 0, sarama.OffsetOldest)
+	assert.Nil(t, err)
+	return &mockClaim{PartitionConsumer: pc}, expectation
+}
+
+// chanClaim hands out the messages sent to msgs, a send returns once the claim loop has taken the message
+type chanClaim struct {
+	msgs chan *sarama.ConsumerMessage
+}
+
+func (c *chanClaim) Topic() string                            { return testTopic }
+func (c *chanClaim) Partition() int32                         { return 0 }
+func (c *chanClaim) InitialOffset() int64                     { return sarama.OffsetOldest }
+func (c *chanClaim) HighWaterMarkOffset() int64               { return 0 }
+func (c *chanClaim) Messages() <-chan *sarama.ConsumerMessage { return c.msgs }
+
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]int64
+	err     error
+	// failures is how many calls fail with err before the batches are handled, negative fails every call
+	failures int
+	calls    int
+}
+
+func (r *batchRecorder) handle(msgs []*sarama.ConsumerMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if r.failures != 0 {
+		if r.failures > 0 {
+			r.failures--
+		}
+		return r.err
+	}
+	var offsets []int64
+	for _, msg := range msgs {
+		offsets = append(offsets, msg.Offset)
+	}
+	r.batches = append(r.batches, offsets)
+	return nil
+}
+
+func (r *batchRecorder) handled() [][]int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([][]int64(nil), r.batches...)
+}
+
+func (r *batchRecorder) callCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func shortenConsumeRetry(t *testing.T) {
+	base, max := consumeRetryBaseInterval, consumeRetryMaxInterval
+	consumeRetryBaseInterval, consumeRetryMaxInterval = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() { consumeRetryBaseInterval, consumeRetryMaxInterval = base, max })
+}
+
+func Test_RetryBackoff(t *testing.T) {
+	backoff := newRetryBackoff(time.Second, 5*time.Second)
+	var waits []time.Duration
+	for i := 0; i < 5; i++ {
+		waits = append(waits, backoff.Next())
+	}
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}, waits)
+	backoff.Reset()
+	assert.Equal(t, time.Second, backoff.Next())
+}
+
+func Test_ConsumeClaimInBatches_BatchSize(t *testing.T) {
+	sess := newMockSession()
+	claim, _ := newMockClaim(t, 4)
+	recorder := &batchRecorder{}
+	done := make(chan error)
+	go func() { done <- ConsumeClaimInBatches(sess, claim, 2, time.Hour, recorder.handle) }()
+
+	assert.Eventually(t, func() bool { return len(sess.markedOffsets()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, [][]int64{{1, 2}, {3, 4}}, recorder.handled())
+	assert.Equal(t, []int64{3, 5}, sess.markedOffsets())
+
+	assert.Nil(t, claim.Close())
+	assert.Nil(t, <-done)
+}
+
+func Test_ConsumeClaimInBatches_FlushInterval(t *testing.T) {
+	sess := newMockSession()
+	claim, _ := newMockClaim(t, 3)
+	recorder := &batchRecorder{}
+	done := make(chan error)
+	go func() { done <- ConsumeClaimInBatches(sess, claim, 100, 20*time.Millisecond, recorder.handle) }()
+
+	assert.Eventually(t, func() bool { return len(sess.markedOffsets()) == 1 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, [][]int64{{1, 2, 3}}, recorder.handled())
+	assert.Equal(t, []int64{4}, sess.markedOffsets())
+
+	assert.Nil(t, claim.Close())
+	assert.Nil(t, <-done)
+}
+
+// a failed batch is handled again in the claim and marked once it succeeds
+func Test_ConsumeClaimInBatches_HandleRetry(t *testing.T) {
+	shortenConsumeRetry(t)
+	sess := newMockSession()
+	claim, _ := newMockClaim(t, 2)
+	recorder := &batchRecorder{err: errors.New("redis unavailable"), failures: 2}
+	done := make(chan error)
+	go func() { done <- ConsumeClaimInBatches(sess, claim, 2, time.Hour, recorder.handle) }()
+
+	assert.Eventually(t, func() bool { return len(sess.markedOffsets()) == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, 3, recorder.callCount())
+	assert.Equal(t, [][]int64{{1, 2}}, recorder.handled())
+	assert.Equal(t, []int64{3}, sess.markedOffsets())
+
+	assert.Nil(t, claim.Close())
+	assert.Nil(t, <-done)
+}
+
+// a batch still failing when the session ends is returned unmarked so it is redelivered
+func Test_ConsumeClaimInBatches_HandleError(t *testing.T) {
+	shortenConsumeRetry(t)
+	sess := newMockSession()
+	claim, _ := newMockClaim(t, 2)
+	recorder := &batchRecorder{err: errors.New("redis unavailable"), failures: -1}
+	done := make(chan error)
+	go func() { done <- ConsumeClaimInBatches(sess, claim, 2, time.Hour, recorder.handle) }()
+
+	assert.Eventually(t, func() bool { return recorder.callCount() >= 3 }, time.Second, time.Millisecond)
+	sess.cancel()
+	assert.NotNil(t, <-done)
+	assert.Empty(t, recorder.handled())
+	assert.Empty(t, sess.markedOffsets())
+	assert.Nil(t, claim.Close())
+}
+
+// a rebalance cancels the session context, the pending batch is flushed and marked before the claim returns
+func Test_ConsumeClaimInBatches_Rebalance(t *testing.T) {
+	sess := newMockSession()
+	claim := &chanClaim{msgs: make(chan *sarama.ConsumerMessage)}
+	recorder := &batchRecorder{}
+	done := make(chan error)
+	go func() { done <- ConsumeClaimInBatches(sess, claim, 100, time.Hour, recorder.handle) }()
+
+	for offset := int64(1); offset <= 3; offset++ {
+		claim.msgs <- &sarama.ConsumerMessage{Topic: testTopic, Offset: offset, Key: []byte("user"), Value: []byte("msg")}
+	}
+	sess.cancel()
+	assert.Nil(t, <-done)
+	assert.Equal(t, [][]int64{{1, 2, 3}}, recorder.handled())
+	assert.Equal(t, []int64{4}, sess.markedOffsets())
+}
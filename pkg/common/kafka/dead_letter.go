@@ -0,0 +1,210 @@
+package kafka
+
+import (
+	"Open_IM/pkg/common/config"
+	log "Open_IM/pkg/common/log"
+	pbMsg "Open_IM/pkg/proto/msg"
+	"Open_IM/pkg/utils"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/golang/protobuf/proto"
+)
+
+// DeadLetterAttemptHeader carries how many times a redriven message has been dead-lettered before
+const DeadLetterAttemptHeader = "dead_letter_attempt"
+
+const deadLetterReadTimeout = 5 * time.Second
+
+var ErrDeadLetterDisabled = errors.New("dead letter topic not configured")
+
+type DeadLetterProducer struct {
+	producer *Producer
+}
+
+// NewDeadLetterProducer returns nil when no dead letter topic is configured
+func NewDeadLetterProducer() *DeadLetterProducer {
+	if config.Config.Kafka.DeadLetter.Topic == "" {
+		return nil
+	}
+	return &DeadLetterProducer{producer: NewKafkaProducer(config.Config.Kafka.DeadLetter.Addr, config.Config.Kafka.DeadLetter.Topic)}
+}
+
+// Send captures the raw payload of a message the handler failed to process
+func (d *DeadLetterProducer) Send(cMsg *sarama.ConsumerMessage, handler string, reason error, operationID string) error {
+	if d == nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "dead letter disabled, message dropped", cMsg.Topic, cMsg.Partition, cMsg.Offset, handler, reason.Error())
+		return ErrDeadLetterDisabled
+	}
+	deadLetter := &pbMsg.DeadLetterMsgToMQ{
+		Topic:       cMsg.Topic,
+		Partition:   cMsg.Partition,
+		Offset:      cMsg.Offset,
+		Key:         cMsg.Key,
+		Value:       cMsg.Value,
+		Handler:     handler,
+		ErrMsg:      reason.Error(),
+		Attempt:     GetDeadLetterAttempt(cMsg) + 1,
+		CreateTime:  utils.GetCurrentTimestampByMill(),
+		OperationID: operationID,
+	}
+	key := string(cMsg.Key)
+	if key == "" {
+		key = cMsg.Topic
+	}
+	_, _, err := d.producer.SendMessage(deadLetter, key, operationID)
+	if err != nil {
+		log.NewError(operationID, utils.GetSelfFuncName(), "send dead letter failed", cMsg.Topic, cMsg.Partition, cMsg.Offset, handler, err.Error())
+		return err
+	}
+	log.NewWarn(operationID, utils.GetSelfFuncName(), "message dead-lettered", cMsg.Topic, cMsg.Partition, cMsg.Offset, handler, reason.Error(), deadLetter.Attempt)
+	return nil
+}
+
+func GetDeadLetterAttempt(cMsg *sarama.ConsumerMessage) int32 {
+	for _, header := range cMsg.Headers {
+		if header != nil && string(header.Key) == DeadLetterAttemptHeader {
+			attempt, _ := strconv.Atoi(string(header.Value))
+			return int32(attempt)
+		}
+	}
+	return 0
+}
+
+type DeadLetterRecord struct {
+	Partition  int32
+	Offset     int64
+	DeadLetter *pbMsg.DeadLetterMsgToMQ
+}
+
+func newDeadLetterSaramaConfig() *sarama.Config {
+	c := sarama.NewConfig()
+	c.Version = sarama.V2_0_0_0
+	c.Producer.Return.Successes = true
+	c.Producer.RequiredAcks = sarama.WaitForAll
+	c.Producer.Partitioner = sarama.NewHashPartitioner
+	if config.Config.Kafka.SASLUserName != "" && config.Config.Kafka.SASLPassword != "" {
+		c.Net.SASL.Enable = true
+		c.Net.SASL.User = config.Config.Kafka.SASLUserName
+		c.Net.SASL.Password = config.Config.Kafka.SASLPassword
+	}
+	return c
+}
+
+// GetDeadLetters reads at most showNumber dead letters of a partition starting at offset,
+// it also returns the offset the next message of the partition will get
+func GetDeadLetters(partition int32, offset int64, showNumber int) ([]*DeadLetterRecord, int64, error) {
+	deadLetterConfig := config.Config.Kafka.DeadLetter
+	if deadLetterConfig.Topic == "" {
+		return nil, 0, ErrDeadLetterDisabled
+	}
+	client, err := sarama.NewClient(deadLetterConfig.Addr, newDeadLetterSaramaConfig())
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	defer client.Close()
+	oldest, err := client.GetOffset(deadLetterConfig.Topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	newest, err := client.GetOffset(deadLetterConfig.Topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	if offset < oldest {
+		offset = oldest
+	}
+	if offset >= newest || showNumber <= 0 {
+		return nil, newest, nil
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	defer consumer.Close()
+	partitionConsumer, err := consumer.ConsumePartition(deadLetterConfig.Topic, partition, offset)
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	defer partitionConsumer.Close()
+	var records []*DeadLetterRecord
+	timeout := time.After(deadLetterReadTimeout)
+	for len(records) < showNumber {
+		select {
+		case msg := <-partitionConsumer.Messages():
+			deadLetter := pbMsg.DeadLetterMsgToMQ{}
+			if err := proto.Unmarshal(msg.Value, &deadLetter); err != nil {
+				log.NewError("", utils.GetSelfFuncName(), "unmarshal dead letter failed", partition, msg.Offset, err.Error())
+			}
+			records = append(records, &DeadLetterRecord{Partition: msg.Partition, Offset: msg.Offset, DeadLetter: &deadLetter})
+			if msg.Offset >= newest-1 {
+				return records, newest, nil
+			}
+		case <-timeout:
+			return records, newest, nil
+		}
+	}
+	return records, newest, nil
+}
+
+// RedriveDeadLetter sends the raw payload of a dead letter back to the topic it was consumed from.
+// Only the topics the transfer and push consume can be redriven, and messages over maxAttempt need force.
+func RedriveDeadLetter(partition int32, offset int64, force bool, operationID string) (*pbMsg.DeadLetterMsgToMQ, error) {
+	records, _, err := GetDeadLetters(partition, offset, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 || records[0].Offset != offset {
+		return nil, errors.New("dead letter not found")
+	}
+	deadLetter := records[0].DeadLetter
+	addr, err := redriveAddr(deadLetter, force)
+	if err != nil {
+		return deadLetter, err
+	}
+	producer, err := sarama.NewSyncProducer(addr, newDeadLetterSaramaConfig())
+	if err != nil {
+		return deadLetter, utils.Wrap(err, "")
+	}
+	defer producer.Close()
+	_, _, err = producer.SendMessage(newRedriveMessage(deadLetter))
+	if err != nil {
+		return deadLetter, utils.Wrap(err, "")
+	}
+	log.NewInfo(operationID, utils.GetSelfFuncName(), "dead letter redriven", partition, offset, deadLetter.Topic, deadLetter.Attempt)
+	return deadLetter, nil
+}
+
+// redriveAddr returns the brokers of the topic a dead letter is redriven to
+func redriveAddr(deadLetter *pbMsg.DeadLetterMsgToMQ, force bool) ([]string, error) {
+	var addr []string
+	switch deadLetter.Topic {
+	case config.Config.Kafka.Ws2mschat.Topic:
+		addr = config.Config.Kafka.Ws2mschat.Addr
+	case config.Config.Kafka.Ms2pschat.Topic:
+		addr = config.Config.Kafka.Ms2pschat.Addr
+	case config.Config.Kafka.MsgToMongo.Topic:
+		addr = config.Config.Kafka.MsgToMongo.Addr
+	case config.Config.Kafka.MsgToModify.Topic:
+		addr = config.Config.Kafka.MsgToModify.Addr
+	default:
+		return nil, errors.New("topic can not be redriven " + deadLetter.Topic)
+	}
+	maxAttempt := config.Config.Kafka.DeadLetter.MaxAttempt
+	if !force && maxAttempt > 0 && deadLetter.Attempt >= maxAttempt {
+		return nil, errors.New("dead letter reached max attempt " + strconv.Itoa(int(maxAttempt)))
+	}
+	return addr, nil
+}
+
+// newRedriveMessage restores the raw payload and carries the attempts so far in a header
+func newRedriveMessage(deadLetter *pbMsg.DeadLetterMsgToMQ) *sarama.ProducerMessage {
+	return &sarama.ProducerMessage{
+		Topic:   deadLetter.Topic,
+		Key:     sarama.ByteEncoder(deadLetter.Key),
+		Value:   sarama.ByteEncoder(deadLetter.Value),
+		Headers: []sarama.RecordHeader{{Key: []byte(DeadLetterAttemptHeader), Value: []byte(strconv.Itoa(int(deadLetter.Attempt)))}},
+	}
+}
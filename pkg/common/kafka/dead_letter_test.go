@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"Open_IM/pkg/common/config"
+	pbMsg "Open_IM/pkg/proto/msg"
+	"errors"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetDeadLetterAttempt(t *testing.T) {
+	assert.Equal(t, int32(0), GetDeadLetterAttempt(&sarama.ConsumerMessage{}))
+	cMsg := &sarama.ConsumerMessage{Headers: []*sarama.RecordHeader{nil, {Key: []byte("other"), Value: []byte("7")},
+		{Key: []byte(DeadLetterAttemptHeader), Value: []byte("2")}}}
+	assert.Equal(t, int32(2), GetDeadLetterAttempt(cMsg))
+}
+
+func Test_DeadLetterProducer_Send(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	var deadLetter pbMsg.DeadLetterMsgToMQ
+	syncProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(value []byte) error {
+		return proto.Unmarshal(value, &deadLetter)
+	})
+	d := &DeadLetterProducer{producer: &Producer{topic: "dead_letter", producer: syncProducer}}
+	cMsg := &sarama.ConsumerMessage{Topic: testTopic, Partition: 1, Offset: 9, Key: []byte("user"), Value: []byte("raw"),
+		Headers: []*sarama.RecordHeader{{Key: []byte(DeadLetterAttemptHeader), Value: []byte("1")}}}
+
+	assert.Nil(t, d.Send(cMsg, "handler", errors.New("unmarshal failed"), "op"))
+	assert.Equal(t, testTopic, deadLetter.Topic)
+	assert.Equal(t, int32(1), deadLetter.Partition)
+	assert.Equal(t, int64(9), deadLetter.Offset)
+	assert.Equal(t, []byte("raw"), deadLetter.Value)
+	assert.Equal(t, "handler", deadLetter.Handler)
+	assert.Equal(t, "unmarshal failed", deadLetter.ErrMsg)
+	assert.Equal(t, int32(2), deadLetter.Attempt)
+	assert.Nil(t, syncProducer.Close())
+}
+
+func Test_DeadLetterProducer_SendDisabled(t *testing.T) {
+	var d *DeadLetterProducer
+	assert.Equal(t, ErrDeadLetterDisabled, d.Send(&sarama.ConsumerMessage{Topic: testTopic}, "handler", errors.New("failed"), "op"))
+}
+
+func Test_RedriveAddr(t *testing.T) {
+	maxAttempt := config.Config.Kafka.DeadLetter.MaxAttempt
+	config.Config.Kafka.DeadLetter.MaxAttempt = 3
+	defer func() { config.Config.Kafka.DeadLetter.MaxAttempt = maxAttempt }()
+
+	addr, err := redriveAddr(&pbMsg.DeadLetterMsgToMQ{Topic: config.Config.Kafka.Ws2mschat.Topic, Attempt: 2}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, config.Config.Kafka.Ws2mschat.Addr, addr)
+
+	_, err = redriveAddr(&pbMsg.DeadLetterMsgToMQ{Topic: config.Config.Kafka.Ws2mschat.Topic, Attempt: 3}, false)
+	assert.NotNil(t, err)
+	_, err = redriveAddr(&pbMsg.DeadLetterMsgToMQ{Topic: config.Config.Kafka.Ws2mschat.Topic, Attempt: 3}, true)
+	assert.Nil(t, err)
+
+	_, err = redriveAddr(&pbMsg.DeadLetterMsgToMQ{Topic: "unknown"}, true)
+	assert.NotNil(t, err)
+}
+
+func Test_NewRedriveMessage(t *testing.T) {
+	msg := newRedriveMessage(&pbMsg.DeadLetterMsgToMQ{Topic: testTopic, Key: []byte("user"), Value: []byte("raw"), Attempt: 2})
+	assert.Equal(t, testTopic, msg.Topic)
+	value, _ := msg.Value.Encode()
+	assert.Equal(t, []byte("raw"), value)
+	// the consumer that dead-letters it again counts from this attempt
+	cMsg := &sarama.ConsumerMessage{}
+	for i := range msg.Headers {
+		cMsg.Headers = append(cMsg.Headers, &msg.Headers[i])
+	}
+	assert.Equal(t, int32(2), GetDeadLetterAttempt(cMsg))
+}
@@ -5,6 +5,8 @@ import (
 	log "Open_IM/pkg/common/log"
 	"Open_IM/pkg/utils"
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/golang/protobuf/proto"
@@ -13,10 +15,17 @@ import (
 )
 
 type Producer struct {
-	topic    string
-	addr     []string
-	config   *sarama.Config
-	producer sarama.SyncProducer
+	topic  string
+	addr   []string
+	config *sarama.Config
+
+	mu            sync.RWMutex
+	client        sarama.Client
+	producer      sarama.SyncProducer
+	asyncProducer sarama.AsyncProducer
+
+	spill   *spillBuffer
+	closeCh chan struct{}
 }
 
 func NewKafkaProducer(addr []string, topic string) *Producer {
@@ -31,16 +40,76 @@ func NewKafkaProducer(addr []string, topic string) *Producer {
 		p.config.Net.SASL.User = config.Config.Kafka.SASLUserName
 		p.config.Net.SASL.Password = config.Config.Kafka.SASLPassword
 	}
+	producerConfig := config.Config.Kafka.Producer
+	if producerConfig.Idempotent {
+		// the broker deduplicates retried batches by producer id and sequence number
+		p.config.Producer.Idempotent = true
+		p.config.Net.MaxOpenRequests = 1
+		if !p.config.Version.IsAtLeast(sarama.V0_11_0_0) {
+			p.config.Version = sarama.V2_0_0_0
+		}
+	}
+	if producerConfig.Async {
+		if producerConfig.LingerMs > 0 {
+			p.config.Producer.Flush.Frequency = time.Duration(producerConfig.LingerMs) * time.Millisecond
+		}
+		p.config.Producer.Flush.Messages = producerConfig.BatchSize
+		p.config.Producer.Flush.Bytes = producerConfig.BatchBytes
+	}
 	p.addr = addr
 	p.topic = topic
+	p.closeCh = make(chan struct{})
+
+	if config.Config.Prometheus.Enable {
+		promePkg.NewKafkaProducerSpillBacklogGauge()
+	}
+	if producerConfig.SpillDir != "" {
+		spill, err := newSpillBuffer(producerConfig.SpillDir, topic, producerConfig.SpillMaxBytes)
+		if err != nil {
+			panic(err.Error())
+		}
+		p.spill = spill
+	}
+
+	if err := p.connect(); err != nil {
+		if p.spill == nil {
+			panic(err.Error())
+		}
+		log.NewError("", utils.GetSelfFuncName(), "connect kafka failed, messages will be spilled until it recovers", addr, topic, err.Error())
+	}
+	if p.spill != nil {
+		go p.replayLoop()
+	}
+	return &p
+}
 
-	producer, err := sarama.NewSyncProducer(p.addr, p.config) //Initialize the client
+func (p *Producer) connect() error {
+	client, err := sarama.NewClient(p.addr, p.config) //Initialize the client
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
 	if err != nil {
-		panic(err.Error())
-		return nil
+		client.Close()
+		return utils.Wrap(err, "")
 	}
+	var asyncProducer sarama.AsyncProducer
+	if config.Config.Kafka.Producer.Async {
+		asyncProducer, err = sarama.NewAsyncProducerFromClient(client)
+		if err != nil {
+			producer.Close()
+			client.Close()
+			return utils.Wrap(err, "")
+		}
+		go p.handleAsyncSuccesses(asyncProducer)
+		go p.handleAsyncErrors(asyncProducer)
+	}
+	p.mu.Lock()
+	p.client = client
 	p.producer = producer
-	return &p
+	p.asyncProducer = asyncProducer
+	p.mu.Unlock()
+	return nil
 }
 
 func (p *Producer) SendMessage(m proto.Message, key string, operationID string) (int32, int64, error) {
@@ -63,10 +132,146 @@ func (p *Producer) SendMessage(m proto.Message, key string, operationID string)
 		log.Error(operationID, "kMsg.Key.Length() == 0 || kMsg.Value.Length() == 0 ", kMsg)
 		return -1, -1, errors.New("key or value == 0")
 	}
-	a, b, c := p.producer.SendMessage(kMsg)
+	if p.spill != nil && p.spill.Backlog() > 0 {
+		// queue behind the spilled messages so that messages of a key keep their order
+		return 0, 0, p.spillMessage([]byte(key), bMsg)
+	}
+	p.mu.RLock()
+	producer, asyncProducer := p.producer, p.asyncProducer
+	p.mu.RUnlock()
+	if producer == nil {
+		log.NewWarn(operationID, "kafka not connected, spill message", p.topic, key)
+		return 0, 0, p.spillMessage([]byte(key), bMsg)
+	}
+	if asyncProducer != nil {
+		// delivery result is reported on the successes and errors channels
+		asyncProducer.Input() <- kMsg
+		return 0, 0, nil
+	}
+	a, b, c := producer.SendMessage(kMsg)
 	log.Info(operationID, "ByteEncoder SendMessage end", "key ", kMsg.Key.Length(), kMsg.Value.Length(), p.producer)
 	if c == nil {
 		promePkg.PromeInc(promePkg.SendMsgCounter)
+		return a, b, nil
+	}
+	if p.spill != nil {
+		log.NewWarn(operationID, "kafka SendMessage failed, spill message", p.topic, key, c.Error())
+		return 0, 0, p.spillMessage([]byte(key), bMsg)
 	}
 	return a, b, utils.Wrap(c, "")
 }
+
+func (p *Producer) spillMessage(key, value []byte) error {
+	if p.spill == nil {
+		return errors.New("kafka not connected and spill disabled")
+	}
+	return p.spill.Write(key, value)
+}
+
+func (p *Producer) handleAsyncSuccesses(asyncProducer sarama.AsyncProducer) {
+	for range asyncProducer.Successes() {
+		promePkg.PromeInc(promePkg.SendMsgCounter)
+	}
+}
+
+func (p *Producer) handleAsyncErrors(asyncProducer sarama.AsyncProducer) {
+	for producerErr := range asyncProducer.Errors() {
+		log.NewError("", utils.GetSelfFuncName(), "async send failed", p.topic, producerErr.Err.Error())
+		key, _ := producerErr.Msg.Key.Encode()
+		value, _ := producerErr.Msg.Value.Encode()
+		if err := p.spillMessage(key, value); err != nil {
+			log.NewError("", utils.GetSelfFuncName(), "spill message failed, message dropped", p.topic, string(key), err.Error())
+		}
+	}
+}
+
+// replayLoop reconnects to kafka when the initial connection failed and
+// resends spilled messages once the topic metadata can be refreshed again
+func (p *Producer) replayLoop() {
+	interval := time.Duration(config.Config.Kafka.Producer.ReplayInterval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.closeCh:
+			return
+		case <-ticker.C:
+		}
+		p.mu.RLock()
+		client, producer := p.client, p.producer
+		p.mu.RUnlock()
+		if client == nil {
+			if err := p.connect(); err != nil {
+				log.NewWarn("", utils.GetSelfFuncName(), "reconnect kafka failed", p.addr, p.topic, err.Error())
+				continue
+			}
+			log.NewInfo("", utils.GetSelfFuncName(), "reconnect kafka success", p.addr, p.topic)
+			p.mu.RLock()
+			client, producer = p.client, p.producer
+			p.mu.RUnlock()
+		}
+		if p.spill.Backlog() == 0 {
+			continue
+		}
+		if err := client.RefreshMetadata(p.topic); err != nil {
+			log.NewWarn("", utils.GetSelfFuncName(), "kafka still unavailable", p.topic, err.Error(), p.spill.Backlog())
+			continue
+		}
+		// new sends are spilled while a backlog exists, replay until it is drained
+		for p.spill.Backlog() > 0 {
+			err := p.spill.Replay(func(records []spillRecord) (int, error) {
+				return p.replayRecords(producer, records)
+			})
+			if err != nil {
+				log.NewError("", utils.GetSelfFuncName(), "replay spilled messages failed", p.topic, err.Error(), p.spill.Backlog())
+				break
+			}
+		}
+		log.NewInfo("", utils.GetSelfFuncName(), "replay spilled messages finished", p.topic, p.spill.Backlog())
+	}
+}
+
+// replayRecords sends records in order and returns how many were delivered before the first failure
+func (p *Producer) replayRecords(producer sarama.SyncProducer, records []spillRecord) (int, error) {
+	batchSize := config.Config.Kafka.Producer.BatchSize
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	for sent := 0; sent < len(records); sent += batchSize {
+		end := sent + batchSize
+		if end > len(records) {
+			end = len(records)
+		}
+		msgs := make([]*sarama.ProducerMessage, 0, end-sent)
+		for _, record := range records[sent:end] {
+			msgs = append(msgs, &sarama.ProducerMessage{Topic: p.topic, Key: sarama.ByteEncoder(record.key), Value: sarama.ByteEncoder(record.value)})
+		}
+		if err := producer.SendMessages(msgs); err != nil {
+			return sent, utils.Wrap(err, "")
+		}
+		promePkg.PromeAdd(promePkg.SendMsgCounter, len(msgs))
+	}
+	return len(records), nil
+}
+
+func (p *Producer) Close() error {
+	close(p.closeCh)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.asyncProducer != nil {
+		p.asyncProducer.Close()
+	}
+	if p.producer != nil {
+		p.producer.Close()
+	}
+	if p.client != nil {
+		p.client.Close()
+	}
+	if p.spill != nil {
+		return p.spill.Close()
+	}
+	return nil
+}
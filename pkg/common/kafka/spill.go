@@ -0,0 +1,259 @@
+package kafka
+
+import (
+	"Open_IM/pkg/utils"
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	promePkg "Open_IM/pkg/common/prometheus"
+)
+
+const (
+	spillSegmentSuffix   = ".spill"
+	spillSegmentMaxBytes = 16 * 1024 * 1024
+	spillRecordHeaderLen = 8
+)
+
+var ErrSpillFull = errors.New("kafka spill buffer is full")
+
+type spillRecord struct {
+	key   []byte
+	value []byte
+}
+
+// spillBuffer keeps messages that could not be delivered to kafka on local disk,
+// one directory per topic and process instance, split into append-only segment files
+type spillBuffer struct {
+	dir          string
+	topic        string
+	maxBytes     int64
+	segmentBytes int64
+
+	mu          sync.Mutex
+	file        *os.File
+	fileSize    int64
+	totalBytes  int64
+	recordCount int64
+}
+
+func newSpillBuffer(dir, topic string, maxBytes int64) (*spillBuffer, error) {
+	s := &spillBuffer{dir: filepath.Join(dir, topic, spillInstanceName()), topic: topic, maxBytes: maxBytes, segmentBytes: spillSegmentMaxBytes}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	segments, err := s.segments()
+	if err != nil {
+		return nil, err
+	}
+	for _, segment := range segments {
+		records, size, err := readSpillSegment(segment)
+		if err != nil {
+			return nil, err
+		}
+		s.totalBytes += size
+		s.recordCount += int64(len(records))
+	}
+	s.updateGauge()
+	return s, nil
+}
+
+// spillInstanceName names the process by its binary and command line, so that processes on a host
+// do not share segments while a restarted instance finds the segments it left behind
+func spillInstanceName() string {
+	return filepath.Base(os.Args[0]) + "_" + utils.Md5(strings.Join(os.Args[1:], " "))[:8]
+}
+
+func (s *spillBuffer) Write(key, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	recordLen := int64(spillRecordHeaderLen + len(key) + len(value))
+	if s.maxBytes > 0 && s.totalBytes+recordLen > s.maxBytes {
+		return ErrSpillFull
+	}
+	if s.file == nil || s.fileSize+recordLen > s.segmentBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+	buf := make([]byte, recordLen)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(key)))
+	binary.BigEndian.PutUint32(buf[4:8], uint32(len(value)))
+	copy(buf[spillRecordHeaderLen:], key)
+	copy(buf[spillRecordHeaderLen+len(key):], value)
+	if _, err := s.file.Write(buf); err != nil {
+		return utils.Wrap(err, "")
+	}
+	s.fileSize += recordLen
+	s.totalBytes += recordLen
+	s.recordCount++
+	s.updateGauge()
+	return nil
+}
+
+func (s *spillBuffer) Backlog() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.recordCount
+}
+
+// Replay sends every spilled record through send, oldest segment first.
+// Records of a segment that fail to send are written back and replay stops.
+func (s *spillBuffer) Replay(send func(records []spillRecord) (int, error)) error {
+	s.mu.Lock()
+	err := s.closeFile()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	segments, err := s.segments()
+	if err != nil {
+		return err
+	}
+	for _, segment := range segments {
+		s.mu.Lock()
+		writing := s.file != nil && s.file.Name() == segment
+		s.mu.Unlock()
+		if writing {
+			break
+		}
+		records, size, err := readSpillSegment(segment)
+		if err != nil {
+			return err
+		}
+		sent, sendErr := send(records)
+		if sendErr == nil {
+			if err := os.Remove(segment); err != nil {
+				return utils.Wrap(err, "")
+			}
+			s.release(size, int64(len(records)))
+			continue
+		}
+		remainSize, err := rewriteSpillSegment(segment, records[sent:])
+		if err != nil {
+			return err
+		}
+		s.release(size-remainSize, int64(sent))
+		return sendErr
+	}
+	return nil
+}
+
+func (s *spillBuffer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closeFile()
+}
+
+func (s *spillBuffer) release(size, count int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.totalBytes -= size
+	s.recordCount -= count
+	s.updateGauge()
+}
+
+func (s *spillBuffer) rotate() error {
+	if err := s.closeFile(); err != nil {
+		return err
+	}
+	name := filepath.Join(s.dir, strconv.FormatInt(time.Now().UnixNano(), 10)+spillSegmentSuffix)
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	s.file = f
+	s.fileSize = 0
+	return nil
+}
+
+func (s *spillBuffer) closeFile() error {
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	s.fileSize = 0
+	return utils.Wrap(err, "")
+}
+
+func (s *spillBuffer) segments() ([]string, error) {
+	segments, err := filepath.Glob(filepath.Join(s.dir, "*"+spillSegmentSuffix))
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	sort.Strings(segments)
+	return segments, nil
+}
+
+func (s *spillBuffer) updateGauge() {
+	promePkg.PromeGaugeVecSet(promePkg.KafkaProducerSpillBacklogGauge, float64(s.recordCount), s.topic)
+}
+
+func readSpillSegment(name string) ([]spillRecord, int64, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, 0, utils.Wrap(err, "")
+	}
+	defer f.Close()
+	var records []spillRecord
+	var size int64
+	reader := bufio.NewReader(f)
+	header := make([]byte, spillRecordHeaderLen)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				// a partial record at the tail is left over from a crash while writing
+				return records, size, nil
+			}
+			return nil, 0, utils.Wrap(err, "")
+		}
+		record := spillRecord{
+			key:   make([]byte, binary.BigEndian.Uint32(header[0:4])),
+			value: make([]byte, binary.BigEndian.Uint32(header[4:8])),
+		}
+		if _, err := io.ReadFull(reader, record.key); err != nil {
+			return records, size, nil
+		}
+		if _, err := io.ReadFull(reader, record.value); err != nil {
+			return records, size, nil
+		}
+		records = append(records, record)
+		size += int64(spillRecordHeaderLen + len(record.key) + len(record.value))
+	}
+}
+
+func rewriteSpillSegment(name string, records []spillRecord) (int64, error) {
+	tmpName := name + ".tmp"
+	f, err := os.OpenFile(tmpName, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	writer := bufio.NewWriter(f)
+	var size int64
+	header := make([]byte, spillRecordHeaderLen)
+	for _, record := range records {
+		binary.BigEndian.PutUint32(header[0:4], uint32(len(record.key)))
+		binary.BigEndian.PutUint32(header[4:8], uint32(len(record.value)))
+		writer.Write(header)
+		writer.Write(record.key)
+		writer.Write(record.value)
+		size += int64(spillRecordHeaderLen + len(record.key) + len(record.value))
+	}
+	if err := writer.Flush(); err != nil {
+		f.Close()
+		return 0, utils.Wrap(err, "")
+	}
+	if err := f.Close(); err != nil {
+		return 0, utils.Wrap(err, "")
+	}
+	return size, utils.Wrap(os.Rename(tmpName, name), "")
+}
@@ -0,0 +1,114 @@
+package kafka
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeSpillRecords(t *testing.T, s *spillBuffer, from, to int) {
+	for i := from; i < to; i++ {
+		assert.Nil(t, s.Write([]byte("user"), []byte("msg"+strconv.Itoa(i))))
+	}
+}
+
+// replayAll drains s and returns the values in the order they were sent
+func replayAll(t *testing.T, s *spillBuffer) []string {
+	var values []string
+	assert.Nil(t, s.Replay(func(records []spillRecord) (int, error) {
+		for _, record := range records {
+			values = append(values, string(record.value))
+		}
+		return len(records), nil
+	}))
+	return values
+}
+
+func Test_SpillBuffer_Write(t *testing.T) {
+	s, err := newSpillBuffer(t.TempDir(), testTopic, 0)
+	assert.Nil(t, err)
+	writeSpillRecords(t, s, 0, 3)
+	assert.Equal(t, int64(3), s.Backlog())
+
+	assert.Equal(t, []string{"msg0", "msg1", "msg2"}, replayAll(t, s))
+	assert.Equal(t, int64(0), s.Backlog())
+	segments, err := s.segments()
+	assert.Nil(t, err)
+	assert.Empty(t, segments)
+}
+
+func Test_SpillBuffer_MaxBytes(t *testing.T) {
+	recordLen := int64(spillRecordHeaderLen + len("user") + len("msg0"))
+	s, err := newSpillBuffer(t.TempDir(), testTopic, 2*recordLen)
+	assert.Nil(t, err)
+	writeSpillRecords(t, s, 0, 2)
+	assert.Equal(t, ErrSpillFull, s.Write([]byte("user"), []byte("msg2")))
+	assert.Equal(t, int64(2), s.Backlog())
+}
+
+func Test_SpillBuffer_Rotate(t *testing.T) {
+	s, err := newSpillBuffer(t.TempDir(), testTopic, 0)
+	assert.Nil(t, err)
+	// two records fit in a segment
+	s.segmentBytes = 2 * int64(spillRecordHeaderLen+len("user")+len("msg0"))
+	writeSpillRecords(t, s, 0, 5)
+	segments, err := s.segments()
+	assert.Nil(t, err)
+	assert.Len(t, segments, 3)
+
+	var batches []int
+	assert.Nil(t, s.Replay(func(records []spillRecord) (int, error) {
+		batches = append(batches, len(records))
+		return len(records), nil
+	}))
+	assert.Equal(t, []int{2, 2, 1}, batches)
+	assert.Equal(t, int64(0), s.Backlog())
+}
+
+// a send failing halfway keeps the unsent records of the segment and the later segments in order
+func Test_SpillBuffer_ReplayPartial(t *testing.T) {
+	s, err := newSpillBuffer(t.TempDir(), testTopic, 0)
+	assert.Nil(t, err)
+	s.segmentBytes = 3 * int64(spillRecordHeaderLen+len("user")+len("msg0"))
+	writeSpillRecords(t, s, 0, 5)
+
+	sendErr := errors.New("broker unavailable")
+	var sent []string
+	err = s.Replay(func(records []spillRecord) (int, error) {
+		sent = append(sent, string(records[0].value))
+		return 1, sendErr
+	})
+	assert.Equal(t, sendErr, err)
+	assert.Equal(t, []string{"msg0"}, sent)
+	assert.Equal(t, int64(4), s.Backlog())
+
+	assert.Equal(t, []string{"msg1", "msg2", "msg3", "msg4"}, replayAll(t, s))
+	assert.Equal(t, int64(0), s.Backlog())
+}
+
+// a restarted process finds the backlog it left behind, a record torn by a crash is dropped
+func Test_SpillBuffer_Recovery(t *testing.T) {
+	dir := t.TempDir()
+	s, err := newSpillBuffer(dir, testTopic, 0)
+	assert.Nil(t, err)
+	writeSpillRecords(t, s, 0, 3)
+	assert.Nil(t, s.Close())
+	segments, err := s.segments()
+	assert.Nil(t, err)
+	assert.Len(t, segments, 1)
+	f, err := os.OpenFile(segments[0], os.O_WRONLY|os.O_APPEND, 0644)
+	assert.Nil(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 4, 0, 0, 0, 9, 'u'})
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+
+	restarted, err := newSpillBuffer(dir, testTopic, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), restarted.Backlog())
+	writeSpillRecords(t, restarted, 3, 4)
+	assert.Equal(t, []string{"msg0", "msg1", "msg2", "msg3"}, replayAll(t, restarted))
+	assert.Equal(t, int64(0), restarted.Backlog())
+}
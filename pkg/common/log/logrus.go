@@ -24,7 +24,9 @@ type Logger struct {
 
 func init() {
 	logger = loggerInit("")
-
+	config.OnReload(func(sections []string) {
+		logger.SetLevel(logrus.Level(config.Current().Log.RemainLogLevel))
+	})
 }
 func NewPrivateLog(moduleName string) {
 	logger = loggerInit(moduleName)
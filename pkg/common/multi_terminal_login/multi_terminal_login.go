@@ -1,73 +1,228 @@
 package multi_terminal_login
 
-//
-//import (
-//	"Open_IM/internal/push/content_struct"
-//	"Open_IM/internal/push/logic"
-//	"Open_IM/pkg/common/config"
-//	"Open_IM/pkg/common/constant"
-//	"Open_IM/pkg/common/db"
-//	pbChat "Open_IM/pkg/proto/msg"
-//	"Open_IM/pkg/utils"
-//)
-//
-//const  DayOfSecond = 24*60*60
-//func MultiTerminalLoginChecker(uid, token string, platformID int32) error {
-//	//	1.check userid and platform class   0 not exists and  1 exists
-//	exists, err := db.DB.ExistsUserIDAndPlatform(uid, constant.PlatformNameToClass(constant.PlatformIDToName(platformID)))
-//	if err != nil {
-//		return err
-//	}
-//	//get config multi login policy
-//	if config.Config.MultiLoginPolicy  {
-//		//OnlyOneTerminalAccess policy need to check all terminal
-//		if constant.PlatformNameToClass(constant.PlatformIDToName(platformID)) == "PC" {
-//			exists, err = db.DB.ExistsUserIDAndPlatform(uid, "Mobile")
-//			if err != nil {
-//				return err
-//			}
-//		} else {
-//			exists, err = db.DB.ExistsUserIDAndPlatform(uid, "PC")
-//			if err != nil {
-//				return err
-//			}
-//		}
-//		if exists == 1 {
-//			err := db.DB.SetUserIDAndPlatform(uid, constant.PlatformNameToClass(constant.PlatformIDToName(platformID)), token, config.Config.TokenPolicy.AccessExpire*DayOfSecond)
-//			if err != nil {
-//				return err
-//			}
-//			PushMessageToTheTerminal(uid, platformID)
-//			return nil
-//		}
-//	} else if config.Config.MultiLoginPolicy.MobileAndPCTerminalAccessButOtherTerminalKickEachOther {
-//		//	common terminal need to kick eich other
-//		if exists == 1 {
-//			err := db.DB.SetUserIDAndPlatform(uid, constant.PlatformNameToClass(constant.PlatformIDToName(platformID)), token, config.Config.TokenPolicy.AccessExpire*DayOfSecond)
-//			if err != nil {
-//				return err
-//			}
-//			PushMessageToTheTerminal(uid, platformID)
-//			return nil
-//		}
-//	}
-//	err = db.DB.SetUserIDAndPlatform(uid, constant.PlatformNameToClass(constant.PlatformIDToName(platformID)), token, config.Config.TokenPolicy.AccessExpire*DayOfSecond)
-//	if err != nil {
-//		return err
-//	}
-//	PushMessageToTheTerminal(uid, platformID)
-//	return nil
-//}
-////
-////func PushMessageToTheTerminal(uid string, platform int32) {
-////
-////	logic.SendMsgByWS(&pbChat.WSToMsgSvrChatMsg{
-////		SendID:      uid,
-////		RecvID:      uid,
-////		Content:     content_struct.NewContentStructString(1, "", "Your account is already logged on other terminal,please confirm"),
-////		SendTime:    utils.GetCurrentTimestampBySecond(),
-////		MsgFrom:     constant.SysMsgType,
-////		ContentType: constant.KickOnlineTip,
-////		PlatformID:  platform,
-////	})
-////}
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	"Open_IM/pkg/common/db"
+	rocksCache "Open_IM/pkg/common/db/rocks_cache"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/common/token_verify"
+	"Open_IM/pkg/utils"
+	"sort"
+	"time"
+)
+
+const (
+	loginLockTTL      = 10 * time.Second
+	loginLockWait     = 3 * time.Second
+	loginLockInterval = 50 * time.Millisecond
+)
+
+type Session struct {
+	SessionID string
+	db.LoginSession
+}
+
+// IsEnabled reports whether the policy matrix replaces config.MultiLoginPolicy
+func IsEnabled() bool {
+	return config.Config.MultiLogin.Enable
+}
+
+// PlatformClass is the class a platform is configured by, platforms without a class use their name
+func PlatformClass(platformID int) string {
+	if class := constant.PlatformIDToClass(platformID); class != "" {
+		return class
+	}
+	return constant.PlatformIDToName(platformID)
+}
+
+// GetPolicy returns the policy of the user's tier, which is the user's appMangerLevel. The level is read
+// from the user info cache, which the user rpc invalidates on every update.
+func GetPolicy(userID string) config.MultiLoginPolicy {
+	if len(config.Config.MultiLogin.Tiers) != 0 {
+		user, err := rocksCache.GetUserInfoFromCache(userID)
+		if err == nil {
+			if policy, ok := config.Config.MultiLogin.Tiers[user.AppMangerLevel]; ok {
+				return policy
+			}
+		}
+	}
+	return config.Config.MultiLogin.Default
+}
+
+// Evaluate returns the sessions that end when newSession logs in next to sessions.
+// Classes listed in kick end first, then the oldest sessions beyond maxSessions and maxPerPlatform.
+func Evaluate(policy config.MultiLoginPolicy, sessions []*Session, newSession *Session) []*Session {
+	class := PlatformClass(newSession.PlatformID)
+	rule, ok := policy[class]
+	if !ok {
+		return nil
+	}
+	var ended, sameClass []*Session
+	for _, session := range sessions {
+		if session.SessionID == newSession.SessionID {
+			continue
+		}
+		sessionClass := PlatformClass(session.PlatformID)
+		if utils.IsContain(sessionClass, rule.Kick) {
+			ended = append(ended, session)
+			continue
+		}
+		if sessionClass == class {
+			sameClass = append(sameClass, session)
+		}
+	}
+	sort.SliceStable(sameClass, func(i, j int) bool { return sameClass[i].LoginTime > sameClass[j].LoginTime })
+	classNum := 1
+	platformNum := map[int]int{newSession.PlatformID: 1}
+	for _, session := range sameClass {
+		if (rule.MaxSessions > 0 && classNum >= rule.MaxSessions) || (rule.MaxPerPlatform > 0 && platformNum[session.PlatformID] >= rule.MaxPerPlatform) {
+			ended = append(ended, session)
+			continue
+		}
+		classNum++
+		platformNum[session.PlatformID]++
+	}
+	return ended
+}
+
+// GetSessions returns the active sessions of the user, expired and kicked ones are removed
+func GetSessions(userID string) ([]*Session, error) {
+	m, err := db.DB.GetLoginSessions(userID)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	now := time.Now().Unix()
+	tokenMaps := make(map[int]map[string]int)
+	var sessions []*Session
+	var deleteSessionIDs []string
+	for sessionID, v := range m {
+		tokenMap, ok := tokenMaps[v.PlatformID]
+		if !ok {
+			tokenMap, err = db.DB.GetTokenMapByUidPid(userID, constant.PlatformIDToName(v.PlatformID))
+			if err != nil {
+				return nil, utils.Wrap(err, "")
+			}
+			tokenMaps[v.PlatformID] = tokenMap
+		}
+		if status, ok := tokenMap[v.Token]; v.ExpireTime < now || !ok || status != constant.NormalToken {
+			deleteSessionIDs = append(deleteSessionIDs, sessionID)
+			continue
+		}
+		sessions = append(sessions, &Session{SessionID: sessionID, LoginSession: *v})
+	}
+	if len(deleteSessionIDs) != 0 {
+		if err := db.DB.DeleteLoginSessions(userID, deleteSessionIDs); err != nil {
+			return nil, utils.Wrap(err, "")
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LoginTime > sessions[j].LoginTime })
+	return sessions, nil
+}
+
+// lockLogin waits for the login lock of the user, concurrent logins would each evaluate the sessions
+// without the other and together end up above the limits
+func lockLogin(userID, owner string) error {
+	deadline := time.Now().Add(loginLockWait)
+	for {
+		ok, err := db.DB.LockUserLogin(userID, owner, loginLockTTL)
+		if err != nil {
+			return utils.Wrap(err, "")
+		}
+		if ok {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return utils.Wrap(constant.ErrInternal, "wait for login lock timeout")
+		}
+		time.Sleep(loginLockInterval)
+	}
+}
+
+// Login records a new session and, if the policy is enabled, ends the sessions it does not allow next to the new one
+func Login(userID string, platformID int, sessionID, token, loginIP string, expireTime int64, operationID string) ([]*Session, error) {
+	owner := utils.OperationIDGenerator()
+	if err := lockLogin(userID, owner); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := db.DB.UnlockUserLogin(userID, owner); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "UnlockUserLogin failed", userID, err.Error())
+		}
+	}()
+	sessions, err := GetSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+	newSession := &Session{SessionID: sessionID, LoginSession: db.LoginSession{PlatformID: platformID, Token: token, LoginIP: loginIP,
+		LoginTime: time.Now().Unix(), ExpireTime: expireTime}}
+	var ended []*Session
+	if IsEnabled() {
+		ended = Evaluate(GetPolicy(userID), sessions, newSession)
+		if err := endSessions(userID, ended); err != nil {
+			return nil, err
+		}
+		log.NewInfo(operationID, utils.GetSelfFuncName(), "sessions ended by multi login policy", userID, platformID, len(ended))
+	}
+	err = db.DB.SetLoginSession(userID, sessionID, &newSession.LoginSession, time.Until(time.Unix(expireTime, 0)))
+	return ended, utils.Wrap(err, "")
+}
+
+// Connect is called by the gateway, a token without a session, e.g. issued before the session was recorded, logs in first
+func Connect(userID string, platformID int, token, ip string, operationID string) ([]*Session, error) {
+	sessions, err := GetSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.Token == token {
+			return nil, nil
+		}
+	}
+	claims, err := token_verify.GetClaimFromToken(token)
+	if err != nil {
+		return nil, err
+	}
+	return Login(userID, platformID, utils.Md5(token), token, ip, claims.ExpiresAt.Unix(), operationID)
+}
+
+// EndSession kicks the token of a session, the caller closes its connections
+func EndSession(userID, sessionID string) (*Session, error) {
+	sessions, err := GetSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.SessionID == sessionID {
+			return session, endSessions(userID, []*Session{session})
+		}
+	}
+	return nil, utils.Wrap(constant.ErrArgs, "session not exist")
+}
+
+func endSessions(userID string, sessions []*Session) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+	kickTokens := make(map[int]map[string]int)
+	var sessionIDs []string
+	for _, session := range sessions {
+		if kickTokens[session.PlatformID] == nil {
+			kickTokens[session.PlatformID] = make(map[string]int)
+		}
+		kickTokens[session.PlatformID][session.Token] = constant.KickedToken
+		sessionIDs = append(sessionIDs, session.SessionID)
+	}
+	for platformID, m := range kickTokens {
+		if err := db.DB.SetTokenMapByUidPid(userID, platformID, m); err != nil {
+			return utils.Wrap(err, "")
+		}
+	}
+	// the session ID is the refresh token family, without this an ended session refreshes itself back
+	for _, session := range sessions {
+		if err := token_verify.RevokeRefreshTokenFamily(userID, session.PlatformID, session.SessionID); err != nil {
+			return utils.Wrap(err, "")
+		}
+	}
+	return utils.Wrap(db.DB.DeleteLoginSessions(userID, sessionIDs), "")
+}
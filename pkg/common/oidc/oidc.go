@@ -0,0 +1,454 @@
+// Package oidc is an OpenID Connect relying party for the authorization code flow with PKCE
+package oidc
+
+import (
+	"Open_IM/pkg/common/config"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	discoveryPath   = "/.well-known/openid-configuration"
+	metadataRefresh = time.Hour
+	// an unknown kid refetches the key set at most this often
+	keysMinRefresh = time.Minute
+)
+
+var (
+	ErrUnknownProvider = errors.New("unknown oidc provider")
+	ErrNoUserIDPrefix  = errors.New("oidc provider has no userIDPrefix")
+	ErrNonceMismatch   = errors.New("id token nonce mismatch")
+)
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Token is the token endpoint response
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Profile is the IM user an identity maps to, Subject is the sub claim the identity is linked by
+type Profile struct {
+	Subject    string
+	UserID     string
+	Nickname   string
+	FaceURL    string
+	Email      string
+	Department string
+}
+
+type Provider struct {
+	config.OIDCProvider
+	client *http.Client
+
+	mu          sync.Mutex
+	meta        *metadata
+	metaTime    time.Time
+	keys        map[string]interface{}
+	keysTime    time.Time
+	now         func() time.Time
+	clockLeeway time.Duration
+}
+
+var (
+	providers     = make(map[string]*Provider)
+	providersLock sync.Mutex
+)
+
+func NewProvider(cfg config.OIDCProvider) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "profile", "email"}
+	}
+	if cfg.Claims.UserID == "" {
+		cfg.Claims.UserID = "sub"
+	}
+	if cfg.Claims.Nickname == "" {
+		cfg.Claims.Nickname = "name"
+	}
+	if cfg.Claims.FaceURL == "" {
+		cfg.Claims.FaceURL = "picture"
+	}
+	if cfg.Claims.Email == "" {
+		cfg.Claims.Email = "email"
+	}
+	return &Provider{OIDCProvider: cfg, client: &http.Client{Timeout: 10 * time.Second}, now: time.Now, clockLeeway: time.Minute}
+}
+
+// GetProvider returns the configured provider by name, metadata and keys are cached per provider.
+// A provider without userIDPrefix is refused, its users could otherwise collide with local user IDs.
+func GetProvider(name string) (*Provider, error) {
+	providersLock.Lock()
+	defer providersLock.Unlock()
+	if p, ok := providers[name]; ok {
+		return p, nil
+	}
+	for _, cfg := range config.Config.OIDC.Providers {
+		if cfg.Name == name {
+			if cfg.UserIDPrefix == "" {
+				return nil, ErrNoUserIDPrefix
+			}
+			p := NewProvider(cfg)
+			providers[name] = p
+			return p, nil
+		}
+	}
+	return nil, ErrUnknownProvider
+}
+
+// RandomString returns a url safe random string of n bytes entropy
+func RandomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// CodeChallenge is the S256 PKCE challenge of verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (p *Provider) getJSON(u string, v interface{}) error {
+	resp, err := p.client.Get(u)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s %s", u, resp.Status, string(body))
+	}
+	return json.Unmarshal(body, v)
+}
+
+func (p *Provider) discover() (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil && p.now().Sub(p.metaTime) < metadataRefresh {
+		return p.meta, nil
+	}
+	var m metadata
+	if err := p.getJSON(strings.TrimSuffix(p.Issuer, "/")+discoveryPath, &m); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(m.Issuer, "/") != strings.TrimSuffix(p.Issuer, "/") {
+		return nil, fmt.Errorf("issuer mismatch: configured %s, discovered %s", p.Issuer, m.Issuer)
+	}
+	if m.AuthorizationEndpoint == "" || m.TokenEndpoint == "" || m.JwksURI == "" {
+		return nil, errors.New("incomplete provider metadata")
+	}
+	p.meta, p.metaTime = &m, p.now()
+	return p.meta, nil
+}
+
+// AuthCodeURL is where the user agent is sent to sign in
+func (p *Provider) AuthCodeURL(state, nonce, codeVerifier string) (string, error) {
+	m, err := p.discover()
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(m.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", p.ClientID)
+	q.Set("redirect_uri", p.RedirectURL)
+	q.Set("scope", strings.Join(p.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", CodeChallenge(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// Exchange redeems an authorization code, the id token is not verified yet
+func (p *Provider) Exchange(code, codeVerifier string) (*Token, error) {
+	m, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.RedirectURL)
+	form.Set("client_id", p.ClientID)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequest(http.MethodPost, m.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token endpoint: %s %s", resp.Status, string(body))
+	}
+	var token Token
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token endpoint returned no id_token")
+	}
+	return &token, nil
+}
+
+func parseJWK(k *jsonWebKey) (interface{}, error) {
+	decode := base64.RawURLEncoding.DecodeString
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+}
+
+func (p *Provider) key(kid string) (interface{}, error) {
+	m, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+	// the provider may have rotated its keys
+	if p.keys != nil && p.now().Sub(p.keysTime) < keysMinRefresh {
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+	var set struct {
+		Keys []*jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(m.JwksURI, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]interface{})
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := parseJWK(k)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	p.keys, p.keysTime = keys, p.now()
+	if key, ok := keys[kid]; ok {
+		return key, nil
+	}
+	// a single unnamed key is used for tokens without kid
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown key id %s", kid)
+}
+
+// VerifyIDToken checks the signature, issuer, audience, expiry and nonce of an id token
+func (p *Provider) VerifyIDToken(rawIDToken, nonce string) (jwt.MapClaims, error) {
+	m, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "ES256", "ES384", "ES512"}), jwt.WithoutClaimsValidation())
+	_, err = parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	now := p.now()
+	if !claims.VerifyIssuer(m.Issuer, true) {
+		return nil, errors.New("id token issuer mismatch")
+	}
+	if !claims.VerifyAudience(p.ClientID, true) {
+		return nil, errors.New("id token audience mismatch")
+	}
+	if !claims.VerifyExpiresAt(now.Add(-p.clockLeeway).Unix(), true) {
+		return nil, errors.New("id token expired")
+	}
+	if !claims.VerifyIssuedAt(now.Add(p.clockLeeway).Unix(), false) {
+		return nil, errors.New("id token issued in the future")
+	}
+	if n, _ := claims["nonce"].(string); n != nonce {
+		return nil, ErrNonceMismatch
+	}
+	return claims, nil
+}
+
+// UserInfo fetches the userinfo claims, they must belong to the id token subject
+func (p *Provider) UserInfo(accessToken, subject string) (map[string]interface{}, error) {
+	m, err := p.discover()
+	if err != nil {
+		return nil, err
+	}
+	if m.UserinfoEndpoint == "" {
+		return nil, nil
+	}
+	req, err := http.NewRequest(http.MethodGet, m.UserinfoEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("userinfo endpoint: %s %s", resp.Status, string(body))
+	}
+	claims := make(map[string]interface{})
+	if err := json.Unmarshal(body, &claims); err != nil {
+		return nil, err
+	}
+	if sub, _ := claims["sub"].(string); sub != subject {
+		return nil, errors.New("userinfo subject mismatch")
+	}
+	return claims, nil
+}
+
+// claim looks up a claim by a dotted path, e.g. org.department
+func claim(claims map[string]interface{}, path string) string {
+	if path == "" {
+		return ""
+	}
+	var v interface{} = claims
+	for _, name := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		v = m[name]
+	}
+	switch value := v.(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case []interface{}:
+		if len(value) > 0 {
+			if s, ok := value[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// MapClaims maps the identity claims onto an IM user profile
+func (p *Provider) MapClaims(claims map[string]interface{}) (*Profile, error) {
+	id := claim(claims, p.Claims.UserID)
+	if id == "" {
+		return nil, fmt.Errorf("claim %s is missing", p.Claims.UserID)
+	}
+	subject := claim(claims, "sub")
+	if subject == "" {
+		return nil, errors.New("claim sub is missing")
+	}
+	profile := &Profile{
+		Subject:    subject,
+		UserID:     p.UserIDPrefix + id,
+		Nickname:   claim(claims, p.Claims.Nickname),
+		FaceURL:    claim(claims, p.Claims.FaceURL),
+		Email:      claim(claims, p.Claims.Email),
+		Department: claim(claims, p.Claims.Department),
+	}
+	if profile.Nickname == "" {
+		profile.Nickname = id
+	}
+	return profile, nil
+}
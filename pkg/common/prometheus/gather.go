@@ -59,6 +59,12 @@ var (
 	GrpcRequestFailedCounter  prometheus.Counter
 
 	SendMsgCounter prometheus.Counter
+
+	//kafka
+	KafkaProducerSpillBacklogGauge *prometheus.GaugeVec
+
+	// demo
+	LoginLockCheckFailedCounter prometheus.Counter
 )
 
 func NewUserLoginCounter() {
@@ -188,6 +194,26 @@ func NewSendMsgCount() {
 	})
 }
 
+func NewKafkaProducerSpillBacklogGauge() {
+	if KafkaProducerSpillBacklogGauge != nil {
+		return
+	}
+	KafkaProducerSpillBacklogGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_producer_spill_backlog",
+		Help: "The number of kafka messages spilled to local disk waiting for replay",
+	}, []string{"topic"})
+}
+
+func NewLoginLockCheckFailedCounter() {
+	if LoginLockCheckFailedCounter != nil {
+		return
+	}
+	LoginLockCheckFailedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "login_lock_check_failed",
+		Help: "The number of login attempts refused because the failed attempt count could not be read",
+	})
+}
+
 func NewMsgInsertRedisSuccessCounter() {
 	if MsgInsertRedisSuccessCounter != nil {
 		return
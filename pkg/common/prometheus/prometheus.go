@@ -80,3 +80,11 @@ func PromeGaugeDec(gauges prometheus.Gauge) {
 		}
 	}
 }
+
+func PromeGaugeVecSet(gaugeVec *prometheus.GaugeVec, value float64, labelValues ...string) {
+	if config.Config.Prometheus.Enable {
+		if gaugeVec != nil {
+			gaugeVec.WithLabelValues(labelValues...).Set(value)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package token_verify
+
+import (
+	"Open_IM/pkg/common/config"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CMSTokenAudience(t *testing.T) {
+	if config.Config.TokenPolicy.AccessSecret == "" {
+		config.Config.TokenPolicy.AccessSecret = "test"
+	}
+	cmsToken, err := signToken(buildCMSClaims("openIMAdmin", 3, time.Hour))
+	assert.Nil(t, err)
+	claims, err := ParseCMSToken(cmsToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "openIMAdmin", claims.AdminID)
+	assert.Equal(t, int64(3), claims.TokenVersion)
+	_, err = GetClaimFromToken(cmsToken)
+	assert.NotNil(t, err)
+
+	imToken, err := signToken(buildClaims("openIMAdmin", "IOS", time.Hour))
+	assert.Nil(t, err)
+	_, err = ParseCMSToken(imToken)
+	assert.NotNil(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	commonDB "Open_IM/pkg/common/db"
 	"Open_IM/pkg/common/log"
 	"Open_IM/pkg/utils"
+	"errors"
 	"time"
 
 	go_redis "github.com/go-redis/redis/v8"
@@ -27,15 +28,19 @@ type Claims struct {
 }
 
 func BuildClaims(uid, platform string, ttl int64) Claims {
+	return buildClaims(uid, platform, time.Duration(ttl*24)*time.Hour)
+}
+
+func buildClaims(uid, platform string, ttl time.Duration) Claims {
 	now := time.Now()
 	before := now.Add(-time.Minute * 5)
 	return Claims{
 		UID:      uid,
 		Platform: platform,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(ttl*24) * time.Hour)), //Expiration time
-			IssuedAt:  jwt.NewNumericDate(now),                                        //Issuing time
-			NotBefore: jwt.NewNumericDate(before),                                     //Begin Effective time
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)), //Expiration time
+			IssuedAt:  jwt.NewNumericDate(now),          //Issuing time
+			NotBefore: jwt.NewNumericDate(before),       //Begin Effective time
 		}}
 }
 
@@ -59,9 +64,12 @@ func DeleteToken(userID string, platformID int) error {
 }
 
 func CreateToken(userID string, platformID int) (string, int64, error) {
-	claims := BuildClaims(userID, constant.PlatformIDToName(platformID), config.Config.TokenPolicy.AccessExpire)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(config.Config.TokenPolicy.AccessSecret))
+	return createToken(userID, platformID, time.Duration(config.Config.TokenPolicy.AccessExpire*24)*time.Hour)
+}
+
+func createToken(userID string, platformID int, ttl time.Duration) (string, int64, error) {
+	claims := buildClaims(userID, constant.PlatformIDToName(platformID), ttl)
+	tokenString, err := signToken(claims)
 	if err != nil {
 		return "", 0, err
 	}
@@ -70,10 +78,15 @@ func CreateToken(userID string, platformID int) (string, int64, error) {
 	if err != nil && err != go_redis.Nil {
 		return "", 0, err
 	}
+	// an expired access token stays while a refresh token can rotate from it, refresh treats a missing one as revoked
+	liveAccessTokens, err := liveRefreshedAccessTokens(userID, platformID)
+	if err != nil {
+		return "", 0, err
+	}
 	var deleteTokenKey []string
 	for k, v := range m {
 		_, err = GetClaimFromToken(k)
-		if err != nil || v != constant.NormalToken {
+		if v != constant.NormalToken || (err != nil && !liveAccessTokens[k]) {
 			deleteTokenKey = append(deleteTokenKey, k)
 		}
 	}
@@ -92,7 +105,20 @@ func CreateToken(userID string, platformID int) (string, int64, error) {
 
 func secret() jwt.Keyfunc {
 	return func(token *jwt.Token) (interface{}, error) {
-		return []byte(config.Config.TokenPolicy.AccessSecret), nil
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			if IsAsymmetricSigning() && !config.Config.TokenPolicy.Signing.AcceptHS256 {
+				return nil, errors.New("HS256 token not accepted")
+			}
+			return []byte(config.Config.TokenPolicy.AccessSecret), nil
+		}
+		if !IsAsymmetricSigning() {
+			return nil, errors.New("unexpected token signing algorithm " + token.Method.Alg())
+		}
+		ks, err := getKeySet()
+		if err != nil {
+			return nil, err
+		}
+		return ks.verificationKey(token, time.Now().Unix())
 	}
 }
 
@@ -114,6 +140,10 @@ func GetClaimFromToken(tokensString string) (*Claims, error) {
 		}
 	} else {
 		if claims, ok := token.Claims.(*Claims); ok && token.Valid {
+			// cms tokens carry an audience, they are no IM tokens
+			if len(claims.Audience) != 0 {
+				return nil, utils.Wrap(constant.ErrTokenUnknown, "")
+			}
 			return claims, nil
 		}
 		return nil, utils.Wrap(constant.ErrTokenNotValidYet, "")
@@ -209,6 +239,9 @@ func ParseToken(tokensString, operationID string) (claims *Claims, err error) {
 		case constant.KickedToken:
 			log.Error(operationID, "this token has been kicked by other same terminal ", constant.ErrTokenKicked)
 			return nil, utils.Wrap(constant.ErrTokenKicked, "this token has been kicked by other same terminal ")
+		case constant.InValidToken:
+			log.Error(operationID, "this token has been revoked ", constant.ErrTokenInvalid)
+			return nil, utils.Wrap(constant.ErrTokenInvalid, "this token has been revoked ")
 		default:
 			return nil, utils.Wrap(constant.ErrTokenUnknown, "")
 		}
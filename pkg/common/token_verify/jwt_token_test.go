@@ -10,3 +10,9 @@ func Test_ParseToken(t *testing.T) {
 	_, err := GetClaimFromToken(token)
 	assert.Nil(t, err)
 }
+
+func Test_CreateAccessTokenClaims(t *testing.T) {
+	claims := buildClaims("openIM123456", "IOS", accessTokenTTL())
+	ttl := claims.ExpiresAt.Sub(claims.IssuedAt.Time)
+	assert.True(t, ttl > 0 && ttl < refreshTokenTTL())
+}
@@ -0,0 +1,250 @@
+package token_verify
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/common/constant"
+	commonDB "Open_IM/pkg/common/db"
+	"Open_IM/pkg/common/log"
+	"Open_IM/pkg/utils"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+type TokenPair struct {
+	Token              string
+	ExpiredTime        int64
+	RefreshToken       string
+	RefreshExpiredTime int64
+	// SessionID is the token family, the login session keeps it across rotations
+	SessionID string
+}
+
+func IsRefreshTokenEnabled() bool {
+	return config.Config.TokenPolicy.RefreshToken.Enable
+}
+
+func accessTokenTTL() time.Duration {
+	minutes := config.Config.TokenPolicy.RefreshToken.AccessExpireMinutes
+	if minutes <= 0 {
+		minutes = 30
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func refreshTokenTTL() time.Duration {
+	days := config.Config.TokenPolicy.RefreshToken.RefreshExpire
+	if days <= 0 {
+		days = config.Config.TokenPolicy.AccessExpire
+	}
+	return time.Duration(days*24) * time.Hour
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// hashRefreshToken is what redis stores of a refresh token, a leaked redis dump can't be used to refresh
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateTokenPair issues a short-lived access token and the first refresh token of a new token family
+func CreateTokenPair(userID string, platformID int) (*TokenPair, error) {
+	familyID, err := randomHex(16)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return createTokenPair(userID, platformID, familyID)
+}
+
+func createTokenPair(userID string, platformID int, familyID string) (*TokenPair, error) {
+	token, expTime, err := createToken(userID, platformID, accessTokenTTL())
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	refreshToken, err := randomHex(32)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	//remove expired refresh token
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	now := time.Now().Unix()
+	var deleteTokenKey []string
+	for k, v := range m {
+		if v.ExpireTime < now {
+			deleteTokenKey = append(deleteTokenKey, k)
+		}
+	}
+	if len(deleteTokenKey) != 0 {
+		if err := commonDB.DB.DeleteRefreshTokens(userID, platformID, deleteTokenKey); err != nil {
+			return nil, utils.Wrap(err, "")
+		}
+	}
+	ttl := refreshTokenTTL()
+	info := &commonDB.RefreshToken{FamilyID: familyID, AccessToken: token, ExpireTime: time.Now().Add(ttl).Unix()}
+	if err := commonDB.DB.AddRefreshToken(userID, platformID, hashRefreshToken(refreshToken), info, ttl); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	if err := renewLoginSession(userID, familyID, token, info.ExpireTime); err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return &TokenPair{Token: token, ExpiredTime: expTime, RefreshToken: refreshToken, RefreshExpiredTime: info.ExpireTime, SessionID: familyID}, nil
+}
+
+// renewLoginSession moves the login session of a token family to its rotated access token
+func renewLoginSession(userID, sessionID, token string, expireTime int64) error {
+	sessions, err := commonDB.DB.GetLoginSessions(userID)
+	if err != nil {
+		return err
+	}
+	session, ok := sessions[sessionID]
+	if !ok {
+		return nil
+	}
+	session.Token, session.ExpireTime = token, expireTime
+	return commonDB.DB.SetLoginSession(userID, sessionID, session, time.Until(time.Unix(expireTime, 0)))
+}
+
+// RefreshToken rotates a refresh token. Presenting a refresh token that was already rotated
+// means it leaked, so every access and refresh token of its family is revoked.
+func RefreshToken(userID string, platformID int, refreshToken string, operationID string) (*TokenPair, error) {
+	refreshToken = hashRefreshToken(refreshToken)
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	info, ok := m[refreshToken]
+	if !ok {
+		return nil, utils.Wrap(constant.ErrRefreshTokenInvalid, "refresh token not find")
+	}
+	if info.ExpireTime < time.Now().Unix() {
+		if err := commonDB.DB.DeleteRefreshTokens(userID, platformID, []string{refreshToken}); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "DeleteRefreshTokens failed", err.Error(), userID, platformID)
+		}
+		return nil, utils.Wrap(constant.ErrRefreshTokenInvalid, "refresh token expired")
+	}
+	firstUse, err := commonDB.DB.MarkRefreshTokenUsed(userID, platformID, refreshToken)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	if !firstUse {
+		log.NewWarn(operationID, utils.GetSelfFuncName(), "refresh token reused, revoke token family", userID, platformID, info.FamilyID)
+		if err := revokeTokenFamily(userID, platformID, info.FamilyID, m); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "revokeTokenFamily failed", err.Error(), userID, platformID, info.FamilyID)
+		}
+		return nil, utils.Wrap(constant.ErrRefreshTokenReused, "")
+	}
+	// a kicked or revoked session can not be extended, its access token may already be pruned
+	tokenMap, err := commonDB.DB.GetTokenMapByUidPid(userID, constant.PlatformIDToName(platformID))
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	if v, ok := tokenMap[info.AccessToken]; !ok || v != constant.NormalToken {
+		log.NewWarn(operationID, utils.GetSelfFuncName(), "session of refresh token has ended", userID, platformID, ok, v)
+		if err := revokeTokenFamily(userID, platformID, info.FamilyID, m); err != nil {
+			log.NewError(operationID, utils.GetSelfFuncName(), "revokeTokenFamily failed", err.Error(), userID, platformID, info.FamilyID)
+		}
+		return nil, utils.Wrap(constant.ErrRefreshTokenInvalid, "session has ended")
+	}
+	return createTokenPair(userID, platformID, info.FamilyID)
+}
+
+// liveRefreshedAccessTokens returns the access tokens an unused and unexpired refresh token can still be rotated from
+func liveRefreshedAccessTokens(userID string, platformID int) (map[string]bool, error) {
+	live := make(map[string]bool)
+	if !IsRefreshTokenEnabled() {
+		return live, nil
+	}
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	used, err := commonDB.DB.GetUsedRefreshTokens(userID, platformID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	for k, v := range m {
+		if !used[k] && v.ExpireTime >= now {
+			live[v.AccessToken] = true
+		}
+	}
+	return live, nil
+}
+
+// RevokeRefreshTokens ends every token family of the user on the platform, on kick, logout and block
+func RevokeRefreshTokens(userID string, platformID int) error {
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	if len(m) == 0 {
+		return nil
+	}
+	return revokeTokenFamilies(userID, platformID, nil, m)
+}
+
+// RevokeRefreshTokenFamily ends one token family, the family ID is also the login session ID
+func RevokeRefreshTokenFamily(userID string, platformID int, familyID string) error {
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	return revokeTokenFamily(userID, platformID, familyID, m)
+}
+
+// RevokeAccessTokenFamily ends the token family an access token was issued in
+func RevokeAccessTokenFamily(userID string, platformID int, accessToken string) error {
+	m, err := commonDB.DB.GetRefreshTokenMap(userID, platformID)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	for _, v := range m {
+		if v.AccessToken == accessToken {
+			return revokeTokenFamily(userID, platformID, v.FamilyID, m)
+		}
+	}
+	return nil
+}
+
+func revokeTokenFamily(userID string, platformID int, familyID string, m map[string]*commonDB.RefreshToken) error {
+	return revokeTokenFamilies(userID, platformID, map[string]bool{familyID: true}, m)
+}
+
+// revokeTokenFamilies invalidates the access tokens and deletes the refresh tokens of familyIDs, nil means every family
+func revokeTokenFamilies(userID string, platformID int, familyIDs map[string]bool, m map[string]*commonDB.RefreshToken) error {
+	tokenMap, err := commonDB.DB.GetTokenMapByUidPid(userID, constant.PlatformIDToName(platformID))
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	var refreshTokens []string
+	revokeTokens := make(map[string]int)
+	for k, v := range m {
+		if familyIDs != nil && !familyIDs[v.FamilyID] {
+			continue
+		}
+		refreshTokens = append(refreshTokens, k)
+		if _, ok := tokenMap[v.AccessToken]; ok {
+			revokeTokens[v.AccessToken] = constant.InValidToken
+		}
+	}
+	if len(revokeTokens) != 0 {
+		if err := commonDB.DB.SetTokenMapByUidPid(userID, platformID, revokeTokens); err != nil {
+			return utils.Wrap(err, "")
+		}
+	}
+	if len(refreshTokens) != 0 {
+		return utils.Wrap(commonDB.DB.DeleteRefreshTokens(userID, platformID, refreshTokens), "")
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package token_verify
+
+import (
+	"Open_IM/pkg/common/config"
+	"Open_IM/pkg/utils"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type signingKey struct {
+	kid        string
+	privateKey crypto.PrivateKey
+	publicKey  crypto.PublicKey
+	activeFrom int64
+}
+
+type keySet struct {
+	method jwt.SigningMethod
+	// keys are sorted by activeFrom
+	keys []*signingKey
+	// maxTokenTTL is how long a superseded key is still used for verification
+	maxTokenTTL int64
+}
+
+// JSONWebKey is the public part of a signing key in JWKS format
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+var (
+	defaultKeySet     *keySet
+	defaultKeySetErr  error
+	defaultKeySetOnce sync.Once
+)
+
+func getKeySet() (*keySet, error) {
+	defaultKeySetOnce.Do(func() {
+		signing := config.Config.TokenPolicy.Signing
+		defaultKeySet, defaultKeySetErr = newKeySet(signing.Algorithm, signing.Keys, config.Config.TokenPolicy.AccessExpire*24*3600)
+	})
+	return defaultKeySet, defaultKeySetErr
+}
+
+// IsAsymmetricSigning reports whether tokens are signed with RS256 or EdDSA instead of the shared secret
+func IsAsymmetricSigning() bool {
+	switch config.Config.TokenPolicy.Signing.Algorithm {
+	case "", jwt.SigningMethodHS256.Alg():
+		return false
+	}
+	return true
+}
+
+func newKeySet(algorithm string, keyConfigs []config.SigningKey, maxTokenTTL int64) (*keySet, error) {
+	ks := &keySet{maxTokenTTL: maxTokenTTL}
+	switch algorithm {
+	case jwt.SigningMethodRS256.Alg():
+		ks.method = jwt.SigningMethodRS256
+	case jwt.SigningMethodEdDSA.Alg():
+		ks.method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errors.New("unsupported token signing algorithm " + algorithm)
+	}
+	kids := make(map[string]bool)
+	for _, keyConfig := range keyConfigs {
+		if keyConfig.Kid == "" {
+			return nil, errors.New("token signing key without kid")
+		}
+		if kids[keyConfig.Kid] {
+			return nil, errors.New("duplicate token signing key " + keyConfig.Kid)
+		}
+		kids[keyConfig.Kid] = true
+		key, err := loadSigningKey(ks.method, keyConfig)
+		if err != nil {
+			return nil, utils.Wrap(err, keyConfig.Kid)
+		}
+		ks.keys = append(ks.keys, key)
+	}
+	if len(ks.keys) == 0 {
+		return nil, errors.New("no token signing key configured for " + algorithm)
+	}
+	sort.SliceStable(ks.keys, func(i, j int) bool { return ks.keys[i].activeFrom < ks.keys[j].activeFrom })
+	return ks, nil
+}
+
+func readPEM(inline, file string) ([]byte, error) {
+	if inline != "" {
+		return []byte(inline), nil
+	}
+	if file != "" {
+		return ioutil.ReadFile(file)
+	}
+	return nil, nil
+}
+
+func loadSigningKey(method jwt.SigningMethod, keyConfig config.SigningKey) (*signingKey, error) {
+	key := &signingKey{kid: keyConfig.Kid, activeFrom: keyConfig.ActiveFrom}
+	privatePEM, err := readPEM(keyConfig.PrivateKey, keyConfig.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	publicPEM, err := readPEM(keyConfig.PublicKey, keyConfig.PublicKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	switch method {
+	case jwt.SigningMethodRS256:
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.privateKey, key.publicKey = privateKey, &privateKey.PublicKey
+		} else if publicPEM != nil {
+			if key.publicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+	case jwt.SigningMethodEdDSA:
+		if privatePEM != nil {
+			privateKey, err := jwt.ParseEdPrivateKeyFromPEM(privatePEM)
+			if err != nil {
+				return nil, err
+			}
+			key.privateKey, key.publicKey = privateKey, privateKey.(ed25519.PrivateKey).Public()
+		} else if publicPEM != nil {
+			if key.publicKey, err = jwt.ParseEdPublicKeyFromPEM(publicPEM); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if key.publicKey == nil {
+		return nil, errors.New("neither private nor public key configured")
+	}
+	return key, nil
+}
+
+// activeIndex returns the index of the key tokens are signed with at now, -1 if no key is active yet
+func (ks *keySet) activeIndex(now int64) int {
+	index := -1
+	for i, key := range ks.keys {
+		if key.activeFrom <= now {
+			index = i
+		}
+	}
+	return index
+}
+
+// verifiable keys are the active one, upcoming ones so that clock skew between services does not
+// matter, and superseded ones until every token they signed has expired
+func (ks *keySet) isVerifiable(i int, now int64) bool {
+	active := ks.activeIndex(now)
+	if i >= active {
+		return true
+	}
+	supersededAt := ks.keys[i+1].activeFrom
+	return now <= supersededAt+ks.maxTokenTTL
+}
+
+func (ks *keySet) sign(claims jwt.Claims, now int64) (string, error) {
+	active := ks.activeIndex(now)
+	if active < 0 {
+		return "", errors.New("no token signing key is active yet")
+	}
+	key := ks.keys[active]
+	if key.privateKey == nil {
+		return "", errors.New("token signing key " + key.kid + " has no private key")
+	}
+	token := jwt.NewWithClaims(ks.method, claims)
+	token.Header["kid"] = key.kid
+	return token.SignedString(key.privateKey)
+}
+
+func (ks *keySet) verificationKey(token *jwt.Token, now int64) (interface{}, error) {
+	if token.Method.Alg() != ks.method.Alg() {
+		return nil, errors.New("unexpected token signing algorithm " + token.Method.Alg())
+	}
+	kid, _ := token.Header["kid"].(string)
+	for i, key := range ks.keys {
+		if key.kid != kid {
+			continue
+		}
+		if !ks.isVerifiable(i, now) {
+			return nil, errors.New("token signing key retired " + kid)
+		}
+		return key.publicKey, nil
+	}
+	return nil, errors.New("unknown token signing key " + kid)
+}
+
+func (ks *keySet) jwks(now int64) []*JSONWebKey {
+	keys := make([]*JSONWebKey, 0, len(ks.keys))
+	for i, key := range ks.keys {
+		if !ks.isVerifiable(i, now) {
+			continue
+		}
+		jwk := &JSONWebKey{Kid: key.kid, Use: "sig", Alg: ks.method.Alg()}
+		switch publicKey := key.publicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+		}
+		keys = append(keys, jwk)
+	}
+	return keys
+}
+
+// GetJWKS returns the public keys tokens can currently be verified with
+func GetJWKS() ([]*JSONWebKey, error) {
+	if !IsAsymmetricSigning() {
+		return []*JSONWebKey{}, nil
+	}
+	ks, err := getKeySet()
+	if err != nil {
+		return nil, err
+	}
+	return ks.jwks(time.Now().Unix()), nil
+}
+
+func signToken(claims jwt.Claims) (string, error) {
+	if !IsAsymmetricSigning() {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(config.Config.TokenPolicy.AccessSecret))
+	}
+	ks, err := getKeySet()
+	if err != nil {
+		return "", err
+	}
+	return ks.sign(claims, time.Now().Unix())
+}
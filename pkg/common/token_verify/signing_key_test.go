@@ -0,0 +1,95 @@
+package token_verify
+
+import (
+	"Open_IM/pkg/common/config"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRSAKeyPEM(t *testing.T) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)}))
+}
+
+func parseWithKeySet(ks *keySet, tokenString string, now int64) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
+		return ks.verificationKey(token, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return token.Claims.(*Claims), nil
+}
+
+func Test_KeySetRotation(t *testing.T) {
+	ks, err := newKeySet("RS256", []config.SigningKey{
+		{Kid: "k2", PrivateKey: newRSAKeyPEM(t), ActiveFrom: 1000},
+		{Kid: "k1", PrivateKey: newRSAKeyPEM(t), ActiveFrom: 0},
+	}, 100)
+	assert.Nil(t, err)
+
+	claims := buildClaims("openIM123456", "IOS", time.Hour)
+	oldToken, err := ks.sign(claims, 500)
+	assert.Nil(t, err)
+	newToken, err := ks.sign(claims, 1050)
+	assert.Nil(t, err)
+
+	token, _, err := new(jwt.Parser).ParseUnverified(oldToken, &Claims{})
+	assert.Nil(t, err)
+	assert.Equal(t, "k1", token.Header["kid"])
+	token, _, err = new(jwt.Parser).ParseUnverified(newToken, &Claims{})
+	assert.Nil(t, err)
+	assert.Equal(t, "k2", token.Header["kid"])
+
+	// the superseded key verifies until its tokens have expired
+	parsed, err := parseWithKeySet(ks, oldToken, 1050)
+	assert.Nil(t, err)
+	assert.Equal(t, "openIM123456", parsed.UID)
+	_, err = parseWithKeySet(ks, oldToken, 1101)
+	assert.NotNil(t, err)
+	_, err = parseWithKeySet(ks, newToken, 1101)
+	assert.Nil(t, err)
+
+	// upcoming keys are published before they become active
+	assert.Len(t, ks.jwks(500), 2)
+	jwks := ks.jwks(1101)
+	assert.Len(t, jwks, 1)
+	assert.Equal(t, "k2", jwks[0].Kid)
+	assert.Equal(t, "RSA", jwks[0].Kty)
+	assert.Equal(t, "AQAB", jwks[0].E)
+}
+
+func Test_KeySetEdDSAVerifyOnly(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	assert.Nil(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	assert.Nil(t, err)
+
+	signer, err := newKeySet("EdDSA", []config.SigningKey{{Kid: "ed", PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))}}, 100)
+	assert.Nil(t, err)
+	verifier, err := newKeySet("EdDSA", []config.SigningKey{{Kid: "ed", PublicKey: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))}}, 100)
+	assert.Nil(t, err)
+
+	tokenString, err := signer.sign(buildClaims("openIM123456", "IOS", time.Hour), 0)
+	assert.Nil(t, err)
+	_, err = parseWithKeySet(verifier, tokenString, 0)
+	assert.Nil(t, err)
+	// a service holding only public keys can not mint tokens
+	_, err = verifier.sign(buildClaims("openIM123456", "IOS", time.Hour), 0)
+	assert.NotNil(t, err)
+
+	jwks := verifier.jwks(0)
+	assert.Equal(t, "OKP", jwks[0].Kty)
+	assert.Equal(t, "Ed25519", jwks[0].Crv)
+}
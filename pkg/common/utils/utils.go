@@ -6,6 +6,7 @@ import (
 	"Open_IM/pkg/common/token_verify"
 	open_im_sdk "Open_IM/pkg/proto/sdk_ws"
 	"Open_IM/pkg/utils"
+	"encoding/json"
 	"math/rand"
 	"strconv"
 	"time"
@@ -142,6 +143,9 @@ func GroupRequestDBCopyOpenIM(dst *open_im_sdk.GroupRequest, src *db.GroupReques
 	utils.CopyStructFields(dst, src)
 	dst.ReqTime = uint32(src.ReqTime.Unix())
 	dst.HandleTime = uint32(src.HandledTime.Unix())
+	if src.Answers != "" {
+		_ = json.Unmarshal([]byte(src.Answers), &dst.JoinAnswers)
+	}
 }
 
 func UserOpenIMCopyDB(dst *db.User, src *open_im_sdk.UserInfo) {
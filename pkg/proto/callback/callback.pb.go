@@ -0,0 +1,1359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: callback/callback.proto
+
+package callback // import "Open_IM/pkg/proto/callback"
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import sdk_ws "Open_IM/pkg/proto/sdk_ws"
+import wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CommonCallbackResp struct {
+	ActionCode           int32    `protobuf:"varint,1,opt,name=actionCode" json:"actionCode,omitempty"`
+	ErrCode              int32    `protobuf:"varint,2,opt,name=errCode" json:"errCode,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,3,opt,name=errMsg" json:"errMsg,omitempty"`
+	OperationID          string   `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommonCallbackResp) Reset()         { *m = CommonCallbackResp{} }
+func (m *CommonCallbackResp) String() string { return proto.CompactTextString(m) }
+func (*CommonCallbackResp) ProtoMessage()    {}
+func (*CommonCallbackResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{0}
+}
+func (m *CommonCallbackResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonCallbackResp.Unmarshal(m, b)
+}
+func (m *CommonCallbackResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonCallbackResp.Marshal(b, m, deterministic)
+}
+func (dst *CommonCallbackResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonCallbackResp.Merge(dst, src)
+}
+func (m *CommonCallbackResp) XXX_Size() int {
+	return xxx_messageInfo_CommonCallbackResp.Size(m)
+}
+func (m *CommonCallbackResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonCallbackResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommonCallbackResp proto.InternalMessageInfo
+
+func (m *CommonCallbackResp) GetActionCode() int32 {
+	if m != nil {
+		return m.ActionCode
+	}
+	return 0
+}
+
+func (m *CommonCallbackResp) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
+}
+
+func (m *CommonCallbackResp) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *CommonCallbackResp) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type CallbackSendMsgReq struct {
+	SendID               string   `protobuf:"bytes,1,opt,name=sendID" json:"sendID,omitempty"`
+	CallbackCommand      string   `protobuf:"bytes,2,opt,name=callbackCommand" json:"callbackCommand,omitempty"`
+	ServerMsgID          string   `protobuf:"bytes,3,opt,name=serverMsgID" json:"serverMsgID,omitempty"`
+	ClientMsgID          string   `protobuf:"bytes,4,opt,name=clientMsgID" json:"clientMsgID,omitempty"`
+	OperationID          string   `protobuf:"bytes,5,opt,name=operationID" json:"operationID,omitempty"`
+	SenderPlatformID     int32    `protobuf:"varint,6,opt,name=senderPlatformID" json:"senderPlatformID,omitempty"`
+	SenderNickname       string   `protobuf:"bytes,7,opt,name=senderNickname" json:"senderNickname,omitempty"`
+	SessionType          int32    `protobuf:"varint,8,opt,name=sessionType" json:"sessionType,omitempty"`
+	MsgFrom              int32    `protobuf:"varint,9,opt,name=msgFrom" json:"msgFrom,omitempty"`
+	ContentType          int32    `protobuf:"varint,10,opt,name=contentType" json:"contentType,omitempty"`
+	Status               int32    `protobuf:"varint,11,opt,name=status" json:"status,omitempty"`
+	CreateTime           int64    `protobuf:"varint,12,opt,name=createTime" json:"createTime,omitempty"`
+	Content              string   `protobuf:"bytes,13,opt,name=content" json:"content,omitempty"`
+	Seq                  uint32   `protobuf:"varint,14,opt,name=seq" json:"seq,omitempty"`
+	AtUserList           []string `protobuf:"bytes,15,rep,name=atUserList" json:"atUserList,omitempty"`
+	FaceURL              string   `protobuf:"bytes,16,opt,name=faceURL" json:"faceURL,omitempty"`
+	Ex                   string   `protobuf:"bytes,17,opt,name=ex" json:"ex,omitempty"`
+	RecvID               string   `protobuf:"bytes,18,opt,name=recvID" json:"recvID,omitempty"`
+	GroupID              string   `protobuf:"bytes,19,opt,name=groupID" json:"groupID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallbackSendMsgReq) Reset()         { *m = CallbackSendMsgReq{} }
+func (m *CallbackSendMsgReq) String() string { return proto.CompactTextString(m) }
+func (*CallbackSendMsgReq) ProtoMessage()    {}
+func (*CallbackSendMsgReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{1}
+}
+func (m *CallbackSendMsgReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallbackSendMsgReq.Unmarshal(m, b)
+}
+func (m *CallbackSendMsgReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallbackSendMsgReq.Marshal(b, m, deterministic)
+}
+func (dst *CallbackSendMsgReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackSendMsgReq.Merge(dst, src)
+}
+func (m *CallbackSendMsgReq) XXX_Size() int {
+	return xxx_messageInfo_CallbackSendMsgReq.Size(m)
+}
+func (m *CallbackSendMsgReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackSendMsgReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackSendMsgReq proto.InternalMessageInfo
+
+func (m *CallbackSendMsgReq) GetSendID() string {
+	if m != nil {
+		return m.SendID
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetCallbackCommand() string {
+	if m != nil {
+		return m.CallbackCommand
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetServerMsgID() string {
+	if m != nil {
+		return m.ServerMsgID
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetClientMsgID() string {
+	if m != nil {
+		return m.ClientMsgID
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetSenderPlatformID() int32 {
+	if m != nil {
+		return m.SenderPlatformID
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetSenderNickname() string {
+	if m != nil {
+		return m.SenderNickname
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetSessionType() int32 {
+	if m != nil {
+		return m.SessionType
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetMsgFrom() int32 {
+	if m != nil {
+		return m.MsgFrom
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetContentType() int32 {
+	if m != nil {
+		return m.ContentType
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *CallbackSendMsgReq) GetAtUserList() []string {
+	if m != nil {
+		return m.AtUserList
+	}
+	return nil
+}
+
+func (m *CallbackSendMsgReq) GetFaceURL() string {
+	if m != nil {
+		return m.FaceURL
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetEx() string {
+	if m != nil {
+		return m.Ex
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetRecvID() string {
+	if m != nil {
+		return m.RecvID
+	}
+	return ""
+}
+
+func (m *CallbackSendMsgReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+// the wrapped fields are the ones a receiver may leave out to keep the message as it is
+type CallbackMsgModifyResp struct {
+	ActionCode           int32                   `protobuf:"varint,1,opt,name=actionCode" json:"actionCode,omitempty"`
+	ErrCode              int32                   `protobuf:"varint,2,opt,name=errCode" json:"errCode,omitempty"`
+	ErrMsg               string                  `protobuf:"bytes,3,opt,name=errMsg" json:"errMsg,omitempty"`
+	OperationID          string                  `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	Content              *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=content" json:"content,omitempty"`
+	RecvID               *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=recvID" json:"recvID,omitempty"`
+	GroupID              *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=groupID" json:"groupID,omitempty"`
+	ClientMsgID          *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=clientMsgID" json:"clientMsgID,omitempty"`
+	ServerMsgID          *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=serverMsgID" json:"serverMsgID,omitempty"`
+	SenderPlatformID     *wrapperspb.Int32Value  `protobuf:"bytes,10,opt,name=senderPlatformID" json:"senderPlatformID,omitempty"`
+	SenderNickname       *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=senderNickname" json:"senderNickname,omitempty"`
+	SenderFaceURL        *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=senderFaceURL" json:"senderFaceURL,omitempty"`
+	SessionType          *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=sessionType" json:"sessionType,omitempty"`
+	MsgFrom              *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=msgFrom" json:"msgFrom,omitempty"`
+	ContentType          *wrapperspb.Int32Value  `protobuf:"bytes,15,opt,name=contentType" json:"contentType,omitempty"`
+	Status               *wrapperspb.Int32Value  `protobuf:"bytes,16,opt,name=status" json:"status,omitempty"`
+	Options              map[string]bool         `protobuf:"bytes,17,rep,name=options" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	OfflinePushInfo      *sdk_ws.OfflinePushInfo `protobuf:"bytes,18,opt,name=offlinePushInfo" json:"offlinePushInfo,omitempty"`
+	AtUserIDList         []string                `protobuf:"bytes,19,rep,name=atUserIDList" json:"atUserIDList,omitempty"`
+	MsgDataList          []byte                  `protobuf:"bytes,20,opt,name=msgDataList" json:"msgDataList,omitempty"`
+	AttachedInfo         *wrapperspb.StringValue `protobuf:"bytes,21,opt,name=attachedInfo" json:"attachedInfo,omitempty"`
+	Ex                   *wrapperspb.StringValue `protobuf:"bytes,22,opt,name=ex" json:"ex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CallbackMsgModifyResp) Reset()         { *m = CallbackMsgModifyResp{} }
+func (m *CallbackMsgModifyResp) String() string { return proto.CompactTextString(m) }
+func (*CallbackMsgModifyResp) ProtoMessage()    {}
+func (*CallbackMsgModifyResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{2}
+}
+func (m *CallbackMsgModifyResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallbackMsgModifyResp.Unmarshal(m, b)
+}
+func (m *CallbackMsgModifyResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallbackMsgModifyResp.Marshal(b, m, deterministic)
+}
+func (dst *CallbackMsgModifyResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackMsgModifyResp.Merge(dst, src)
+}
+func (m *CallbackMsgModifyResp) XXX_Size() int {
+	return xxx_messageInfo_CallbackMsgModifyResp.Size(m)
+}
+func (m *CallbackMsgModifyResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackMsgModifyResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackMsgModifyResp proto.InternalMessageInfo
+
+func (m *CallbackMsgModifyResp) GetActionCode() int32 {
+	if m != nil {
+		return m.ActionCode
+	}
+	return 0
+}
+
+func (m *CallbackMsgModifyResp) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
+}
+
+func (m *CallbackMsgModifyResp) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *CallbackMsgModifyResp) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *CallbackMsgModifyResp) GetContent() *wrapperspb.StringValue {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetRecvID() *wrapperspb.StringValue {
+	if m != nil {
+		return m.RecvID
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetGroupID() *wrapperspb.StringValue {
+	if m != nil {
+		return m.GroupID
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetClientMsgID() *wrapperspb.StringValue {
+	if m != nil {
+		return m.ClientMsgID
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetServerMsgID() *wrapperspb.StringValue {
+	if m != nil {
+		return m.ServerMsgID
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetSenderPlatformID() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.SenderPlatformID
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetSenderNickname() *wrapperspb.StringValue {
+	if m != nil {
+		return m.SenderNickname
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetSenderFaceURL() *wrapperspb.StringValue {
+	if m != nil {
+		return m.SenderFaceURL
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetSessionType() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.SessionType
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetMsgFrom() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.MsgFrom
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetContentType() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.ContentType
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetStatus() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetOptions() map[string]bool {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetOfflinePushInfo() *sdk_ws.OfflinePushInfo {
+	if m != nil {
+		return m.OfflinePushInfo
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetAtUserIDList() []string {
+	if m != nil {
+		return m.AtUserIDList
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetMsgDataList() []byte {
+	if m != nil {
+		return m.MsgDataList
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetAttachedInfo() *wrapperspb.StringValue {
+	if m != nil {
+		return m.AttachedInfo
+	}
+	return nil
+}
+
+func (m *CallbackMsgModifyResp) GetEx() *wrapperspb.StringValue {
+	if m != nil {
+		return m.Ex
+	}
+	return nil
+}
+
+type CallbackUserStatusReq struct {
+	CallbackCommand      string   `protobuf:"bytes,1,opt,name=callbackCommand" json:"callbackCommand,omitempty"`
+	OperationID          string   `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	PlatformID           int32    `protobuf:"varint,3,opt,name=platformID" json:"platformID,omitempty"`
+	Platform             string   `protobuf:"bytes,4,opt,name=platform" json:"platform,omitempty"`
+	UserID               string   `protobuf:"bytes,5,opt,name=userID" json:"userID,omitempty"`
+	Token                string   `protobuf:"bytes,6,opt,name=token" json:"token,omitempty"`
+	Seq                  int32    `protobuf:"varint,7,opt,name=seq" json:"seq,omitempty"`
+	IsAppBackground      bool     `protobuf:"varint,8,opt,name=isAppBackground" json:"isAppBackground,omitempty"`
+	ConnID               string   `protobuf:"bytes,9,opt,name=connID" json:"connID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallbackUserStatusReq) Reset()         { *m = CallbackUserStatusReq{} }
+func (m *CallbackUserStatusReq) String() string { return proto.CompactTextString(m) }
+func (*CallbackUserStatusReq) ProtoMessage()    {}
+func (*CallbackUserStatusReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{3}
+}
+func (m *CallbackUserStatusReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallbackUserStatusReq.Unmarshal(m, b)
+}
+func (m *CallbackUserStatusReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallbackUserStatusReq.Marshal(b, m, deterministic)
+}
+func (dst *CallbackUserStatusReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackUserStatusReq.Merge(dst, src)
+}
+func (m *CallbackUserStatusReq) XXX_Size() int {
+	return xxx_messageInfo_CallbackUserStatusReq.Size(m)
+}
+func (m *CallbackUserStatusReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackUserStatusReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackUserStatusReq proto.InternalMessageInfo
+
+func (m *CallbackUserStatusReq) GetCallbackCommand() string {
+	if m != nil {
+		return m.CallbackCommand
+	}
+	return ""
+}
+
+func (m *CallbackUserStatusReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *CallbackUserStatusReq) GetPlatformID() int32 {
+	if m != nil {
+		return m.PlatformID
+	}
+	return 0
+}
+
+func (m *CallbackUserStatusReq) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *CallbackUserStatusReq) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *CallbackUserStatusReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CallbackUserStatusReq) GetSeq() int32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *CallbackUserStatusReq) GetIsAppBackground() bool {
+	if m != nil {
+		return m.IsAppBackground
+	}
+	return false
+}
+
+func (m *CallbackUserStatusReq) GetConnID() string {
+	if m != nil {
+		return m.ConnID
+	}
+	return ""
+}
+
+type CallbackBeforePushReq struct {
+	CallbackCommand      string   `protobuf:"bytes,1,opt,name=callbackCommand" json:"callbackCommand,omitempty"`
+	OperationID          string   `protobuf:"bytes,2,opt,name=operationID" json:"operationID,omitempty"`
+	PlatformID           int32    `protobuf:"varint,3,opt,name=platformID" json:"platformID,omitempty"`
+	Platform             string   `protobuf:"bytes,4,opt,name=platform" json:"platform,omitempty"`
+	UserIDList           []string `protobuf:"bytes,5,rep,name=userIDList" json:"userIDList,omitempty"`
+	Title                string   `protobuf:"bytes,6,opt,name=title" json:"title,omitempty"`
+	Desc                 string   `protobuf:"bytes,7,opt,name=desc" json:"desc,omitempty"`
+	Ex                   string   `protobuf:"bytes,8,opt,name=ex" json:"ex,omitempty"`
+	IOSPushSound         string   `protobuf:"bytes,9,opt,name=iOSPushSound" json:"iOSPushSound,omitempty"`
+	IOSBadgeCount        bool     `protobuf:"varint,10,opt,name=iOSBadgeCount" json:"iOSBadgeCount,omitempty"`
+	ClientMsgID          string   `protobuf:"bytes,11,opt,name=clientMsgID" json:"clientMsgID,omitempty"`
+	SendID               string   `protobuf:"bytes,12,opt,name=sendID" json:"sendID,omitempty"`
+	GroupID              string   `protobuf:"bytes,13,opt,name=groupID" json:"groupID,omitempty"`
+	ContentType          int32    `protobuf:"varint,14,opt,name=contentType" json:"contentType,omitempty"`
+	SessionType          int32    `protobuf:"varint,15,opt,name=sessionType" json:"sessionType,omitempty"`
+	AtUserIDList         []string `protobuf:"bytes,16,rep,name=atUserIDList" json:"atUserIDList,omitempty"`
+	Content              string   `protobuf:"bytes,17,opt,name=content" json:"content,omitempty"`
+	Seq                  uint32   `protobuf:"varint,18,opt,name=seq" json:"seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CallbackBeforePushReq) Reset()         { *m = CallbackBeforePushReq{} }
+func (m *CallbackBeforePushReq) String() string { return proto.CompactTextString(m) }
+func (*CallbackBeforePushReq) ProtoMessage()    {}
+func (*CallbackBeforePushReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{4}
+}
+func (m *CallbackBeforePushReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallbackBeforePushReq.Unmarshal(m, b)
+}
+func (m *CallbackBeforePushReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallbackBeforePushReq.Marshal(b, m, deterministic)
+}
+func (dst *CallbackBeforePushReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackBeforePushReq.Merge(dst, src)
+}
+func (m *CallbackBeforePushReq) XXX_Size() int {
+	return xxx_messageInfo_CallbackBeforePushReq.Size(m)
+}
+func (m *CallbackBeforePushReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackBeforePushReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackBeforePushReq proto.InternalMessageInfo
+
+func (m *CallbackBeforePushReq) GetCallbackCommand() string {
+	if m != nil {
+		return m.CallbackCommand
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetPlatformID() int32 {
+	if m != nil {
+		return m.PlatformID
+	}
+	return 0
+}
+
+func (m *CallbackBeforePushReq) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetUserIDList() []string {
+	if m != nil {
+		return m.UserIDList
+	}
+	return nil
+}
+
+func (m *CallbackBeforePushReq) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetEx() string {
+	if m != nil {
+		return m.Ex
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetIOSPushSound() string {
+	if m != nil {
+		return m.IOSPushSound
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetIOSBadgeCount() bool {
+	if m != nil {
+		return m.IOSBadgeCount
+	}
+	return false
+}
+
+func (m *CallbackBeforePushReq) GetClientMsgID() string {
+	if m != nil {
+		return m.ClientMsgID
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetSendID() string {
+	if m != nil {
+		return m.SendID
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetContentType() int32 {
+	if m != nil {
+		return m.ContentType
+	}
+	return 0
+}
+
+func (m *CallbackBeforePushReq) GetSessionType() int32 {
+	if m != nil {
+		return m.SessionType
+	}
+	return 0
+}
+
+func (m *CallbackBeforePushReq) GetAtUserIDList() []string {
+	if m != nil {
+		return m.AtUserIDList
+	}
+	return nil
+}
+
+func (m *CallbackBeforePushReq) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushReq) GetSeq() uint32 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+type CallbackBeforePushResp struct {
+	ActionCode           int32                   `protobuf:"varint,1,opt,name=actionCode" json:"actionCode,omitempty"`
+	ErrCode              int32                   `protobuf:"varint,2,opt,name=errCode" json:"errCode,omitempty"`
+	ErrMsg               string                  `protobuf:"bytes,3,opt,name=errMsg" json:"errMsg,omitempty"`
+	OperationID          string                  `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	UserIDList           []string                `protobuf:"bytes,5,rep,name=userIDList" json:"userIDList,omitempty"`
+	OfflinePushInfo      *sdk_ws.OfflinePushInfo `protobuf:"bytes,6,opt,name=offlinePushInfo" json:"offlinePushInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *CallbackBeforePushResp) Reset()         { *m = CallbackBeforePushResp{} }
+func (m *CallbackBeforePushResp) String() string { return proto.CompactTextString(m) }
+func (*CallbackBeforePushResp) ProtoMessage()    {}
+func (*CallbackBeforePushResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_callback_29f0f26d22328b1b, []int{5}
+}
+func (m *CallbackBeforePushResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallbackBeforePushResp.Unmarshal(m, b)
+}
+func (m *CallbackBeforePushResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallbackBeforePushResp.Marshal(b, m, deterministic)
+}
+func (dst *CallbackBeforePushResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallbackBeforePushResp.Merge(dst, src)
+}
+func (m *CallbackBeforePushResp) XXX_Size() int {
+	return xxx_messageInfo_CallbackBeforePushResp.Size(m)
+}
+func (m *CallbackBeforePushResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallbackBeforePushResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallbackBeforePushResp proto.InternalMessageInfo
+
+func (m *CallbackBeforePushResp) GetActionCode() int32 {
+	if m != nil {
+		return m.ActionCode
+	}
+	return 0
+}
+
+func (m *CallbackBeforePushResp) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
+}
+
+func (m *CallbackBeforePushResp) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushResp) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+func (m *CallbackBeforePushResp) GetUserIDList() []string {
+	if m != nil {
+		return m.UserIDList
+	}
+	return nil
+}
+
+func (m *CallbackBeforePushResp) GetOfflinePushInfo() *sdk_ws.OfflinePushInfo {
+	if m != nil {
+		return m.OfflinePushInfo
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CommonCallbackResp)(nil), "callback.CommonCallbackResp")
+	proto.RegisterType((*CallbackSendMsgReq)(nil), "callback.CallbackSendMsgReq")
+	proto.RegisterType((*CallbackMsgModifyResp)(nil), "callback.CallbackMsgModifyResp")
+	proto.RegisterMapType((map[string]bool)(nil), "callback.CallbackMsgModifyResp.OptionsEntry")
+	proto.RegisterType((*CallbackUserStatusReq)(nil), "callback.CallbackUserStatusReq")
+	proto.RegisterType((*CallbackBeforePushReq)(nil), "callback.CallbackBeforePushReq")
+	proto.RegisterType((*CallbackBeforePushResp)(nil), "callback.CallbackBeforePushResp")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Callback service
+
+type CallbackClient interface {
+	CallbackBeforeSendSingleMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackAfterSendSingleMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackBeforeSendGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackAfterSendGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackAfterConsumeGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackMsgModify(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CallbackMsgModifyResp, error)
+	CallbackUserOnline(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackUserOffline(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackUserKickOff(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error)
+	CallbackOfflinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error)
+	CallbackOnlinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error)
+	CallbackSuperGroupOnlinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error)
+}
+
+type callbackClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCallbackClient(cc *grpc.ClientConn) CallbackClient {
+	return &callbackClient{cc}
+}
+
+func (c *callbackClient) CallbackBeforeSendSingleMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackBeforeSendSingleMsg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackAfterSendSingleMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackAfterSendSingleMsg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackBeforeSendGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackBeforeSendGroupMsg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackAfterSendGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackAfterSendGroupMsg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackAfterConsumeGroupMsg(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackAfterConsumeGroupMsg", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackMsgModify(ctx context.Context, in *CallbackSendMsgReq, opts ...grpc.CallOption) (*CallbackMsgModifyResp, error) {
+	out := new(CallbackMsgModifyResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackMsgModify", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackUserOnline(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackUserOnline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackUserOffline(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackUserOffline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackUserKickOff(ctx context.Context, in *CallbackUserStatusReq, opts ...grpc.CallOption) (*CommonCallbackResp, error) {
+	out := new(CommonCallbackResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackUserKickOff", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackOfflinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error) {
+	out := new(CallbackBeforePushResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackOfflinePush", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackOnlinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error) {
+	out := new(CallbackBeforePushResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackOnlinePush", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackClient) CallbackSuperGroupOnlinePush(ctx context.Context, in *CallbackBeforePushReq, opts ...grpc.CallOption) (*CallbackBeforePushResp, error) {
+	out := new(CallbackBeforePushResp)
+	err := grpc.Invoke(ctx, "/callback.Callback/CallbackSuperGroupOnlinePush", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Callback service
+
+type CallbackServer interface {
+	CallbackBeforeSendSingleMsg(context.Context, *CallbackSendMsgReq) (*CommonCallbackResp, error)
+	CallbackAfterSendSingleMsg(context.Context, *CallbackSendMsgReq) (*CommonCallbackResp, error)
+	CallbackBeforeSendGroupMsg(context.Context, *CallbackSendMsgReq) (*CommonCallbackResp, error)
+	CallbackAfterSendGroupMsg(context.Context, *CallbackSendMsgReq) (*CommonCallbackResp, error)
+	CallbackAfterConsumeGroupMsg(context.Context, *CallbackSendMsgReq) (*CommonCallbackResp, error)
+	CallbackMsgModify(context.Context, *CallbackSendMsgReq) (*CallbackMsgModifyResp, error)
+	CallbackUserOnline(context.Context, *CallbackUserStatusReq) (*CommonCallbackResp, error)
+	CallbackUserOffline(context.Context, *CallbackUserStatusReq) (*CommonCallbackResp, error)
+	CallbackUserKickOff(context.Context, *CallbackUserStatusReq) (*CommonCallbackResp, error)
+	CallbackOfflinePush(context.Context, *CallbackBeforePushReq) (*CallbackBeforePushResp, error)
+	CallbackOnlinePush(context.Context, *CallbackBeforePushReq) (*CallbackBeforePushResp, error)
+	CallbackSuperGroupOnlinePush(context.Context, *CallbackBeforePushReq) (*CallbackBeforePushResp, error)
+}
+
+func RegisterCallbackServer(s *grpc.Server, srv CallbackServer) {
+	s.RegisterService(&_Callback_serviceDesc, srv)
+}
+
+func _Callback_CallbackBeforeSendSingleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackBeforeSendSingleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackBeforeSendSingleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackBeforeSendSingleMsg(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackAfterSendSingleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackAfterSendSingleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackAfterSendSingleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackAfterSendSingleMsg(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackBeforeSendGroupMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackBeforeSendGroupMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackBeforeSendGroupMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackBeforeSendGroupMsg(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackAfterSendGroupMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackAfterSendGroupMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackAfterSendGroupMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackAfterSendGroupMsg(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackAfterConsumeGroupMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackAfterConsumeGroupMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackAfterConsumeGroupMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackAfterConsumeGroupMsg(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackMsgModify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackSendMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackMsgModify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackMsgModify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackMsgModify(ctx, req.(*CallbackSendMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackUserOnline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackUserStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackUserOnline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackUserOnline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackUserOnline(ctx, req.(*CallbackUserStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackUserOffline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackUserStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackUserOffline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackUserOffline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackUserOffline(ctx, req.(*CallbackUserStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackUserKickOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackUserStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackUserKickOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackUserKickOff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackUserKickOff(ctx, req.(*CallbackUserStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackOfflinePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackBeforePushReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackOfflinePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackOfflinePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackOfflinePush(ctx, req.(*CallbackBeforePushReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackOnlinePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackBeforePushReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackOnlinePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackOnlinePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackOnlinePush(ctx, req.(*CallbackBeforePushReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Callback_CallbackSuperGroupOnlinePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallbackBeforePushReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackServer).CallbackSuperGroupOnlinePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/callback.Callback/CallbackSuperGroupOnlinePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackServer).CallbackSuperGroupOnlinePush(ctx, req.(*CallbackBeforePushReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Callback_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "callback.Callback",
+	HandlerType: (*CallbackServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CallbackBeforeSendSingleMsg",
+			Handler:    _Callback_CallbackBeforeSendSingleMsg_Handler,
+		},
+		{
+			MethodName: "CallbackAfterSendSingleMsg",
+			Handler:    _Callback_CallbackAfterSendSingleMsg_Handler,
+		},
+		{
+			MethodName: "CallbackBeforeSendGroupMsg",
+			Handler:    _Callback_CallbackBeforeSendGroupMsg_Handler,
+		},
+		{
+			MethodName: "CallbackAfterSendGroupMsg",
+			Handler:    _Callback_CallbackAfterSendGroupMsg_Handler,
+		},
+		{
+			MethodName: "CallbackAfterConsumeGroupMsg",
+			Handler:    _Callback_CallbackAfterConsumeGroupMsg_Handler,
+		},
+		{
+			MethodName: "CallbackMsgModify",
+			Handler:    _Callback_CallbackMsgModify_Handler,
+		},
+		{
+			MethodName: "CallbackUserOnline",
+			Handler:    _Callback_CallbackUserOnline_Handler,
+		},
+		{
+			MethodName: "CallbackUserOffline",
+			Handler:    _Callback_CallbackUserOffline_Handler,
+		},
+		{
+			MethodName: "CallbackUserKickOff",
+			Handler:    _Callback_CallbackUserKickOff_Handler,
+		},
+		{
+			MethodName: "CallbackOfflinePush",
+			Handler:    _Callback_CallbackOfflinePush_Handler,
+		},
+		{
+			MethodName: "CallbackOnlinePush",
+			Handler:    _Callback_CallbackOnlinePush_Handler,
+		},
+		{
+			MethodName: "CallbackSuperGroupOnlinePush",
+			Handler:    _Callback_CallbackSuperGroupOnlinePush_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "callback/callback.proto",
+}
+
+func init() { proto.RegisterFile("callback/callback.proto", fileDescriptor_callback_29f0f26d22328b1b) }
+
+var fileDescriptor_callback_29f0f26d22328b1b = []byte{
+	// 1219 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xdb, 0x6e, 0xdb, 0x46,
+	0x13, 0x86, 0x24, 0x1f, 0xa4, 0x95, 0xe4, 0xc3, 0x3a, 0xf1, 0xcf, 0x5f, 0x31, 0x5c, 0x41, 0x3d,
+	0x40, 0x28, 0x12, 0x19, 0xb0, 0xdb, 0xa0, 0x48, 0x91, 0xa0, 0xb1, 0x55, 0x07, 0x42, 0xad, 0x2a,
+	0xa0, 0x9c, 0x36, 0xcd, 0x8d, 0xb1, 0xa6, 0x86, 0x0c, 0x21, 0x69, 0x97, 0xd9, 0xa5, 0x1c, 0xfb,
+	0x0d, 0xfa, 0x1a, 0xbd, 0xee, 0x13, 0xf4, 0x25, 0xfa, 0x38, 0xbd, 0xe8, 0x55, 0xb1, 0x07, 0x8a,
+	0xa4, 0xa8, 0x56, 0x42, 0xe3, 0x20, 0xbd, 0xe3, 0x0c, 0x67, 0xbe, 0x9d, 0x1d, 0xce, 0x37, 0x33,
+	0x12, 0xfa, 0x9f, 0x43, 0x46, 0xa3, 0x4b, 0xe2, 0x0c, 0x0f, 0xa2, 0x87, 0x56, 0xc0, 0x59, 0xc8,
+	0x70, 0x31, 0x92, 0x6b, 0xcd, 0x5e, 0x00, 0xf4, 0x41, 0xa7, 0xfb, 0xa0, 0x0f, 0xfc, 0x0a, 0xf8,
+	0x41, 0x30, 0xf4, 0x0e, 0x94, 0xcd, 0x81, 0x18, 0x0c, 0x2f, 0xde, 0x8a, 0x83, 0xb7, 0x42, 0xfb,
+	0xd4, 0x5a, 0x0b, 0x2d, 0x39, 0x09, 0x02, 0xe0, 0xc6, 0xbe, 0xf1, 0x73, 0x0e, 0xe1, 0x13, 0x36,
+	0x1e, 0x33, 0x7a, 0x62, 0x0e, 0xb3, 0x41, 0x04, 0x78, 0x1f, 0x21, 0xe2, 0x84, 0x3e, 0xa3, 0x27,
+	0x6c, 0x00, 0x56, 0xae, 0x9e, 0x6b, 0xae, 0xda, 0x09, 0x0d, 0xb6, 0xd0, 0x3a, 0x70, 0xae, 0x5e,
+	0xe6, 0xd5, 0xcb, 0x48, 0xc4, 0xbb, 0x68, 0x0d, 0x38, 0xef, 0x0a, 0xcf, 0x2a, 0xd4, 0x73, 0xcd,
+	0x92, 0x6d, 0x24, 0x5c, 0x47, 0x65, 0x16, 0x00, 0x27, 0x12, 0xa2, 0xd3, 0xb6, 0x56, 0xd4, 0xcb,
+	0xa4, 0xaa, 0xf1, 0xfb, 0x0a, 0xc2, 0x51, 0x10, 0x7d, 0xa0, 0x83, 0xae, 0xf0, 0x6c, 0x78, 0x23,
+	0x01, 0x05, 0xd0, 0x41, 0xa7, 0xad, 0xc2, 0x28, 0xd9, 0x46, 0xc2, 0x4d, 0xb4, 0x19, 0xe5, 0x47,
+	0x5e, 0x80, 0xd0, 0x81, 0x0a, 0xa5, 0x64, 0xcf, 0xaa, 0xe5, 0xd1, 0x42, 0x65, 0xa3, 0x2b, 0xbc,
+	0x4e, 0xdb, 0xc4, 0x95, 0x54, 0x49, 0x0b, 0x67, 0xe4, 0x03, 0x0d, 0xb5, 0x85, 0x09, 0x2e, 0xa1,
+	0x9a, 0x0d, 0x7f, 0x35, 0x13, 0x3e, 0xfe, 0x1c, 0x6d, 0xc9, 0xc8, 0x80, 0x3f, 0x1f, 0x91, 0xd0,
+	0x65, 0x7c, 0xdc, 0x69, 0x5b, 0x6b, 0x2a, 0x37, 0x19, 0x3d, 0xfe, 0x0c, 0x6d, 0x68, 0xdd, 0xf7,
+	0xbe, 0x33, 0xa4, 0x64, 0x0c, 0xd6, 0xba, 0x02, 0x9c, 0xd1, 0xea, 0xc8, 0x85, 0xf0, 0x19, 0x3d,
+	0xbf, 0x09, 0xc0, 0x2a, 0x2a, 0xb8, 0xa4, 0x4a, 0x7e, 0x88, 0xb1, 0xf0, 0x4e, 0x39, 0x1b, 0x5b,
+	0x25, 0xfd, 0x21, 0x8c, 0xa8, 0xee, 0xc4, 0x68, 0x08, 0x34, 0x54, 0xbe, 0x48, 0xfb, 0x26, 0x54,
+	0x2a, 0xb3, 0x21, 0x09, 0x27, 0xc2, 0x2a, 0xab, 0x97, 0x46, 0x92, 0x1f, 0xdf, 0xe1, 0x40, 0x42,
+	0x38, 0xf7, 0xc7, 0x60, 0x55, 0xea, 0xb9, 0x66, 0xc1, 0x4e, 0x68, 0xe4, 0x99, 0x06, 0xc6, 0xaa,
+	0xaa, 0xb0, 0x23, 0x11, 0x6f, 0xa1, 0x82, 0x80, 0x37, 0xd6, 0x46, 0x3d, 0xd7, 0xac, 0xda, 0xf2,
+	0x51, 0x15, 0x52, 0xf8, 0x42, 0x00, 0x3f, 0xf3, 0x45, 0x68, 0x6d, 0xd6, 0x0b, 0xcd, 0x92, 0x9d,
+	0xd0, 0x48, 0x2c, 0x97, 0x38, 0xf0, 0xc2, 0x3e, 0xb3, 0xb6, 0x34, 0x96, 0x11, 0xf1, 0x06, 0xca,
+	0xc3, 0xb5, 0xb5, 0xad, 0x94, 0x79, 0xb8, 0x96, 0xd1, 0x72, 0x70, 0xae, 0x3a, 0x6d, 0x0b, 0xeb,
+	0x3a, 0xd0, 0x92, 0x44, 0xf0, 0x38, 0x9b, 0x04, 0x9d, 0xb6, 0xb5, 0xa3, 0x11, 0x8c, 0xd8, 0xf8,
+	0xa3, 0x84, 0xee, 0x46, 0x05, 0xd5, 0x15, 0x5e, 0x97, 0x0d, 0x7c, 0xf7, 0xe6, 0x43, 0x95, 0x37,
+	0x7e, 0x18, 0x67, 0x4d, 0x56, 0x4f, 0xf9, 0x70, 0xaf, 0xe5, 0x31, 0xe6, 0x8d, 0x40, 0x33, 0xf1,
+	0x72, 0xe2, 0xb6, 0xfa, 0x21, 0xf7, 0xa9, 0xf7, 0x03, 0x19, 0x4d, 0x20, 0xce, 0xe9, 0x17, 0xd3,
+	0x7b, 0xaf, 0x2d, 0xe1, 0x16, 0x65, 0xe5, 0x61, 0x9c, 0x95, 0xf5, 0x65, 0x4e, 0x33, 0xc6, 0xf8,
+	0x49, 0x9a, 0x09, 0xc5, 0x25, 0x7c, 0x53, 0x3c, 0x79, 0x92, 0xe6, 0x5a, 0x69, 0x19, 0xff, 0x24,
+	0x13, 0x9f, 0xcd, 0x61, 0x11, 0x52, 0x20, 0xf7, 0x32, 0x20, 0x1d, 0x1a, 0x1e, 0x1d, 0x6a, 0x8c,
+	0x2c, 0xc5, 0xda, 0x19, 0x8a, 0x95, 0x97, 0x88, 0x65, 0x96, 0x80, 0xc7, 0xa8, 0xaa, 0x35, 0xa7,
+	0xa6, 0x48, 0x2b, 0x4b, 0x80, 0xa4, 0x5d, 0xf0, 0xe3, 0x34, 0x89, 0xab, 0x8b, 0x6f, 0x93, 0x62,
+	0xf8, 0x97, 0x31, 0xc3, 0x37, 0x16, 0xbb, 0x4e, 0xe9, 0xff, 0x38, 0x4d, 0xff, 0xcd, 0x25, 0x4e,
+	0x4d, 0xd8, 0xe3, 0xa3, 0x69, 0x6f, 0xd8, 0x5a, 0xec, 0x69, 0x4c, 0xf1, 0x29, 0x5a, 0x67, 0x81,
+	0x2c, 0x77, 0x61, 0x6d, 0xd7, 0x0b, 0xcd, 0xf2, 0xe1, 0xfd, 0xd6, 0x74, 0xa4, 0xcd, 0x25, 0x62,
+	0xab, 0xa7, 0xcd, 0xbf, 0xa5, 0x21, 0xbf, 0xb1, 0x23, 0x67, 0x7c, 0x86, 0x36, 0x99, 0xeb, 0x8e,
+	0x7c, 0x0a, 0xcf, 0x27, 0xe2, 0x75, 0x87, 0xba, 0x4c, 0x71, 0xbe, 0x7c, 0xd8, 0x68, 0xe9, 0x5a,
+	0xb9, 0x20, 0x81, 0x7f, 0x11, 0x10, 0x4e, 0xc6, 0xa2, 0xd5, 0x4b, 0x5b, 0xda, 0xb3, 0xae, 0xb8,
+	0x81, 0x2a, 0xba, 0xe1, 0x74, 0xda, 0xaa, 0x09, 0xed, 0xa8, 0x26, 0x94, 0xd2, 0x49, 0xfa, 0x8e,
+	0x85, 0xd7, 0x26, 0x21, 0x51, 0x26, 0x77, 0xea, 0xb9, 0x66, 0xc5, 0x4e, 0xaa, 0xf0, 0x37, 0x12,
+	0x25, 0x24, 0xce, 0x6b, 0x18, 0xa8, 0x80, 0xee, 0x2e, 0x51, 0x08, 0x29, 0x0f, 0x7c, 0x5f, 0x35,
+	0xb4, 0xdd, 0x25, 0xfc, 0xf2, 0x70, 0x5d, 0x7b, 0x84, 0x2a, 0xc9, 0xe4, 0xc8, 0xd6, 0x3a, 0x84,
+	0x1b, 0x33, 0x03, 0xe5, 0x23, 0xbe, 0x83, 0x56, 0xaf, 0xa4, 0xb9, 0x6a, 0x51, 0x45, 0x5b, 0x0b,
+	0x8f, 0xf2, 0x5f, 0xe5, 0x1a, 0xbf, 0xe4, 0xe3, 0xc6, 0x27, 0x2f, 0xd9, 0x57, 0x9f, 0x47, 0x0e,
+	0xd3, 0x39, 0x43, 0x33, 0xf7, 0xb7, 0x43, 0x33, 0xd9, 0xd0, 0xf2, 0xd9, 0x86, 0xb6, 0x8f, 0x50,
+	0x10, 0x93, 0xb4, 0xa0, 0x9b, 0x68, 0xac, 0xc1, 0x35, 0x54, 0x8c, 0x24, 0xd3, 0x0f, 0xa7, 0xb2,
+	0x6c, 0xa3, 0x13, 0x95, 0x7d, 0x33, 0x49, 0x8d, 0x24, 0xef, 0x14, 0xb2, 0x21, 0x50, 0xd5, 0xeb,
+	0x4a, 0xb6, 0x16, 0xa2, 0xb1, 0xb2, 0xae, 0x8e, 0x28, 0x08, 0x7d, 0x0f, 0x5f, 0x3c, 0x0d, 0x82,
+	0x63, 0xe2, 0x0c, 0x65, 0xeb, 0xa2, 0x03, 0xd5, 0xaa, 0x8a, 0xf6, 0xac, 0x5a, 0x9e, 0xe4, 0x30,
+	0x4a, 0x4d, 0x2f, 0x2a, 0xd9, 0x46, 0x6a, 0xfc, 0xba, 0x12, 0xe7, 0xe8, 0x18, 0x5c, 0xc6, 0x55,
+	0xc1, 0xfc, 0x97, 0x72, 0xb4, 0x8f, 0xd0, 0x24, 0xae, 0xda, 0x55, 0x3d, 0x3a, 0x63, 0x8d, 0xca,
+	0x95, 0x1f, 0x8e, 0x60, 0x9a, 0x2b, 0x29, 0x60, 0x8c, 0x56, 0x06, 0x20, 0x1c, 0xb3, 0x50, 0xa8,
+	0x67, 0x33, 0x4a, 0x8b, 0xd3, 0x51, 0xda, 0x40, 0x15, 0xbf, 0xd7, 0x97, 0xf7, 0xed, 0xab, 0xd4,
+	0xe9, 0xcc, 0xa4, 0x74, 0xf8, 0x13, 0x54, 0xf5, 0x7b, 0xfd, 0x63, 0x32, 0xf0, 0xe0, 0x84, 0x4d,
+	0x68, 0xa8, 0xba, 0x70, 0xd1, 0x4e, 0x2b, 0x67, 0x17, 0xa7, 0x72, 0x76, 0x71, 0x8a, 0xd7, 0xb7,
+	0x4a, 0x6a, 0x7d, 0x4b, 0x8c, 0xed, 0x6a, 0x6a, 0x6c, 0xcf, 0x2e, 0x2e, 0x1b, 0xd9, 0xc5, 0x65,
+	0x66, 0x2d, 0xda, 0xcc, 0xae, 0x45, 0xb3, 0x9c, 0xdf, 0x9a, 0xc3, 0xf9, 0xc4, 0x1a, 0xb3, 0x3d,
+	0x77, 0x8d, 0xc1, 0xd3, 0x35, 0xa6, 0xf1, 0x67, 0x0e, 0xed, 0xce, 0xab, 0x96, 0x0f, 0xb4, 0x4b,
+	0x2c, 0x2a, 0x8d, 0x39, 0x0d, 0x74, 0xed, 0x5f, 0x37, 0xd0, 0xc3, 0xdf, 0x8a, 0xa8, 0x18, 0x5d,
+	0x1e, 0xff, 0x84, 0xee, 0xa5, 0x13, 0x21, 0x57, 0xf5, 0xbe, 0x4f, 0xbd, 0x11, 0xc8, 0xd8, 0xf7,
+	0xb2, 0x1d, 0x3f, 0xde, 0xe5, 0x6b, 0xc9, 0xb7, 0xd9, 0x1f, 0x1d, 0x2f, 0x51, 0x2d, 0x92, 0x9f,
+	0xba, 0x21, 0xf0, 0xf7, 0x82, 0x1c, 0x07, 0xfd, 0x4c, 0xd6, 0xdb, 0xbb, 0x22, 0xff, 0x88, 0xfe,
+	0x9f, 0x89, 0xf9, 0x56, 0x80, 0x5f, 0xa1, 0xbd, 0x14, 0xf0, 0x09, 0xa3, 0x62, 0x32, 0x86, 0x5b,
+	0xc1, 0xb6, 0xd1, 0x76, 0x66, 0x1c, 0x2f, 0x00, 0xfc, 0x68, 0xc1, 0x24, 0xc7, 0xfd, 0xf8, 0xc7,
+	0x9b, 0xe4, 0x58, 0x8f, 0xca, 0x12, 0xc2, 0x73, 0xdc, 0x52, 0x03, 0x69, 0x41, 0xa0, 0xe7, 0x68,
+	0x27, 0x05, 0xea, 0xba, 0xef, 0x01, 0xf5, 0x3b, 0xdf, 0x19, 0xf6, 0x5c, 0xf7, 0x5d, 0x51, 0x5f,
+	0xc6, 0xa8, 0x09, 0x46, 0xcd, 0x43, 0x4d, 0x8d, 0x9b, 0x5a, 0xfd, 0x9f, 0x0d, 0x54, 0x8d, 0x4d,
+	0x53, 0xab, 0xd3, 0x7a, 0x5b, 0xc0, 0x24, 0xae, 0xb1, 0xfe, 0x24, 0x00, 0xae, 0x8a, 0xeb, 0x56,
+	0x8f, 0x38, 0xfe, 0xf4, 0xd5, 0xc7, 0xf2, 0x1f, 0x89, 0x8b, 0x4e, 0x37, 0xf1, 0x57, 0x44, 0xe4,
+	0xf4, 0x75, 0xf4, 0x70, 0xb9, 0xa6, 0x5e, 0x1c, 0xfd, 0x35, 0x00, 0x2c, 0x8e, 0x1a, 0x0a, 0x0c,
+	0x11, 0x00, 0x00,
+}
@@ -35,7 +35,7 @@ func (m *CommonResp) Reset()         { *m = CommonResp{} }
 func (m *CommonResp) String() string { return proto.CompactTextString(m) }
 func (*CommonResp) ProtoMessage()    {}
 func (*CommonResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_conversation_7438d6c35155b4e4, []int{0}
+	return fileDescriptor_conversation_6a8b86daf0d8ba12, []int{0}
 }
 func (m *CommonResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommonResp.Unmarshal(m, b)
@@ -86,6 +86,7 @@ type Conversation struct {
 	Ex                    string   `protobuf:"bytes,14,opt,name=ex" json:"ex,omitempty"`
 	UpdateUnreadCountTime int64    `protobuf:"varint,15,opt,name=updateUnreadCountTime" json:"updateUnreadCountTime,omitempty"`
 	BurnDuration          int32    `protobuf:"varint,16,opt,name=burnDuration" json:"burnDuration,omitempty"`
+	MuteUntilTime         int64    `protobuf:"varint,17,opt,name=muteUntilTime" json:"muteUntilTime,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
 	XXX_unrecognized      []byte   `json:"-"`
 	XXX_sizecache         int32    `json:"-"`
@@ -95,7 +96,7 @@ func (m *Conversation) Reset()         { *m = Conversation{} }
 func (m *Conversation) String() string { return proto.CompactTextString(m) }
 func (*Conversation) ProtoMessage()    {}
 func (*Conversation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_conversation_7438d6c35155b4e4, []int{1}
+	return fileDescriptor_conversation_6a8b86daf0d8ba12, []int{1}
 }
 func (m *Conversation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Conversation.Unmarshal(m, b)
@@ -227,6 +228,13 @@ func (m *Conversation) GetBurnDuration() int32 {
 	return 0
 }
 
+func (m *Conversation) GetMuteUntilTime() int64 {
+	if m != nil {
+		return m.MuteUntilTime
+	}
+	return 0
+}
+
 type ModifyConversationFieldReq struct {
 	Conversation         *Conversation `protobuf:"bytes,1,opt,name=conversation" json:"conversation,omitempty"`
 	FieldType            int32         `protobuf:"varint,2,opt,name=fieldType" json:"fieldType,omitempty"`
@@ -241,7 +249,7 @@ func (m *ModifyConversationFieldReq) Reset()         { *m = ModifyConversationFi
 func (m *ModifyConversationFieldReq) String() string { return proto.CompactTextString(m) }
 func (*ModifyConversationFieldReq) ProtoMessage()    {}
 func (*ModifyConversationFieldReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_conversation_7438d6c35155b4e4, []int{2}
+	return fileDescriptor_conversation_6a8b86daf0d8ba12, []int{2}
 }
 func (m *ModifyConversationFieldReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyConversationFieldReq.Unmarshal(m, b)
@@ -300,7 +308,7 @@ func (m *ModifyConversationFieldResp) Reset()         { *m = ModifyConversationF
 func (m *ModifyConversationFieldResp) String() string { return proto.CompactTextString(m) }
 func (*ModifyConversationFieldResp) ProtoMessage()    {}
 func (*ModifyConversationFieldResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_conversation_7438d6c35155b4e4, []int{3}
+	return fileDescriptor_conversation_6a8b86daf0d8ba12, []int{3}
 }
 func (m *ModifyConversationFieldResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyConversationFieldResp.Unmarshal(m, b)
@@ -342,8 +350,9 @@ var _ grpc.ClientConn
 // is compatible with the grpc package it is being compiled against.
 const _ = grpc.SupportPackageIsVersion4
 
-// Client API for Conversation service
-
+// ConversationClient is the client API for Conversation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ConversationClient interface {
 	ModifyConversationField(ctx context.Context, in *ModifyConversationFieldReq, opts ...grpc.CallOption) (*ModifyConversationFieldResp, error)
 }
@@ -358,15 +367,14 @@ func NewConversationClient(cc *grpc.ClientConn) ConversationClient {
 
 func (c *conversationClient) ModifyConversationField(ctx context.Context, in *ModifyConversationFieldReq, opts ...grpc.CallOption) (*ModifyConversationFieldResp, error) {
 	out := new(ModifyConversationFieldResp)
-	err := grpc.Invoke(ctx, "/conversation.conversation/ModifyConversationField", in, out, c.cc, opts...)
+	err := c.cc.Invoke(ctx, "/conversation.conversation/ModifyConversationField", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for Conversation service
-
+// ConversationServer is the server API for Conversation service.
 type ConversationServer interface {
 	ModifyConversationField(context.Context, *ModifyConversationFieldReq) (*ModifyConversationFieldResp, error)
 }
@@ -407,42 +415,43 @@ var _Conversation_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("conversation/conversation.proto", fileDescriptor_conversation_7438d6c35155b4e4)
+	proto.RegisterFile("conversation/conversation.proto", fileDescriptor_conversation_6a8b86daf0d8ba12)
 }
 
-var fileDescriptor_conversation_7438d6c35155b4e4 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xd1, 0x6b, 0x13, 0x41,
-	0x10, 0xc6, 0xb9, 0xb4, 0x4d, 0x93, 0x49, 0x1a, 0xcb, 0x82, 0xba, 0x44, 0xd1, 0x10, 0x44, 0x4e,
+var fileDescriptor_conversation_6a8b86daf0d8ba12 = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x51, 0x6b, 0x13, 0x41,
+	0x10, 0xc7, 0xb9, 0x34, 0x4d, 0x93, 0x49, 0x1a, 0xeb, 0x82, 0xba, 0x44, 0xd1, 0x10, 0x44, 0x4e,
 	0xc5, 0x06, 0xaa, 0x0f, 0x82, 0x50, 0xd0, 0x04, 0xe5, 0xc0, 0xd8, 0x72, 0xa4, 0x08, 0xbe, 0xc8,
-	0x35, 0x37, 0x49, 0x0f, 0xcd, 0xee, 0xba, 0xbb, 0x17, 0xd3, 0x17, 0xff, 0x2a, 0x1f, 0xfd, 0xe3,
-	0x64, 0x27, 0x49, 0xb3, 0x5b, 0x0d, 0xf8, 0x38, 0xbf, 0x9d, 0xfb, 0xe6, 0x9b, 0xcd, 0x97, 0x85,
-	0x87, 0x63, 0x29, 0xe6, 0xa8, 0x4d, 0x66, 0x0b, 0x29, 0x7a, 0x7e, 0x71, 0xa4, 0xb4, 0xb4, 0x92,
-	0x35, 0x7d, 0xd6, 0x3d, 0x01, 0xe8, 0xcb, 0xd9, 0x4c, 0x8a, 0x14, 0x8d, 0x62, 0x1c, 0xf6, 0x51,
-	0xeb, 0xbe, 0xcc, 0x91, 0x47, 0x9d, 0x28, 0xde, 0x4b, 0xd7, 0x25, 0xbb, 0x03, 0x55, 0xd4, 0x7a,
-	0x68, 0xa6, 0xbc, 0xd2, 0x89, 0xe2, 0x7a, 0xba, 0xaa, 0xba, 0xbf, 0x76, 0xa1, 0xd9, 0xf7, 0x04,
-	0x59, 0x07, 0x1a, 0xf2, 0x87, 0x40, 0x7d, 0x6e, 0x50, 0x27, 0x03, 0x92, 0xa9, 0xa7, 0x3e, 0x62,
-	0x8f, 0xa1, 0xe5, 0x5b, 0x48, 0x06, 0x2b, 0xc9, 0x1b, 0x94, 0x3d, 0x00, 0xd0, 0x38, 0x9e, 0x0f,
-	0xcd, 0xf4, 0x54, 0x59, 0xbe, 0x43, 0x7e, 0x3c, 0xc2, 0x9e, 0xc2, 0xa1, 0xff, 0xc5, 0xe8, 0x4a,
-	0x21, 0xdf, 0xa5, 0xae, 0xbf, 0xb8, 0xb3, 0x5f, 0x2e, 0x0d, 0xed, 0x2d, 0xed, 0x2f, 0x2b, 0xb7,
-	0xf0, 0x54, 0xcb, 0x52, 0x25, 0x03, 0x5e, 0xa5, 0x83, 0x75, 0xe9, 0xf6, 0x28, 0x85, 0xc6, 0x2c,
-	0xef, 0xcb, 0x52, 0x58, 0xbe, 0x4f, 0xc2, 0x3e, 0x62, 0x8f, 0xe0, 0x20, 0xd7, 0xd9, 0xc4, 0x8e,
-	0x70, 0x61, 0x47, 0xc5, 0x0c, 0x79, 0xad, 0x13, 0xc5, 0x3b, 0x69, 0x08, 0x59, 0x1b, 0x6a, 0x85,
-	0x39, 0x2b, 0x84, 0xc0, 0x9c, 0xd7, 0x3b, 0x51, 0x5c, 0x4b, 0xaf, 0x6b, 0xd6, 0x85, 0x66, 0x66,
-	0x6d, 0x36, 0xbe, 0xc4, 0x3c, 0x11, 0x13, 0xc9, 0x81, 0x2c, 0x04, 0xcc, 0x4d, 0x29, 0xcc, 0x99,
-	0x2e, 0xe6, 0x99, 0xc5, 0xfe, 0x65, 0x66, 0x79, 0x83, 0x44, 0x42, 0xe8, 0xdc, 0x92, 0xf1, 0x37,
-	0x96, 0xae, 0xa1, 0xb9, 0x74, 0xeb, 0x21, 0x37, 0xab, 0x30, 0x1f, 0xa5, 0x4d, 0xc4, 0x7b, 0x47,
-	0xf9, 0x01, 0xc9, 0x04, 0x8c, 0xb5, 0xa0, 0x82, 0x0b, 0xde, 0x22, 0x17, 0x15, 0x5c, 0xb0, 0x97,
-	0x70, 0xbb, 0x54, 0x79, 0x66, 0xf1, 0x7c, 0xb3, 0x36, 0x6d, 0x7a, 0x8b, 0x36, 0xfd, 0xf7, 0xa1,
-	0x9b, 0x74, 0x51, 0x6a, 0x31, 0x28, 0x35, 0xdd, 0x3f, 0x3f, 0x24, 0x33, 0x01, 0xeb, 0xfe, 0x8e,
-	0xa0, 0x3d, 0x94, 0x79, 0x31, 0xb9, 0xf2, 0xc3, 0xf3, 0xae, 0xc0, 0x6f, 0x79, 0x8a, 0xdf, 0xd9,
-	0x09, 0x04, 0x29, 0xa5, 0x14, 0x35, 0x8e, 0xdb, 0x47, 0x41, 0x9c, 0xfd, 0x2f, 0xd3, 0xa0, 0x9f,
-	0xdd, 0x87, 0xfa, 0xc4, 0x69, 0xd1, 0x65, 0x54, 0x68, 0xfe, 0x06, 0xb8, 0x60, 0x2d, 0x7f, 0xfe,
-	0x0f, 0x85, 0x71, 0xc1, 0xda, 0x89, 0xeb, 0xa9, 0x47, 0x28, 0xc2, 0x0a, 0xf5, 0x3a, 0x9d, 0xbb,
-	0xab, 0x08, 0x6f, 0x50, 0xf7, 0x13, 0xdc, 0xdb, 0xea, 0xde, 0x28, 0xf6, 0x0a, 0x60, 0x7c, 0xfd,
-	0xa7, 0x5a, 0x99, 0xe7, 0x37, 0xcd, 0xaf, 0xcf, 0x53, 0xaf, 0xf7, 0xf8, 0x67, 0xb8, 0x38, 0x13,
-	0x70, 0x77, 0xcb, 0x20, 0x16, 0x87, 0x82, 0xdb, 0x6f, 0xb3, 0xfd, 0xe4, 0x3f, 0x3b, 0x8d, 0x7a,
-	0xfb, 0xfc, 0xf3, 0xb3, 0x53, 0x85, 0xe2, 0x4b, 0x32, 0xec, 0xa9, 0xaf, 0xd3, 0x1e, 0xbd, 0x18,
-	0xc1, 0x23, 0xf2, 0xda, 0x2f, 0x2e, 0xaa, 0xd4, 0xf0, 0xe2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xb3, 0xd2, 0x40, 0x56, 0x75, 0x04, 0x00, 0x00,
+	0x35, 0x37, 0x49, 0x0f, 0x9b, 0xdd, 0x73, 0x77, 0x2f, 0xa6, 0x2f, 0x7e, 0x32, 0x3f, 0x89, 0x9f,
+	0x46, 0x76, 0x2e, 0x69, 0x76, 0xab, 0x01, 0x1f, 0xe7, 0xb7, 0xb3, 0xff, 0xfd, 0xcf, 0xf0, 0xbf,
+	0x83, 0x47, 0x13, 0x29, 0x16, 0xa8, 0x74, 0x62, 0x32, 0x29, 0xfa, 0x6e, 0x71, 0x98, 0x2b, 0x69,
+	0x24, 0x6b, 0xb9, 0xac, 0x77, 0x0c, 0x30, 0x90, 0xf3, 0xb9, 0x14, 0x31, 0xea, 0x9c, 0x71, 0xd8,
+	0x43, 0xa5, 0x06, 0x32, 0x45, 0x1e, 0x74, 0x83, 0x70, 0x37, 0x5e, 0x97, 0xec, 0x2e, 0xd4, 0x50,
+	0xa9, 0x91, 0x9e, 0xf1, 0x4a, 0x37, 0x08, 0x1b, 0xf1, 0xaa, 0xea, 0xfd, 0xae, 0x42, 0x6b, 0xe0,
+	0x08, 0xb2, 0x2e, 0x34, 0xe5, 0x0f, 0x81, 0xea, 0x4c, 0xa3, 0x8a, 0x86, 0x24, 0xd3, 0x88, 0x5d,
+	0xc4, 0x9e, 0x40, 0xdb, 0xb5, 0x10, 0x0d, 0x57, 0x92, 0x37, 0x28, 0x7b, 0x08, 0xa0, 0x70, 0xb2,
+	0x18, 0xe9, 0xd9, 0x49, 0x6e, 0xf8, 0x0e, 0xf9, 0x71, 0x08, 0x7b, 0x06, 0x07, 0xee, 0x8d, 0xf1,
+	0x55, 0x8e, 0xbc, 0x4a, 0x5d, 0x7f, 0x71, 0x6b, 0xbf, 0x28, 0x0d, 0xed, 0x96, 0xf6, 0xcb, 0xca,
+	0x0e, 0x3c, 0x53, 0xb2, 0xc8, 0xa3, 0x21, 0xaf, 0xd1, 0xc1, 0xba, 0xb4, 0x73, 0x14, 0x42, 0x61,
+	0x92, 0x0e, 0x64, 0x21, 0x0c, 0xdf, 0x23, 0x61, 0x17, 0xb1, 0xc7, 0xb0, 0x9f, 0xaa, 0x64, 0x6a,
+	0xc6, 0xb8, 0x34, 0xe3, 0x6c, 0x8e, 0xbc, 0xde, 0x0d, 0xc2, 0x9d, 0xd8, 0x87, 0xac, 0x03, 0xf5,
+	0x4c, 0x9f, 0x66, 0x42, 0x60, 0xca, 0x1b, 0xdd, 0x20, 0xac, 0xc7, 0xd7, 0x35, 0xeb, 0x41, 0x2b,
+	0x31, 0x26, 0x99, 0x5c, 0x60, 0x1a, 0x89, 0xa9, 0xe4, 0x40, 0x16, 0x3c, 0x66, 0x5f, 0xc9, 0xf4,
+	0xa9, 0xca, 0x16, 0x89, 0xc1, 0xc1, 0x45, 0x62, 0x78, 0x93, 0x44, 0x7c, 0x68, 0xdd, 0x92, 0xf1,
+	0xb7, 0x86, 0xd6, 0xd0, 0x2a, 0xdd, 0x3a, 0xc8, 0xbe, 0x95, 0xe9, 0x4f, 0xd2, 0x44, 0xe2, 0x83,
+	0xa5, 0x7c, 0x9f, 0x64, 0x3c, 0xc6, 0xda, 0x50, 0xc1, 0x25, 0x6f, 0x93, 0x8b, 0x0a, 0x2e, 0xd9,
+	0x2b, 0xb8, 0x53, 0xe4, 0x69, 0x62, 0xf0, 0x6c, 0x33, 0x36, 0x4d, 0x7a, 0x8b, 0x26, 0xfd, 0xf7,
+	0xa1, 0x7d, 0xe9, 0xbc, 0x50, 0x62, 0x58, 0x28, 0xda, 0x3f, 0x3f, 0x20, 0x33, 0x1e, 0xb3, 0x53,
+	0xcd, 0x0b, 0x7b, 0xd5, 0x64, 0x97, 0xa4, 0x78, 0xbb, 0xdc, 0x9d, 0x07, 0x7b, 0xbf, 0x02, 0xe8,
+	0x8c, 0x64, 0x9a, 0x4d, 0xaf, 0xdc, 0x88, 0xbd, 0xcf, 0xf0, 0x32, 0x8d, 0xf1, 0x3b, 0x3b, 0x06,
+	0x2f, 0xcb, 0x94, 0xb5, 0xe6, 0x51, 0xe7, 0xd0, 0x0b, 0xbd, 0x7b, 0x33, 0xf6, 0xfa, 0xd9, 0x03,
+	0x68, 0x4c, 0xad, 0x16, 0xad, 0xac, 0x42, 0x2e, 0x37, 0xc0, 0xc6, 0xaf, 0x0c, 0xc9, 0xc7, 0x4c,
+	0xdb, 0xf8, 0xed, 0x84, 0x8d, 0xd8, 0x21, 0x14, 0xf4, 0x1c, 0xd5, 0x3a, 0xc3, 0xd5, 0x55, 0xd0,
+	0x37, 0xa8, 0xf7, 0x19, 0xee, 0x6f, 0x75, 0xaf, 0x73, 0xf6, 0x1a, 0x60, 0x72, 0xfd, 0xe9, 0xad,
+	0xcc, 0xf3, 0x9b, 0xe6, 0xd7, 0xe7, 0xb1, 0xd3, 0x7b, 0xf4, 0xd3, 0x1f, 0x9c, 0x09, 0xb8, 0xb7,
+	0xe5, 0x21, 0x16, 0xfa, 0x82, 0xdb, 0xb7, 0xd9, 0x79, 0xfa, 0x9f, 0x9d, 0x3a, 0x7f, 0xf7, 0xe2,
+	0xcb, 0xf3, 0x93, 0x1c, 0xc5, 0xd7, 0x68, 0xd4, 0xcf, 0xbf, 0xcd, 0xfa, 0xf4, 0x5f, 0xf1, 0x7e,
+	0x35, 0x6f, 0xdc, 0xe2, 0xbc, 0x46, 0x0d, 0x2f, 0xff, 0x0c, 0x00, 0x46, 0xf9, 0xbb, 0x40, 0x9b,
+	0x04, 0x00, 0x00,
 }
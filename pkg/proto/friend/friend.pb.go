@@ -1699,6 +1699,918 @@ func (x *GetSelfApplyListResp) GetFriendRequestList() []*sdk_ws.FriendRequest {
 	return nil
 }
 
+type FriendCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CategoryID       string   `protobuf:"bytes,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	Name             string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FriendUserIDList []string `protobuf:"bytes,3,rep,name=friendUserIDList,proto3" json:"friendUserIDList,omitempty"`
+	CreateTime       int64    `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime       int64    `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+}
+
+func (x *FriendCategory) Reset() {
+	*x = FriendCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendCategory) ProtoMessage() {}
+
+func (x *FriendCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendCategory.ProtoReflect.Descriptor instead.
+func (*FriendCategory) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FriendCategory) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *FriendCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FriendCategory) GetFriendUserIDList() []string {
+	if x != nil {
+		return x.FriendUserIDList
+	}
+	return nil
+}
+
+func (x *FriendCategory) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *FriendCategory) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SetFriendCategoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID     *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`         //FromUserID is the owner
+	CategoryID string  `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID,omitempty"` //empty to create a category
+	Name       string  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SetFriendCategoryReq) Reset() {
+	*x = SetFriendCategoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoryReq) ProtoMessage() {}
+
+func (x *SetFriendCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoryReq.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoryReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetFriendCategoryReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *SetFriendCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+func (x *SetFriendCategoryReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetFriendCategoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp *CommonResp     `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+	Category   *FriendCategory `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *SetFriendCategoryResp) Reset() {
+	*x = SetFriendCategoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoryResp) ProtoMessage() {}
+
+func (x *SetFriendCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoryResp.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoryResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetFriendCategoryResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+func (x *SetFriendCategoryResp) GetCategory() *FriendCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type DeleteFriendCategoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID     *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`
+	CategoryID string  `protobuf:"bytes,2,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+}
+
+func (x *DeleteFriendCategoryReq) Reset() {
+	*x = DeleteFriendCategoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFriendCategoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendCategoryReq) ProtoMessage() {}
+
+func (x *DeleteFriendCategoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendCategoryReq.ProtoReflect.Descriptor instead.
+func (*DeleteFriendCategoryReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteFriendCategoryReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *DeleteFriendCategoryReq) GetCategoryID() string {
+	if x != nil {
+		return x.CategoryID
+	}
+	return ""
+}
+
+type DeleteFriendCategoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp *CommonResp `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+}
+
+func (x *DeleteFriendCategoryResp) Reset() {
+	*x = DeleteFriendCategoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFriendCategoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFriendCategoryResp) ProtoMessage() {}
+
+func (x *DeleteFriendCategoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFriendCategoryResp.ProtoReflect.Descriptor instead.
+func (*DeleteFriendCategoryResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteFriendCategoryResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+type SetFriendCategoriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID         *CommID  `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`                 //ToUserID is the friend
+	CategoryIDList []string `protobuf:"bytes,2,rep,name=categoryIDList,proto3" json:"categoryIDList,omitempty"` //replaces the categories of the friend, empty removes it from all
+}
+
+func (x *SetFriendCategoriesReq) Reset() {
+	*x = SetFriendCategoriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoriesReq) ProtoMessage() {}
+
+func (x *SetFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetFriendCategoriesReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *SetFriendCategoriesReq) GetCategoryIDList() []string {
+	if x != nil {
+		return x.CategoryIDList
+	}
+	return nil
+}
+
+type SetFriendCategoriesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp *CommonResp `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+}
+
+func (x *SetFriendCategoriesResp) Reset() {
+	*x = SetFriendCategoriesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendCategoriesResp) ProtoMessage() {}
+
+func (x *SetFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*SetFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetFriendCategoriesResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+type SetFriendStarredReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID  *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`
+	Starred bool    `protobuf:"varint,2,opt,name=starred,proto3" json:"starred,omitempty"`
+}
+
+func (x *SetFriendStarredReq) Reset() {
+	*x = SetFriendStarredReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendStarredReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendStarredReq) ProtoMessage() {}
+
+func (x *SetFriendStarredReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendStarredReq.ProtoReflect.Descriptor instead.
+func (*SetFriendStarredReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetFriendStarredReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *SetFriendStarredReq) GetStarred() bool {
+	if x != nil {
+		return x.Starred
+	}
+	return false
+}
+
+type SetFriendStarredResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp *CommonResp `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+}
+
+func (x *SetFriendStarredResp) Reset() {
+	*x = SetFriendStarredResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendStarredResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendStarredResp) ProtoMessage() {}
+
+func (x *SetFriendStarredResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendStarredResp.ProtoReflect.Descriptor instead.
+func (*SetFriendStarredResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetFriendStarredResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+type GetFriendCategoriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`
+}
+
+func (x *GetFriendCategoriesReq) Reset() {
+	*x = GetFriendCategoriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendCategoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendCategoriesReq) ProtoMessage() {}
+
+func (x *GetFriendCategoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendCategoriesReq.ProtoReflect.Descriptor instead.
+func (*GetFriendCategoriesReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetFriendCategoriesReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+type GetFriendCategoriesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp              *CommonResp       `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+	Categories              []*FriendCategory `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	StarredFriendUserIDList []string          `protobuf:"bytes,3,rep,name=starredFriendUserIDList,proto3" json:"starredFriendUserIDList,omitempty"`
+}
+
+func (x *GetFriendCategoriesResp) Reset() {
+	*x = GetFriendCategoriesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendCategoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendCategoriesResp) ProtoMessage() {}
+
+func (x *GetFriendCategoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendCategoriesResp.ProtoReflect.Descriptor instead.
+func (*GetFriendCategoriesResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetFriendCategoriesResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+func (x *GetFriendCategoriesResp) GetCategories() []*FriendCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetFriendCategoriesResp) GetStarredFriendUserIDList() []string {
+	if x != nil {
+		return x.StarredFriendUserIDList
+	}
+	return nil
+}
+
+type FriendRecommendation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	MutualFriendNum int32                  `protobuf:"varint,2,opt,name=mutualFriendNum,proto3" json:"mutualFriendNum,omitempty"`
+	SharedGroupNum  int32                  `protobuf:"varint,3,opt,name=sharedGroupNum,proto3" json:"sharedGroupNum,omitempty"`
+	SameDepartment  bool                   `protobuf:"varint,4,opt,name=sameDepartment,proto3" json:"sameDepartment,omitempty"`
+	Score           int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	PublicUserInfo  *sdk_ws.PublicUserInfo `protobuf:"bytes,6,opt,name=publicUserInfo,proto3" json:"publicUserInfo,omitempty"`
+}
+
+func (x *FriendRecommendation) Reset() {
+	*x = FriendRecommendation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FriendRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRecommendation) ProtoMessage() {}
+
+func (x *FriendRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRecommendation.ProtoReflect.Descriptor instead.
+func (*FriendRecommendation) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FriendRecommendation) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *FriendRecommendation) GetMutualFriendNum() int32 {
+	if x != nil {
+		return x.MutualFriendNum
+	}
+	return 0
+}
+
+func (x *FriendRecommendation) GetSharedGroupNum() int32 {
+	if x != nil {
+		return x.SharedGroupNum
+	}
+	return 0
+}
+
+func (x *FriendRecommendation) GetSameDepartment() bool {
+	if x != nil {
+		return x.SameDepartment
+	}
+	return false
+}
+
+func (x *FriendRecommendation) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FriendRecommendation) GetPublicUserInfo() *sdk_ws.PublicUserInfo {
+	if x != nil {
+		return x.PublicUserInfo
+	}
+	return nil
+}
+
+type GetFriendRecommendationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`
+	Count  int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` //0 returns all cached recommendations
+}
+
+func (x *GetFriendRecommendationsReq) Reset() {
+	*x = GetFriendRecommendationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendRecommendationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRecommendationsReq) ProtoMessage() {}
+
+func (x *GetFriendRecommendationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRecommendationsReq.ProtoReflect.Descriptor instead.
+func (*GetFriendRecommendationsReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetFriendRecommendationsReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *GetFriendRecommendationsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetFriendRecommendationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp         *CommonResp             `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+	RecommendationList []*FriendRecommendation `protobuf:"bytes,2,rep,name=recommendationList,proto3" json:"recommendationList,omitempty"`
+}
+
+func (x *GetFriendRecommendationsResp) Reset() {
+	*x = GetFriendRecommendationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFriendRecommendationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRecommendationsResp) ProtoMessage() {}
+
+func (x *GetFriendRecommendationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRecommendationsResp.ProtoReflect.Descriptor instead.
+func (*GetFriendRecommendationsResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetFriendRecommendationsResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
+func (x *GetFriendRecommendationsResp) GetRecommendationList() []*FriendRecommendation {
+	if x != nil {
+		return x.RecommendationList
+	}
+	return nil
+}
+
+type SetFriendRecommendOptReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommID       *CommID `protobuf:"bytes,1,opt,name=CommID,proto3" json:"CommID,omitempty"`
+	RecommendOpt int32   `protobuf:"varint,2,opt,name=recommendOpt,proto3" json:"recommendOpt,omitempty"` //0 may be recommended to others, 1 never recommended
+}
+
+func (x *SetFriendRecommendOptReq) Reset() {
+	*x = SetFriendRecommendOptReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendRecommendOptReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendRecommendOptReq) ProtoMessage() {}
+
+func (x *SetFriendRecommendOptReq) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendRecommendOptReq.ProtoReflect.Descriptor instead.
+func (*SetFriendRecommendOptReq) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetFriendRecommendOptReq) GetCommID() *CommID {
+	if x != nil {
+		return x.CommID
+	}
+	return nil
+}
+
+func (x *SetFriendRecommendOptReq) GetRecommendOpt() int32 {
+	if x != nil {
+		return x.RecommendOpt
+	}
+	return 0
+}
+
+type SetFriendRecommendOptResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommonResp *CommonResp `protobuf:"bytes,1,opt,name=CommonResp,proto3" json:"CommonResp,omitempty"`
+}
+
+func (x *SetFriendRecommendOptResp) Reset() {
+	*x = SetFriendRecommendOptResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_friend_friend_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetFriendRecommendOptResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendRecommendOptResp) ProtoMessage() {}
+
+func (x *SetFriendRecommendOptResp) ProtoReflect() protoreflect.Message {
+	mi := &file_friend_friend_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFriendRecommendOptResp.ProtoReflect.Descriptor instead.
+func (*SetFriendRecommendOptResp) Descriptor() ([]byte, []int) {
+	return file_friend_friend_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetFriendRecommendOptResp) GetCommonResp() *CommonResp {
+	if x != nil {
+		return x.CommonResp
+	}
+	return nil
+}
+
 var File_friend_friend_proto protoreflect.FileDescriptor
 
 var file_friend_friend_proto_rawDesc = []byte{
@@ -1884,67 +2796,226 @@ var file_friend_friend_proto_rawDesc = []byte{
 	0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
 	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x11, 0x46,
 	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x32, 0x9f, 0x07, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x61,
-	0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15,
-	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x66, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x66, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x10, 0x67, 0x65,
-	0x74, 0x53, 0x65, 0x6c, 0x66, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b,
-	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x66, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x67, 0x65, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x41, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12,
-	0x17, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6c, 0x61, 0x63,
-	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x61, 0x63,
-	0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35,
-	0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x66, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x14, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x69, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x61,
-	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
-	0x49, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x19, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x49, 0x6e, 0x42, 0x6c,
-	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a, 0x0c, 0x67,
-	0x65, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x66, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41,
-	0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x17,
-	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x50, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
+	0x22, 0xb0, 0x01, 0x0a, 0x0e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x72, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43,
+	0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d,
+	0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x61, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x22, 0x4e, 0x0a, 0x18, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52,
+	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x68, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49,
+	0x44, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x57, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43,
+	0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d,
+	0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x22, 0x4a, 0x0a,
+	0x14, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x40, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x22, 0xbf, 0x01, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52,
+	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x73, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x89, 0x02,
+	0x0a, 0x14, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x75, 0x6d,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x61, 0x6d, 0x65, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x61, 0x6d, 0x65, 0x44, 0x65,
+	0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x49,
+	0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x61, 0x70, 0x69, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5b, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52,
+	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x12, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x2e, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70,
+	0x74, 0x22, 0x4f, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x32, 0x90, 0x0c, 0x0a, 0x06, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x10,
+	0x67, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1b, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c,
+	0x66, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x67,
+	0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x41, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73,
+	0x74, 0x12, 0x17, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x35, 0x0a, 0x08, 0x69, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x13, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x0d, 0x69, 0x73, 0x49, 0x6e, 0x42,
+	0x6c, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x49, 0x73, 0x49, 0x6e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x73, 0x49, 0x6e,
+	0x42, 0x6c, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x41, 0x0a,
+	0x0c, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x41, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x12, 0x17, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x11, 0x61, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
 	0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x41, 0x64,
-	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e,
-	0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x52,
-	0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x41, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12,
-	0x17, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x42, 0x21, 0x5a, 0x1f, 0x4f, 0x70, 0x65, 0x6e, 0x5f, 0x49, 0x4d, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x3b, 0x66,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1a, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x41, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x17, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x66, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f,
+	0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x56, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x10, 0x73, 0x65, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x2e,
+	0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x66, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x1e, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1f, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x65, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x66,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x15, 0x73, 0x65, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74,
+	0x12, 0x20, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x2e, 0x53, 0x65, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x4f, 0x70,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x42, 0x21, 0x5a, 0x1f, 0x4f, 0x70, 0x65, 0x6e, 0x5f, 0x49, 0x4d,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x66, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x3b, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1959,60 +3030,76 @@ func file_friend_friend_proto_rawDescGZIP() []byte {
 	return file_friend_friend_proto_rawDescData
 }
 
-var file_friend_friend_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_friend_friend_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_friend_friend_proto_goTypes = []interface{}{
-	(*CommonResp)(nil),             // 0: friend.CommonResp
-	(*CommID)(nil),                 // 1: friend.CommID
-	(*GetFriendsInfoReq)(nil),      // 2: friend.GetFriendsInfoReq
-	(*GetFriendInfoResp)(nil),      // 3: friend.GetFriendInfoResp
-	(*AddFriendReq)(nil),           // 4: friend.AddFriendReq
-	(*AddFriendResp)(nil),          // 5: friend.AddFriendResp
-	(*ImportFriendReq)(nil),        // 6: friend.ImportFriendReq
-	(*UserIDResult)(nil),           // 7: friend.UserIDResult
-	(*ImportFriendResp)(nil),       // 8: friend.ImportFriendResp
-	(*GetFriendApplyListReq)(nil),  // 9: friend.GetFriendApplyListReq
-	(*GetFriendApplyListResp)(nil), // 10: friend.GetFriendApplyListResp
-	(*GetFriendListReq)(nil),       // 11: friend.GetFriendListReq
-	(*GetFriendListResp)(nil),      // 12: friend.GetFriendListResp
-	(*AddBlacklistReq)(nil),        // 13: friend.AddBlacklistReq
-	(*AddBlacklistResp)(nil),       // 14: friend.AddBlacklistResp
-	(*RemoveBlacklistReq)(nil),     // 15: friend.RemoveBlacklistReq
-	(*RemoveBlacklistResp)(nil),    // 16: friend.RemoveBlacklistResp
-	(*GetBlacklistReq)(nil),        // 17: friend.GetBlacklistReq
-	(*GetBlacklistResp)(nil),       // 18: friend.GetBlacklistResp
-	(*IsFriendReq)(nil),            // 19: friend.IsFriendReq
-	(*IsFriendResp)(nil),           // 20: friend.IsFriendResp
-	(*IsInBlackListReq)(nil),       // 21: friend.IsInBlackListReq
-	(*IsInBlackListResp)(nil),      // 22: friend.IsInBlackListResp
-	(*DeleteFriendReq)(nil),        // 23: friend.DeleteFriendReq
-	(*DeleteFriendResp)(nil),       // 24: friend.DeleteFriendResp
-	(*AddFriendResponseReq)(nil),   // 25: friend.AddFriendResponseReq
-	(*AddFriendResponseResp)(nil),  // 26: friend.AddFriendResponseResp
-	(*SetFriendRemarkReq)(nil),     // 27: friend.SetFriendRemarkReq
-	(*SetFriendRemarkResp)(nil),    // 28: friend.SetFriendRemarkResp
-	(*GetSelfApplyListReq)(nil),    // 29: friend.GetSelfApplyListReq
-	(*GetSelfApplyListResp)(nil),   // 30: friend.GetSelfApplyListResp
-	(*sdk_ws.FriendInfo)(nil),      // 31: server_api_params.FriendInfo
-	(*sdk_ws.FriendRequest)(nil),   // 32: server_api_params.FriendRequest
-	(*sdk_ws.PublicUserInfo)(nil),  // 33: server_api_params.PublicUserInfo
+	(*CommonResp)(nil),                   // 0: friend.CommonResp
+	(*CommID)(nil),                       // 1: friend.CommID
+	(*GetFriendsInfoReq)(nil),            // 2: friend.GetFriendsInfoReq
+	(*GetFriendInfoResp)(nil),            // 3: friend.GetFriendInfoResp
+	(*AddFriendReq)(nil),                 // 4: friend.AddFriendReq
+	(*AddFriendResp)(nil),                // 5: friend.AddFriendResp
+	(*ImportFriendReq)(nil),              // 6: friend.ImportFriendReq
+	(*UserIDResult)(nil),                 // 7: friend.UserIDResult
+	(*ImportFriendResp)(nil),             // 8: friend.ImportFriendResp
+	(*GetFriendApplyListReq)(nil),        // 9: friend.GetFriendApplyListReq
+	(*GetFriendApplyListResp)(nil),       // 10: friend.GetFriendApplyListResp
+	(*GetFriendListReq)(nil),             // 11: friend.GetFriendListReq
+	(*GetFriendListResp)(nil),            // 12: friend.GetFriendListResp
+	(*AddBlacklistReq)(nil),              // 13: friend.AddBlacklistReq
+	(*AddBlacklistResp)(nil),             // 14: friend.AddBlacklistResp
+	(*RemoveBlacklistReq)(nil),           // 15: friend.RemoveBlacklistReq
+	(*RemoveBlacklistResp)(nil),          // 16: friend.RemoveBlacklistResp
+	(*GetBlacklistReq)(nil),              // 17: friend.GetBlacklistReq
+	(*GetBlacklistResp)(nil),             // 18: friend.GetBlacklistResp
+	(*IsFriendReq)(nil),                  // 19: friend.IsFriendReq
+	(*IsFriendResp)(nil),                 // 20: friend.IsFriendResp
+	(*IsInBlackListReq)(nil),             // 21: friend.IsInBlackListReq
+	(*IsInBlackListResp)(nil),            // 22: friend.IsInBlackListResp
+	(*DeleteFriendReq)(nil),              // 23: friend.DeleteFriendReq
+	(*DeleteFriendResp)(nil),             // 24: friend.DeleteFriendResp
+	(*AddFriendResponseReq)(nil),         // 25: friend.AddFriendResponseReq
+	(*AddFriendResponseResp)(nil),        // 26: friend.AddFriendResponseResp
+	(*SetFriendRemarkReq)(nil),           // 27: friend.SetFriendRemarkReq
+	(*SetFriendRemarkResp)(nil),          // 28: friend.SetFriendRemarkResp
+	(*GetSelfApplyListReq)(nil),          // 29: friend.GetSelfApplyListReq
+	(*GetSelfApplyListResp)(nil),         // 30: friend.GetSelfApplyListResp
+	(*FriendCategory)(nil),               // 31: friend.FriendCategory
+	(*SetFriendCategoryReq)(nil),         // 32: friend.SetFriendCategoryReq
+	(*SetFriendCategoryResp)(nil),        // 33: friend.SetFriendCategoryResp
+	(*DeleteFriendCategoryReq)(nil),      // 34: friend.DeleteFriendCategoryReq
+	(*DeleteFriendCategoryResp)(nil),     // 35: friend.DeleteFriendCategoryResp
+	(*SetFriendCategoriesReq)(nil),       // 36: friend.SetFriendCategoriesReq
+	(*SetFriendCategoriesResp)(nil),      // 37: friend.SetFriendCategoriesResp
+	(*SetFriendStarredReq)(nil),          // 38: friend.SetFriendStarredReq
+	(*SetFriendStarredResp)(nil),         // 39: friend.SetFriendStarredResp
+	(*GetFriendCategoriesReq)(nil),       // 40: friend.GetFriendCategoriesReq
+	(*GetFriendCategoriesResp)(nil),      // 41: friend.GetFriendCategoriesResp
+	(*FriendRecommendation)(nil),         // 42: friend.FriendRecommendation
+	(*GetFriendRecommendationsReq)(nil),  // 43: friend.GetFriendRecommendationsReq
+	(*GetFriendRecommendationsResp)(nil), // 44: friend.GetFriendRecommendationsResp
+	(*SetFriendRecommendOptReq)(nil),     // 45: friend.SetFriendRecommendOptReq
+	(*SetFriendRecommendOptResp)(nil),    // 46: friend.SetFriendRecommendOptResp
+	(*sdk_ws.FriendInfo)(nil),            // 47: server_api_params.FriendInfo
+	(*sdk_ws.FriendRequest)(nil),         // 48: server_api_params.FriendRequest
+	(*sdk_ws.PublicUserInfo)(nil),        // 49: server_api_params.PublicUserInfo
 }
 var file_friend_friend_proto_depIdxs = []int32{
 	1,  // 0: friend.GetFriendsInfoReq.CommID:type_name -> friend.CommID
-	31, // 1: friend.GetFriendInfoResp.FriendInfoList:type_name -> server_api_params.FriendInfo
+	47, // 1: friend.GetFriendInfoResp.FriendInfoList:type_name -> server_api_params.FriendInfo
 	1,  // 2: friend.AddFriendReq.CommID:type_name -> friend.CommID
 	0,  // 3: friend.AddFriendResp.CommonResp:type_name -> friend.CommonResp
 	0,  // 4: friend.ImportFriendResp.CommonResp:type_name -> friend.CommonResp
 	7,  // 5: friend.ImportFriendResp.UserIDResultList:type_name -> friend.UserIDResult
 	1,  // 6: friend.GetFriendApplyListReq.CommID:type_name -> friend.CommID
-	32, // 7: friend.GetFriendApplyListResp.FriendRequestList:type_name -> server_api_params.FriendRequest
+	48, // 7: friend.GetFriendApplyListResp.FriendRequestList:type_name -> server_api_params.FriendRequest
 	1,  // 8: friend.GetFriendListReq.CommID:type_name -> friend.CommID
-	31, // 9: friend.GetFriendListResp.FriendInfoList:type_name -> server_api_params.FriendInfo
+	47, // 9: friend.GetFriendListResp.FriendInfoList:type_name -> server_api_params.FriendInfo
 	1,  // 10: friend.AddBlacklistReq.CommID:type_name -> friend.CommID
 	0,  // 11: friend.AddBlacklistResp.CommonResp:type_name -> friend.CommonResp
 	1,  // 12: friend.RemoveBlacklistReq.CommID:type_name -> friend.CommID
 	0,  // 13: friend.RemoveBlacklistResp.CommonResp:type_name -> friend.CommonResp
 	1,  // 14: friend.GetBlacklistReq.CommID:type_name -> friend.CommID
-	33, // 15: friend.GetBlacklistResp.BlackUserInfoList:type_name -> server_api_params.PublicUserInfo
+	49, // 15: friend.GetBlacklistResp.BlackUserInfoList:type_name -> server_api_params.PublicUserInfo
 	1,  // 16: friend.IsFriendReq.CommID:type_name -> friend.CommID
 	1,  // 17: friend.IsInBlackListReq.CommID:type_name -> friend.CommID
 	1,  // 18: friend.DeleteFriendReq.CommID:type_name -> friend.CommID
@@ -2022,38 +3109,70 @@ var file_friend_friend_proto_depIdxs = []int32{
 	1,  // 22: friend.SetFriendRemarkReq.CommID:type_name -> friend.CommID
 	0,  // 23: friend.SetFriendRemarkResp.CommonResp:type_name -> friend.CommonResp
 	1,  // 24: friend.GetSelfApplyListReq.CommID:type_name -> friend.CommID
-	32, // 25: friend.GetSelfApplyListResp.FriendRequestList:type_name -> server_api_params.FriendRequest
-	4,  // 26: friend.friend.addFriend:input_type -> friend.AddFriendReq
-	9,  // 27: friend.friend.getFriendApplyList:input_type -> friend.GetFriendApplyListReq
-	29, // 28: friend.friend.getSelfApplyList:input_type -> friend.GetSelfApplyListReq
-	11, // 29: friend.friend.getFriendList:input_type -> friend.GetFriendListReq
-	13, // 30: friend.friend.addBlacklist:input_type -> friend.AddBlacklistReq
-	15, // 31: friend.friend.removeBlacklist:input_type -> friend.RemoveBlacklistReq
-	19, // 32: friend.friend.isFriend:input_type -> friend.IsFriendReq
-	21, // 33: friend.friend.isInBlackList:input_type -> friend.IsInBlackListReq
-	17, // 34: friend.friend.getBlacklist:input_type -> friend.GetBlacklistReq
-	23, // 35: friend.friend.deleteFriend:input_type -> friend.DeleteFriendReq
-	25, // 36: friend.friend.addFriendResponse:input_type -> friend.AddFriendResponseReq
-	27, // 37: friend.friend.setFriendRemark:input_type -> friend.SetFriendRemarkReq
-	6,  // 38: friend.friend.importFriend:input_type -> friend.ImportFriendReq
-	5,  // 39: friend.friend.addFriend:output_type -> friend.AddFriendResp
-	10, // 40: friend.friend.getFriendApplyList:output_type -> friend.GetFriendApplyListResp
-	30, // 41: friend.friend.getSelfApplyList:output_type -> friend.GetSelfApplyListResp
-	12, // 42: friend.friend.getFriendList:output_type -> friend.GetFriendListResp
-	14, // 43: friend.friend.addBlacklist:output_type -> friend.AddBlacklistResp
-	16, // 44: friend.friend.removeBlacklist:output_type -> friend.RemoveBlacklistResp
-	20, // 45: friend.friend.isFriend:output_type -> friend.IsFriendResp
-	22, // 46: friend.friend.isInBlackList:output_type -> friend.IsInBlackListResp
-	18, // 47: friend.friend.getBlacklist:output_type -> friend.GetBlacklistResp
-	24, // 48: friend.friend.deleteFriend:output_type -> friend.DeleteFriendResp
-	26, // 49: friend.friend.addFriendResponse:output_type -> friend.AddFriendResponseResp
-	28, // 50: friend.friend.setFriendRemark:output_type -> friend.SetFriendRemarkResp
-	8,  // 51: friend.friend.importFriend:output_type -> friend.ImportFriendResp
-	39, // [39:52] is the sub-list for method output_type
-	26, // [26:39] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	48, // 25: friend.GetSelfApplyListResp.FriendRequestList:type_name -> server_api_params.FriendRequest
+	1,  // 26: friend.SetFriendCategoryReq.CommID:type_name -> friend.CommID
+	0,  // 27: friend.SetFriendCategoryResp.CommonResp:type_name -> friend.CommonResp
+	31, // 28: friend.SetFriendCategoryResp.category:type_name -> friend.FriendCategory
+	1,  // 29: friend.DeleteFriendCategoryReq.CommID:type_name -> friend.CommID
+	0,  // 30: friend.DeleteFriendCategoryResp.CommonResp:type_name -> friend.CommonResp
+	1,  // 31: friend.SetFriendCategoriesReq.CommID:type_name -> friend.CommID
+	0,  // 32: friend.SetFriendCategoriesResp.CommonResp:type_name -> friend.CommonResp
+	1,  // 33: friend.SetFriendStarredReq.CommID:type_name -> friend.CommID
+	0,  // 34: friend.SetFriendStarredResp.CommonResp:type_name -> friend.CommonResp
+	1,  // 35: friend.GetFriendCategoriesReq.CommID:type_name -> friend.CommID
+	0,  // 36: friend.GetFriendCategoriesResp.CommonResp:type_name -> friend.CommonResp
+	31, // 37: friend.GetFriendCategoriesResp.categories:type_name -> friend.FriendCategory
+	49, // 38: friend.FriendRecommendation.publicUserInfo:type_name -> server_api_params.PublicUserInfo
+	1,  // 39: friend.GetFriendRecommendationsReq.CommID:type_name -> friend.CommID
+	0,  // 40: friend.GetFriendRecommendationsResp.CommonResp:type_name -> friend.CommonResp
+	42, // 41: friend.GetFriendRecommendationsResp.recommendationList:type_name -> friend.FriendRecommendation
+	1,  // 42: friend.SetFriendRecommendOptReq.CommID:type_name -> friend.CommID
+	0,  // 43: friend.SetFriendRecommendOptResp.CommonResp:type_name -> friend.CommonResp
+	4,  // 44: friend.friend.addFriend:input_type -> friend.AddFriendReq
+	9,  // 45: friend.friend.getFriendApplyList:input_type -> friend.GetFriendApplyListReq
+	29, // 46: friend.friend.getSelfApplyList:input_type -> friend.GetSelfApplyListReq
+	11, // 47: friend.friend.getFriendList:input_type -> friend.GetFriendListReq
+	13, // 48: friend.friend.addBlacklist:input_type -> friend.AddBlacklistReq
+	15, // 49: friend.friend.removeBlacklist:input_type -> friend.RemoveBlacklistReq
+	19, // 50: friend.friend.isFriend:input_type -> friend.IsFriendReq
+	21, // 51: friend.friend.isInBlackList:input_type -> friend.IsInBlackListReq
+	17, // 52: friend.friend.getBlacklist:input_type -> friend.GetBlacklistReq
+	23, // 53: friend.friend.deleteFriend:input_type -> friend.DeleteFriendReq
+	25, // 54: friend.friend.addFriendResponse:input_type -> friend.AddFriendResponseReq
+	27, // 55: friend.friend.setFriendRemark:input_type -> friend.SetFriendRemarkReq
+	6,  // 56: friend.friend.importFriend:input_type -> friend.ImportFriendReq
+	32, // 57: friend.friend.setFriendCategory:input_type -> friend.SetFriendCategoryReq
+	34, // 58: friend.friend.deleteFriendCategory:input_type -> friend.DeleteFriendCategoryReq
+	36, // 59: friend.friend.setFriendCategories:input_type -> friend.SetFriendCategoriesReq
+	38, // 60: friend.friend.setFriendStarred:input_type -> friend.SetFriendStarredReq
+	40, // 61: friend.friend.getFriendCategories:input_type -> friend.GetFriendCategoriesReq
+	43, // 62: friend.friend.getFriendRecommendations:input_type -> friend.GetFriendRecommendationsReq
+	45, // 63: friend.friend.setFriendRecommendOpt:input_type -> friend.SetFriendRecommendOptReq
+	5,  // 64: friend.friend.addFriend:output_type -> friend.AddFriendResp
+	10, // 65: friend.friend.getFriendApplyList:output_type -> friend.GetFriendApplyListResp
+	30, // 66: friend.friend.getSelfApplyList:output_type -> friend.GetSelfApplyListResp
+	12, // 67: friend.friend.getFriendList:output_type -> friend.GetFriendListResp
+	14, // 68: friend.friend.addBlacklist:output_type -> friend.AddBlacklistResp
+	16, // 69: friend.friend.removeBlacklist:output_type -> friend.RemoveBlacklistResp
+	20, // 70: friend.friend.isFriend:output_type -> friend.IsFriendResp
+	22, // 71: friend.friend.isInBlackList:output_type -> friend.IsInBlackListResp
+	18, // 72: friend.friend.getBlacklist:output_type -> friend.GetBlacklistResp
+	24, // 73: friend.friend.deleteFriend:output_type -> friend.DeleteFriendResp
+	26, // 74: friend.friend.addFriendResponse:output_type -> friend.AddFriendResponseResp
+	28, // 75: friend.friend.setFriendRemark:output_type -> friend.SetFriendRemarkResp
+	8,  // 76: friend.friend.importFriend:output_type -> friend.ImportFriendResp
+	33, // 77: friend.friend.setFriendCategory:output_type -> friend.SetFriendCategoryResp
+	35, // 78: friend.friend.deleteFriendCategory:output_type -> friend.DeleteFriendCategoryResp
+	37, // 79: friend.friend.setFriendCategories:output_type -> friend.SetFriendCategoriesResp
+	39, // 80: friend.friend.setFriendStarred:output_type -> friend.SetFriendStarredResp
+	41, // 81: friend.friend.getFriendCategories:output_type -> friend.GetFriendCategoriesResp
+	44, // 82: friend.friend.getFriendRecommendations:output_type -> friend.GetFriendRecommendationsResp
+	46, // 83: friend.friend.setFriendRecommendOpt:output_type -> friend.SetFriendRecommendOptResp
+	64, // [64:84] is the sub-list for method output_type
+	44, // [44:64] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_friend_friend_proto_init() }
@@ -2074,8 +3193,188 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommID); i {
+		file_friend_friend_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendsInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFriendReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIDResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportFriendResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendApplyListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendApplyListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendListReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBlacklistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBlacklistResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBlacklistReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBlacklistResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2086,8 +3385,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendsInfoReq); i {
+		file_friend_friend_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlacklistReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2098,8 +3397,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendInfoResp); i {
+		file_friend_friend_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlacklistResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2110,8 +3409,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendReq); i {
+		file_friend_friend_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2122,8 +3421,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendResp); i {
+		file_friend_friend_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2134,8 +3433,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportFriendReq); i {
+		file_friend_friend_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsInBlackListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2146,8 +3445,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIDResult); i {
+		file_friend_friend_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsInBlackListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2158,8 +3457,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportFriendResp); i {
+		file_friend_friend_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2170,8 +3469,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendApplyListReq); i {
+		file_friend_friend_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2182,8 +3481,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendApplyListResp); i {
+		file_friend_friend_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendResponseReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2194,8 +3493,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendListReq); i {
+		file_friend_friend_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddFriendResponseResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2206,8 +3505,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFriendListResp); i {
+		file_friend_friend_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendRemarkReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2218,8 +3517,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBlacklistReq); i {
+		file_friend_friend_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendRemarkResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2230,8 +3529,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBlacklistResp); i {
+		file_friend_friend_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSelfApplyListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2242,8 +3541,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBlacklistReq); i {
+		file_friend_friend_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSelfApplyListResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2254,8 +3553,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBlacklistResp); i {
+		file_friend_friend_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendCategory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2266,8 +3565,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlacklistReq); i {
+		file_friend_friend_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendCategoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2278,8 +3577,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlacklistResp); i {
+		file_friend_friend_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendCategoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2290,8 +3589,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsFriendReq); i {
+		file_friend_friend_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendCategoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2302,8 +3601,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsFriendResp); i {
+		file_friend_friend_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFriendCategoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2314,8 +3613,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsInBlackListReq); i {
+		file_friend_friend_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendCategoriesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2326,8 +3625,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsInBlackListResp); i {
+		file_friend_friend_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendCategoriesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2338,8 +3637,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFriendReq); i {
+		file_friend_friend_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendStarredReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2350,8 +3649,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFriendResp); i {
+		file_friend_friend_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendStarredResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2362,8 +3661,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendResponseReq); i {
+		file_friend_friend_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendCategoriesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2374,8 +3673,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddFriendResponseResp); i {
+		file_friend_friend_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendCategoriesResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2386,8 +3685,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFriendRemarkReq); i {
+		file_friend_friend_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FriendRecommendation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2398,8 +3697,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetFriendRemarkResp); i {
+		file_friend_friend_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRecommendationsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2410,8 +3709,8 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSelfApplyListReq); i {
+		file_friend_friend_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFriendRecommendationsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2422,8 +3721,20 @@ func file_friend_friend_proto_init() {
 				return nil
 			}
 		}
-		file_friend_friend_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSelfApplyListResp); i {
+		file_friend_friend_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendRecommendOptReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_friend_friend_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetFriendRecommendOptResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2441,7 +3752,7 @@ func file_friend_friend_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_friend_friend_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   31,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2481,6 +3792,13 @@ type FriendClient interface {
 	AddFriendResponse(ctx context.Context, in *AddFriendResponseReq, opts ...grpc.CallOption) (*AddFriendResponseResp, error)
 	SetFriendRemark(ctx context.Context, in *SetFriendRemarkReq, opts ...grpc.CallOption) (*SetFriendRemarkResp, error)
 	ImportFriend(ctx context.Context, in *ImportFriendReq, opts ...grpc.CallOption) (*ImportFriendResp, error)
+	SetFriendCategory(ctx context.Context, in *SetFriendCategoryReq, opts ...grpc.CallOption) (*SetFriendCategoryResp, error)
+	DeleteFriendCategory(ctx context.Context, in *DeleteFriendCategoryReq, opts ...grpc.CallOption) (*DeleteFriendCategoryResp, error)
+	SetFriendCategories(ctx context.Context, in *SetFriendCategoriesReq, opts ...grpc.CallOption) (*SetFriendCategoriesResp, error)
+	SetFriendStarred(ctx context.Context, in *SetFriendStarredReq, opts ...grpc.CallOption) (*SetFriendStarredResp, error)
+	GetFriendCategories(ctx context.Context, in *GetFriendCategoriesReq, opts ...grpc.CallOption) (*GetFriendCategoriesResp, error)
+	GetFriendRecommendations(ctx context.Context, in *GetFriendRecommendationsReq, opts ...grpc.CallOption) (*GetFriendRecommendationsResp, error)
+	SetFriendRecommendOpt(ctx context.Context, in *SetFriendRecommendOptReq, opts ...grpc.CallOption) (*SetFriendRecommendOptResp, error)
 }
 
 type friendClient struct {
@@ -2608,6 +3926,69 @@ func (c *friendClient) ImportFriend(ctx context.Context, in *ImportFriendReq, op
 	return out, nil
 }
 
+func (c *friendClient) SetFriendCategory(ctx context.Context, in *SetFriendCategoryReq, opts ...grpc.CallOption) (*SetFriendCategoryResp, error) {
+	out := new(SetFriendCategoryResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/setFriendCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) DeleteFriendCategory(ctx context.Context, in *DeleteFriendCategoryReq, opts ...grpc.CallOption) (*DeleteFriendCategoryResp, error) {
+	out := new(DeleteFriendCategoryResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/deleteFriendCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetFriendCategories(ctx context.Context, in *SetFriendCategoriesReq, opts ...grpc.CallOption) (*SetFriendCategoriesResp, error) {
+	out := new(SetFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/setFriendCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetFriendStarred(ctx context.Context, in *SetFriendStarredReq, opts ...grpc.CallOption) (*SetFriendStarredResp, error) {
+	out := new(SetFriendStarredResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/setFriendStarred", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendCategories(ctx context.Context, in *GetFriendCategoriesReq, opts ...grpc.CallOption) (*GetFriendCategoriesResp, error) {
+	out := new(GetFriendCategoriesResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/getFriendCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) GetFriendRecommendations(ctx context.Context, in *GetFriendRecommendationsReq, opts ...grpc.CallOption) (*GetFriendRecommendationsResp, error) {
+	out := new(GetFriendRecommendationsResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/getFriendRecommendations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *friendClient) SetFriendRecommendOpt(ctx context.Context, in *SetFriendRecommendOptReq, opts ...grpc.CallOption) (*SetFriendRecommendOptResp, error) {
+	out := new(SetFriendRecommendOptResp)
+	err := c.cc.Invoke(ctx, "/friend.friend/setFriendRecommendOpt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FriendServer is the server API for Friend service.
 type FriendServer interface {
 	// rpc getFriendsInfo(GetFriendsInfoReq) returns(GetFriendInfoResp);
@@ -2624,6 +4005,13 @@ type FriendServer interface {
 	AddFriendResponse(context.Context, *AddFriendResponseReq) (*AddFriendResponseResp, error)
 	SetFriendRemark(context.Context, *SetFriendRemarkReq) (*SetFriendRemarkResp, error)
 	ImportFriend(context.Context, *ImportFriendReq) (*ImportFriendResp, error)
+	SetFriendCategory(context.Context, *SetFriendCategoryReq) (*SetFriendCategoryResp, error)
+	DeleteFriendCategory(context.Context, *DeleteFriendCategoryReq) (*DeleteFriendCategoryResp, error)
+	SetFriendCategories(context.Context, *SetFriendCategoriesReq) (*SetFriendCategoriesResp, error)
+	SetFriendStarred(context.Context, *SetFriendStarredReq) (*SetFriendStarredResp, error)
+	GetFriendCategories(context.Context, *GetFriendCategoriesReq) (*GetFriendCategoriesResp, error)
+	GetFriendRecommendations(context.Context, *GetFriendRecommendationsReq) (*GetFriendRecommendationsResp, error)
+	SetFriendRecommendOpt(context.Context, *SetFriendRecommendOptReq) (*SetFriendRecommendOptResp, error)
 }
 
 // UnimplementedFriendServer can be embedded to have forward compatible implementations.
@@ -2669,6 +4057,27 @@ func (*UnimplementedFriendServer) SetFriendRemark(context.Context, *SetFriendRem
 func (*UnimplementedFriendServer) ImportFriend(context.Context, *ImportFriendReq) (*ImportFriendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ImportFriend not implemented")
 }
+func (*UnimplementedFriendServer) SetFriendCategory(context.Context, *SetFriendCategoryReq) (*SetFriendCategoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendCategory not implemented")
+}
+func (*UnimplementedFriendServer) DeleteFriendCategory(context.Context, *DeleteFriendCategoryReq) (*DeleteFriendCategoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFriendCategory not implemented")
+}
+func (*UnimplementedFriendServer) SetFriendCategories(context.Context, *SetFriendCategoriesReq) (*SetFriendCategoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendCategories not implemented")
+}
+func (*UnimplementedFriendServer) SetFriendStarred(context.Context, *SetFriendStarredReq) (*SetFriendStarredResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendStarred not implemented")
+}
+func (*UnimplementedFriendServer) GetFriendCategories(context.Context, *GetFriendCategoriesReq) (*GetFriendCategoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendCategories not implemented")
+}
+func (*UnimplementedFriendServer) GetFriendRecommendations(context.Context, *GetFriendRecommendationsReq) (*GetFriendRecommendationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRecommendations not implemented")
+}
+func (*UnimplementedFriendServer) SetFriendRecommendOpt(context.Context, *SetFriendRecommendOptReq) (*SetFriendRecommendOptResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFriendRecommendOpt not implemented")
+}
 
 func RegisterFriendServer(s *grpc.Server, srv FriendServer) {
 	s.RegisterService(&_Friend_serviceDesc, srv)
@@ -2908,6 +4317,132 @@ func _Friend_ImportFriend_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Friend_SetFriendCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/SetFriendCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendCategory(ctx, req.(*SetFriendCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_DeleteFriendCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFriendCategoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).DeleteFriendCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/DeleteFriendCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).DeleteFriendCategory(ctx, req.(*DeleteFriendCategoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/SetFriendCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendCategories(ctx, req.(*SetFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetFriendStarred_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendStarredReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendStarred(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/SetFriendStarred",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendStarred(ctx, req.(*SetFriendStarredReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendCategoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/GetFriendCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendCategories(ctx, req.(*GetFriendCategoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_GetFriendRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRecommendationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).GetFriendRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/GetFriendRecommendations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).GetFriendRecommendations(ctx, req.(*GetFriendRecommendationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Friend_SetFriendRecommendOpt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFriendRecommendOptReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FriendServer).SetFriendRecommendOpt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/friend.friend/SetFriendRecommendOpt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FriendServer).SetFriendRecommendOpt(ctx, req.(*SetFriendRecommendOptReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Friend_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "friend.friend",
 	HandlerType: (*FriendServer)(nil),
@@ -2964,6 +4499,34 @@ var _Friend_serviceDesc = grpc.ServiceDesc{
 			MethodName: "importFriend",
 			Handler:    _Friend_ImportFriend_Handler,
 		},
+		{
+			MethodName: "setFriendCategory",
+			Handler:    _Friend_SetFriendCategory_Handler,
+		},
+		{
+			MethodName: "deleteFriendCategory",
+			Handler:    _Friend_DeleteFriendCategory_Handler,
+		},
+		{
+			MethodName: "setFriendCategories",
+			Handler:    _Friend_SetFriendCategories_Handler,
+		},
+		{
+			MethodName: "setFriendStarred",
+			Handler:    _Friend_SetFriendStarred_Handler,
+		},
+		{
+			MethodName: "getFriendCategories",
+			Handler:    _Friend_GetFriendCategories_Handler,
+		},
+		{
+			MethodName: "getFriendRecommendations",
+			Handler:    _Friend_GetFriendRecommendations_Handler,
+		},
+		{
+			MethodName: "setFriendRecommendOpt",
+			Handler:    _Friend_SetFriendRecommendOpt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "friend/friend.proto",
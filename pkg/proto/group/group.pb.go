@@ -37,7 +37,7 @@ func (m *CommonResp) Reset()         { *m = CommonResp{} }
 func (m *CommonResp) String() string { return proto.CompactTextString(m) }
 func (*CommonResp) ProtoMessage()    {}
 func (*CommonResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{0}
+	return fileDescriptor_group_82343494531cedb3, []int{0}
 }
 func (m *CommonResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CommonResp.Unmarshal(m, b)
@@ -83,7 +83,7 @@ func (m *GroupAddMemberInfo) Reset()         { *m = GroupAddMemberInfo{} }
 func (m *GroupAddMemberInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupAddMemberInfo) ProtoMessage()    {}
 func (*GroupAddMemberInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{1}
+	return fileDescriptor_group_82343494531cedb3, []int{1}
 }
 func (m *GroupAddMemberInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupAddMemberInfo.Unmarshal(m, b)
@@ -132,7 +132,7 @@ func (m *CreateGroupReq) Reset()         { *m = CreateGroupReq{} }
 func (m *CreateGroupReq) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupReq) ProtoMessage()    {}
 func (*CreateGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{2}
+	return fileDescriptor_group_82343494531cedb3, []int{2}
 }
 func (m *CreateGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGroupReq.Unmarshal(m, b)
@@ -200,7 +200,7 @@ func (m *CreateGroupResp) Reset()         { *m = CreateGroupResp{} }
 func (m *CreateGroupResp) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupResp) ProtoMessage()    {}
 func (*CreateGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{3}
+	return fileDescriptor_group_82343494531cedb3, []int{3}
 }
 func (m *CreateGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGroupResp.Unmarshal(m, b)
@@ -254,7 +254,7 @@ func (m *GetGroupsInfoReq) Reset()         { *m = GetGroupsInfoReq{} }
 func (m *GetGroupsInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsInfoReq) ProtoMessage()    {}
 func (*GetGroupsInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{4}
+	return fileDescriptor_group_82343494531cedb3, []int{4}
 }
 func (m *GetGroupsInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupsInfoReq.Unmarshal(m, b)
@@ -308,7 +308,7 @@ func (m *GetGroupsInfoResp) Reset()         { *m = GetGroupsInfoResp{} }
 func (m *GetGroupsInfoResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsInfoResp) ProtoMessage()    {}
 func (*GetGroupsInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{5}
+	return fileDescriptor_group_82343494531cedb3, []int{5}
 }
 func (m *GetGroupsInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupsInfoResp.Unmarshal(m, b)
@@ -362,7 +362,7 @@ func (m *SetGroupInfoReq) Reset()         { *m = SetGroupInfoReq{} }
 func (m *SetGroupInfoReq) String() string { return proto.CompactTextString(m) }
 func (*SetGroupInfoReq) ProtoMessage()    {}
 func (*SetGroupInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{6}
+	return fileDescriptor_group_82343494531cedb3, []int{6}
 }
 func (m *SetGroupInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupInfoReq.Unmarshal(m, b)
@@ -414,7 +414,7 @@ func (m *SetGroupInfoResp) Reset()         { *m = SetGroupInfoResp{} }
 func (m *SetGroupInfoResp) String() string { return proto.CompactTextString(m) }
 func (*SetGroupInfoResp) ProtoMessage()    {}
 func (*SetGroupInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{7}
+	return fileDescriptor_group_82343494531cedb3, []int{7}
 }
 func (m *SetGroupInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupInfoResp.Unmarshal(m, b)
@@ -454,7 +454,7 @@ func (m *GetGroupApplicationListReq) Reset()         { *m = GetGroupApplicationL
 func (m *GetGroupApplicationListReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupApplicationListReq) ProtoMessage()    {}
 func (*GetGroupApplicationListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{8}
+	return fileDescriptor_group_82343494531cedb3, []int{8}
 }
 func (m *GetGroupApplicationListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupApplicationListReq.Unmarshal(m, b)
@@ -508,7 +508,7 @@ func (m *GetGroupApplicationListResp) Reset()         { *m = GetGroupApplication
 func (m *GetGroupApplicationListResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupApplicationListResp) ProtoMessage()    {}
 func (*GetGroupApplicationListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{9}
+	return fileDescriptor_group_82343494531cedb3, []int{9}
 }
 func (m *GetGroupApplicationListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupApplicationListResp.Unmarshal(m, b)
@@ -562,7 +562,7 @@ func (m *GetUserReqApplicationListReq) Reset()         { *m = GetUserReqApplicat
 func (m *GetUserReqApplicationListReq) String() string { return proto.CompactTextString(m) }
 func (*GetUserReqApplicationListReq) ProtoMessage()    {}
 func (*GetUserReqApplicationListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{10}
+	return fileDescriptor_group_82343494531cedb3, []int{10}
 }
 func (m *GetUserReqApplicationListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUserReqApplicationListReq.Unmarshal(m, b)
@@ -615,7 +615,7 @@ func (m *GetUserReqApplicationListResp) Reset()         { *m = GetUserReqApplica
 func (m *GetUserReqApplicationListResp) String() string { return proto.CompactTextString(m) }
 func (*GetUserReqApplicationListResp) ProtoMessage()    {}
 func (*GetUserReqApplicationListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{11}
+	return fileDescriptor_group_82343494531cedb3, []int{11}
 }
 func (m *GetUserReqApplicationListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUserReqApplicationListResp.Unmarshal(m, b)
@@ -664,7 +664,7 @@ func (m *TransferGroupOwnerReq) Reset()         { *m = TransferGroupOwnerReq{} }
 func (m *TransferGroupOwnerReq) String() string { return proto.CompactTextString(m) }
 func (*TransferGroupOwnerReq) ProtoMessage()    {}
 func (*TransferGroupOwnerReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{12}
+	return fileDescriptor_group_82343494531cedb3, []int{12}
 }
 func (m *TransferGroupOwnerReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransferGroupOwnerReq.Unmarshal(m, b)
@@ -730,7 +730,7 @@ func (m *TransferGroupOwnerResp) Reset()         { *m = TransferGroupOwnerResp{}
 func (m *TransferGroupOwnerResp) String() string { return proto.CompactTextString(m) }
 func (*TransferGroupOwnerResp) ProtoMessage()    {}
 func (*TransferGroupOwnerResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{13}
+	return fileDescriptor_group_82343494531cedb3, []int{13}
 }
 func (m *TransferGroupOwnerResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransferGroupOwnerResp.Unmarshal(m, b)
@@ -758,22 +758,24 @@ func (m *TransferGroupOwnerResp) GetCommonResp() *CommonResp {
 }
 
 type JoinGroupReq struct {
-	GroupID              string   `protobuf:"bytes,1,opt,name=GroupID" json:"GroupID,omitempty"`
-	ReqMessage           string   `protobuf:"bytes,2,opt,name=ReqMessage" json:"ReqMessage,omitempty"`
-	OpUserID             string   `protobuf:"bytes,3,opt,name=OpUserID" json:"OpUserID,omitempty"`
-	OperationID          string   `protobuf:"bytes,4,opt,name=OperationID" json:"OperationID,omitempty"`
-	JoinSource           int32    `protobuf:"varint,5,opt,name=JoinSource" json:"JoinSource,omitempty"`
-	InviterUserID        string   `protobuf:"bytes,6,opt,name=InviterUserID" json:"InviterUserID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GroupID              string                    `protobuf:"bytes,1,opt,name=GroupID" json:"GroupID,omitempty"`
+	ReqMessage           string                    `protobuf:"bytes,2,opt,name=ReqMessage" json:"ReqMessage,omitempty"`
+	OpUserID             string                    `protobuf:"bytes,3,opt,name=OpUserID" json:"OpUserID,omitempty"`
+	OperationID          string                    `protobuf:"bytes,4,opt,name=OperationID" json:"OperationID,omitempty"`
+	JoinSource           int32                     `protobuf:"varint,5,opt,name=JoinSource" json:"JoinSource,omitempty"`
+	InviterUserID        string                    `protobuf:"bytes,6,opt,name=InviterUserID" json:"InviterUserID,omitempty"`
+	InviteToken          string                    `protobuf:"bytes,7,opt,name=InviteToken" json:"InviteToken,omitempty"`
+	JoinAnswers          []*sdk_ws.GroupJoinAnswer `protobuf:"bytes,8,rep,name=JoinAnswers" json:"JoinAnswers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *JoinGroupReq) Reset()         { *m = JoinGroupReq{} }
 func (m *JoinGroupReq) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupReq) ProtoMessage()    {}
 func (*JoinGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{14}
+	return fileDescriptor_group_82343494531cedb3, []int{14}
 }
 func (m *JoinGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JoinGroupReq.Unmarshal(m, b)
@@ -835,6 +837,20 @@ func (m *JoinGroupReq) GetInviterUserID() string {
 	return ""
 }
 
+func (m *JoinGroupReq) GetInviteToken() string {
+	if m != nil {
+		return m.InviteToken
+	}
+	return ""
+}
+
+func (m *JoinGroupReq) GetJoinAnswers() []*sdk_ws.GroupJoinAnswer {
+	if m != nil {
+		return m.JoinAnswers
+	}
+	return nil
+}
+
 type JoinGroupResp struct {
 	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -846,7 +862,7 @@ func (m *JoinGroupResp) Reset()         { *m = JoinGroupResp{} }
 func (m *JoinGroupResp) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupResp) ProtoMessage()    {}
 func (*JoinGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{15}
+	return fileDescriptor_group_82343494531cedb3, []int{15}
 }
 func (m *JoinGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JoinGroupResp.Unmarshal(m, b)
@@ -889,7 +905,7 @@ func (m *GroupApplicationResponseReq) Reset()         { *m = GroupApplicationRes
 func (m *GroupApplicationResponseReq) String() string { return proto.CompactTextString(m) }
 func (*GroupApplicationResponseReq) ProtoMessage()    {}
 func (*GroupApplicationResponseReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{16}
+	return fileDescriptor_group_82343494531cedb3, []int{16}
 }
 func (m *GroupApplicationResponseReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupApplicationResponseReq.Unmarshal(m, b)
@@ -962,7 +978,7 @@ func (m *GroupApplicationResponseResp) Reset()         { *m = GroupApplicationRe
 func (m *GroupApplicationResponseResp) String() string { return proto.CompactTextString(m) }
 func (*GroupApplicationResponseResp) ProtoMessage()    {}
 func (*GroupApplicationResponseResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{17}
+	return fileDescriptor_group_82343494531cedb3, []int{17}
 }
 func (m *GroupApplicationResponseResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupApplicationResponseResp.Unmarshal(m, b)
@@ -1002,7 +1018,7 @@ func (m *QuitGroupReq) Reset()         { *m = QuitGroupReq{} }
 func (m *QuitGroupReq) String() string { return proto.CompactTextString(m) }
 func (*QuitGroupReq) ProtoMessage()    {}
 func (*QuitGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{18}
+	return fileDescriptor_group_82343494531cedb3, []int{18}
 }
 func (m *QuitGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QuitGroupReq.Unmarshal(m, b)
@@ -1054,7 +1070,7 @@ func (m *QuitGroupResp) Reset()         { *m = QuitGroupResp{} }
 func (m *QuitGroupResp) String() string { return proto.CompactTextString(m) }
 func (*QuitGroupResp) ProtoMessage()    {}
 func (*QuitGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{19}
+	return fileDescriptor_group_82343494531cedb3, []int{19}
 }
 func (m *QuitGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QuitGroupResp.Unmarshal(m, b)
@@ -1096,7 +1112,7 @@ func (m *GetGroupMemberListReq) Reset()         { *m = GetGroupMemberListReq{} }
 func (m *GetGroupMemberListReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMemberListReq) ProtoMessage()    {}
 func (*GetGroupMemberListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{20}
+	return fileDescriptor_group_82343494531cedb3, []int{20}
 }
 func (m *GetGroupMemberListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMemberListReq.Unmarshal(m, b)
@@ -1165,7 +1181,7 @@ func (m *GetGroupMemberListResp) Reset()         { *m = GetGroupMemberListResp{}
 func (m *GetGroupMemberListResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMemberListResp) ProtoMessage()    {}
 func (*GetGroupMemberListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{21}
+	return fileDescriptor_group_82343494531cedb3, []int{21}
 }
 func (m *GetGroupMemberListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMemberListResp.Unmarshal(m, b)
@@ -1228,7 +1244,7 @@ func (m *GetGroupMembersInfoReq) Reset()         { *m = GetGroupMembersInfoReq{}
 func (m *GetGroupMembersInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMembersInfoReq) ProtoMessage()    {}
 func (*GetGroupMembersInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{22}
+	return fileDescriptor_group_82343494531cedb3, []int{22}
 }
 func (m *GetGroupMembersInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMembersInfoReq.Unmarshal(m, b)
@@ -1296,7 +1312,7 @@ func (m *GetGroupMembersInfoResp) Reset()         { *m = GetGroupMembersInfoResp
 func (m *GetGroupMembersInfoResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMembersInfoResp) ProtoMessage()    {}
 func (*GetGroupMembersInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{23}
+	return fileDescriptor_group_82343494531cedb3, []int{23}
 }
 func (m *GetGroupMembersInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMembersInfoResp.Unmarshal(m, b)
@@ -1352,7 +1368,7 @@ func (m *KickGroupMemberReq) Reset()         { *m = KickGroupMemberReq{} }
 func (m *KickGroupMemberReq) String() string { return proto.CompactTextString(m) }
 func (*KickGroupMemberReq) ProtoMessage()    {}
 func (*KickGroupMemberReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{24}
+	return fileDescriptor_group_82343494531cedb3, []int{24}
 }
 func (m *KickGroupMemberReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KickGroupMemberReq.Unmarshal(m, b)
@@ -1419,7 +1435,7 @@ func (m *Id2Result) Reset()         { *m = Id2Result{} }
 func (m *Id2Result) String() string { return proto.CompactTextString(m) }
 func (*Id2Result) ProtoMessage()    {}
 func (*Id2Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{25}
+	return fileDescriptor_group_82343494531cedb3, []int{25}
 }
 func (m *Id2Result) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Id2Result.Unmarshal(m, b)
@@ -1466,7 +1482,7 @@ func (m *KickGroupMemberResp) Reset()         { *m = KickGroupMemberResp{} }
 func (m *KickGroupMemberResp) String() string { return proto.CompactTextString(m) }
 func (*KickGroupMemberResp) ProtoMessage()    {}
 func (*KickGroupMemberResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{26}
+	return fileDescriptor_group_82343494531cedb3, []int{26}
 }
 func (m *KickGroupMemberResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KickGroupMemberResp.Unmarshal(m, b)
@@ -1520,7 +1536,7 @@ func (m *GetJoinedGroupListReq) Reset()         { *m = GetJoinedGroupListReq{} }
 func (m *GetJoinedGroupListReq) String() string { return proto.CompactTextString(m) }
 func (*GetJoinedGroupListReq) ProtoMessage()    {}
 func (*GetJoinedGroupListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{27}
+	return fileDescriptor_group_82343494531cedb3, []int{27}
 }
 func (m *GetJoinedGroupListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetJoinedGroupListReq.Unmarshal(m, b)
@@ -1574,7 +1590,7 @@ func (m *GetJoinedGroupListResp) Reset()         { *m = GetJoinedGroupListResp{}
 func (m *GetJoinedGroupListResp) String() string { return proto.CompactTextString(m) }
 func (*GetJoinedGroupListResp) ProtoMessage()    {}
 func (*GetJoinedGroupListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{28}
+	return fileDescriptor_group_82343494531cedb3, []int{28}
 }
 func (m *GetJoinedGroupListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetJoinedGroupListResp.Unmarshal(m, b)
@@ -1630,7 +1646,7 @@ func (m *InviteUserToGroupReq) Reset()         { *m = InviteUserToGroupReq{} }
 func (m *InviteUserToGroupReq) String() string { return proto.CompactTextString(m) }
 func (*InviteUserToGroupReq) ProtoMessage()    {}
 func (*InviteUserToGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{29}
+	return fileDescriptor_group_82343494531cedb3, []int{29}
 }
 func (m *InviteUserToGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteUserToGroupReq.Unmarshal(m, b)
@@ -1698,7 +1714,7 @@ func (m *InviteUserToGroupResp) Reset()         { *m = InviteUserToGroupResp{} }
 func (m *InviteUserToGroupResp) String() string { return proto.CompactTextString(m) }
 func (*InviteUserToGroupResp) ProtoMessage()    {}
 func (*InviteUserToGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{30}
+	return fileDescriptor_group_82343494531cedb3, []int{30}
 }
 func (m *InviteUserToGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteUserToGroupResp.Unmarshal(m, b)
@@ -1754,7 +1770,7 @@ func (m *InviteUserToGroupsReq) Reset()         { *m = InviteUserToGroupsReq{} }
 func (m *InviteUserToGroupsReq) String() string { return proto.CompactTextString(m) }
 func (*InviteUserToGroupsReq) ProtoMessage()    {}
 func (*InviteUserToGroupsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{31}
+	return fileDescriptor_group_82343494531cedb3, []int{31}
 }
 func (m *InviteUserToGroupsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteUserToGroupsReq.Unmarshal(m, b)
@@ -1821,7 +1837,7 @@ func (m *InviteUserToGroupsResp) Reset()         { *m = InviteUserToGroupsResp{}
 func (m *InviteUserToGroupsResp) String() string { return proto.CompactTextString(m) }
 func (*InviteUserToGroupsResp) ProtoMessage()    {}
 func (*InviteUserToGroupsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{32}
+	return fileDescriptor_group_82343494531cedb3, []int{32}
 }
 func (m *InviteUserToGroupsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InviteUserToGroupsResp.Unmarshal(m, b)
@@ -1870,7 +1886,7 @@ func (m *GetGroupAllMemberReq) Reset()         { *m = GetGroupAllMemberReq{} }
 func (m *GetGroupAllMemberReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupAllMemberReq) ProtoMessage()    {}
 func (*GetGroupAllMemberReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{33}
+	return fileDescriptor_group_82343494531cedb3, []int{33}
 }
 func (m *GetGroupAllMemberReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupAllMemberReq.Unmarshal(m, b)
@@ -1938,7 +1954,7 @@ func (m *GetGroupAllMemberResp) Reset()         { *m = GetGroupAllMemberResp{} }
 func (m *GetGroupAllMemberResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupAllMemberResp) ProtoMessage()    {}
 func (*GetGroupAllMemberResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{34}
+	return fileDescriptor_group_82343494531cedb3, []int{34}
 }
 func (m *GetGroupAllMemberResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupAllMemberResp.Unmarshal(m, b)
@@ -1992,7 +2008,7 @@ func (m *CMSGroup) Reset()         { *m = CMSGroup{} }
 func (m *CMSGroup) String() string { return proto.CompactTextString(m) }
 func (*CMSGroup) ProtoMessage()    {}
 func (*CMSGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{35}
+	return fileDescriptor_group_82343494531cedb3, []int{35}
 }
 func (m *CMSGroup) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CMSGroup.Unmarshal(m, b)
@@ -2047,7 +2063,7 @@ func (m *GetGroupsReq) Reset()         { *m = GetGroupsReq{} }
 func (m *GetGroupsReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsReq) ProtoMessage()    {}
 func (*GetGroupsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{36}
+	return fileDescriptor_group_82343494531cedb3, []int{36}
 }
 func (m *GetGroupsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupsReq.Unmarshal(m, b)
@@ -2109,7 +2125,7 @@ func (m *GetGroupsResp) Reset()         { *m = GetGroupsResp{} }
 func (m *GetGroupsResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupsResp) ProtoMessage()    {}
 func (*GetGroupsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{37}
+	return fileDescriptor_group_82343494531cedb3, []int{37}
 }
 func (m *GetGroupsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupsResp.Unmarshal(m, b)
@@ -2169,7 +2185,7 @@ func (m *GetGroupMemberReq) Reset()         { *m = GetGroupMemberReq{} }
 func (m *GetGroupMemberReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMemberReq) ProtoMessage()    {}
 func (*GetGroupMemberReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{38}
+	return fileDescriptor_group_82343494531cedb3, []int{38}
 }
 func (m *GetGroupMemberReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMemberReq.Unmarshal(m, b)
@@ -2217,7 +2233,7 @@ func (m *GetGroupMembersCMSReq) Reset()         { *m = GetGroupMembersCMSReq{} }
 func (m *GetGroupMembersCMSReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMembersCMSReq) ProtoMessage()    {}
 func (*GetGroupMembersCMSReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{39}
+	return fileDescriptor_group_82343494531cedb3, []int{39}
 }
 func (m *GetGroupMembersCMSReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMembersCMSReq.Unmarshal(m, b)
@@ -2279,7 +2295,7 @@ func (m *GetGroupMembersCMSResp) Reset()         { *m = GetGroupMembersCMSResp{}
 func (m *GetGroupMembersCMSResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupMembersCMSResp) ProtoMessage()    {}
 func (*GetGroupMembersCMSResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{40}
+	return fileDescriptor_group_82343494531cedb3, []int{40}
 }
 func (m *GetGroupMembersCMSResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupMembersCMSResp.Unmarshal(m, b)
@@ -2340,7 +2356,7 @@ func (m *DismissGroupReq) Reset()         { *m = DismissGroupReq{} }
 func (m *DismissGroupReq) String() string { return proto.CompactTextString(m) }
 func (*DismissGroupReq) ProtoMessage()    {}
 func (*DismissGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{41}
+	return fileDescriptor_group_82343494531cedb3, []int{41}
 }
 func (m *DismissGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DismissGroupReq.Unmarshal(m, b)
@@ -2392,7 +2408,7 @@ func (m *DismissGroupResp) Reset()         { *m = DismissGroupResp{} }
 func (m *DismissGroupResp) String() string { return proto.CompactTextString(m) }
 func (*DismissGroupResp) ProtoMessage()    {}
 func (*DismissGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{42}
+	return fileDescriptor_group_82343494531cedb3, []int{42}
 }
 func (m *DismissGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DismissGroupResp.Unmarshal(m, b)
@@ -2434,7 +2450,7 @@ func (m *MuteGroupMemberReq) Reset()         { *m = MuteGroupMemberReq{} }
 func (m *MuteGroupMemberReq) String() string { return proto.CompactTextString(m) }
 func (*MuteGroupMemberReq) ProtoMessage()    {}
 func (*MuteGroupMemberReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{43}
+	return fileDescriptor_group_82343494531cedb3, []int{43}
 }
 func (m *MuteGroupMemberReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MuteGroupMemberReq.Unmarshal(m, b)
@@ -2500,7 +2516,7 @@ func (m *MuteGroupMemberResp) Reset()         { *m = MuteGroupMemberResp{} }
 func (m *MuteGroupMemberResp) String() string { return proto.CompactTextString(m) }
 func (*MuteGroupMemberResp) ProtoMessage()    {}
 func (*MuteGroupMemberResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{44}
+	return fileDescriptor_group_82343494531cedb3, []int{44}
 }
 func (m *MuteGroupMemberResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MuteGroupMemberResp.Unmarshal(m, b)
@@ -2541,7 +2557,7 @@ func (m *CancelMuteGroupMemberReq) Reset()         { *m = CancelMuteGroupMemberR
 func (m *CancelMuteGroupMemberReq) String() string { return proto.CompactTextString(m) }
 func (*CancelMuteGroupMemberReq) ProtoMessage()    {}
 func (*CancelMuteGroupMemberReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{45}
+	return fileDescriptor_group_82343494531cedb3, []int{45}
 }
 func (m *CancelMuteGroupMemberReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelMuteGroupMemberReq.Unmarshal(m, b)
@@ -2600,7 +2616,7 @@ func (m *CancelMuteGroupMemberResp) Reset()         { *m = CancelMuteGroupMember
 func (m *CancelMuteGroupMemberResp) String() string { return proto.CompactTextString(m) }
 func (*CancelMuteGroupMemberResp) ProtoMessage()    {}
 func (*CancelMuteGroupMemberResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{46}
+	return fileDescriptor_group_82343494531cedb3, []int{46}
 }
 func (m *CancelMuteGroupMemberResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelMuteGroupMemberResp.Unmarshal(m, b)
@@ -2640,7 +2656,7 @@ func (m *MuteGroupReq) Reset()         { *m = MuteGroupReq{} }
 func (m *MuteGroupReq) String() string { return proto.CompactTextString(m) }
 func (*MuteGroupReq) ProtoMessage()    {}
 func (*MuteGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{47}
+	return fileDescriptor_group_82343494531cedb3, []int{47}
 }
 func (m *MuteGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MuteGroupReq.Unmarshal(m, b)
@@ -2692,7 +2708,7 @@ func (m *MuteGroupResp) Reset()         { *m = MuteGroupResp{} }
 func (m *MuteGroupResp) String() string { return proto.CompactTextString(m) }
 func (*MuteGroupResp) ProtoMessage()    {}
 func (*MuteGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{48}
+	return fileDescriptor_group_82343494531cedb3, []int{48}
 }
 func (m *MuteGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MuteGroupResp.Unmarshal(m, b)
@@ -2732,7 +2748,7 @@ func (m *CancelMuteGroupReq) Reset()         { *m = CancelMuteGroupReq{} }
 func (m *CancelMuteGroupReq) String() string { return proto.CompactTextString(m) }
 func (*CancelMuteGroupReq) ProtoMessage()    {}
 func (*CancelMuteGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{49}
+	return fileDescriptor_group_82343494531cedb3, []int{49}
 }
 func (m *CancelMuteGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelMuteGroupReq.Unmarshal(m, b)
@@ -2784,7 +2800,7 @@ func (m *CancelMuteGroupResp) Reset()         { *m = CancelMuteGroupResp{} }
 func (m *CancelMuteGroupResp) String() string { return proto.CompactTextString(m) }
 func (*CancelMuteGroupResp) ProtoMessage()    {}
 func (*CancelMuteGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{50}
+	return fileDescriptor_group_82343494531cedb3, []int{50}
 }
 func (m *CancelMuteGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelMuteGroupResp.Unmarshal(m, b)
@@ -2826,7 +2842,7 @@ func (m *SetGroupMemberNicknameReq) Reset()         { *m = SetGroupMemberNicknam
 func (m *SetGroupMemberNicknameReq) String() string { return proto.CompactTextString(m) }
 func (*SetGroupMemberNicknameReq) ProtoMessage()    {}
 func (*SetGroupMemberNicknameReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{51}
+	return fileDescriptor_group_82343494531cedb3, []int{51}
 }
 func (m *SetGroupMemberNicknameReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupMemberNicknameReq.Unmarshal(m, b)
@@ -2892,7 +2908,7 @@ func (m *SetGroupMemberNicknameResp) Reset()         { *m = SetGroupMemberNickna
 func (m *SetGroupMemberNicknameResp) String() string { return proto.CompactTextString(m) }
 func (*SetGroupMemberNicknameResp) ProtoMessage()    {}
 func (*SetGroupMemberNicknameResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{52}
+	return fileDescriptor_group_82343494531cedb3, []int{52}
 }
 func (m *SetGroupMemberNicknameResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupMemberNicknameResp.Unmarshal(m, b)
@@ -2932,7 +2948,7 @@ func (m *GetJoinedSuperGroupListReq) Reset()         { *m = GetJoinedSuperGroupL
 func (m *GetJoinedSuperGroupListReq) String() string { return proto.CompactTextString(m) }
 func (*GetJoinedSuperGroupListReq) ProtoMessage()    {}
 func (*GetJoinedSuperGroupListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{53}
+	return fileDescriptor_group_82343494531cedb3, []int{53}
 }
 func (m *GetJoinedSuperGroupListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetJoinedSuperGroupListReq.Unmarshal(m, b)
@@ -2985,7 +3001,7 @@ func (m *GetJoinedSuperGroupListResp) Reset()         { *m = GetJoinedSuperGroup
 func (m *GetJoinedSuperGroupListResp) String() string { return proto.CompactTextString(m) }
 func (*GetJoinedSuperGroupListResp) ProtoMessage()    {}
 func (*GetJoinedSuperGroupListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{54}
+	return fileDescriptor_group_82343494531cedb3, []int{54}
 }
 func (m *GetJoinedSuperGroupListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetJoinedSuperGroupListResp.Unmarshal(m, b)
@@ -3032,7 +3048,7 @@ func (m *GetSuperGroupsInfoReq) Reset()         { *m = GetSuperGroupsInfoReq{} }
 func (m *GetSuperGroupsInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetSuperGroupsInfoReq) ProtoMessage()    {}
 func (*GetSuperGroupsInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{55}
+	return fileDescriptor_group_82343494531cedb3, []int{55}
 }
 func (m *GetSuperGroupsInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSuperGroupsInfoReq.Unmarshal(m, b)
@@ -3085,7 +3101,7 @@ func (m *GetSuperGroupsInfoResp) Reset()         { *m = GetSuperGroupsInfoResp{}
 func (m *GetSuperGroupsInfoResp) String() string { return proto.CompactTextString(m) }
 func (*GetSuperGroupsInfoResp) ProtoMessage()    {}
 func (*GetSuperGroupsInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{56}
+	return fileDescriptor_group_82343494531cedb3, []int{56}
 }
 func (m *GetSuperGroupsInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSuperGroupsInfoResp.Unmarshal(m, b)
@@ -3137,7 +3153,7 @@ func (m *SetGroupMemberInfoReq) Reset()         { *m = SetGroupMemberInfoReq{} }
 func (m *SetGroupMemberInfoReq) String() string { return proto.CompactTextString(m) }
 func (*SetGroupMemberInfoReq) ProtoMessage()    {}
 func (*SetGroupMemberInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{57}
+	return fileDescriptor_group_82343494531cedb3, []int{57}
 }
 func (m *SetGroupMemberInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupMemberInfoReq.Unmarshal(m, b)
@@ -3224,7 +3240,7 @@ func (m *SetGroupMemberInfoResp) Reset()         { *m = SetGroupMemberInfoResp{}
 func (m *SetGroupMemberInfoResp) String() string { return proto.CompactTextString(m) }
 func (*SetGroupMemberInfoResp) ProtoMessage()    {}
 func (*SetGroupMemberInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{58}
+	return fileDescriptor_group_82343494531cedb3, []int{58}
 }
 func (m *SetGroupMemberInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGroupMemberInfoResp.Unmarshal(m, b)
@@ -3264,7 +3280,7 @@ func (m *GetGroupAbstractInfoReq) Reset()         { *m = GetGroupAbstractInfoReq
 func (m *GetGroupAbstractInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetGroupAbstractInfoReq) ProtoMessage()    {}
 func (*GetGroupAbstractInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{59}
+	return fileDescriptor_group_82343494531cedb3, []int{59}
 }
 func (m *GetGroupAbstractInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupAbstractInfoReq.Unmarshal(m, b)
@@ -3318,7 +3334,7 @@ func (m *GetGroupAbstractInfoResp) Reset()         { *m = GetGroupAbstractInfoRe
 func (m *GetGroupAbstractInfoResp) String() string { return proto.CompactTextString(m) }
 func (*GetGroupAbstractInfoResp) ProtoMessage()    {}
 func (*GetGroupAbstractInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{60}
+	return fileDescriptor_group_82343494531cedb3, []int{60}
 }
 func (m *GetGroupAbstractInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGroupAbstractInfoResp.Unmarshal(m, b)
@@ -3372,7 +3388,7 @@ func (m *GroupIsExistReq) Reset()         { *m = GroupIsExistReq{} }
 func (m *GroupIsExistReq) String() string { return proto.CompactTextString(m) }
 func (*GroupIsExistReq) ProtoMessage()    {}
 func (*GroupIsExistReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{61}
+	return fileDescriptor_group_82343494531cedb3, []int{61}
 }
 func (m *GroupIsExistReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupIsExistReq.Unmarshal(m, b)
@@ -3425,7 +3441,7 @@ func (m *GroupIsExistResp) Reset()         { *m = GroupIsExistResp{} }
 func (m *GroupIsExistResp) String() string { return proto.CompactTextString(m) }
 func (*GroupIsExistResp) ProtoMessage()    {}
 func (*GroupIsExistResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{62}
+	return fileDescriptor_group_82343494531cedb3, []int{62}
 }
 func (m *GroupIsExistResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupIsExistResp.Unmarshal(m, b)
@@ -3472,7 +3488,7 @@ func (m *UserIsInGroupReq) Reset()         { *m = UserIsInGroupReq{} }
 func (m *UserIsInGroupReq) String() string { return proto.CompactTextString(m) }
 func (*UserIsInGroupReq) ProtoMessage()    {}
 func (*UserIsInGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{63}
+	return fileDescriptor_group_82343494531cedb3, []int{63}
 }
 func (m *UserIsInGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserIsInGroupReq.Unmarshal(m, b)
@@ -3525,7 +3541,7 @@ func (m *UserIsInGroupResp) Reset()         { *m = UserIsInGroupResp{} }
 func (m *UserIsInGroupResp) String() string { return proto.CompactTextString(m) }
 func (*UserIsInGroupResp) ProtoMessage()    {}
 func (*UserIsInGroupResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_group_e55c79105d50978e, []int{64}
+	return fileDescriptor_group_82343494531cedb3, []int{64}
 }
 func (m *UserIsInGroupResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserIsInGroupResp.Unmarshal(m, b)
@@ -3559,233 +3575,2728 @@ func (m *UserIsInGroupResp) GetIsExistMap() map[string]bool {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*CommonResp)(nil), "group.CommonResp")
-	proto.RegisterType((*GroupAddMemberInfo)(nil), "group.GroupAddMemberInfo")
-	proto.RegisterType((*CreateGroupReq)(nil), "group.CreateGroupReq")
-	proto.RegisterType((*CreateGroupResp)(nil), "group.CreateGroupResp")
-	proto.RegisterType((*GetGroupsInfoReq)(nil), "group.GetGroupsInfoReq")
-	proto.RegisterType((*GetGroupsInfoResp)(nil), "group.GetGroupsInfoResp")
-	proto.RegisterType((*SetGroupInfoReq)(nil), "group.SetGroupInfoReq")
-	proto.RegisterType((*SetGroupInfoResp)(nil), "group.SetGroupInfoResp")
-	proto.RegisterType((*GetGroupApplicationListReq)(nil), "group.GetGroupApplicationListReq")
-	proto.RegisterType((*GetGroupApplicationListResp)(nil), "group.GetGroupApplicationListResp")
-	proto.RegisterType((*GetUserReqApplicationListReq)(nil), "group.GetUserReqApplicationListReq")
-	proto.RegisterType((*GetUserReqApplicationListResp)(nil), "group.GetUserReqApplicationListResp")
-	proto.RegisterType((*TransferGroupOwnerReq)(nil), "group.TransferGroupOwnerReq")
-	proto.RegisterType((*TransferGroupOwnerResp)(nil), "group.TransferGroupOwnerResp")
-	proto.RegisterType((*JoinGroupReq)(nil), "group.JoinGroupReq")
-	proto.RegisterType((*JoinGroupResp)(nil), "group.JoinGroupResp")
-	proto.RegisterType((*GroupApplicationResponseReq)(nil), "group.GroupApplicationResponseReq")
-	proto.RegisterType((*GroupApplicationResponseResp)(nil), "group.GroupApplicationResponseResp")
-	proto.RegisterType((*QuitGroupReq)(nil), "group.QuitGroupReq")
-	proto.RegisterType((*QuitGroupResp)(nil), "group.QuitGroupResp")
-	proto.RegisterType((*GetGroupMemberListReq)(nil), "group.GetGroupMemberListReq")
-	proto.RegisterType((*GetGroupMemberListResp)(nil), "group.GetGroupMemberListResp")
-	proto.RegisterType((*GetGroupMembersInfoReq)(nil), "group.GetGroupMembersInfoReq")
-	proto.RegisterType((*GetGroupMembersInfoResp)(nil), "group.GetGroupMembersInfoResp")
-	proto.RegisterType((*KickGroupMemberReq)(nil), "group.KickGroupMemberReq")
-	proto.RegisterType((*Id2Result)(nil), "group.Id2Result")
-	proto.RegisterType((*KickGroupMemberResp)(nil), "group.KickGroupMemberResp")
-	proto.RegisterType((*GetJoinedGroupListReq)(nil), "group.GetJoinedGroupListReq")
-	proto.RegisterType((*GetJoinedGroupListResp)(nil), "group.GetJoinedGroupListResp")
-	proto.RegisterType((*InviteUserToGroupReq)(nil), "group.InviteUserToGroupReq")
-	proto.RegisterType((*InviteUserToGroupResp)(nil), "group.InviteUserToGroupResp")
-	proto.RegisterType((*InviteUserToGroupsReq)(nil), "group.InviteUserToGroupsReq")
-	proto.RegisterType((*InviteUserToGroupsResp)(nil), "group.InviteUserToGroupsResp")
-	proto.RegisterType((*GetGroupAllMemberReq)(nil), "group.GetGroupAllMemberReq")
-	proto.RegisterType((*GetGroupAllMemberResp)(nil), "group.GetGroupAllMemberResp")
-	proto.RegisterType((*CMSGroup)(nil), "group.CMSGroup")
-	proto.RegisterType((*GetGroupsReq)(nil), "group.GetGroupsReq")
-	proto.RegisterType((*GetGroupsResp)(nil), "group.GetGroupsResp")
-	proto.RegisterType((*GetGroupMemberReq)(nil), "group.GetGroupMemberReq")
-	proto.RegisterType((*GetGroupMembersCMSReq)(nil), "group.GetGroupMembersCMSReq")
-	proto.RegisterType((*GetGroupMembersCMSResp)(nil), "group.GetGroupMembersCMSResp")
-	proto.RegisterType((*DismissGroupReq)(nil), "group.DismissGroupReq")
-	proto.RegisterType((*DismissGroupResp)(nil), "group.DismissGroupResp")
-	proto.RegisterType((*MuteGroupMemberReq)(nil), "group.MuteGroupMemberReq")
-	proto.RegisterType((*MuteGroupMemberResp)(nil), "group.MuteGroupMemberResp")
-	proto.RegisterType((*CancelMuteGroupMemberReq)(nil), "group.CancelMuteGroupMemberReq")
-	proto.RegisterType((*CancelMuteGroupMemberResp)(nil), "group.CancelMuteGroupMemberResp")
-	proto.RegisterType((*MuteGroupReq)(nil), "group.MuteGroupReq")
-	proto.RegisterType((*MuteGroupResp)(nil), "group.MuteGroupResp")
-	proto.RegisterType((*CancelMuteGroupReq)(nil), "group.CancelMuteGroupReq")
-	proto.RegisterType((*CancelMuteGroupResp)(nil), "group.CancelMuteGroupResp")
-	proto.RegisterType((*SetGroupMemberNicknameReq)(nil), "group.SetGroupMemberNicknameReq")
-	proto.RegisterType((*SetGroupMemberNicknameResp)(nil), "group.SetGroupMemberNicknameResp")
-	proto.RegisterType((*GetJoinedSuperGroupListReq)(nil), "group.GetJoinedSuperGroupListReq")
-	proto.RegisterType((*GetJoinedSuperGroupListResp)(nil), "group.GetJoinedSuperGroupListResp")
-	proto.RegisterType((*GetSuperGroupsInfoReq)(nil), "group.GetSuperGroupsInfoReq")
-	proto.RegisterType((*GetSuperGroupsInfoResp)(nil), "group.GetSuperGroupsInfoResp")
-	proto.RegisterType((*SetGroupMemberInfoReq)(nil), "group.SetGroupMemberInfoReq")
-	proto.RegisterType((*SetGroupMemberInfoResp)(nil), "group.SetGroupMemberInfoResp")
-	proto.RegisterType((*GetGroupAbstractInfoReq)(nil), "group.GetGroupAbstractInfoReq")
-	proto.RegisterType((*GetGroupAbstractInfoResp)(nil), "group.GetGroupAbstractInfoResp")
-	proto.RegisterType((*GroupIsExistReq)(nil), "group.GroupIsExistReq")
-	proto.RegisterType((*GroupIsExistResp)(nil), "group.GroupIsExistResp")
-	proto.RegisterMapType((map[string]bool)(nil), "group.GroupIsExistResp.IsExistMapEntry")
-	proto.RegisterType((*UserIsInGroupReq)(nil), "group.UserIsInGroupReq")
-	proto.RegisterType((*UserIsInGroupResp)(nil), "group.UserIsInGroupResp")
-	proto.RegisterMapType((map[string]bool)(nil), "group.UserIsInGroupResp.IsExistMapEntry")
+type GroupInviteLink struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	GroupID              string   `protobuf:"bytes,2,opt,name=groupID" json:"groupID,omitempty"`
+	CreatorUserID        string   `protobuf:"bytes,3,opt,name=creatorUserID" json:"creatorUserID,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,4,opt,name=expireTime" json:"expireTime,omitempty"`
+	MaxUses              int32    `protobuf:"varint,5,opt,name=maxUses" json:"maxUses,omitempty"`
+	UseCount             int32    `protobuf:"varint,6,opt,name=useCount" json:"useCount,omitempty"`
+	AutoApprove          bool     `protobuf:"varint,7,opt,name=autoApprove" json:"autoApprove,omitempty"`
+	Status               int32    `protobuf:"varint,8,opt,name=status" json:"status,omitempty"`
+	CreateTime           int64    `protobuf:"varint,9,opt,name=createTime" json:"createTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GroupInviteLink) Reset()         { *m = GroupInviteLink{} }
+func (m *GroupInviteLink) String() string { return proto.CompactTextString(m) }
+func (*GroupInviteLink) ProtoMessage()    {}
+func (*GroupInviteLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{65}
+}
+func (m *GroupInviteLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupInviteLink.Unmarshal(m, b)
+}
+func (m *GroupInviteLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupInviteLink.Marshal(b, m, deterministic)
+}
+func (dst *GroupInviteLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupInviteLink.Merge(dst, src)
+}
+func (m *GroupInviteLink) XXX_Size() int {
+	return xxx_messageInfo_GroupInviteLink.Size(m)
+}
+func (m *GroupInviteLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupInviteLink.DiscardUnknown(m)
+}
 
-// Client API for Group service
+var xxx_messageInfo_GroupInviteLink proto.InternalMessageInfo
 
-type GroupClient interface {
-	CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error)
-	JoinGroup(ctx context.Context, in *JoinGroupReq, opts ...grpc.CallOption) (*JoinGroupResp, error)
-	QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*QuitGroupResp, error)
-	GetGroupsInfo(ctx context.Context, in *GetGroupsInfoReq, opts ...grpc.CallOption) (*GetGroupsInfoResp, error)
-	SetGroupInfo(ctx context.Context, in *SetGroupInfoReq, opts ...grpc.CallOption) (*SetGroupInfoResp, error)
-	GetGroupApplicationList(ctx context.Context, in *GetGroupApplicationListReq, opts ...grpc.CallOption) (*GetGroupApplicationListResp, error)
-	GetUserReqApplicationList(ctx context.Context, in *GetUserReqApplicationListReq, opts ...grpc.CallOption) (*GetUserReqApplicationListResp, error)
-	TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*TransferGroupOwnerResp, error)
-	GroupApplicationResponse(ctx context.Context, in *GroupApplicationResponseReq, opts ...grpc.CallOption) (*GroupApplicationResponseResp, error)
-	GetGroupMemberList(ctx context.Context, in *GetGroupMemberListReq, opts ...grpc.CallOption) (*GetGroupMemberListResp, error)
-	GetGroupMembersInfo(ctx context.Context, in *GetGroupMembersInfoReq, opts ...grpc.CallOption) (*GetGroupMembersInfoResp, error)
-	KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*KickGroupMemberResp, error)
-	GetJoinedGroupList(ctx context.Context, in *GetJoinedGroupListReq, opts ...grpc.CallOption) (*GetJoinedGroupListResp, error)
-	InviteUserToGroup(ctx context.Context, in *InviteUserToGroupReq, opts ...grpc.CallOption) (*InviteUserToGroupResp, error)
-	InviteUserToGroups(ctx context.Context, in *InviteUserToGroupsReq, opts ...grpc.CallOption) (*InviteUserToGroupsResp, error)
-	GetGroupAllMember(ctx context.Context, in *GetGroupAllMemberReq, opts ...grpc.CallOption) (*GetGroupAllMemberResp, error)
-	GetGroups(ctx context.Context, in *GetGroupsReq, opts ...grpc.CallOption) (*GetGroupsResp, error)
-	GetGroupMembersCMS(ctx context.Context, in *GetGroupMembersCMSReq, opts ...grpc.CallOption) (*GetGroupMembersCMSResp, error)
-	DismissGroup(ctx context.Context, in *DismissGroupReq, opts ...grpc.CallOption) (*DismissGroupResp, error)
-	MuteGroupMember(ctx context.Context, in *MuteGroupMemberReq, opts ...grpc.CallOption) (*MuteGroupMemberResp, error)
-	CancelMuteGroupMember(ctx context.Context, in *CancelMuteGroupMemberReq, opts ...grpc.CallOption) (*CancelMuteGroupMemberResp, error)
-	MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*MuteGroupResp, error)
-	CancelMuteGroup(ctx context.Context, in *CancelMuteGroupReq, opts ...grpc.CallOption) (*CancelMuteGroupResp, error)
-	SetGroupMemberNickname(ctx context.Context, in *SetGroupMemberNicknameReq, opts ...grpc.CallOption) (*SetGroupMemberNicknameResp, error)
-	GetJoinedSuperGroupList(ctx context.Context, in *GetJoinedSuperGroupListReq, opts ...grpc.CallOption) (*GetJoinedSuperGroupListResp, error)
-	GetSuperGroupsInfo(ctx context.Context, in *GetSuperGroupsInfoReq, opts ...grpc.CallOption) (*GetSuperGroupsInfoResp, error)
-	SetGroupMemberInfo(ctx context.Context, in *SetGroupMemberInfoReq, opts ...grpc.CallOption) (*SetGroupMemberInfoResp, error)
-	GetGroupAbstractInfo(ctx context.Context, in *GetGroupAbstractInfoReq, opts ...grpc.CallOption) (*GetGroupAbstractInfoResp, error)
-	GroupIsExist(ctx context.Context, in *GroupIsExistReq, opts ...grpc.CallOption) (*GroupIsExistResp, error)
-	UserIsInGroup(ctx context.Context, in *UserIsInGroupReq, opts ...grpc.CallOption) (*UserIsInGroupResp, error)
+func (m *GroupInviteLink) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
 }
 
-type groupClient struct {
-	cc *grpc.ClientConn
+func (m *GroupInviteLink) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
 }
 
-func NewGroupClient(cc *grpc.ClientConn) GroupClient {
-	return &groupClient{cc}
+func (m *GroupInviteLink) GetCreatorUserID() string {
+	if m != nil {
+		return m.CreatorUserID
+	}
+	return ""
 }
 
-func (c *groupClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
-	out := new(CreateGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/createGroup", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) JoinGroup(ctx context.Context, in *JoinGroupReq, opts ...grpc.CallOption) (*JoinGroupResp, error) {
-	out := new(JoinGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/joinGroup", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetMaxUses() int32 {
+	if m != nil {
+		return m.MaxUses
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*QuitGroupResp, error) {
-	out := new(QuitGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/quitGroup", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetUseCount() int32 {
+	if m != nil {
+		return m.UseCount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) GetGroupsInfo(ctx context.Context, in *GetGroupsInfoReq, opts ...grpc.CallOption) (*GetGroupsInfoResp, error) {
-	out := new(GetGroupsInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/getGroupsInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetAutoApprove() bool {
+	if m != nil {
+		return m.AutoApprove
 	}
-	return out, nil
+	return false
 }
 
-func (c *groupClient) SetGroupInfo(ctx context.Context, in *SetGroupInfoReq, opts ...grpc.CallOption) (*SetGroupInfoResp, error) {
-	out := new(SetGroupInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/setGroupInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) GetGroupApplicationList(ctx context.Context, in *GetGroupApplicationListReq, opts ...grpc.CallOption) (*GetGroupApplicationListResp, error) {
-	out := new(GetGroupApplicationListResp)
-	err := grpc.Invoke(ctx, "/group.group/getGroupApplicationList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *GroupInviteLink) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) GetUserReqApplicationList(ctx context.Context, in *GetUserReqApplicationListReq, opts ...grpc.CallOption) (*GetUserReqApplicationListResp, error) {
-	out := new(GetUserReqApplicationListResp)
-	err := grpc.Invoke(ctx, "/group.group/getUserReqApplicationList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateGroupInviteLinkReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	ExpireSeconds        int64    `protobuf:"varint,2,opt,name=expireSeconds" json:"expireSeconds,omitempty"`
+	MaxUses              int32    `protobuf:"varint,3,opt,name=maxUses" json:"maxUses,omitempty"`
+	AutoApprove          bool     `protobuf:"varint,4,opt,name=autoApprove" json:"autoApprove,omitempty"`
+	OpUserID             string   `protobuf:"bytes,5,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,6,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *groupClient) TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*TransferGroupOwnerResp, error) {
-	out := new(TransferGroupOwnerResp)
-	err := grpc.Invoke(ctx, "/group.group/transferGroupOwner", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateGroupInviteLinkReq) Reset()         { *m = CreateGroupInviteLinkReq{} }
+func (m *CreateGroupInviteLinkReq) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupInviteLinkReq) ProtoMessage()    {}
+func (*CreateGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{66}
+}
+func (m *CreateGroupInviteLinkReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupInviteLinkReq.Unmarshal(m, b)
+}
+func (m *CreateGroupInviteLinkReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupInviteLinkReq.Marshal(b, m, deterministic)
+}
+func (dst *CreateGroupInviteLinkReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupInviteLinkReq.Merge(dst, src)
+}
+func (m *CreateGroupInviteLinkReq) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupInviteLinkReq.Size(m)
+}
+func (m *CreateGroupInviteLinkReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupInviteLinkReq.DiscardUnknown(m)
 }
 
-func (c *groupClient) GroupApplicationResponse(ctx context.Context, in *GroupApplicationResponseReq, opts ...grpc.CallOption) (*GroupApplicationResponseResp, error) {
-	out := new(GroupApplicationResponseResp)
-	err := grpc.Invoke(ctx, "/group.group/groupApplicationResponse", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateGroupInviteLinkReq proto.InternalMessageInfo
+
+func (m *CreateGroupInviteLinkReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *groupClient) GetGroupMemberList(ctx context.Context, in *GetGroupMemberListReq, opts ...grpc.CallOption) (*GetGroupMemberListResp, error) {
-	out := new(GetGroupMemberListResp)
-	err := grpc.Invoke(ctx, "/group.group/getGroupMemberList", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateGroupInviteLinkReq) GetExpireSeconds() int64 {
+	if m != nil {
+		return m.ExpireSeconds
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) GetGroupMembersInfo(ctx context.Context, in *GetGroupMembersInfoReq, opts ...grpc.CallOption) (*GetGroupMembersInfoResp, error) {
-	out := new(GetGroupMembersInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/getGroupMembersInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateGroupInviteLinkReq) GetMaxUses() int32 {
+	if m != nil {
+		return m.MaxUses
 	}
-	return out, nil
+	return 0
 }
 
-func (c *groupClient) KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*KickGroupMemberResp, error) {
-	out := new(KickGroupMemberResp)
-	err := grpc.Invoke(ctx, "/group.group/kickGroupMember", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateGroupInviteLinkReq) GetAutoApprove() bool {
+	if m != nil {
+		return m.AutoApprove
 	}
-	return out, nil
+	return false
+}
+
+func (m *CreateGroupInviteLinkReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *CreateGroupInviteLinkReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type CreateGroupInviteLinkResp struct {
+	CommonResp           *CommonResp      `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Link                 *GroupInviteLink `protobuf:"bytes,2,opt,name=link" json:"link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateGroupInviteLinkResp) Reset()         { *m = CreateGroupInviteLinkResp{} }
+func (m *CreateGroupInviteLinkResp) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupInviteLinkResp) ProtoMessage()    {}
+func (*CreateGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{67}
+}
+func (m *CreateGroupInviteLinkResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupInviteLinkResp.Unmarshal(m, b)
+}
+func (m *CreateGroupInviteLinkResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupInviteLinkResp.Marshal(b, m, deterministic)
+}
+func (dst *CreateGroupInviteLinkResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupInviteLinkResp.Merge(dst, src)
+}
+func (m *CreateGroupInviteLinkResp) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupInviteLinkResp.Size(m)
+}
+func (m *CreateGroupInviteLinkResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupInviteLinkResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupInviteLinkResp proto.InternalMessageInfo
+
+func (m *CreateGroupInviteLinkResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *CreateGroupInviteLinkResp) GetLink() *GroupInviteLink {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+type GetGroupInviteLinksReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,2,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupInviteLinksReq) Reset()         { *m = GetGroupInviteLinksReq{} }
+func (m *GetGroupInviteLinksReq) String() string { return proto.CompactTextString(m) }
+func (*GetGroupInviteLinksReq) ProtoMessage()    {}
+func (*GetGroupInviteLinksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{68}
+}
+func (m *GetGroupInviteLinksReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupInviteLinksReq.Unmarshal(m, b)
+}
+func (m *GetGroupInviteLinksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupInviteLinksReq.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupInviteLinksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupInviteLinksReq.Merge(dst, src)
+}
+func (m *GetGroupInviteLinksReq) XXX_Size() int {
+	return xxx_messageInfo_GetGroupInviteLinksReq.Size(m)
+}
+func (m *GetGroupInviteLinksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupInviteLinksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupInviteLinksReq proto.InternalMessageInfo
+
+func (m *GetGroupInviteLinksReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GetGroupInviteLinksReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GetGroupInviteLinksReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GetGroupInviteLinksResp struct {
+	CommonResp           *CommonResp        `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Links                []*GroupInviteLink `protobuf:"bytes,2,rep,name=links" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetGroupInviteLinksResp) Reset()         { *m = GetGroupInviteLinksResp{} }
+func (m *GetGroupInviteLinksResp) String() string { return proto.CompactTextString(m) }
+func (*GetGroupInviteLinksResp) ProtoMessage()    {}
+func (*GetGroupInviteLinksResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{69}
+}
+func (m *GetGroupInviteLinksResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupInviteLinksResp.Unmarshal(m, b)
+}
+func (m *GetGroupInviteLinksResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupInviteLinksResp.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupInviteLinksResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupInviteLinksResp.Merge(dst, src)
+}
+func (m *GetGroupInviteLinksResp) XXX_Size() int {
+	return xxx_messageInfo_GetGroupInviteLinksResp.Size(m)
+}
+func (m *GetGroupInviteLinksResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupInviteLinksResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupInviteLinksResp proto.InternalMessageInfo
+
+func (m *GetGroupInviteLinksResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *GetGroupInviteLinksResp) GetLinks() []*GroupInviteLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type RevokeGroupInviteLinkReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token" json:"token,omitempty"`
+	OpUserID             string   `protobuf:"bytes,3,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeGroupInviteLinkReq) Reset()         { *m = RevokeGroupInviteLinkReq{} }
+func (m *RevokeGroupInviteLinkReq) String() string { return proto.CompactTextString(m) }
+func (*RevokeGroupInviteLinkReq) ProtoMessage()    {}
+func (*RevokeGroupInviteLinkReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{70}
+}
+func (m *RevokeGroupInviteLinkReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeGroupInviteLinkReq.Unmarshal(m, b)
+}
+func (m *RevokeGroupInviteLinkReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeGroupInviteLinkReq.Marshal(b, m, deterministic)
+}
+func (dst *RevokeGroupInviteLinkReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeGroupInviteLinkReq.Merge(dst, src)
+}
+func (m *RevokeGroupInviteLinkReq) XXX_Size() int {
+	return xxx_messageInfo_RevokeGroupInviteLinkReq.Size(m)
+}
+func (m *RevokeGroupInviteLinkReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeGroupInviteLinkReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeGroupInviteLinkReq proto.InternalMessageInfo
+
+func (m *RevokeGroupInviteLinkReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *RevokeGroupInviteLinkReq) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RevokeGroupInviteLinkReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *RevokeGroupInviteLinkReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type RevokeGroupInviteLinkResp struct {
+	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RevokeGroupInviteLinkResp) Reset()         { *m = RevokeGroupInviteLinkResp{} }
+func (m *RevokeGroupInviteLinkResp) String() string { return proto.CompactTextString(m) }
+func (*RevokeGroupInviteLinkResp) ProtoMessage()    {}
+func (*RevokeGroupInviteLinkResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{71}
+}
+func (m *RevokeGroupInviteLinkResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeGroupInviteLinkResp.Unmarshal(m, b)
+}
+func (m *RevokeGroupInviteLinkResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeGroupInviteLinkResp.Marshal(b, m, deterministic)
+}
+func (dst *RevokeGroupInviteLinkResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeGroupInviteLinkResp.Merge(dst, src)
+}
+func (m *RevokeGroupInviteLinkResp) XXX_Size() int {
+	return xxx_messageInfo_RevokeGroupInviteLinkResp.Size(m)
+}
+func (m *RevokeGroupInviteLinkResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeGroupInviteLinkResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeGroupInviteLinkResp proto.InternalMessageInfo
+
+func (m *RevokeGroupInviteLinkResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+type GroupRole struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	RoleID               string   `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Capabilities         int64    `protobuf:"varint,4,opt,name=capabilities" json:"capabilities,omitempty"`
+	Builtin              bool     `protobuf:"varint,5,opt,name=builtin" json:"builtin,omitempty"`
+	UpdateTime           int64    `protobuf:"varint,6,opt,name=updateTime" json:"updateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupRole) Reset()         { *m = GroupRole{} }
+func (m *GroupRole) String() string { return proto.CompactTextString(m) }
+func (*GroupRole) ProtoMessage()    {}
+func (*GroupRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{72}
+}
+func (m *GroupRole) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupRole.Unmarshal(m, b)
+}
+func (m *GroupRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupRole.Marshal(b, m, deterministic)
+}
+func (dst *GroupRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRole.Merge(dst, src)
+}
+func (m *GroupRole) XXX_Size() int {
+	return xxx_messageInfo_GroupRole.Size(m)
+}
+func (m *GroupRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupRole proto.InternalMessageInfo
+
+func (m *GroupRole) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GroupRole) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *GroupRole) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GroupRole) GetCapabilities() int64 {
+	if m != nil {
+		return m.Capabilities
+	}
+	return 0
+}
+
+func (m *GroupRole) GetBuiltin() bool {
+	if m != nil {
+		return m.Builtin
+	}
+	return false
+}
+
+func (m *GroupRole) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+type SetGroupRoleReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	RoleID               string   `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name" json:"name,omitempty"`
+	Capabilities         int64    `protobuf:"varint,4,opt,name=capabilities" json:"capabilities,omitempty"`
+	OpUserID             string   `protobuf:"bytes,5,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,6,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetGroupRoleReq) Reset()         { *m = SetGroupRoleReq{} }
+func (m *SetGroupRoleReq) String() string { return proto.CompactTextString(m) }
+func (*SetGroupRoleReq) ProtoMessage()    {}
+func (*SetGroupRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{73}
+}
+func (m *SetGroupRoleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupRoleReq.Unmarshal(m, b)
+}
+func (m *SetGroupRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupRoleReq.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupRoleReq.Merge(dst, src)
+}
+func (m *SetGroupRoleReq) XXX_Size() int {
+	return xxx_messageInfo_SetGroupRoleReq.Size(m)
+}
+func (m *SetGroupRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupRoleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupRoleReq proto.InternalMessageInfo
+
+func (m *SetGroupRoleReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *SetGroupRoleReq) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *SetGroupRoleReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetGroupRoleReq) GetCapabilities() int64 {
+	if m != nil {
+		return m.Capabilities
+	}
+	return 0
+}
+
+func (m *SetGroupRoleReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *SetGroupRoleReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SetGroupRoleResp struct {
+	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetGroupRoleResp) Reset()         { *m = SetGroupRoleResp{} }
+func (m *SetGroupRoleResp) String() string { return proto.CompactTextString(m) }
+func (*SetGroupRoleResp) ProtoMessage()    {}
+func (*SetGroupRoleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{74}
+}
+func (m *SetGroupRoleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupRoleResp.Unmarshal(m, b)
+}
+func (m *SetGroupRoleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupRoleResp.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupRoleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupRoleResp.Merge(dst, src)
+}
+func (m *SetGroupRoleResp) XXX_Size() int {
+	return xxx_messageInfo_SetGroupRoleResp.Size(m)
+}
+func (m *SetGroupRoleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupRoleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupRoleResp proto.InternalMessageInfo
+
+func (m *SetGroupRoleResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+type DeleteGroupRoleReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	RoleID               string   `protobuf:"bytes,2,opt,name=roleID" json:"roleID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,3,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGroupRoleReq) Reset()         { *m = DeleteGroupRoleReq{} }
+func (m *DeleteGroupRoleReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteGroupRoleReq) ProtoMessage()    {}
+func (*DeleteGroupRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{75}
+}
+func (m *DeleteGroupRoleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGroupRoleReq.Unmarshal(m, b)
+}
+func (m *DeleteGroupRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGroupRoleReq.Marshal(b, m, deterministic)
+}
+func (dst *DeleteGroupRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGroupRoleReq.Merge(dst, src)
+}
+func (m *DeleteGroupRoleReq) XXX_Size() int {
+	return xxx_messageInfo_DeleteGroupRoleReq.Size(m)
+}
+func (m *DeleteGroupRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGroupRoleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGroupRoleReq proto.InternalMessageInfo
+
+func (m *DeleteGroupRoleReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *DeleteGroupRoleReq) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *DeleteGroupRoleReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *DeleteGroupRoleReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type DeleteGroupRoleResp struct {
+	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DeleteGroupRoleResp) Reset()         { *m = DeleteGroupRoleResp{} }
+func (m *DeleteGroupRoleResp) String() string { return proto.CompactTextString(m) }
+func (*DeleteGroupRoleResp) ProtoMessage()    {}
+func (*DeleteGroupRoleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{76}
+}
+func (m *DeleteGroupRoleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGroupRoleResp.Unmarshal(m, b)
+}
+func (m *DeleteGroupRoleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGroupRoleResp.Marshal(b, m, deterministic)
+}
+func (dst *DeleteGroupRoleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGroupRoleResp.Merge(dst, src)
+}
+func (m *DeleteGroupRoleResp) XXX_Size() int {
+	return xxx_messageInfo_DeleteGroupRoleResp.Size(m)
+}
+func (m *DeleteGroupRoleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGroupRoleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGroupRoleResp proto.InternalMessageInfo
+
+func (m *DeleteGroupRoleResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+type GetGroupRolesReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,2,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupRolesReq) Reset()         { *m = GetGroupRolesReq{} }
+func (m *GetGroupRolesReq) String() string { return proto.CompactTextString(m) }
+func (*GetGroupRolesReq) ProtoMessage()    {}
+func (*GetGroupRolesReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{77}
+}
+func (m *GetGroupRolesReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupRolesReq.Unmarshal(m, b)
+}
+func (m *GetGroupRolesReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupRolesReq.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupRolesReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupRolesReq.Merge(dst, src)
+}
+func (m *GetGroupRolesReq) XXX_Size() int {
+	return xxx_messageInfo_GetGroupRolesReq.Size(m)
+}
+func (m *GetGroupRolesReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupRolesReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupRolesReq proto.InternalMessageInfo
+
+func (m *GetGroupRolesReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GetGroupRolesReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GetGroupRolesReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GetGroupRolesResp struct {
+	CommonResp           *CommonResp  `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Roles                []*GroupRole `protobuf:"bytes,2,rep,name=roles" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetGroupRolesResp) Reset()         { *m = GetGroupRolesResp{} }
+func (m *GetGroupRolesResp) String() string { return proto.CompactTextString(m) }
+func (*GetGroupRolesResp) ProtoMessage()    {}
+func (*GetGroupRolesResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{78}
+}
+func (m *GetGroupRolesResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupRolesResp.Unmarshal(m, b)
+}
+func (m *GetGroupRolesResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupRolesResp.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupRolesResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupRolesResp.Merge(dst, src)
+}
+func (m *GetGroupRolesResp) XXX_Size() int {
+	return xxx_messageInfo_GetGroupRolesResp.Size(m)
+}
+func (m *GetGroupRolesResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupRolesResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupRolesResp proto.InternalMessageInfo
+
+func (m *GetGroupRolesResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *GetGroupRolesResp) GetRoles() []*GroupRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type SetGroupMemberRoleReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	UserIDList           []string `protobuf:"bytes,2,rep,name=userIDList" json:"userIDList,omitempty"`
+	RoleID               string   `protobuf:"bytes,3,opt,name=roleID" json:"roleID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,4,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,5,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetGroupMemberRoleReq) Reset()         { *m = SetGroupMemberRoleReq{} }
+func (m *SetGroupMemberRoleReq) String() string { return proto.CompactTextString(m) }
+func (*SetGroupMemberRoleReq) ProtoMessage()    {}
+func (*SetGroupMemberRoleReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{79}
+}
+func (m *SetGroupMemberRoleReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupMemberRoleReq.Unmarshal(m, b)
+}
+func (m *SetGroupMemberRoleReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupMemberRoleReq.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupMemberRoleReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupMemberRoleReq.Merge(dst, src)
+}
+func (m *SetGroupMemberRoleReq) XXX_Size() int {
+	return xxx_messageInfo_SetGroupMemberRoleReq.Size(m)
+}
+func (m *SetGroupMemberRoleReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupMemberRoleReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupMemberRoleReq proto.InternalMessageInfo
+
+func (m *SetGroupMemberRoleReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *SetGroupMemberRoleReq) GetUserIDList() []string {
+	if m != nil {
+		return m.UserIDList
+	}
+	return nil
+}
+
+func (m *SetGroupMemberRoleReq) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+func (m *SetGroupMemberRoleReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *SetGroupMemberRoleReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SetGroupMemberRoleResp struct {
+	CommonResp           *CommonResp  `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Id2ResultList        []*Id2Result `protobuf:"bytes,2,rep,name=Id2ResultList" json:"Id2ResultList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SetGroupMemberRoleResp) Reset()         { *m = SetGroupMemberRoleResp{} }
+func (m *SetGroupMemberRoleResp) String() string { return proto.CompactTextString(m) }
+func (*SetGroupMemberRoleResp) ProtoMessage()    {}
+func (*SetGroupMemberRoleResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{80}
+}
+func (m *SetGroupMemberRoleResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupMemberRoleResp.Unmarshal(m, b)
+}
+func (m *SetGroupMemberRoleResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupMemberRoleResp.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupMemberRoleResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupMemberRoleResp.Merge(dst, src)
+}
+func (m *SetGroupMemberRoleResp) XXX_Size() int {
+	return xxx_messageInfo_SetGroupMemberRoleResp.Size(m)
+}
+func (m *SetGroupMemberRoleResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupMemberRoleResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupMemberRoleResp proto.InternalMessageInfo
+
+func (m *SetGroupMemberRoleResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *SetGroupMemberRoleResp) GetId2ResultList() []*Id2Result {
+	if m != nil {
+		return m.Id2ResultList
+	}
+	return nil
+}
+
+type GroupJoinQuestion struct {
+	QuestionID           string   `protobuf:"bytes,1,opt,name=questionID" json:"questionID,omitempty"`
+	Question             string   `protobuf:"bytes,2,opt,name=question" json:"question,omitempty"`
+	Answer               string   `protobuf:"bytes,3,opt,name=answer" json:"answer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupJoinQuestion) Reset()         { *m = GroupJoinQuestion{} }
+func (m *GroupJoinQuestion) String() string { return proto.CompactTextString(m) }
+func (*GroupJoinQuestion) ProtoMessage()    {}
+func (*GroupJoinQuestion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{81}
+}
+func (m *GroupJoinQuestion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupJoinQuestion.Unmarshal(m, b)
+}
+func (m *GroupJoinQuestion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupJoinQuestion.Marshal(b, m, deterministic)
+}
+func (dst *GroupJoinQuestion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupJoinQuestion.Merge(dst, src)
+}
+func (m *GroupJoinQuestion) XXX_Size() int {
+	return xxx_messageInfo_GroupJoinQuestion.Size(m)
+}
+func (m *GroupJoinQuestion) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupJoinQuestion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupJoinQuestion proto.InternalMessageInfo
+
+func (m *GroupJoinQuestion) GetQuestionID() string {
+	if m != nil {
+		return m.QuestionID
+	}
+	return ""
+}
+
+func (m *GroupJoinQuestion) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *GroupJoinQuestion) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
+type GroupJoinRule struct {
+	RuleID               string   `protobuf:"bytes,1,opt,name=ruleID" json:"ruleID,omitempty"`
+	RuleType             int32    `protobuf:"varint,2,opt,name=ruleType" json:"ruleType,omitempty"`
+	Action               int32    `protobuf:"varint,3,opt,name=action" json:"action,omitempty"`
+	Value                string   `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupJoinRule) Reset()         { *m = GroupJoinRule{} }
+func (m *GroupJoinRule) String() string { return proto.CompactTextString(m) }
+func (*GroupJoinRule) ProtoMessage()    {}
+func (*GroupJoinRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{82}
+}
+func (m *GroupJoinRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupJoinRule.Unmarshal(m, b)
+}
+func (m *GroupJoinRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupJoinRule.Marshal(b, m, deterministic)
+}
+func (dst *GroupJoinRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupJoinRule.Merge(dst, src)
+}
+func (m *GroupJoinRule) XXX_Size() int {
+	return xxx_messageInfo_GroupJoinRule.Size(m)
+}
+func (m *GroupJoinRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupJoinRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupJoinRule proto.InternalMessageInfo
+
+func (m *GroupJoinRule) GetRuleID() string {
+	if m != nil {
+		return m.RuleID
+	}
+	return ""
+}
+
+func (m *GroupJoinRule) GetRuleType() int32 {
+	if m != nil {
+		return m.RuleType
+	}
+	return 0
+}
+
+func (m *GroupJoinRule) GetAction() int32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *GroupJoinRule) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type SetGroupJoinQuestionnaireReq struct {
+	GroupID              string               `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	Questions            []*GroupJoinQuestion `protobuf:"bytes,2,rep,name=questions" json:"questions,omitempty"`
+	Rules                []*GroupJoinRule     `protobuf:"bytes,3,rep,name=rules" json:"rules,omitempty"`
+	OpUserID             string               `protobuf:"bytes,4,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string               `protobuf:"bytes,5,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SetGroupJoinQuestionnaireReq) Reset()         { *m = SetGroupJoinQuestionnaireReq{} }
+func (m *SetGroupJoinQuestionnaireReq) String() string { return proto.CompactTextString(m) }
+func (*SetGroupJoinQuestionnaireReq) ProtoMessage()    {}
+func (*SetGroupJoinQuestionnaireReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{83}
+}
+func (m *SetGroupJoinQuestionnaireReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireReq.Unmarshal(m, b)
+}
+func (m *SetGroupJoinQuestionnaireReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireReq.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupJoinQuestionnaireReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupJoinQuestionnaireReq.Merge(dst, src)
+}
+func (m *SetGroupJoinQuestionnaireReq) XXX_Size() int {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireReq.Size(m)
+}
+func (m *SetGroupJoinQuestionnaireReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupJoinQuestionnaireReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupJoinQuestionnaireReq proto.InternalMessageInfo
+
+func (m *SetGroupJoinQuestionnaireReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *SetGroupJoinQuestionnaireReq) GetQuestions() []*GroupJoinQuestion {
+	if m != nil {
+		return m.Questions
+	}
+	return nil
+}
+
+func (m *SetGroupJoinQuestionnaireReq) GetRules() []*GroupJoinRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *SetGroupJoinQuestionnaireReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *SetGroupJoinQuestionnaireReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SetGroupJoinQuestionnaireResp struct {
+	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetGroupJoinQuestionnaireResp) Reset()         { *m = SetGroupJoinQuestionnaireResp{} }
+func (m *SetGroupJoinQuestionnaireResp) String() string { return proto.CompactTextString(m) }
+func (*SetGroupJoinQuestionnaireResp) ProtoMessage()    {}
+func (*SetGroupJoinQuestionnaireResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{84}
+}
+func (m *SetGroupJoinQuestionnaireResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireResp.Unmarshal(m, b)
+}
+func (m *SetGroupJoinQuestionnaireResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireResp.Marshal(b, m, deterministic)
+}
+func (dst *SetGroupJoinQuestionnaireResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetGroupJoinQuestionnaireResp.Merge(dst, src)
+}
+func (m *SetGroupJoinQuestionnaireResp) XXX_Size() int {
+	return xxx_messageInfo_SetGroupJoinQuestionnaireResp.Size(m)
+}
+func (m *SetGroupJoinQuestionnaireResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetGroupJoinQuestionnaireResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetGroupJoinQuestionnaireResp proto.InternalMessageInfo
+
+func (m *SetGroupJoinQuestionnaireResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+type GetGroupJoinQuestionnaireReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,2,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupJoinQuestionnaireReq) Reset()         { *m = GetGroupJoinQuestionnaireReq{} }
+func (m *GetGroupJoinQuestionnaireReq) String() string { return proto.CompactTextString(m) }
+func (*GetGroupJoinQuestionnaireReq) ProtoMessage()    {}
+func (*GetGroupJoinQuestionnaireReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{85}
+}
+func (m *GetGroupJoinQuestionnaireReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireReq.Unmarshal(m, b)
+}
+func (m *GetGroupJoinQuestionnaireReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireReq.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupJoinQuestionnaireReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupJoinQuestionnaireReq.Merge(dst, src)
+}
+func (m *GetGroupJoinQuestionnaireReq) XXX_Size() int {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireReq.Size(m)
+}
+func (m *GetGroupJoinQuestionnaireReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupJoinQuestionnaireReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupJoinQuestionnaireReq proto.InternalMessageInfo
+
+func (m *GetGroupJoinQuestionnaireReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GetGroupJoinQuestionnaireReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GetGroupJoinQuestionnaireReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GetGroupJoinQuestionnaireResp struct {
+	CommonResp           *CommonResp          `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Questions            []*GroupJoinQuestion `protobuf:"bytes,2,rep,name=questions" json:"questions,omitempty"`
+	Rules                []*GroupJoinRule     `protobuf:"bytes,3,rep,name=rules" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetGroupJoinQuestionnaireResp) Reset()         { *m = GetGroupJoinQuestionnaireResp{} }
+func (m *GetGroupJoinQuestionnaireResp) String() string { return proto.CompactTextString(m) }
+func (*GetGroupJoinQuestionnaireResp) ProtoMessage()    {}
+func (*GetGroupJoinQuestionnaireResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{86}
+}
+func (m *GetGroupJoinQuestionnaireResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireResp.Unmarshal(m, b)
+}
+func (m *GetGroupJoinQuestionnaireResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireResp.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupJoinQuestionnaireResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupJoinQuestionnaireResp.Merge(dst, src)
+}
+func (m *GetGroupJoinQuestionnaireResp) XXX_Size() int {
+	return xxx_messageInfo_GetGroupJoinQuestionnaireResp.Size(m)
+}
+func (m *GetGroupJoinQuestionnaireResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupJoinQuestionnaireResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupJoinQuestionnaireResp proto.InternalMessageInfo
+
+func (m *GetGroupJoinQuestionnaireResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *GetGroupJoinQuestionnaireResp) GetQuestions() []*GroupJoinQuestion {
+	if m != nil {
+		return m.Questions
+	}
+	return nil
+}
+
+func (m *GetGroupJoinQuestionnaireResp) GetRules() []*GroupJoinRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type QueryGroupMembersReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	Keyword              string   `protobuf:"bytes,2,opt,name=keyword" json:"keyword,omitempty"`
+	Roles                []string `protobuf:"bytes,3,rep,name=roles" json:"roles,omitempty"`
+	MuteStatus           int32    `protobuf:"varint,4,opt,name=muteStatus" json:"muteStatus,omitempty"`
+	JoinTimeBegin        int64    `protobuf:"varint,5,opt,name=joinTimeBegin" json:"joinTimeBegin,omitempty"`
+	JoinTimeEnd          int64    `protobuf:"varint,6,opt,name=joinTimeEnd" json:"joinTimeEnd,omitempty"`
+	Cursor               string   `protobuf:"bytes,7,opt,name=cursor" json:"cursor,omitempty"`
+	Count                int32    `protobuf:"varint,8,opt,name=count" json:"count,omitempty"`
+	OpUserID             string   `protobuf:"bytes,9,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,10,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryGroupMembersReq) Reset()         { *m = QueryGroupMembersReq{} }
+func (m *QueryGroupMembersReq) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMembersReq) ProtoMessage()    {}
+func (*QueryGroupMembersReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{87}
+}
+func (m *QueryGroupMembersReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGroupMembersReq.Unmarshal(m, b)
+}
+func (m *QueryGroupMembersReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGroupMembersReq.Marshal(b, m, deterministic)
+}
+func (dst *QueryGroupMembersReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMembersReq.Merge(dst, src)
+}
+func (m *QueryGroupMembersReq) XXX_Size() int {
+	return xxx_messageInfo_QueryGroupMembersReq.Size(m)
+}
+func (m *QueryGroupMembersReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMembersReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupMembersReq proto.InternalMessageInfo
+
+func (m *QueryGroupMembersReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *QueryGroupMembersReq) GetKeyword() string {
+	if m != nil {
+		return m.Keyword
+	}
+	return ""
+}
+
+func (m *QueryGroupMembersReq) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *QueryGroupMembersReq) GetMuteStatus() int32 {
+	if m != nil {
+		return m.MuteStatus
+	}
+	return 0
+}
+
+func (m *QueryGroupMembersReq) GetJoinTimeBegin() int64 {
+	if m != nil {
+		return m.JoinTimeBegin
+	}
+	return 0
+}
+
+func (m *QueryGroupMembersReq) GetJoinTimeEnd() int64 {
+	if m != nil {
+		return m.JoinTimeEnd
+	}
+	return 0
+}
+
+func (m *QueryGroupMembersReq) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *QueryGroupMembersReq) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryGroupMembersReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *QueryGroupMembersReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GroupRoleMemberCount struct {
+	Role                 string   `protobuf:"bytes,1,opt,name=role" json:"role,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupRoleMemberCount) Reset()         { *m = GroupRoleMemberCount{} }
+func (m *GroupRoleMemberCount) String() string { return proto.CompactTextString(m) }
+func (*GroupRoleMemberCount) ProtoMessage()    {}
+func (*GroupRoleMemberCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{88}
+}
+func (m *GroupRoleMemberCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupRoleMemberCount.Unmarshal(m, b)
+}
+func (m *GroupRoleMemberCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupRoleMemberCount.Marshal(b, m, deterministic)
+}
+func (dst *GroupRoleMemberCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupRoleMemberCount.Merge(dst, src)
+}
+func (m *GroupRoleMemberCount) XXX_Size() int {
+	return xxx_messageInfo_GroupRoleMemberCount.Size(m)
+}
+func (m *GroupRoleMemberCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupRoleMemberCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupRoleMemberCount proto.InternalMessageInfo
+
+func (m *GroupRoleMemberCount) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *GroupRoleMemberCount) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QueryGroupMembersResp struct {
+	CommonResp           *CommonResp                   `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Members              []*sdk_ws.GroupMemberFullInfo `protobuf:"bytes,2,rep,name=members" json:"members,omitempty"`
+	NextCursor           string                        `protobuf:"bytes,3,opt,name=nextCursor" json:"nextCursor,omitempty"`
+	RoleCounts           []*GroupRoleMemberCount       `protobuf:"bytes,4,rep,name=roleCounts" json:"roleCounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *QueryGroupMembersResp) Reset()         { *m = QueryGroupMembersResp{} }
+func (m *QueryGroupMembersResp) String() string { return proto.CompactTextString(m) }
+func (*QueryGroupMembersResp) ProtoMessage()    {}
+func (*QueryGroupMembersResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{89}
+}
+func (m *QueryGroupMembersResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryGroupMembersResp.Unmarshal(m, b)
+}
+func (m *QueryGroupMembersResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryGroupMembersResp.Marshal(b, m, deterministic)
+}
+func (dst *QueryGroupMembersResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGroupMembersResp.Merge(dst, src)
+}
+func (m *QueryGroupMembersResp) XXX_Size() int {
+	return xxx_messageInfo_QueryGroupMembersResp.Size(m)
+}
+func (m *QueryGroupMembersResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGroupMembersResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGroupMembersResp proto.InternalMessageInfo
+
+func (m *QueryGroupMembersResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *QueryGroupMembersResp) GetMembers() []*sdk_ws.GroupMemberFullInfo {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryGroupMembersResp) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *QueryGroupMembersResp) GetRoleCounts() []*GroupRoleMemberCount {
+	if m != nil {
+		return m.RoleCounts
+	}
+	return nil
+}
+
+type GroupConversion struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	FromGroupType        int32    `protobuf:"varint,2,opt,name=fromGroupType" json:"fromGroupType,omitempty"`
+	ToGroupType          int32    `protobuf:"varint,3,opt,name=toGroupType" json:"toGroupType,omitempty"`
+	Status               int32    `protobuf:"varint,4,opt,name=status" json:"status,omitempty"`
+	Step                 int32    `protobuf:"varint,5,opt,name=step" json:"step,omitempty"`
+	HistoryCount         int32    `protobuf:"varint,6,opt,name=historyCount" json:"historyCount,omitempty"`
+	CopiedCount          int32    `protobuf:"varint,7,opt,name=copiedCount" json:"copiedCount,omitempty"`
+	OpUserID             string   `protobuf:"bytes,8,opt,name=opUserID" json:"opUserID,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,9,opt,name=errMsg" json:"errMsg,omitempty"`
+	CreateTime           int64    `protobuf:"varint,10,opt,name=createTime" json:"createTime,omitempty"`
+	UpdateTime           int64    `protobuf:"varint,11,opt,name=updateTime" json:"updateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupConversion) Reset()         { *m = GroupConversion{} }
+func (m *GroupConversion) String() string { return proto.CompactTextString(m) }
+func (*GroupConversion) ProtoMessage()    {}
+func (*GroupConversion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{90}
+}
+func (m *GroupConversion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupConversion.Unmarshal(m, b)
+}
+func (m *GroupConversion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupConversion.Marshal(b, m, deterministic)
+}
+func (dst *GroupConversion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupConversion.Merge(dst, src)
+}
+func (m *GroupConversion) XXX_Size() int {
+	return xxx_messageInfo_GroupConversion.Size(m)
+}
+func (m *GroupConversion) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupConversion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupConversion proto.InternalMessageInfo
+
+func (m *GroupConversion) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GroupConversion) GetFromGroupType() int32 {
+	if m != nil {
+		return m.FromGroupType
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetToGroupType() int32 {
+	if m != nil {
+		return m.ToGroupType
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetHistoryCount() int32 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetCopiedCount() int32 {
+	if m != nil {
+		return m.CopiedCount
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GroupConversion) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *GroupConversion) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *GroupConversion) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+type ConvertGroupTypeReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	GroupType            int32    `protobuf:"varint,2,opt,name=groupType" json:"groupType,omitempty"`
+	HistoryCount         int32    `protobuf:"varint,3,opt,name=historyCount" json:"historyCount,omitempty"`
+	OpUserID             string   `protobuf:"bytes,4,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,5,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConvertGroupTypeReq) Reset()         { *m = ConvertGroupTypeReq{} }
+func (m *ConvertGroupTypeReq) String() string { return proto.CompactTextString(m) }
+func (*ConvertGroupTypeReq) ProtoMessage()    {}
+func (*ConvertGroupTypeReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{91}
+}
+func (m *ConvertGroupTypeReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConvertGroupTypeReq.Unmarshal(m, b)
+}
+func (m *ConvertGroupTypeReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConvertGroupTypeReq.Marshal(b, m, deterministic)
+}
+func (dst *ConvertGroupTypeReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertGroupTypeReq.Merge(dst, src)
+}
+func (m *ConvertGroupTypeReq) XXX_Size() int {
+	return xxx_messageInfo_ConvertGroupTypeReq.Size(m)
+}
+func (m *ConvertGroupTypeReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertGroupTypeReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertGroupTypeReq proto.InternalMessageInfo
+
+func (m *ConvertGroupTypeReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *ConvertGroupTypeReq) GetGroupType() int32 {
+	if m != nil {
+		return m.GroupType
+	}
+	return 0
+}
+
+func (m *ConvertGroupTypeReq) GetHistoryCount() int32 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *ConvertGroupTypeReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *ConvertGroupTypeReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type ConvertGroupTypeResp struct {
+	CommonResp           *CommonResp      `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Conversion           *GroupConversion `protobuf:"bytes,2,opt,name=conversion" json:"conversion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ConvertGroupTypeResp) Reset()         { *m = ConvertGroupTypeResp{} }
+func (m *ConvertGroupTypeResp) String() string { return proto.CompactTextString(m) }
+func (*ConvertGroupTypeResp) ProtoMessage()    {}
+func (*ConvertGroupTypeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{92}
+}
+func (m *ConvertGroupTypeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConvertGroupTypeResp.Unmarshal(m, b)
+}
+func (m *ConvertGroupTypeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConvertGroupTypeResp.Marshal(b, m, deterministic)
+}
+func (dst *ConvertGroupTypeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvertGroupTypeResp.Merge(dst, src)
+}
+func (m *ConvertGroupTypeResp) XXX_Size() int {
+	return xxx_messageInfo_ConvertGroupTypeResp.Size(m)
+}
+func (m *ConvertGroupTypeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvertGroupTypeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvertGroupTypeResp proto.InternalMessageInfo
+
+func (m *ConvertGroupTypeResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *ConvertGroupTypeResp) GetConversion() *GroupConversion {
+	if m != nil {
+		return m.Conversion
+	}
+	return nil
+}
+
+type GetGroupConversionReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,2,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,3,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupConversionReq) Reset()         { *m = GetGroupConversionReq{} }
+func (m *GetGroupConversionReq) String() string { return proto.CompactTextString(m) }
+func (*GetGroupConversionReq) ProtoMessage()    {}
+func (*GetGroupConversionReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{93}
+}
+func (m *GetGroupConversionReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupConversionReq.Unmarshal(m, b)
+}
+func (m *GetGroupConversionReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupConversionReq.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupConversionReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupConversionReq.Merge(dst, src)
+}
+func (m *GetGroupConversionReq) XXX_Size() int {
+	return xxx_messageInfo_GetGroupConversionReq.Size(m)
+}
+func (m *GetGroupConversionReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupConversionReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupConversionReq proto.InternalMessageInfo
+
+func (m *GetGroupConversionReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *GetGroupConversionReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GetGroupConversionReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GetGroupConversionResp struct {
+	CommonResp           *CommonResp      `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Conversion           *GroupConversion `protobuf:"bytes,2,opt,name=conversion" json:"conversion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetGroupConversionResp) Reset()         { *m = GetGroupConversionResp{} }
+func (m *GetGroupConversionResp) String() string { return proto.CompactTextString(m) }
+func (*GetGroupConversionResp) ProtoMessage()    {}
+func (*GetGroupConversionResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{94}
+}
+func (m *GetGroupConversionResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupConversionResp.Unmarshal(m, b)
+}
+func (m *GetGroupConversionResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupConversionResp.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupConversionResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupConversionResp.Merge(dst, src)
+}
+func (m *GetGroupConversionResp) XXX_Size() int {
+	return xxx_messageInfo_GetGroupConversionResp.Size(m)
+}
+func (m *GetGroupConversionResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupConversionResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupConversionResp proto.InternalMessageInfo
+
+func (m *GetGroupConversionResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *GetGroupConversionResp) GetConversion() *GroupConversion {
+	if m != nil {
+		return m.Conversion
+	}
+	return nil
+}
+
+type SucceedGroupOwnerReq struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	OldOwnerUserID       string   `protobuf:"bytes,2,opt,name=oldOwnerUserID" json:"oldOwnerUserID,omitempty"`
+	OpUserID             string   `protobuf:"bytes,3,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SucceedGroupOwnerReq) Reset()         { *m = SucceedGroupOwnerReq{} }
+func (m *SucceedGroupOwnerReq) String() string { return proto.CompactTextString(m) }
+func (*SucceedGroupOwnerReq) ProtoMessage()    {}
+func (*SucceedGroupOwnerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{95}
+}
+func (m *SucceedGroupOwnerReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SucceedGroupOwnerReq.Unmarshal(m, b)
+}
+func (m *SucceedGroupOwnerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SucceedGroupOwnerReq.Marshal(b, m, deterministic)
+}
+func (dst *SucceedGroupOwnerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SucceedGroupOwnerReq.Merge(dst, src)
+}
+func (m *SucceedGroupOwnerReq) XXX_Size() int {
+	return xxx_messageInfo_SucceedGroupOwnerReq.Size(m)
+}
+func (m *SucceedGroupOwnerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SucceedGroupOwnerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SucceedGroupOwnerReq proto.InternalMessageInfo
+
+func (m *SucceedGroupOwnerReq) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *SucceedGroupOwnerReq) GetOldOwnerUserID() string {
+	if m != nil {
+		return m.OldOwnerUserID
+	}
+	return ""
+}
+
+func (m *SucceedGroupOwnerReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *SucceedGroupOwnerReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type SucceedGroupOwnerResp struct {
+	CommonResp           *CommonResp `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	NewOwnerUserID       string      `protobuf:"bytes,2,opt,name=newOwnerUserID" json:"newOwnerUserID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SucceedGroupOwnerResp) Reset()         { *m = SucceedGroupOwnerResp{} }
+func (m *SucceedGroupOwnerResp) String() string { return proto.CompactTextString(m) }
+func (*SucceedGroupOwnerResp) ProtoMessage()    {}
+func (*SucceedGroupOwnerResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{96}
+}
+func (m *SucceedGroupOwnerResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SucceedGroupOwnerResp.Unmarshal(m, b)
+}
+func (m *SucceedGroupOwnerResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SucceedGroupOwnerResp.Marshal(b, m, deterministic)
+}
+func (dst *SucceedGroupOwnerResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SucceedGroupOwnerResp.Merge(dst, src)
+}
+func (m *SucceedGroupOwnerResp) XXX_Size() int {
+	return xxx_messageInfo_SucceedGroupOwnerResp.Size(m)
+}
+func (m *SucceedGroupOwnerResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SucceedGroupOwnerResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SucceedGroupOwnerResp proto.InternalMessageInfo
+
+func (m *SucceedGroupOwnerResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *SucceedGroupOwnerResp) GetNewOwnerUserID() string {
+	if m != nil {
+		return m.NewOwnerUserID
+	}
+	return ""
+}
+
+type ImportGroupMember struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
+	RoleLevel            int32    `protobuf:"varint,2,opt,name=roleLevel" json:"roleLevel,omitempty"`
+	RoleID               string   `protobuf:"bytes,3,opt,name=roleID" json:"roleID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportGroupMember) Reset()         { *m = ImportGroupMember{} }
+func (m *ImportGroupMember) String() string { return proto.CompactTextString(m) }
+func (*ImportGroupMember) ProtoMessage()    {}
+func (*ImportGroupMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{97}
+}
+func (m *ImportGroupMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGroupMember.Unmarshal(m, b)
+}
+func (m *ImportGroupMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGroupMember.Marshal(b, m, deterministic)
+}
+func (dst *ImportGroupMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGroupMember.Merge(dst, src)
+}
+func (m *ImportGroupMember) XXX_Size() int {
+	return xxx_messageInfo_ImportGroupMember.Size(m)
+}
+func (m *ImportGroupMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGroupMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGroupMember proto.InternalMessageInfo
+
+func (m *ImportGroupMember) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *ImportGroupMember) GetRoleLevel() int32 {
+	if m != nil {
+		return m.RoleLevel
+	}
+	return 0
+}
+
+func (m *ImportGroupMember) GetRoleID() string {
+	if m != nil {
+		return m.RoleID
+	}
+	return ""
+}
+
+type ImportGroup struct {
+	GroupInfo            *sdk_ws.GroupInfo    `protobuf:"bytes,1,opt,name=groupInfo" json:"groupInfo,omitempty"`
+	OwnerUserID          string               `protobuf:"bytes,2,opt,name=ownerUserID" json:"ownerUserID,omitempty"`
+	Members              []*ImportGroupMember `protobuf:"bytes,3,rep,name=members" json:"members,omitempty"`
+	Roles                []*GroupRole         `protobuf:"bytes,4,rep,name=roles" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportGroup) Reset()         { *m = ImportGroup{} }
+func (m *ImportGroup) String() string { return proto.CompactTextString(m) }
+func (*ImportGroup) ProtoMessage()    {}
+func (*ImportGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{98}
+}
+func (m *ImportGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGroup.Unmarshal(m, b)
+}
+func (m *ImportGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGroup.Marshal(b, m, deterministic)
+}
+func (dst *ImportGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGroup.Merge(dst, src)
+}
+func (m *ImportGroup) XXX_Size() int {
+	return xxx_messageInfo_ImportGroup.Size(m)
+}
+func (m *ImportGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGroup proto.InternalMessageInfo
+
+func (m *ImportGroup) GetGroupInfo() *sdk_ws.GroupInfo {
+	if m != nil {
+		return m.GroupInfo
+	}
+	return nil
+}
+
+func (m *ImportGroup) GetOwnerUserID() string {
+	if m != nil {
+		return m.OwnerUserID
+	}
+	return ""
+}
+
+func (m *ImportGroup) GetMembers() []*ImportGroupMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *ImportGroup) GetRoles() []*GroupRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+type ImportGroupResult struct {
+	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
+	ErrCode              int32    `protobuf:"varint,2,opt,name=errCode" json:"errCode,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,3,opt,name=errMsg" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportGroupResult) Reset()         { *m = ImportGroupResult{} }
+func (m *ImportGroupResult) String() string { return proto.CompactTextString(m) }
+func (*ImportGroupResult) ProtoMessage()    {}
+func (*ImportGroupResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{99}
+}
+func (m *ImportGroupResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGroupResult.Unmarshal(m, b)
+}
+func (m *ImportGroupResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGroupResult.Marshal(b, m, deterministic)
+}
+func (dst *ImportGroupResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGroupResult.Merge(dst, src)
+}
+func (m *ImportGroupResult) XXX_Size() int {
+	return xxx_messageInfo_ImportGroupResult.Size(m)
+}
+func (m *ImportGroupResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGroupResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGroupResult proto.InternalMessageInfo
+
+func (m *ImportGroupResult) GetGroupID() string {
+	if m != nil {
+		return m.GroupID
+	}
+	return ""
+}
+
+func (m *ImportGroupResult) GetErrCode() int32 {
+	if m != nil {
+		return m.ErrCode
+	}
+	return 0
+}
+
+func (m *ImportGroupResult) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+type GroupImportJob struct {
+	JobID                string               `protobuf:"bytes,1,opt,name=jobID" json:"jobID,omitempty"`
+	IdempotencyKey       string               `protobuf:"bytes,2,opt,name=idempotencyKey" json:"idempotencyKey,omitempty"`
+	Status               int32                `protobuf:"varint,3,opt,name=status" json:"status,omitempty"`
+	Silent               bool                 `protobuf:"varint,4,opt,name=silent" json:"silent,omitempty"`
+	Total                int32                `protobuf:"varint,5,opt,name=total" json:"total,omitempty"`
+	Succeeded            int32                `protobuf:"varint,6,opt,name=succeeded" json:"succeeded,omitempty"`
+	Failed               int32                `protobuf:"varint,7,opt,name=failed" json:"failed,omitempty"`
+	Results              []*ImportGroupResult `protobuf:"bytes,8,rep,name=results" json:"results,omitempty"`
+	OpUserID             string               `protobuf:"bytes,9,opt,name=opUserID" json:"opUserID,omitempty"`
+	CreateTime           int64                `protobuf:"varint,10,opt,name=createTime" json:"createTime,omitempty"`
+	UpdateTime           int64                `protobuf:"varint,11,opt,name=updateTime" json:"updateTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GroupImportJob) Reset()         { *m = GroupImportJob{} }
+func (m *GroupImportJob) String() string { return proto.CompactTextString(m) }
+func (*GroupImportJob) ProtoMessage()    {}
+func (*GroupImportJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{100}
+}
+func (m *GroupImportJob) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupImportJob.Unmarshal(m, b)
+}
+func (m *GroupImportJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupImportJob.Marshal(b, m, deterministic)
+}
+func (dst *GroupImportJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupImportJob.Merge(dst, src)
+}
+func (m *GroupImportJob) XXX_Size() int {
+	return xxx_messageInfo_GroupImportJob.Size(m)
+}
+func (m *GroupImportJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupImportJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupImportJob proto.InternalMessageInfo
+
+func (m *GroupImportJob) GetJobID() string {
+	if m != nil {
+		return m.JobID
+	}
+	return ""
+}
+
+func (m *GroupImportJob) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *GroupImportJob) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GroupImportJob) GetSilent() bool {
+	if m != nil {
+		return m.Silent
+	}
+	return false
+}
+
+func (m *GroupImportJob) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GroupImportJob) GetSucceeded() int32 {
+	if m != nil {
+		return m.Succeeded
+	}
+	return 0
+}
+
+func (m *GroupImportJob) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *GroupImportJob) GetResults() []*ImportGroupResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *GroupImportJob) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GroupImportJob) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *GroupImportJob) GetUpdateTime() int64 {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return 0
+}
+
+type ImportGroupsReq struct {
+	Groups               []*ImportGroup `protobuf:"bytes,1,rep,name=groups" json:"groups,omitempty"`
+	Silent               bool           `protobuf:"varint,2,opt,name=silent" json:"silent,omitempty"`
+	IdempotencyKey       string         `protobuf:"bytes,3,opt,name=idempotencyKey" json:"idempotencyKey,omitempty"`
+	OpUserID             string         `protobuf:"bytes,4,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string         `protobuf:"bytes,5,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ImportGroupsReq) Reset()         { *m = ImportGroupsReq{} }
+func (m *ImportGroupsReq) String() string { return proto.CompactTextString(m) }
+func (*ImportGroupsReq) ProtoMessage()    {}
+func (*ImportGroupsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{101}
+}
+func (m *ImportGroupsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGroupsReq.Unmarshal(m, b)
+}
+func (m *ImportGroupsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGroupsReq.Marshal(b, m, deterministic)
+}
+func (dst *ImportGroupsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGroupsReq.Merge(dst, src)
+}
+func (m *ImportGroupsReq) XXX_Size() int {
+	return xxx_messageInfo_ImportGroupsReq.Size(m)
+}
+func (m *ImportGroupsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGroupsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGroupsReq proto.InternalMessageInfo
+
+func (m *ImportGroupsReq) GetGroups() []*ImportGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *ImportGroupsReq) GetSilent() bool {
+	if m != nil {
+		return m.Silent
+	}
+	return false
+}
+
+func (m *ImportGroupsReq) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *ImportGroupsReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *ImportGroupsReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type ImportGroupsResp struct {
+	CommonResp           *CommonResp     `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Job                  *GroupImportJob `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ImportGroupsResp) Reset()         { *m = ImportGroupsResp{} }
+func (m *ImportGroupsResp) String() string { return proto.CompactTextString(m) }
+func (*ImportGroupsResp) ProtoMessage()    {}
+func (*ImportGroupsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{102}
+}
+func (m *ImportGroupsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportGroupsResp.Unmarshal(m, b)
+}
+func (m *ImportGroupsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportGroupsResp.Marshal(b, m, deterministic)
+}
+func (dst *ImportGroupsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportGroupsResp.Merge(dst, src)
+}
+func (m *ImportGroupsResp) XXX_Size() int {
+	return xxx_messageInfo_ImportGroupsResp.Size(m)
+}
+func (m *ImportGroupsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportGroupsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportGroupsResp proto.InternalMessageInfo
+
+func (m *ImportGroupsResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *ImportGroupsResp) GetJob() *GroupImportJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+type GetGroupImportJobReq struct {
+	JobID                string   `protobuf:"bytes,1,opt,name=jobID" json:"jobID,omitempty"`
+	IdempotencyKey       string   `protobuf:"bytes,2,opt,name=idempotencyKey" json:"idempotencyKey,omitempty"`
+	OpUserID             string   `protobuf:"bytes,3,opt,name=opUserID" json:"opUserID,omitempty"`
+	OperationID          string   `protobuf:"bytes,4,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetGroupImportJobReq) Reset()         { *m = GetGroupImportJobReq{} }
+func (m *GetGroupImportJobReq) String() string { return proto.CompactTextString(m) }
+func (*GetGroupImportJobReq) ProtoMessage()    {}
+func (*GetGroupImportJobReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{103}
+}
+func (m *GetGroupImportJobReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupImportJobReq.Unmarshal(m, b)
+}
+func (m *GetGroupImportJobReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupImportJobReq.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupImportJobReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupImportJobReq.Merge(dst, src)
+}
+func (m *GetGroupImportJobReq) XXX_Size() int {
+	return xxx_messageInfo_GetGroupImportJobReq.Size(m)
+}
+func (m *GetGroupImportJobReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupImportJobReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupImportJobReq proto.InternalMessageInfo
+
+func (m *GetGroupImportJobReq) GetJobID() string {
+	if m != nil {
+		return m.JobID
+	}
+	return ""
+}
+
+func (m *GetGroupImportJobReq) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+func (m *GetGroupImportJobReq) GetOpUserID() string {
+	if m != nil {
+		return m.OpUserID
+	}
+	return ""
+}
+
+func (m *GetGroupImportJobReq) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
+type GetGroupImportJobResp struct {
+	CommonResp           *CommonResp     `protobuf:"bytes,1,opt,name=CommonResp" json:"CommonResp,omitempty"`
+	Job                  *GroupImportJob `protobuf:"bytes,2,opt,name=job" json:"job,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetGroupImportJobResp) Reset()         { *m = GetGroupImportJobResp{} }
+func (m *GetGroupImportJobResp) String() string { return proto.CompactTextString(m) }
+func (*GetGroupImportJobResp) ProtoMessage()    {}
+func (*GetGroupImportJobResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_group_82343494531cedb3, []int{104}
+}
+func (m *GetGroupImportJobResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGroupImportJobResp.Unmarshal(m, b)
+}
+func (m *GetGroupImportJobResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGroupImportJobResp.Marshal(b, m, deterministic)
+}
+func (dst *GetGroupImportJobResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGroupImportJobResp.Merge(dst, src)
+}
+func (m *GetGroupImportJobResp) XXX_Size() int {
+	return xxx_messageInfo_GetGroupImportJobResp.Size(m)
+}
+func (m *GetGroupImportJobResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGroupImportJobResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGroupImportJobResp proto.InternalMessageInfo
+
+func (m *GetGroupImportJobResp) GetCommonResp() *CommonResp {
+	if m != nil {
+		return m.CommonResp
+	}
+	return nil
+}
+
+func (m *GetGroupImportJobResp) GetJob() *GroupImportJob {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CommonResp)(nil), "group.CommonResp")
+	proto.RegisterType((*GroupAddMemberInfo)(nil), "group.GroupAddMemberInfo")
+	proto.RegisterType((*CreateGroupReq)(nil), "group.CreateGroupReq")
+	proto.RegisterType((*CreateGroupResp)(nil), "group.CreateGroupResp")
+	proto.RegisterType((*GetGroupsInfoReq)(nil), "group.GetGroupsInfoReq")
+	proto.RegisterType((*GetGroupsInfoResp)(nil), "group.GetGroupsInfoResp")
+	proto.RegisterType((*SetGroupInfoReq)(nil), "group.SetGroupInfoReq")
+	proto.RegisterType((*SetGroupInfoResp)(nil), "group.SetGroupInfoResp")
+	proto.RegisterType((*GetGroupApplicationListReq)(nil), "group.GetGroupApplicationListReq")
+	proto.RegisterType((*GetGroupApplicationListResp)(nil), "group.GetGroupApplicationListResp")
+	proto.RegisterType((*GetUserReqApplicationListReq)(nil), "group.GetUserReqApplicationListReq")
+	proto.RegisterType((*GetUserReqApplicationListResp)(nil), "group.GetUserReqApplicationListResp")
+	proto.RegisterType((*TransferGroupOwnerReq)(nil), "group.TransferGroupOwnerReq")
+	proto.RegisterType((*TransferGroupOwnerResp)(nil), "group.TransferGroupOwnerResp")
+	proto.RegisterType((*JoinGroupReq)(nil), "group.JoinGroupReq")
+	proto.RegisterType((*JoinGroupResp)(nil), "group.JoinGroupResp")
+	proto.RegisterType((*GroupApplicationResponseReq)(nil), "group.GroupApplicationResponseReq")
+	proto.RegisterType((*GroupApplicationResponseResp)(nil), "group.GroupApplicationResponseResp")
+	proto.RegisterType((*QuitGroupReq)(nil), "group.QuitGroupReq")
+	proto.RegisterType((*QuitGroupResp)(nil), "group.QuitGroupResp")
+	proto.RegisterType((*GetGroupMemberListReq)(nil), "group.GetGroupMemberListReq")
+	proto.RegisterType((*GetGroupMemberListResp)(nil), "group.GetGroupMemberListResp")
+	proto.RegisterType((*GetGroupMembersInfoReq)(nil), "group.GetGroupMembersInfoReq")
+	proto.RegisterType((*GetGroupMembersInfoResp)(nil), "group.GetGroupMembersInfoResp")
+	proto.RegisterType((*KickGroupMemberReq)(nil), "group.KickGroupMemberReq")
+	proto.RegisterType((*Id2Result)(nil), "group.Id2Result")
+	proto.RegisterType((*KickGroupMemberResp)(nil), "group.KickGroupMemberResp")
+	proto.RegisterType((*GetJoinedGroupListReq)(nil), "group.GetJoinedGroupListReq")
+	proto.RegisterType((*GetJoinedGroupListResp)(nil), "group.GetJoinedGroupListResp")
+	proto.RegisterType((*InviteUserToGroupReq)(nil), "group.InviteUserToGroupReq")
+	proto.RegisterType((*InviteUserToGroupResp)(nil), "group.InviteUserToGroupResp")
+	proto.RegisterType((*InviteUserToGroupsReq)(nil), "group.InviteUserToGroupsReq")
+	proto.RegisterType((*InviteUserToGroupsResp)(nil), "group.InviteUserToGroupsResp")
+	proto.RegisterType((*GetGroupAllMemberReq)(nil), "group.GetGroupAllMemberReq")
+	proto.RegisterType((*GetGroupAllMemberResp)(nil), "group.GetGroupAllMemberResp")
+	proto.RegisterType((*CMSGroup)(nil), "group.CMSGroup")
+	proto.RegisterType((*GetGroupsReq)(nil), "group.GetGroupsReq")
+	proto.RegisterType((*GetGroupsResp)(nil), "group.GetGroupsResp")
+	proto.RegisterType((*GetGroupMemberReq)(nil), "group.GetGroupMemberReq")
+	proto.RegisterType((*GetGroupMembersCMSReq)(nil), "group.GetGroupMembersCMSReq")
+	proto.RegisterType((*GetGroupMembersCMSResp)(nil), "group.GetGroupMembersCMSResp")
+	proto.RegisterType((*DismissGroupReq)(nil), "group.DismissGroupReq")
+	proto.RegisterType((*DismissGroupResp)(nil), "group.DismissGroupResp")
+	proto.RegisterType((*MuteGroupMemberReq)(nil), "group.MuteGroupMemberReq")
+	proto.RegisterType((*MuteGroupMemberResp)(nil), "group.MuteGroupMemberResp")
+	proto.RegisterType((*CancelMuteGroupMemberReq)(nil), "group.CancelMuteGroupMemberReq")
+	proto.RegisterType((*CancelMuteGroupMemberResp)(nil), "group.CancelMuteGroupMemberResp")
+	proto.RegisterType((*MuteGroupReq)(nil), "group.MuteGroupReq")
+	proto.RegisterType((*MuteGroupResp)(nil), "group.MuteGroupResp")
+	proto.RegisterType((*CancelMuteGroupReq)(nil), "group.CancelMuteGroupReq")
+	proto.RegisterType((*CancelMuteGroupResp)(nil), "group.CancelMuteGroupResp")
+	proto.RegisterType((*SetGroupMemberNicknameReq)(nil), "group.SetGroupMemberNicknameReq")
+	proto.RegisterType((*SetGroupMemberNicknameResp)(nil), "group.SetGroupMemberNicknameResp")
+	proto.RegisterType((*GetJoinedSuperGroupListReq)(nil), "group.GetJoinedSuperGroupListReq")
+	proto.RegisterType((*GetJoinedSuperGroupListResp)(nil), "group.GetJoinedSuperGroupListResp")
+	proto.RegisterType((*GetSuperGroupsInfoReq)(nil), "group.GetSuperGroupsInfoReq")
+	proto.RegisterType((*GetSuperGroupsInfoResp)(nil), "group.GetSuperGroupsInfoResp")
+	proto.RegisterType((*SetGroupMemberInfoReq)(nil), "group.SetGroupMemberInfoReq")
+	proto.RegisterType((*SetGroupMemberInfoResp)(nil), "group.SetGroupMemberInfoResp")
+	proto.RegisterType((*GetGroupAbstractInfoReq)(nil), "group.GetGroupAbstractInfoReq")
+	proto.RegisterType((*GetGroupAbstractInfoResp)(nil), "group.GetGroupAbstractInfoResp")
+	proto.RegisterType((*GroupIsExistReq)(nil), "group.GroupIsExistReq")
+	proto.RegisterType((*GroupIsExistResp)(nil), "group.GroupIsExistResp")
+	proto.RegisterMapType((map[string]bool)(nil), "group.GroupIsExistResp.IsExistMapEntry")
+	proto.RegisterType((*UserIsInGroupReq)(nil), "group.UserIsInGroupReq")
+	proto.RegisterType((*UserIsInGroupResp)(nil), "group.UserIsInGroupResp")
+	proto.RegisterMapType((map[string]bool)(nil), "group.UserIsInGroupResp.IsExistMapEntry")
+	proto.RegisterType((*GroupInviteLink)(nil), "group.GroupInviteLink")
+	proto.RegisterType((*CreateGroupInviteLinkReq)(nil), "group.CreateGroupInviteLinkReq")
+	proto.RegisterType((*CreateGroupInviteLinkResp)(nil), "group.CreateGroupInviteLinkResp")
+	proto.RegisterType((*GetGroupInviteLinksReq)(nil), "group.GetGroupInviteLinksReq")
+	proto.RegisterType((*GetGroupInviteLinksResp)(nil), "group.GetGroupInviteLinksResp")
+	proto.RegisterType((*RevokeGroupInviteLinkReq)(nil), "group.RevokeGroupInviteLinkReq")
+	proto.RegisterType((*RevokeGroupInviteLinkResp)(nil), "group.RevokeGroupInviteLinkResp")
+	proto.RegisterType((*GroupRole)(nil), "group.GroupRole")
+	proto.RegisterType((*SetGroupRoleReq)(nil), "group.SetGroupRoleReq")
+	proto.RegisterType((*SetGroupRoleResp)(nil), "group.SetGroupRoleResp")
+	proto.RegisterType((*DeleteGroupRoleReq)(nil), "group.DeleteGroupRoleReq")
+	proto.RegisterType((*DeleteGroupRoleResp)(nil), "group.DeleteGroupRoleResp")
+	proto.RegisterType((*GetGroupRolesReq)(nil), "group.GetGroupRolesReq")
+	proto.RegisterType((*GetGroupRolesResp)(nil), "group.GetGroupRolesResp")
+	proto.RegisterType((*SetGroupMemberRoleReq)(nil), "group.SetGroupMemberRoleReq")
+	proto.RegisterType((*SetGroupMemberRoleResp)(nil), "group.SetGroupMemberRoleResp")
+	proto.RegisterType((*GroupJoinQuestion)(nil), "group.GroupJoinQuestion")
+	proto.RegisterType((*GroupJoinRule)(nil), "group.GroupJoinRule")
+	proto.RegisterType((*SetGroupJoinQuestionnaireReq)(nil), "group.SetGroupJoinQuestionnaireReq")
+	proto.RegisterType((*SetGroupJoinQuestionnaireResp)(nil), "group.SetGroupJoinQuestionnaireResp")
+	proto.RegisterType((*GetGroupJoinQuestionnaireReq)(nil), "group.GetGroupJoinQuestionnaireReq")
+	proto.RegisterType((*GetGroupJoinQuestionnaireResp)(nil), "group.GetGroupJoinQuestionnaireResp")
+	proto.RegisterType((*QueryGroupMembersReq)(nil), "group.QueryGroupMembersReq")
+	proto.RegisterType((*GroupRoleMemberCount)(nil), "group.GroupRoleMemberCount")
+	proto.RegisterType((*QueryGroupMembersResp)(nil), "group.QueryGroupMembersResp")
+	proto.RegisterType((*GroupConversion)(nil), "group.GroupConversion")
+	proto.RegisterType((*ConvertGroupTypeReq)(nil), "group.ConvertGroupTypeReq")
+	proto.RegisterType((*ConvertGroupTypeResp)(nil), "group.ConvertGroupTypeResp")
+	proto.RegisterType((*GetGroupConversionReq)(nil), "group.GetGroupConversionReq")
+	proto.RegisterType((*GetGroupConversionResp)(nil), "group.GetGroupConversionResp")
+	proto.RegisterType((*SucceedGroupOwnerReq)(nil), "group.SucceedGroupOwnerReq")
+	proto.RegisterType((*SucceedGroupOwnerResp)(nil), "group.SucceedGroupOwnerResp")
+	proto.RegisterType((*ImportGroupMember)(nil), "group.ImportGroupMember")
+	proto.RegisterType((*ImportGroup)(nil), "group.ImportGroup")
+	proto.RegisterType((*ImportGroupResult)(nil), "group.ImportGroupResult")
+	proto.RegisterType((*GroupImportJob)(nil), "group.GroupImportJob")
+	proto.RegisterType((*ImportGroupsReq)(nil), "group.ImportGroupsReq")
+	proto.RegisterType((*ImportGroupsResp)(nil), "group.ImportGroupsResp")
+	proto.RegisterType((*GetGroupImportJobReq)(nil), "group.GetGroupImportJobReq")
+	proto.RegisterType((*GetGroupImportJobResp)(nil), "group.GetGroupImportJobResp")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Group service
+
+type GroupClient interface {
+	CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error)
+	JoinGroup(ctx context.Context, in *JoinGroupReq, opts ...grpc.CallOption) (*JoinGroupResp, error)
+	QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*QuitGroupResp, error)
+	GetGroupsInfo(ctx context.Context, in *GetGroupsInfoReq, opts ...grpc.CallOption) (*GetGroupsInfoResp, error)
+	SetGroupInfo(ctx context.Context, in *SetGroupInfoReq, opts ...grpc.CallOption) (*SetGroupInfoResp, error)
+	GetGroupApplicationList(ctx context.Context, in *GetGroupApplicationListReq, opts ...grpc.CallOption) (*GetGroupApplicationListResp, error)
+	GetUserReqApplicationList(ctx context.Context, in *GetUserReqApplicationListReq, opts ...grpc.CallOption) (*GetUserReqApplicationListResp, error)
+	TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*TransferGroupOwnerResp, error)
+	GroupApplicationResponse(ctx context.Context, in *GroupApplicationResponseReq, opts ...grpc.CallOption) (*GroupApplicationResponseResp, error)
+	GetGroupMemberList(ctx context.Context, in *GetGroupMemberListReq, opts ...grpc.CallOption) (*GetGroupMemberListResp, error)
+	GetGroupMembersInfo(ctx context.Context, in *GetGroupMembersInfoReq, opts ...grpc.CallOption) (*GetGroupMembersInfoResp, error)
+	KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*KickGroupMemberResp, error)
+	GetJoinedGroupList(ctx context.Context, in *GetJoinedGroupListReq, opts ...grpc.CallOption) (*GetJoinedGroupListResp, error)
+	InviteUserToGroup(ctx context.Context, in *InviteUserToGroupReq, opts ...grpc.CallOption) (*InviteUserToGroupResp, error)
+	InviteUserToGroups(ctx context.Context, in *InviteUserToGroupsReq, opts ...grpc.CallOption) (*InviteUserToGroupsResp, error)
+	GetGroupAllMember(ctx context.Context, in *GetGroupAllMemberReq, opts ...grpc.CallOption) (*GetGroupAllMemberResp, error)
+	GetGroups(ctx context.Context, in *GetGroupsReq, opts ...grpc.CallOption) (*GetGroupsResp, error)
+	GetGroupMembersCMS(ctx context.Context, in *GetGroupMembersCMSReq, opts ...grpc.CallOption) (*GetGroupMembersCMSResp, error)
+	DismissGroup(ctx context.Context, in *DismissGroupReq, opts ...grpc.CallOption) (*DismissGroupResp, error)
+	MuteGroupMember(ctx context.Context, in *MuteGroupMemberReq, opts ...grpc.CallOption) (*MuteGroupMemberResp, error)
+	CancelMuteGroupMember(ctx context.Context, in *CancelMuteGroupMemberReq, opts ...grpc.CallOption) (*CancelMuteGroupMemberResp, error)
+	MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*MuteGroupResp, error)
+	CancelMuteGroup(ctx context.Context, in *CancelMuteGroupReq, opts ...grpc.CallOption) (*CancelMuteGroupResp, error)
+	SetGroupMemberNickname(ctx context.Context, in *SetGroupMemberNicknameReq, opts ...grpc.CallOption) (*SetGroupMemberNicknameResp, error)
+	GetJoinedSuperGroupList(ctx context.Context, in *GetJoinedSuperGroupListReq, opts ...grpc.CallOption) (*GetJoinedSuperGroupListResp, error)
+	GetSuperGroupsInfo(ctx context.Context, in *GetSuperGroupsInfoReq, opts ...grpc.CallOption) (*GetSuperGroupsInfoResp, error)
+	SetGroupMemberInfo(ctx context.Context, in *SetGroupMemberInfoReq, opts ...grpc.CallOption) (*SetGroupMemberInfoResp, error)
+	GetGroupAbstractInfo(ctx context.Context, in *GetGroupAbstractInfoReq, opts ...grpc.CallOption) (*GetGroupAbstractInfoResp, error)
+	GroupIsExist(ctx context.Context, in *GroupIsExistReq, opts ...grpc.CallOption) (*GroupIsExistResp, error)
+	UserIsInGroup(ctx context.Context, in *UserIsInGroupReq, opts ...grpc.CallOption) (*UserIsInGroupResp, error)
+	CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error)
+	GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error)
+	RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error)
+	SetGroupRole(ctx context.Context, in *SetGroupRoleReq, opts ...grpc.CallOption) (*SetGroupRoleResp, error)
+	DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error)
+	SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*SetGroupMemberRoleResp, error)
+	SetGroupJoinQuestionnaire(ctx context.Context, in *SetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionnaireResp, error)
+	GetGroupJoinQuestionnaire(ctx context.Context, in *GetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionnaireResp, error)
+	QueryGroupMembers(ctx context.Context, in *QueryGroupMembersReq, opts ...grpc.CallOption) (*QueryGroupMembersResp, error)
+	ConvertGroupType(ctx context.Context, in *ConvertGroupTypeReq, opts ...grpc.CallOption) (*ConvertGroupTypeResp, error)
+	GetGroupConversion(ctx context.Context, in *GetGroupConversionReq, opts ...grpc.CallOption) (*GetGroupConversionResp, error)
+	SucceedGroupOwner(ctx context.Context, in *SucceedGroupOwnerReq, opts ...grpc.CallOption) (*SucceedGroupOwnerResp, error)
+	ImportGroups(ctx context.Context, in *ImportGroupsReq, opts ...grpc.CallOption) (*ImportGroupsResp, error)
+	GetGroupImportJob(ctx context.Context, in *GetGroupImportJobReq, opts ...grpc.CallOption) (*GetGroupImportJobResp, error)
+}
+
+type groupClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGroupClient(cc *grpc.ClientConn) GroupClient {
+	return &groupClient{cc}
+}
+
+func (c *groupClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
+	out := new(CreateGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/createGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) JoinGroup(ctx context.Context, in *JoinGroupReq, opts ...grpc.CallOption) (*JoinGroupResp, error) {
+	out := new(JoinGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/joinGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) QuitGroup(ctx context.Context, in *QuitGroupReq, opts ...grpc.CallOption) (*QuitGroupResp, error) {
+	out := new(QuitGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/quitGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupsInfo(ctx context.Context, in *GetGroupsInfoReq, opts ...grpc.CallOption) (*GetGroupsInfoResp, error) {
+	out := new(GetGroupsInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/getGroupsInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupInfo(ctx context.Context, in *SetGroupInfoReq, opts ...grpc.CallOption) (*SetGroupInfoResp, error) {
+	out := new(SetGroupInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/setGroupInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupApplicationList(ctx context.Context, in *GetGroupApplicationListReq, opts ...grpc.CallOption) (*GetGroupApplicationListResp, error) {
+	out := new(GetGroupApplicationListResp)
+	err := grpc.Invoke(ctx, "/group.group/getGroupApplicationList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetUserReqApplicationList(ctx context.Context, in *GetUserReqApplicationListReq, opts ...grpc.CallOption) (*GetUserReqApplicationListResp, error) {
+	out := new(GetUserReqApplicationListResp)
+	err := grpc.Invoke(ctx, "/group.group/getUserReqApplicationList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) TransferGroupOwner(ctx context.Context, in *TransferGroupOwnerReq, opts ...grpc.CallOption) (*TransferGroupOwnerResp, error) {
+	out := new(TransferGroupOwnerResp)
+	err := grpc.Invoke(ctx, "/group.group/transferGroupOwner", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GroupApplicationResponse(ctx context.Context, in *GroupApplicationResponseReq, opts ...grpc.CallOption) (*GroupApplicationResponseResp, error) {
+	out := new(GroupApplicationResponseResp)
+	err := grpc.Invoke(ctx, "/group.group/groupApplicationResponse", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupMemberList(ctx context.Context, in *GetGroupMemberListReq, opts ...grpc.CallOption) (*GetGroupMemberListResp, error) {
+	out := new(GetGroupMemberListResp)
+	err := grpc.Invoke(ctx, "/group.group/getGroupMemberList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupMembersInfo(ctx context.Context, in *GetGroupMembersInfoReq, opts ...grpc.CallOption) (*GetGroupMembersInfoResp, error) {
+	out := new(GetGroupMembersInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/getGroupMembersInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) KickGroupMember(ctx context.Context, in *KickGroupMemberReq, opts ...grpc.CallOption) (*KickGroupMemberResp, error) {
+	out := new(KickGroupMemberResp)
+	err := grpc.Invoke(ctx, "/group.group/kickGroupMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *groupClient) GetJoinedGroupList(ctx context.Context, in *GetJoinedGroupListReq, opts ...grpc.CallOption) (*GetJoinedGroupListResp, error) {
@@ -3860,90 +6371,225 @@ func (c *groupClient) MuteGroupMember(ctx context.Context, in *MuteGroupMemberRe
 	return out, nil
 }
 
-func (c *groupClient) CancelMuteGroupMember(ctx context.Context, in *CancelMuteGroupMemberReq, opts ...grpc.CallOption) (*CancelMuteGroupMemberResp, error) {
-	out := new(CancelMuteGroupMemberResp)
-	err := grpc.Invoke(ctx, "/group.group/CancelMuteGroupMember", in, out, c.cc, opts...)
+func (c *groupClient) CancelMuteGroupMember(ctx context.Context, in *CancelMuteGroupMemberReq, opts ...grpc.CallOption) (*CancelMuteGroupMemberResp, error) {
+	out := new(CancelMuteGroupMemberResp)
+	err := grpc.Invoke(ctx, "/group.group/CancelMuteGroupMember", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*MuteGroupResp, error) {
+	out := new(MuteGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/MuteGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) CancelMuteGroup(ctx context.Context, in *CancelMuteGroupReq, opts ...grpc.CallOption) (*CancelMuteGroupResp, error) {
+	out := new(CancelMuteGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/CancelMuteGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupMemberNickname(ctx context.Context, in *SetGroupMemberNicknameReq, opts ...grpc.CallOption) (*SetGroupMemberNicknameResp, error) {
+	out := new(SetGroupMemberNicknameResp)
+	err := grpc.Invoke(ctx, "/group.group/SetGroupMemberNickname", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetJoinedSuperGroupList(ctx context.Context, in *GetJoinedSuperGroupListReq, opts ...grpc.CallOption) (*GetJoinedSuperGroupListResp, error) {
+	out := new(GetJoinedSuperGroupListResp)
+	err := grpc.Invoke(ctx, "/group.group/GetJoinedSuperGroupList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetSuperGroupsInfo(ctx context.Context, in *GetSuperGroupsInfoReq, opts ...grpc.CallOption) (*GetSuperGroupsInfoResp, error) {
+	out := new(GetSuperGroupsInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/GetSuperGroupsInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupMemberInfo(ctx context.Context, in *SetGroupMemberInfoReq, opts ...grpc.CallOption) (*SetGroupMemberInfoResp, error) {
+	out := new(SetGroupMemberInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/SetGroupMemberInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupAbstractInfo(ctx context.Context, in *GetGroupAbstractInfoReq, opts ...grpc.CallOption) (*GetGroupAbstractInfoResp, error) {
+	out := new(GetGroupAbstractInfoResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupAbstractInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GroupIsExist(ctx context.Context, in *GroupIsExistReq, opts ...grpc.CallOption) (*GroupIsExistResp, error) {
+	out := new(GroupIsExistResp)
+	err := grpc.Invoke(ctx, "/group.group/GroupIsExist", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) UserIsInGroup(ctx context.Context, in *UserIsInGroupReq, opts ...grpc.CallOption) (*UserIsInGroupResp, error) {
+	out := new(UserIsInGroupResp)
+	err := grpc.Invoke(ctx, "/group.group/UserIsInGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) CreateGroupInviteLink(ctx context.Context, in *CreateGroupInviteLinkReq, opts ...grpc.CallOption) (*CreateGroupInviteLinkResp, error) {
+	out := new(CreateGroupInviteLinkResp)
+	err := grpc.Invoke(ctx, "/group.group/CreateGroupInviteLink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupInviteLinks(ctx context.Context, in *GetGroupInviteLinksReq, opts ...grpc.CallOption) (*GetGroupInviteLinksResp, error) {
+	out := new(GetGroupInviteLinksResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupInviteLinks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) RevokeGroupInviteLink(ctx context.Context, in *RevokeGroupInviteLinkReq, opts ...grpc.CallOption) (*RevokeGroupInviteLinkResp, error) {
+	out := new(RevokeGroupInviteLinkResp)
+	err := grpc.Invoke(ctx, "/group.group/RevokeGroupInviteLink", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) SetGroupRole(ctx context.Context, in *SetGroupRoleReq, opts ...grpc.CallOption) (*SetGroupRoleResp, error) {
+	out := new(SetGroupRoleResp)
+	err := grpc.Invoke(ctx, "/group.group/SetGroupRole", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) DeleteGroupRole(ctx context.Context, in *DeleteGroupRoleReq, opts ...grpc.CallOption) (*DeleteGroupRoleResp, error) {
+	out := new(DeleteGroupRoleResp)
+	err := grpc.Invoke(ctx, "/group.group/DeleteGroupRole", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupClient) GetGroupRoles(ctx context.Context, in *GetGroupRolesReq, opts ...grpc.CallOption) (*GetGroupRolesResp, error) {
+	out := new(GetGroupRolesResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupRoles", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) MuteGroup(ctx context.Context, in *MuteGroupReq, opts ...grpc.CallOption) (*MuteGroupResp, error) {
-	out := new(MuteGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/MuteGroup", in, out, c.cc, opts...)
+func (c *groupClient) SetGroupMemberRole(ctx context.Context, in *SetGroupMemberRoleReq, opts ...grpc.CallOption) (*SetGroupMemberRoleResp, error) {
+	out := new(SetGroupMemberRoleResp)
+	err := grpc.Invoke(ctx, "/group.group/SetGroupMemberRole", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) CancelMuteGroup(ctx context.Context, in *CancelMuteGroupReq, opts ...grpc.CallOption) (*CancelMuteGroupResp, error) {
-	out := new(CancelMuteGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/CancelMuteGroup", in, out, c.cc, opts...)
+func (c *groupClient) SetGroupJoinQuestionnaire(ctx context.Context, in *SetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*SetGroupJoinQuestionnaireResp, error) {
+	out := new(SetGroupJoinQuestionnaireResp)
+	err := grpc.Invoke(ctx, "/group.group/SetGroupJoinQuestionnaire", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) SetGroupMemberNickname(ctx context.Context, in *SetGroupMemberNicknameReq, opts ...grpc.CallOption) (*SetGroupMemberNicknameResp, error) {
-	out := new(SetGroupMemberNicknameResp)
-	err := grpc.Invoke(ctx, "/group.group/SetGroupMemberNickname", in, out, c.cc, opts...)
+func (c *groupClient) GetGroupJoinQuestionnaire(ctx context.Context, in *GetGroupJoinQuestionnaireReq, opts ...grpc.CallOption) (*GetGroupJoinQuestionnaireResp, error) {
+	out := new(GetGroupJoinQuestionnaireResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupJoinQuestionnaire", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) GetJoinedSuperGroupList(ctx context.Context, in *GetJoinedSuperGroupListReq, opts ...grpc.CallOption) (*GetJoinedSuperGroupListResp, error) {
-	out := new(GetJoinedSuperGroupListResp)
-	err := grpc.Invoke(ctx, "/group.group/GetJoinedSuperGroupList", in, out, c.cc, opts...)
+func (c *groupClient) QueryGroupMembers(ctx context.Context, in *QueryGroupMembersReq, opts ...grpc.CallOption) (*QueryGroupMembersResp, error) {
+	out := new(QueryGroupMembersResp)
+	err := grpc.Invoke(ctx, "/group.group/QueryGroupMembers", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) GetSuperGroupsInfo(ctx context.Context, in *GetSuperGroupsInfoReq, opts ...grpc.CallOption) (*GetSuperGroupsInfoResp, error) {
-	out := new(GetSuperGroupsInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/GetSuperGroupsInfo", in, out, c.cc, opts...)
+func (c *groupClient) ConvertGroupType(ctx context.Context, in *ConvertGroupTypeReq, opts ...grpc.CallOption) (*ConvertGroupTypeResp, error) {
+	out := new(ConvertGroupTypeResp)
+	err := grpc.Invoke(ctx, "/group.group/ConvertGroupType", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) SetGroupMemberInfo(ctx context.Context, in *SetGroupMemberInfoReq, opts ...grpc.CallOption) (*SetGroupMemberInfoResp, error) {
-	out := new(SetGroupMemberInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/SetGroupMemberInfo", in, out, c.cc, opts...)
+func (c *groupClient) GetGroupConversion(ctx context.Context, in *GetGroupConversionReq, opts ...grpc.CallOption) (*GetGroupConversionResp, error) {
+	out := new(GetGroupConversionResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupConversion", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) GetGroupAbstractInfo(ctx context.Context, in *GetGroupAbstractInfoReq, opts ...grpc.CallOption) (*GetGroupAbstractInfoResp, error) {
-	out := new(GetGroupAbstractInfoResp)
-	err := grpc.Invoke(ctx, "/group.group/GetGroupAbstractInfo", in, out, c.cc, opts...)
+func (c *groupClient) SucceedGroupOwner(ctx context.Context, in *SucceedGroupOwnerReq, opts ...grpc.CallOption) (*SucceedGroupOwnerResp, error) {
+	out := new(SucceedGroupOwnerResp)
+	err := grpc.Invoke(ctx, "/group.group/SucceedGroupOwner", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) GroupIsExist(ctx context.Context, in *GroupIsExistReq, opts ...grpc.CallOption) (*GroupIsExistResp, error) {
-	out := new(GroupIsExistResp)
-	err := grpc.Invoke(ctx, "/group.group/GroupIsExist", in, out, c.cc, opts...)
+func (c *groupClient) ImportGroups(ctx context.Context, in *ImportGroupsReq, opts ...grpc.CallOption) (*ImportGroupsResp, error) {
+	out := new(ImportGroupsResp)
+	err := grpc.Invoke(ctx, "/group.group/ImportGroups", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *groupClient) UserIsInGroup(ctx context.Context, in *UserIsInGroupReq, opts ...grpc.CallOption) (*UserIsInGroupResp, error) {
-	out := new(UserIsInGroupResp)
-	err := grpc.Invoke(ctx, "/group.group/UserIsInGroup", in, out, c.cc, opts...)
+func (c *groupClient) GetGroupImportJob(ctx context.Context, in *GetGroupImportJobReq, opts ...grpc.CallOption) (*GetGroupImportJobResp, error) {
+	out := new(GetGroupImportJobResp)
+	err := grpc.Invoke(ctx, "/group.group/GetGroupImportJob", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3983,6 +6629,21 @@ type GroupServer interface {
 	GetGroupAbstractInfo(context.Context, *GetGroupAbstractInfoReq) (*GetGroupAbstractInfoResp, error)
 	GroupIsExist(context.Context, *GroupIsExistReq) (*GroupIsExistResp, error)
 	UserIsInGroup(context.Context, *UserIsInGroupReq) (*UserIsInGroupResp, error)
+	CreateGroupInviteLink(context.Context, *CreateGroupInviteLinkReq) (*CreateGroupInviteLinkResp, error)
+	GetGroupInviteLinks(context.Context, *GetGroupInviteLinksReq) (*GetGroupInviteLinksResp, error)
+	RevokeGroupInviteLink(context.Context, *RevokeGroupInviteLinkReq) (*RevokeGroupInviteLinkResp, error)
+	SetGroupRole(context.Context, *SetGroupRoleReq) (*SetGroupRoleResp, error)
+	DeleteGroupRole(context.Context, *DeleteGroupRoleReq) (*DeleteGroupRoleResp, error)
+	GetGroupRoles(context.Context, *GetGroupRolesReq) (*GetGroupRolesResp, error)
+	SetGroupMemberRole(context.Context, *SetGroupMemberRoleReq) (*SetGroupMemberRoleResp, error)
+	SetGroupJoinQuestionnaire(context.Context, *SetGroupJoinQuestionnaireReq) (*SetGroupJoinQuestionnaireResp, error)
+	GetGroupJoinQuestionnaire(context.Context, *GetGroupJoinQuestionnaireReq) (*GetGroupJoinQuestionnaireResp, error)
+	QueryGroupMembers(context.Context, *QueryGroupMembersReq) (*QueryGroupMembersResp, error)
+	ConvertGroupType(context.Context, *ConvertGroupTypeReq) (*ConvertGroupTypeResp, error)
+	GetGroupConversion(context.Context, *GetGroupConversionReq) (*GetGroupConversionResp, error)
+	SucceedGroupOwner(context.Context, *SucceedGroupOwnerReq) (*SucceedGroupOwnerResp, error)
+	ImportGroups(context.Context, *ImportGroupsReq) (*ImportGroupsResp, error)
+	GetGroupImportJob(context.Context, *GetGroupImportJobReq) (*GetGroupImportJobResp, error)
 }
 
 func RegisterGroupServer(s *grpc.Server, srv GroupServer) {
@@ -4529,6 +7190,276 @@ func _Group_UserIsInGroup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Group_CreateGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).CreateGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/CreateGroupInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).CreateGroupInviteLink(ctx, req.(*CreateGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupInviteLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupInviteLinksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupInviteLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/GetGroupInviteLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupInviteLinks(ctx, req.(*GetGroupInviteLinksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_RevokeGroupInviteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeGroupInviteLinkReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).RevokeGroupInviteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/RevokeGroupInviteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).RevokeGroupInviteLink(ctx, req.(*RevokeGroupInviteLinkReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/SetGroupRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupRole(ctx, req.(*SetGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_DeleteGroupRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).DeleteGroupRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/DeleteGroupRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).DeleteGroupRole(ctx, req.(*DeleteGroupRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/GetGroupRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupRoles(ctx, req.(*GetGroupRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupMemberRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/SetGroupMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupMemberRole(ctx, req.(*SetGroupMemberRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SetGroupJoinQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupJoinQuestionnaireReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SetGroupJoinQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/SetGroupJoinQuestionnaire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SetGroupJoinQuestionnaire(ctx, req.(*SetGroupJoinQuestionnaireReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupJoinQuestionnaire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupJoinQuestionnaireReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupJoinQuestionnaire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/GetGroupJoinQuestionnaire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupJoinQuestionnaire(ctx, req.(*GetGroupJoinQuestionnaireReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_QueryGroupMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGroupMembersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).QueryGroupMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/QueryGroupMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).QueryGroupMembers(ctx, req.(*QueryGroupMembersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ConvertGroupType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertGroupTypeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ConvertGroupType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/ConvertGroupType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ConvertGroupType(ctx, req.(*ConvertGroupTypeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupConversionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/GetGroupConversion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupConversion(ctx, req.(*GetGroupConversionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_SucceedGroupOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SucceedGroupOwnerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).SucceedGroupOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/SucceedGroupOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).SucceedGroupOwner(ctx, req.(*SucceedGroupOwnerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_ImportGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGroupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).ImportGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/ImportGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).ImportGroups(ctx, req.(*ImportGroupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Group_GetGroupImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupImportJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServer).GetGroupImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/group.group/GetGroupImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServer).GetGroupImportJob(ctx, req.(*GetGroupImportJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Group_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "group.group",
 	HandlerType: (*GroupServer)(nil),
@@ -4653,165 +7584,316 @@ var _Group_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UserIsInGroup",
 			Handler:    _Group_UserIsInGroup_Handler,
 		},
+		{
+			MethodName: "CreateGroupInviteLink",
+			Handler:    _Group_CreateGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "GetGroupInviteLinks",
+			Handler:    _Group_GetGroupInviteLinks_Handler,
+		},
+		{
+			MethodName: "RevokeGroupInviteLink",
+			Handler:    _Group_RevokeGroupInviteLink_Handler,
+		},
+		{
+			MethodName: "SetGroupRole",
+			Handler:    _Group_SetGroupRole_Handler,
+		},
+		{
+			MethodName: "DeleteGroupRole",
+			Handler:    _Group_DeleteGroupRole_Handler,
+		},
+		{
+			MethodName: "GetGroupRoles",
+			Handler:    _Group_GetGroupRoles_Handler,
+		},
+		{
+			MethodName: "SetGroupMemberRole",
+			Handler:    _Group_SetGroupMemberRole_Handler,
+		},
+		{
+			MethodName: "SetGroupJoinQuestionnaire",
+			Handler:    _Group_SetGroupJoinQuestionnaire_Handler,
+		},
+		{
+			MethodName: "GetGroupJoinQuestionnaire",
+			Handler:    _Group_GetGroupJoinQuestionnaire_Handler,
+		},
+		{
+			MethodName: "QueryGroupMembers",
+			Handler:    _Group_QueryGroupMembers_Handler,
+		},
+		{
+			MethodName: "ConvertGroupType",
+			Handler:    _Group_ConvertGroupType_Handler,
+		},
+		{
+			MethodName: "GetGroupConversion",
+			Handler:    _Group_GetGroupConversion_Handler,
+		},
+		{
+			MethodName: "SucceedGroupOwner",
+			Handler:    _Group_SucceedGroupOwner_Handler,
+		},
+		{
+			MethodName: "ImportGroups",
+			Handler:    _Group_ImportGroups_Handler,
+		},
+		{
+			MethodName: "GetGroupImportJob",
+			Handler:    _Group_GetGroupImportJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "group/group.proto",
 }
 
-func init() { proto.RegisterFile("group/group.proto", fileDescriptor_group_e55c79105d50978e) }
-
-var fileDescriptor_group_e55c79105d50978e = []byte{
-	// 2425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x1a, 0x4d, 0x6f, 0x1c, 0x49,
-	0x55, 0x3d, 0xe3, 0x89, 0xed, 0x67, 0x4f, 0xc6, 0x2e, 0xc7, 0xce, 0xa4, 0xe3, 0x38, 0xde, 0xde,
-	0xb0, 0x58, 0x28, 0xb1, 0xc1, 0x2b, 0x45, 0xcb, 0x2e, 0xb0, 0xc4, 0x5f, 0xf1, 0x24, 0xb1, 0x8d,
-	0x7b, 0xb2, 0x20, 0xad, 0x84, 0x42, 0x7b, 0xa6, 0xdc, 0x3b, 0x78, 0xa6, 0xbb, 0xdd, 0xd5, 0x13,
-	0x67, 0xb9, 0xac, 0xb8, 0x20, 0x81, 0x90, 0x10, 0xe2, 0xba, 0x08, 0xc1, 0x05, 0x84, 0x60, 0xc5,
-	0x01, 0xce, 0xfc, 0x01, 0x10, 0x17, 0x2e, 0x5c, 0xf9, 0x03, 0x5c, 0xf8, 0x01, 0xab, 0xae, 0xaa,
-	0xae, 0xae, 0xee, 0xea, 0xee, 0x99, 0x6d, 0x6f, 0x36, 0x97, 0xd1, 0xd4, 0xab, 0x57, 0x55, 0xef,
-	0xbd, 0x7a, 0xef, 0xd5, 0xfb, 0x68, 0x98, 0xb7, 0x7d, 0x77, 0xe8, 0x6d, 0xd0, 0xdf, 0x75, 0xcf,
-	0x77, 0x03, 0x17, 0xd5, 0xe8, 0x40, 0x5f, 0x3b, 0xf2, 0xb0, 0x73, 0xaf, 0x75, 0x70, 0xaf, 0x8d,
-	0xfd, 0xe7, 0xd8, 0xdf, 0xf0, 0xce, 0xec, 0x0d, 0x8a, 0xb0, 0x41, 0xba, 0x67, 0xcf, 0x2e, 0xc8,
-	0xc6, 0x05, 0x61, 0x0b, 0xf4, 0xf5, 0x91, 0x98, 0xbe, 0xe5, 0x79, 0xd8, 0xe7, 0xf8, 0xc6, 0xb7,
-	0x00, 0xb6, 0xdd, 0xc1, 0xc0, 0x75, 0x4c, 0x4c, 0x3c, 0xd4, 0x84, 0xc9, 0x5d, 0xdf, 0xdf, 0x76,
-	0xbb, 0xb8, 0xa9, 0xad, 0x6a, 0x6b, 0x35, 0x33, 0x1a, 0xa2, 0x25, 0xb8, 0xb2, 0xeb, 0xfb, 0x07,
-	0xc4, 0x6e, 0x56, 0x56, 0xb5, 0xb5, 0x69, 0x93, 0x8f, 0x8c, 0x47, 0x80, 0x1e, 0x86, 0x24, 0x3e,
-	0xe8, 0x76, 0x0f, 0xf0, 0xe0, 0x04, 0xfb, 0x2d, 0xe7, 0xd4, 0x0d, 0xb1, 0xdf, 0x23, 0xd8, 0x6f,
-	0xed, 0xd0, 0x6d, 0xa6, 0x4d, 0x3e, 0x42, 0xcb, 0x30, 0x6d, 0xba, 0x7d, 0xfc, 0x04, 0x3f, 0xc7,
-	0x7d, 0xba, 0x51, 0xcd, 0x8c, 0x01, 0xc6, 0xff, 0x34, 0xb8, 0xba, 0xed, 0x63, 0x2b, 0xc0, 0x74,
-	0x4b, 0x13, 0x9f, 0xa3, 0x07, 0x70, 0xb5, 0xe5, 0xf4, 0x02, 0xb6, 0xf5, 0x93, 0x1e, 0x09, 0x9a,
-	0xda, 0x6a, 0x75, 0x6d, 0x66, 0xf3, 0xc6, 0x3a, 0x93, 0x92, 0x7a, 0xb6, 0x99, 0x5a, 0x80, 0xde,
-	0x86, 0x69, 0x8a, 0x15, 0x4e, 0xd2, 0x33, 0x67, 0x36, 0x97, 0xd7, 0x09, 0x95, 0xce, 0x33, 0xcb,
-	0xeb, 0x3d, 0xf3, 0x2c, 0xdf, 0x1a, 0x90, 0x75, 0x81, 0x63, 0xc6, 0xe8, 0x68, 0x15, 0x66, 0x8e,
-	0x3c, 0xec, 0x5b, 0x41, 0xcf, 0x75, 0x5a, 0x3b, 0xcd, 0x2a, 0x65, 0x46, 0x06, 0x21, 0x1d, 0xa6,
-	0x8e, 0x3c, 0xce, 0xeb, 0x04, 0x9d, 0x16, 0x63, 0xba, 0xfa, 0xc2, 0xc1, 0x3e, 0x9f, 0xae, 0xf1,
-	0xd5, 0x31, 0xc8, 0xf8, 0x08, 0x1a, 0x09, 0x86, 0xcb, 0x5c, 0x41, 0x92, 0xc1, 0xea, 0x67, 0x62,
-	0xd0, 0xf0, 0x61, 0xee, 0x21, 0x0e, 0xe8, 0x98, 0xd0, 0x39, 0x7c, 0x1e, 0x92, 0xcd, 0x10, 0x76,
-	0x84, 0xc0, 0xa7, 0x4d, 0x19, 0x94, 0x16, 0x4b, 0xa5, 0x58, 0x2c, 0xd5, 0xa4, 0x58, 0x8c, 0x9f,
-	0x6a, 0x30, 0x9f, 0x3a, 0xb4, 0x14, 0xdf, 0x5b, 0x50, 0x17, 0x8c, 0x50, 0x4a, 0xab, 0x54, 0x35,
-	0x8a, 0x79, 0x4f, 0x2e, 0x31, 0x7e, 0xad, 0x41, 0xa3, 0xcd, 0x69, 0x89, 0xf8, 0x7f, 0x02, 0x0d,
-	0x3b, 0x1a, 0xef, 0xb9, 0x7e, 0x1b, 0x07, 0x94, 0xa2, 0x99, 0x4d, 0xa3, 0x68, 0x67, 0x86, 0x69,
-	0xa6, 0x97, 0x26, 0x24, 0x51, 0xc9, 0x50, 0x90, 0x42, 0xf5, 0x32, 0x76, 0x61, 0x2e, 0x49, 0x1e,
-	0xf1, 0xd0, 0xd7, 0x64, 0x93, 0xe5, 0xa4, 0xcd, 0x73, 0x7b, 0x88, 0x27, 0x4c, 0x09, 0xc9, 0xf8,
-	0x11, 0xe8, 0x91, 0xc4, 0x1f, 0x78, 0x5e, 0xbf, 0xd7, 0xa1, 0xfb, 0x87, 0x12, 0x08, 0x19, 0x96,
-	0x49, 0xd4, 0x8a, 0x49, 0xcc, 0xb8, 0xea, 0x15, 0x80, 0x3d, 0xdf, 0x1d, 0x24, 0x2e, 0x5b, 0x82,
-	0x18, 0x1f, 0x6b, 0x70, 0x33, 0xf7, 0xf0, 0x52, 0x17, 0xff, 0x18, 0xe6, 0x22, 0x07, 0x31, 0xc4,
-	0x24, 0x90, 0xee, 0xfe, 0x76, 0xde, 0x0d, 0x71, 0x54, 0x53, 0x59, 0x68, 0x04, 0xb0, 0xfc, 0x10,
-	0x07, 0x21, 0xad, 0x26, 0x3e, 0xcf, 0x10, 0x4e, 0x9e, 0x2b, 0xbb, 0xdc, 0xbd, 0xfe, 0x46, 0x83,
-	0x5b, 0x05, 0xc7, 0x96, 0xba, 0xe5, 0x4c, 0xb9, 0x54, 0xca, 0xca, 0xe5, 0xef, 0x1a, 0x2c, 0x3e,
-	0xf5, 0x2d, 0x87, 0x9c, 0x62, 0x9f, 0x4e, 0x52, 0xbf, 0x15, 0x4a, 0xa4, 0x09, 0x93, 0xdc, 0x19,
-	0x70, 0x91, 0x44, 0x43, 0xf4, 0x06, 0x5c, 0x3d, 0xea, 0x77, 0x65, 0x9f, 0xc7, 0x24, 0x93, 0x82,
-	0x86, 0x78, 0x87, 0xf8, 0x42, 0xc6, 0x63, 0x22, 0x4a, 0x41, 0xd3, 0x72, 0x9c, 0x28, 0xf6, 0x33,
-	0xb5, 0x94, 0x9f, 0x79, 0x0c, 0x4b, 0x59, 0x0c, 0x94, 0xb3, 0xa0, 0x7f, 0x68, 0x30, 0xfb, 0xc8,
-	0xed, 0x39, 0xe2, 0x65, 0xca, 0x97, 0xc2, 0x0a, 0x80, 0x89, 0xcf, 0x0f, 0x30, 0x21, 0x96, 0x8d,
-	0xb9, 0x04, 0x24, 0x48, 0x91, 0x6f, 0x1c, 0x83, 0xe3, 0x15, 0x80, 0x90, 0x8e, 0xb6, 0x3b, 0xf4,
-	0x3b, 0x98, 0xf2, 0x5c, 0x33, 0x25, 0x08, 0xba, 0x03, 0xf5, 0x96, 0xf3, 0xbc, 0x17, 0x08, 0xd1,
-	0x5e, 0xa1, 0x7b, 0x24, 0x81, 0xc6, 0x16, 0xd4, 0x25, 0x6e, 0xca, 0x89, 0xe4, 0x3f, 0xa1, 0x61,
-	0xa7, 0xac, 0x3a, 0x9c, 0x70, 0x1d, 0x82, 0xf9, 0x3b, 0x22, 0xf3, 0xa2, 0x15, 0xdf, 0x5e, 0xda,
-	0x86, 0x24, 0xf9, 0x56, 0x15, 0xf9, 0x4a, 0x0e, 0x67, 0x22, 0xed, 0x70, 0xc2, 0xf9, 0x7d, 0xcb,
-	0xe9, 0xf6, 0x71, 0x37, 0x74, 0x1d, 0x4c, 0x2b, 0x24, 0x08, 0x32, 0x60, 0x96, 0x8d, 0x4c, 0x4c,
-	0x86, 0xfd, 0x80, 0x0a, 0xa8, 0x66, 0x26, 0x60, 0xc6, 0x31, 0x2c, 0xe7, 0xb3, 0x56, 0x4e, 0x5c,
-	0xa7, 0x30, 0x7b, 0x3c, 0xec, 0x05, 0x63, 0x28, 0xd0, 0xe5, 0x9e, 0xd7, 0x2d, 0xa8, 0x4b, 0xe7,
-	0x94, 0xa3, 0xf5, 0xb7, 0x1a, 0x2c, 0x46, 0x3e, 0x3b, 0x0e, 0xa5, 0x8a, 0xa9, 0xbe, 0x94, 0x43,
-	0x0c, 0xdd, 0xec, 0x5e, 0xaf, 0x1f, 0x60, 0x9f, 0x5e, 0x68, 0xcd, 0xe4, 0xa3, 0xf0, 0xbc, 0x43,
-	0xfc, 0x22, 0x68, 0xe3, 0x73, 0xae, 0xeb, 0xd1, 0xd0, 0xf8, 0x93, 0x06, 0x4b, 0x59, 0x34, 0x96,
-	0x7a, 0x52, 0xf6, 0x00, 0x06, 0x71, 0x8c, 0xc9, 0x1e, 0x93, 0x37, 0xf2, 0x9c, 0x26, 0x3b, 0x6d,
-	0x6f, 0xd8, 0xef, 0xd3, 0x37, 0x59, 0x5a, 0x19, 0x9e, 0xec, 0x70, 0x72, 0x19, 0x1f, 0xd1, 0xd0,
-	0xf8, 0xa3, 0x42, 0xae, 0x08, 0xb8, 0x0a, 0x5d, 0x89, 0x44, 0x56, 0x85, 0x46, 0x62, 0xf2, 0x71,
-	0x97, 0x73, 0x25, 0x21, 0xb1, 0xee, 0xb6, 0xd5, 0xf9, 0x80, 0xf9, 0x91, 0x29, 0x33, 0x1a, 0x1a,
-	0xbf, 0xd2, 0xe0, 0x7a, 0x26, 0xb1, 0xaf, 0x52, 0xb8, 0xc6, 0x5f, 0x34, 0x40, 0x8f, 0x7b, 0x9d,
-	0x33, 0x09, 0xaf, 0x58, 0x7c, 0x5f, 0x81, 0xb9, 0x10, 0x1f, 0x77, 0x99, 0x48, 0x24, 0x21, 0x2a,
-	0xf0, 0x90, 0x78, 0x13, 0x5b, 0xc4, 0x75, 0xb8, 0x20, 0xf9, 0x28, 0x2d, 0xc6, 0x5a, 0xb1, 0x31,
-	0x5e, 0x49, 0x19, 0xe3, 0x3b, 0x30, 0xdd, 0xea, 0x6e, 0x32, 0xa7, 0x92, 0x1b, 0x4a, 0xd0, 0xa3,
-	0xa9, 0x2b, 0x62, 0x29, 0x11, 0x1f, 0x19, 0x1f, 0xc1, 0x82, 0xc2, 0x6e, 0xa9, 0x0b, 0xb8, 0x0f,
-	0x75, 0x41, 0x85, 0x74, 0x07, 0x73, 0xdc, 0x09, 0x88, 0x39, 0x33, 0x89, 0x66, 0x0c, 0xa9, 0x17,
-	0x08, 0x1f, 0x0a, 0xdc, 0xa5, 0x54, 0x44, 0x5e, 0x20, 0xe9, 0x82, 0x35, 0xc5, 0x05, 0xaf, 0xc2,
-	0x8c, 0xab, 0x7a, 0x30, 0x77, 0x4c, 0x0f, 0xf6, 0x13, 0x66, 0x2a, 0xca, 0xb9, 0x97, 0xca, 0x8e,
-	0xc6, 0xce, 0x10, 0x62, 0x74, 0xe3, 0xaf, 0x1a, 0x5c, 0x63, 0xef, 0x66, 0x48, 0xd9, 0x53, 0x57,
-	0xf8, 0xee, 0xd1, 0x1e, 0x3a, 0xff, 0xf9, 0x8a, 0x15, 0x6d, 0x22, 0xa1, 0x68, 0x77, 0x61, 0x9e,
-	0x9d, 0x25, 0x6b, 0x6b, 0x8d, 0x6a, 0xab, 0x3a, 0x51, 0xa8, 0x74, 0x3f, 0xd6, 0x60, 0x31, 0x83,
-	0xec, 0x2f, 0x54, 0x75, 0xfe, 0x96, 0x45, 0x03, 0x19, 0x2f, 0x2c, 0x58, 0x85, 0x19, 0x5b, 0x4a,
-	0x40, 0x99, 0xc5, 0xca, 0xa0, 0x5c, 0x63, 0xbd, 0x03, 0xf5, 0x9e, 0x2c, 0x2a, 0x2e, 0xe2, 0x24,
-	0xb0, 0x30, 0x68, 0x7c, 0x04, 0x4b, 0x59, 0x64, 0x97, 0xaa, 0x8d, 0x7c, 0xac, 0xc1, 0x35, 0x91,
-	0xf9, 0xf4, 0xfb, 0xe3, 0x78, 0xac, 0x4b, 0x3f, 0xa2, 0x47, 0xa7, 0xa7, 0x04, 0x07, 0xd1, 0x23,
-	0xca, 0x46, 0xe8, 0x1a, 0xd4, 0xb6, 0xdd, 0xa1, 0x13, 0xf0, 0x27, 0x94, 0x0d, 0x8c, 0x5f, 0x4a,
-	0x8f, 0xbc, 0x44, 0xde, 0x2b, 0x75, 0xf1, 0xbf, 0xd3, 0x60, 0x6a, 0xfb, 0xa0, 0x4d, 0xd1, 0x92,
-	0x85, 0x0d, 0xed, 0xb3, 0x55, 0x6e, 0xd6, 0x79, 0x5d, 0x4a, 0xa4, 0x13, 0x87, 0xd6, 0x20, 0x0a,
-	0xc6, 0x33, 0x66, 0xc2, 0xa7, 0x22, 0x09, 0x15, 0x12, 0x56, 0xe0, 0xc6, 0x27, 0x1a, 0xcc, 0x8a,
-	0x02, 0x46, 0x78, 0x9f, 0x3b, 0x00, 0xdf, 0xb1, 0xec, 0x9e, 0x43, 0xef, 0x81, 0x53, 0x7a, 0x27,
-	0x83, 0x52, 0x9e, 0x5f, 0xc5, 0xb8, 0xa6, 0xb4, 0x0e, 0x2d, 0x73, 0x76, 0x25, 0x4a, 0x63, 0x40,
-	0x81, 0x43, 0x19, 0xf9, 0xd0, 0x1b, 0xff, 0xd6, 0xa0, 0x2e, 0x11, 0x4c, 0x3c, 0x74, 0x0f, 0xa6,
-	0x23, 0x31, 0x13, 0x5e, 0x52, 0x6b, 0x44, 0x21, 0x21, 0x87, 0x9b, 0x31, 0x06, 0xda, 0x4d, 0x30,
-	0xc8, 0x8a, 0x68, 0x5f, 0xca, 0x64, 0x90, 0xc5, 0xc8, 0x39, 0x1c, 0xea, 0x30, 0xc5, 0x18, 0x1a,
-	0x0e, 0x28, 0x13, 0x35, 0x53, 0x8c, 0xc3, 0x28, 0xb5, 0x13, 0x47, 0xa9, 0x13, 0xb9, 0x51, 0x6a,
-	0x8c, 0x64, 0x1c, 0xc5, 0x75, 0xa4, 0x71, 0x6c, 0x6b, 0xa4, 0xd3, 0xa6, 0x4e, 0x2b, 0x15, 0xf6,
-	0x6c, 0x1f, 0xb4, 0x47, 0x5a, 0x6c, 0x4a, 0xbd, 0xc4, 0x38, 0xa5, 0x17, 0xd5, 0x92, 0x7a, 0x31,
-	0xfa, 0x7e, 0xff, 0xaf, 0xc6, 0x96, 0x94, 0x6e, 0xe2, 0xa1, 0x6f, 0xc3, 0x24, 0x33, 0xaf, 0xe8,
-	0x9a, 0xc7, 0xb5, 0xca, 0x68, 0xd9, 0xe7, 0x75, 0xf7, 0x2b, 0x00, 0xec, 0x84, 0xc3, 0xe1, 0x80,
-	0xf0, 0xdb, 0x97, 0x20, 0x65, 0xee, 0xbf, 0x07, 0x8d, 0x9d, 0x1e, 0x19, 0xf4, 0x08, 0x11, 0x0f,
-	0xb3, 0x0e, 0x53, 0x6e, 0xaa, 0x94, 0xe5, 0x7a, 0x63, 0x07, 0x25, 0x4d, 0x98, 0xb4, 0x93, 0x36,
-	0xc6, 0x87, 0xc6, 0x2e, 0xcc, 0x25, 0x8f, 0x62, 0x79, 0x55, 0x67, 0x9c, 0xbc, 0x4a, 0xa2, 0xf8,
-	0x0f, 0x1a, 0xa0, 0x83, 0x21, 0x2f, 0xf7, 0xc6, 0x3a, 0xfb, 0x92, 0xa8, 0x0e, 0xbd, 0xf5, 0x50,
-	0x7e, 0x07, 0xf9, 0x28, 0xcc, 0x80, 0x07, 0xc3, 0x00, 0x77, 0xdb, 0xb8, 0xe3, 0x3a, 0x5d, 0x42,
-	0x9f, 0x85, 0xba, 0x99, 0x80, 0x19, 0xfb, 0xb0, 0xa0, 0x50, 0x5a, 0x8e, 0xe9, 0x9f, 0x69, 0xd0,
-	0xdc, 0xb6, 0x9c, 0x0e, 0xee, 0xbf, 0x7a, 0xd6, 0x8d, 0x43, 0xb8, 0x91, 0x43, 0x4b, 0x39, 0xe6,
-	0x4e, 0x61, 0x56, 0xec, 0xf4, 0x32, 0x15, 0x70, 0x0b, 0xea, 0xd2, 0x39, 0xe5, 0x68, 0xed, 0x03,
-	0x4a, 0xf1, 0xfe, 0x32, 0x29, 0xde, 0x87, 0x05, 0xe5, 0xb4, 0x72, 0x74, 0xff, 0x5e, 0x83, 0x1b,
-	0xed, 0x84, 0x7b, 0x3b, 0xec, 0x75, 0xce, 0x1c, 0x6b, 0x80, 0xb9, 0x6b, 0xb6, 0x93, 0xae, 0xd9,
-	0x8e, 0x5d, 0xb3, 0xc3, 0x11, 0x23, 0xd7, 0x1c, 0x8d, 0x13, 0x5c, 0x57, 0x8b, 0xb9, 0x9e, 0x50,
-	0xb9, 0x8e, 0xb5, 0xab, 0x96, 0xd0, 0xae, 0x23, 0xd0, 0xf3, 0x08, 0x2d, 0x57, 0x88, 0xf1, 0x69,
-	0xe1, 0x9e, 0x65, 0x42, 0xed, 0xa1, 0xc7, 0x2b, 0x99, 0x51, 0x1a, 0x96, 0x22, 0x54, 0x2b, 0x22,
-	0xb4, 0x92, 0xf0, 0x00, 0x05, 0xec, 0x1b, 0x3f, 0x67, 0x05, 0xfb, 0xec, 0x43, 0x4b, 0xdd, 0xe0,
-	0xa5, 0x92, 0xb0, 0x0b, 0xfa, 0x26, 0xc7, 0x74, 0x7c, 0x61, 0x7d, 0xaa, 0x5f, 0xb0, 0x57, 0x55,
-	0x39, 0xb9, 0x9c, 0x08, 0x3e, 0x8f, 0x6e, 0xd5, 0x7f, 0x2b, 0xb0, 0x98, 0xd4, 0x2f, 0xa9, 0x84,
-	0x94, 0x63, 0x04, 0x25, 0x34, 0x60, 0x0c, 0x03, 0x78, 0x4b, 0x32, 0xad, 0x1a, 0x8f, 0xcc, 0x6d,
-	0xd7, 0xb5, 0xfb, 0x98, 0xf5, 0x95, 0x4f, 0x86, 0xa7, 0xeb, 0xed, 0xc0, 0xef, 0x39, 0xf6, 0x77,
-	0xad, 0xfe, 0x10, 0x4b, 0x86, 0x77, 0x1f, 0x26, 0x4f, 0xad, 0x0e, 0x7e, 0xcf, 0x7c, 0x42, 0xf3,
-	0xd6, 0x51, 0x0b, 0x23, 0x64, 0xf4, 0x75, 0x98, 0xf6, 0x45, 0xeb, 0x78, 0x92, 0xae, 0xbc, 0xa9,
-	0xac, 0x6c, 0x39, 0xc1, 0x9b, 0x9b, 0x6c, 0x61, 0x8c, 0x8d, 0xee, 0x42, 0x05, 0xbf, 0x68, 0x4e,
-	0x8d, 0x71, 0x5a, 0x05, 0xbf, 0x30, 0x1e, 0xc3, 0x52, 0x96, 0x8c, 0xcb, 0xd9, 0xef, 0x79, 0x5c,
-	0x47, 0x7b, 0x70, 0x42, 0x02, 0xdf, 0xea, 0x04, 0xa3, 0xaf, 0x4c, 0xbe, 0x9a, 0x4a, 0xf1, 0xd5,
-	0x54, 0x95, 0xab, 0x31, 0xfe, 0xac, 0x41, 0x33, 0xfb, 0xcc, 0x72, 0x5d, 0xa5, 0xbb, 0xfc, 0xbb,
-	0x04, 0x11, 0xab, 0x9d, 0x60, 0x9f, 0x17, 0xaa, 0xd4, 0x09, 0xf4, 0x55, 0x58, 0xb0, 0x93, 0x15,
-	0xd9, 0x7d, 0x8b, 0x7c, 0x40, 0xe9, 0x9c, 0x30, 0xb3, 0xa6, 0x8c, 0x73, 0x68, 0x30, 0x2d, 0x27,
-	0xbb, 0x2f, 0x62, 0xbf, 0x66, 0xab, 0x96, 0x2d, 0x17, 0x00, 0x2e, 0x27, 0xa2, 0x7f, 0x6a, 0x3c,
-	0xdb, 0x13, 0x67, 0x96, 0x13, 0xcd, 0x43, 0x00, 0xbe, 0xc3, 0x81, 0xe5, 0xf1, 0x56, 0xdb, 0x97,
-	0xe5, 0x2f, 0x13, 0xa4, 0xfd, 0xd7, 0x63, 0xcc, 0x5d, 0x27, 0xf0, 0x3f, 0x34, 0xa5, 0xa5, 0xfa,
-	0x37, 0xa1, 0x91, 0x9a, 0x46, 0x73, 0x50, 0x3d, 0xc3, 0x1f, 0x72, 0xd5, 0x08, 0xff, 0x86, 0x59,
-	0xfc, 0xf3, 0x50, 0x4b, 0x29, 0xc3, 0x53, 0x26, 0x1b, 0xbc, 0x5d, 0x79, 0x4b, 0x33, 0x1c, 0x98,
-	0xa3, 0xbc, 0x93, 0x56, 0xa2, 0x3f, 0x95, 0xa3, 0x5e, 0x2b, 0x00, 0xc3, 0x74, 0x3d, 0x54, 0x82,
-	0x8c, 0x21, 0xbf, 0x7f, 0x69, 0x30, 0x9f, 0x3a, 0xb0, 0x9c, 0x00, 0xf7, 0x33, 0x04, 0xb8, 0xc6,
-	0x97, 0x28, 0x07, 0xbc, 0x44, 0x09, 0x6e, 0x7e, 0x82, 0x80, 0x7d, 0x6a, 0x83, 0xbe, 0x01, 0x33,
-	0x9d, 0xf8, 0x93, 0x0c, 0xb4, 0x18, 0x31, 0x90, 0xf8, 0x2e, 0x45, 0x5f, 0xca, 0x02, 0x13, 0x0f,
-	0xdd, 0x87, 0xe9, 0x1f, 0x46, 0x7d, 0x35, 0xb4, 0xc0, 0x91, 0xe4, 0xbe, 0xa1, 0x7e, 0x4d, 0x05,
-	0xb2, 0x75, 0xe7, 0x51, 0xd3, 0x46, 0xac, 0x93, 0xdb, 0x45, 0x62, 0x5d, 0xb2, 0xb7, 0xb3, 0x05,
-	0x75, 0x5b, 0xfe, 0x94, 0x02, 0x5d, 0x8f, 0xd4, 0x2f, 0xf5, 0x55, 0x87, 0xde, 0xcc, 0x9e, 0x20,
-	0x1e, 0x7a, 0x17, 0x66, 0x89, 0xf4, 0x8d, 0x01, 0x8a, 0x78, 0x4b, 0x7d, 0x17, 0xa1, 0x5f, 0xcf,
-	0x84, 0x13, 0x0f, 0xfd, 0x00, 0xae, 0xdb, 0xd9, 0x0d, 0x7e, 0xf4, 0x5a, 0xea, 0x54, 0xb5, 0xc1,
-	0xae, 0x1b, 0xa3, 0x50, 0x88, 0x87, 0x4e, 0xe1, 0x86, 0x9d, 0xd7, 0x2d, 0x47, 0xaf, 0xc7, 0x1b,
-	0xe4, 0xb6, 0xf1, 0xf5, 0x3b, 0xa3, 0x91, 0x88, 0x87, 0x8e, 0x01, 0x05, 0x4a, 0xcb, 0x18, 0x2d,
-	0xf3, 0xb5, 0x99, 0xed, 0x70, 0xfd, 0x56, 0xc1, 0x2c, 0xf1, 0x50, 0x07, 0x9a, 0x76, 0x4e, 0x27,
-	0x11, 0x19, 0x89, 0xaf, 0x98, 0x32, 0xbb, 0xa8, 0xfa, 0xeb, 0x23, 0x71, 0x18, 0xdd, 0xb6, 0xd2,
-	0x0a, 0x13, 0x74, 0x67, 0x76, 0xf2, 0x04, 0xdd, 0x39, 0x3d, 0xb4, 0xa7, 0xb0, 0x60, 0xab, 0x1d,
-	0x20, 0x94, 0xbd, 0x4a, 0x68, 0xd9, 0x4a, 0xd1, 0x34, 0x35, 0xf8, 0xc6, 0x59, 0xb2, 0xa5, 0x81,
-	0xa2, 0x4f, 0xb9, 0xd4, 0xce, 0x8e, 0xae, 0xe7, 0x4d, 0x09, 0x96, 0x53, 0x3d, 0x02, 0x99, 0x65,
-	0xb5, 0x6d, 0x21, 0xb3, 0x9c, 0xd5, 0x5c, 0x38, 0x84, 0xf9, 0x5e, 0xba, 0xf6, 0x8b, 0x6e, 0x46,
-	0x95, 0xee, 0x8c, 0x3e, 0x80, 0xbe, 0x9c, 0x3f, 0xc9, 0x48, 0x54, 0xf6, 0x23, 0x28, 0x77, 0x0d,
-	0x91, 0x49, 0xcc, 0x29, 0x42, 0x1f, 0xc2, 0xbc, 0x9d, 0x2e, 0xd9, 0x0a, 0x12, 0xb3, 0x6a, 0xcd,
-	0xfa, 0x72, 0xfe, 0x24, 0xf3, 0x3b, 0xc2, 0x21, 0x08, 0xbf, 0x23, 0xd7, 0x36, 0x85, 0xdf, 0x49,
-	0xd6, 0x0f, 0x8f, 0x01, 0xa9, 0x05, 0xa7, 0x1c, 0x85, 0xe3, 0x35, 0xb4, 0x1c, 0x85, 0x13, 0x95,
-	0xaa, 0x77, 0x61, 0x56, 0x2e, 0xb1, 0x08, 0x37, 0x94, 0x2a, 0xf1, 0x08, 0x37, 0xa4, 0xd4, 0x63,
-	0xf6, 0xa1, 0x91, 0x4a, 0xea, 0x85, 0x6e, 0xa9, 0x85, 0x07, 0xa1, 0x5b, 0x59, 0x75, 0x80, 0xf7,
-	0x61, 0x31, 0xb3, 0x48, 0x80, 0x6e, 0x47, 0x6e, 0x3f, 0xa7, 0x9c, 0xa1, 0xaf, 0x16, 0x23, 0x30,
-	0x89, 0x0b, 0xb0, 0x90, 0xb8, 0x9c, 0x90, 0x0b, 0x89, 0x27, 0xf3, 0xe6, 0x7d, 0x68, 0xa4, 0x36,
-	0x15, 0xdc, 0xa9, 0x49, 0xbd, 0xe0, 0x2e, 0x2b, 0x03, 0xff, 0x7e, 0x3a, 0xc0, 0x8d, 0x92, 0x54,
-	0xb4, 0x9a, 0xf2, 0xf0, 0x4a, 0xb2, 0xad, 0xbf, 0x36, 0x02, 0x83, 0xbd, 0x06, 0x39, 0xd9, 0xa3,
-	0xfc, 0x1a, 0xe4, 0xa4, 0xb4, 0xf2, 0x6b, 0x90, 0x9b, 0x80, 0x32, 0xe5, 0x4b, 0xe5, 0x65, 0xb2,
-	0xf2, 0xa9, 0xc9, 0xa2, 0xac, 0x7c, 0x59, 0x09, 0xdd, 0x31, 0x20, 0x35, 0xe8, 0x17, 0x5b, 0x66,
-	0xe6, 0x5c, 0x62, 0xcb, 0x9c, 0x6c, 0xe1, 0x7b, 0x52, 0xf3, 0x47, 0x0a, 0xc3, 0x51, 0xda, 0x45,
-	0xa6, 0xf2, 0x02, 0xfd, 0x76, 0xe1, 0x3c, 0x33, 0x14, 0x39, 0xb8, 0x14, 0x86, 0x92, 0x8a, 0xa2,
-	0x85, 0xa1, 0x28, 0x91, 0xee, 0x16, 0xd4, 0x13, 0xc1, 0x95, 0x08, 0x1a, 0xd2, 0x41, 0xa4, 0x08,
-	0x1a, 0x94, 0x58, 0x6c, 0xeb, 0xf6, 0xfb, 0xb7, 0x8e, 0x3c, 0xec, 0x3c, 0x6b, 0x1d, 0x48, 0x9f,
-	0x18, 0x53, 0xe4, 0x77, 0xe8, 0xef, 0xc9, 0x15, 0x0a, 0x7a, 0xf3, 0xd3, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x7a, 0x7b, 0x02, 0xb3, 0xd5, 0x2c, 0x00, 0x00,
+func init() { proto.RegisterFile("group/group.proto", fileDescriptor_group_82343494531cedb3) }
+
+var fileDescriptor_group_82343494531cedb3 = []byte{
+	// 3879 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x4d, 0x6c, 0x1c, 0x49,
+	0xd5, 0xea, 0xf9, 0xf1, 0xcf, 0x73, 0x1c, 0xdb, 0xe5, 0xbf, 0x49, 0xc7, 0x76, 0xbc, 0xbd, 0xf9,
+	0xb2, 0x51, 0xb4, 0xeb, 0x7c, 0x5f, 0x56, 0x5a, 0xed, 0xb7, 0xfb, 0x7d, 0xb0, 0x89, 0xe3, 0x24,
+	0x4e, 0x62, 0x9b, 0xb4, 0xb3, 0x20, 0xad, 0x84, 0x42, 0x7b, 0xa6, 0x3c, 0xdb, 0xf1, 0x4c, 0x77,
+	0xbb, 0xab, 0xc7, 0x8e, 0xf7, 0xb2, 0x5a, 0x2d, 0x42, 0x80, 0x90, 0x10, 0x20, 0xc1, 0x65, 0x11,
+	0x82, 0x0b, 0x08, 0x01, 0xcb, 0x61, 0x41, 0x42, 0x1c, 0x38, 0x72, 0x42, 0x5c, 0xb8, 0xec, 0x1e,
+	0xb9, 0x71, 0xe2, 0xc2, 0x1d, 0x54, 0x3f, 0xdd, 0x5d, 0xd5, 0x7f, 0x33, 0xe9, 0x89, 0xb3, 0x17,
+	0x6b, 0xde, 0xab, 0xd7, 0x55, 0xef, 0xaf, 0x5e, 0xd5, 0xab, 0x7a, 0x65, 0x98, 0x69, 0xfb, 0x6e,
+	0xcf, 0xbb, 0xca, 0xfe, 0xae, 0x79, 0xbe, 0x1b, 0xb8, 0xa8, 0xce, 0x00, 0xfd, 0xf2, 0x8e, 0x87,
+	0x9d, 0x57, 0x36, 0xb7, 0x5e, 0xd9, 0xc5, 0xfe, 0x11, 0xf6, 0xaf, 0x7a, 0x07, 0xed, 0xab, 0x8c,
+	0xe0, 0x2a, 0x69, 0x1d, 0x3c, 0x3a, 0x26, 0x57, 0x8f, 0x09, 0xff, 0x40, 0x5f, 0xeb, 0x4b, 0xe9,
+	0x5b, 0x9e, 0x87, 0x7d, 0x41, 0x6f, 0x7c, 0x01, 0x60, 0xdd, 0xed, 0x76, 0x5d, 0xc7, 0xc4, 0xc4,
+	0x43, 0x0d, 0x18, 0xdd, 0xf0, 0xfd, 0x75, 0xb7, 0x85, 0x1b, 0xda, 0xaa, 0x76, 0xb9, 0x6e, 0x86,
+	0x20, 0x5a, 0x80, 0x91, 0x0d, 0xdf, 0xdf, 0x22, 0xed, 0x46, 0x65, 0x55, 0xbb, 0x3c, 0x6e, 0x0a,
+	0xc8, 0xb8, 0x0b, 0xe8, 0x36, 0x65, 0xf1, 0x7a, 0xab, 0xb5, 0x85, 0xbb, 0x7b, 0xd8, 0xdf, 0x74,
+	0xf6, 0x5d, 0x4a, 0xfd, 0x36, 0xc1, 0xfe, 0xe6, 0x4d, 0xd6, 0xcd, 0xb8, 0x29, 0x20, 0xb4, 0x04,
+	0xe3, 0xa6, 0xdb, 0xc1, 0xf7, 0xf1, 0x11, 0xee, 0xb0, 0x8e, 0xea, 0x66, 0x8c, 0x30, 0xfe, 0xa9,
+	0xc1, 0xd9, 0x75, 0x1f, 0x5b, 0x01, 0x66, 0x5d, 0x9a, 0xf8, 0x10, 0x5d, 0x87, 0xb3, 0x9b, 0x8e,
+	0x1d, 0xf0, 0xae, 0xef, 0xdb, 0x24, 0x68, 0x68, 0xab, 0xd5, 0xcb, 0x13, 0xd7, 0xce, 0xad, 0x71,
+	0x2d, 0xa5, 0xc7, 0x36, 0x13, 0x1f, 0xa0, 0x37, 0x60, 0x9c, 0x51, 0xd1, 0x46, 0x36, 0xe6, 0xc4,
+	0xb5, 0xa5, 0x35, 0xc2, 0xb4, 0xf3, 0xc8, 0xf2, 0xec, 0x47, 0x9e, 0xe5, 0x5b, 0x5d, 0xb2, 0x16,
+	0xd1, 0x98, 0x31, 0x39, 0x5a, 0x85, 0x89, 0x1d, 0x0f, 0xfb, 0x56, 0x60, 0xbb, 0xce, 0xe6, 0xcd,
+	0x46, 0x95, 0x09, 0x23, 0xa3, 0x90, 0x0e, 0x63, 0x3b, 0x9e, 0x90, 0xb5, 0xc6, 0x9a, 0x23, 0x98,
+	0x7d, 0x7d, 0xec, 0x60, 0x5f, 0x34, 0xd7, 0xc5, 0xd7, 0x31, 0xca, 0x78, 0x1f, 0xa6, 0x14, 0x81,
+	0xcb, 0x98, 0x40, 0x15, 0xb0, 0xfa, 0x54, 0x02, 0x1a, 0x3e, 0x4c, 0xdf, 0xc6, 0x01, 0x83, 0x09,
+	0x6b, 0xc3, 0x87, 0x94, 0x6d, 0x4e, 0x70, 0x33, 0x52, 0xf8, 0xb8, 0x29, 0xa3, 0x92, 0x6a, 0xa9,
+	0x14, 0xab, 0xa5, 0xaa, 0xaa, 0xc5, 0xf8, 0x96, 0x06, 0x33, 0x89, 0x41, 0x4b, 0xc9, 0x7d, 0x03,
+	0x26, 0x23, 0x41, 0x18, 0xa7, 0xd5, 0xd5, 0x6a, 0x5f, 0xd9, 0xd5, 0x4f, 0x8c, 0x1f, 0x6b, 0x30,
+	0xb5, 0x8b, 0x83, 0x08, 0x49, 0xe5, 0xbf, 0x0f, 0x53, 0xed, 0x10, 0xbe, 0xe5, 0xfa, 0xbb, 0x38,
+	0x60, 0x1c, 0x4d, 0x5c, 0x33, 0x8a, 0x7a, 0xe6, 0x94, 0x66, 0xf2, 0x53, 0x45, 0x13, 0x95, 0x0c,
+	0x07, 0x29, 0x74, 0x2f, 0x63, 0x03, 0xa6, 0x55, 0xf6, 0x88, 0x87, 0xfe, 0x47, 0x9e, 0xb2, 0x82,
+	0xb5, 0x19, 0x31, 0x1f, 0xe2, 0x06, 0x53, 0x22, 0x32, 0xde, 0x03, 0x3d, 0xd4, 0xf8, 0x75, 0xcf,
+	0xeb, 0xd8, 0x4d, 0xd6, 0x3f, 0xd5, 0x00, 0x15, 0x58, 0x66, 0x51, 0x2b, 0x66, 0x31, 0xc3, 0xd4,
+	0x2b, 0x00, 0xb7, 0x7c, 0xb7, 0xab, 0x18, 0x5b, 0xc2, 0x18, 0x1f, 0x69, 0x70, 0x3e, 0x77, 0xf0,
+	0x52, 0x86, 0xbf, 0x07, 0xd3, 0x61, 0x80, 0xe8, 0x61, 0x12, 0x48, 0xb6, 0xbf, 0x90, 0x67, 0x21,
+	0x41, 0x6a, 0xa6, 0x3e, 0x34, 0x02, 0x58, 0xba, 0x8d, 0x03, 0xca, 0xab, 0x89, 0x0f, 0x33, 0x94,
+	0x93, 0x17, 0xca, 0x86, 0xb3, 0xeb, 0x4f, 0x34, 0x58, 0x2e, 0x18, 0xb6, 0x94, 0x95, 0x33, 0xf5,
+	0x52, 0x29, 0xab, 0x97, 0x3f, 0x69, 0x30, 0xff, 0xd0, 0xb7, 0x1c, 0xb2, 0x8f, 0x7d, 0xd6, 0xc8,
+	0xe2, 0x16, 0xd5, 0x48, 0x03, 0x46, 0x45, 0x30, 0x10, 0x2a, 0x09, 0x41, 0x74, 0x09, 0xce, 0xee,
+	0x74, 0x5a, 0x72, 0xcc, 0xe3, 0x9a, 0x49, 0x60, 0x29, 0xdd, 0x36, 0x3e, 0x96, 0xe9, 0xb8, 0x8a,
+	0x12, 0xd8, 0xa4, 0x1e, 0x6b, 0xc5, 0x71, 0xa6, 0x9e, 0x88, 0x33, 0xf7, 0x60, 0x21, 0x4b, 0x80,
+	0x72, 0x33, 0xe8, 0x93, 0x0a, 0x9c, 0xb9, 0xeb, 0xda, 0x4e, 0xb4, 0x32, 0xe5, 0x6b, 0x61, 0x05,
+	0xc0, 0xc4, 0x87, 0x5b, 0x98, 0x10, 0xab, 0x8d, 0x85, 0x06, 0x24, 0x4c, 0x51, 0x6c, 0x1c, 0x40,
+	0xe2, 0x15, 0x00, 0xca, 0xc7, 0xae, 0xdb, 0xf3, 0x9b, 0x98, 0xc9, 0x5c, 0x37, 0x25, 0x0c, 0xba,
+	0x08, 0x93, 0x9b, 0xce, 0x91, 0x1d, 0x44, 0xaa, 0x1d, 0x61, 0x7d, 0xa8, 0x48, 0x3a, 0x0e, 0x47,
+	0x3c, 0x74, 0x0f, 0xb0, 0xd3, 0x18, 0xe5, 0xe3, 0x48, 0x28, 0x74, 0x13, 0x26, 0x68, 0xaf, 0xd7,
+	0x1d, 0x72, 0x8c, 0x7d, 0xd2, 0x18, 0x5b, 0xad, 0x16, 0x45, 0xc0, 0x98, 0xd4, 0x94, 0x3f, 0x33,
+	0x6e, 0xc0, 0xa4, 0xa4, 0xb5, 0x72, 0xaa, 0xff, 0x94, 0x06, 0x90, 0x44, 0xf4, 0xa0, 0x0d, 0xae,
+	0x43, 0xb0, 0x58, 0xaf, 0x64, 0x9d, 0x69, 0xc5, 0x5e, 0x92, 0x9c, 0xab, 0x92, 0x1d, 0xab, 0x29,
+	0x3b, 0x4a, 0x81, 0xad, 0x96, 0x0c, 0x6c, 0xb4, 0xfd, 0x8e, 0xe5, 0xb4, 0x3a, 0xb8, 0x45, 0x43,
+	0x14, 0xf7, 0x3e, 0x09, 0x83, 0x0c, 0x38, 0xc3, 0x21, 0x13, 0x93, 0x5e, 0x27, 0x60, 0x86, 0xa8,
+	0x9b, 0x0a, 0xce, 0x78, 0x00, 0x4b, 0xf9, 0xa2, 0x95, 0x53, 0xd7, 0x3e, 0x9c, 0x79, 0xd0, 0xb3,
+	0x83, 0x01, 0x1c, 0x75, 0xb8, 0x65, 0xfc, 0x06, 0x4c, 0x4a, 0xe3, 0x94, 0xe3, 0xf5, 0xa7, 0x1a,
+	0xcc, 0x87, 0x6b, 0x43, 0xbc, 0x65, 0x2b, 0xe6, 0x7a, 0xa8, 0xc0, 0x4b, 0xc3, 0xf9, 0x2d, 0xbb,
+	0x13, 0x60, 0x9f, 0x19, 0xb4, 0x6e, 0x0a, 0x88, 0x8e, 0xb7, 0x8d, 0x9f, 0x04, 0xbb, 0xf8, 0x50,
+	0xcc, 0xa9, 0x10, 0x34, 0x7e, 0xa5, 0xc1, 0x42, 0x16, 0x8f, 0xa5, 0x96, 0xae, 0x5b, 0x00, 0xdd,
+	0xa8, 0x0f, 0xb1, 0x68, 0x5d, 0xca, 0x9b, 0x54, 0x7c, 0xb4, 0x5b, 0xbd, 0x4e, 0x87, 0xad, 0xfd,
+	0xd2, 0x97, 0x74, 0x64, 0x47, 0xb0, 0xcb, 0xe5, 0x08, 0x41, 0xe3, 0x97, 0x29, 0x76, 0xa3, 0x8d,
+	0x5d, 0x61, 0xc8, 0x92, 0xd8, 0xaa, 0xb0, 0x1d, 0x9f, 0x3c, 0xdc, 0x70, 0x21, 0x8b, 0x32, 0xeb,
+	0xae, 0x5b, 0xcd, 0x77, 0x79, 0xbc, 0x1a, 0x33, 0x43, 0xd0, 0xf8, 0x81, 0x06, 0x8b, 0x99, 0xcc,
+	0x7e, 0x9e, 0xca, 0x35, 0x7e, 0xab, 0x01, 0xba, 0x67, 0x37, 0x0f, 0x24, 0xba, 0x62, 0xf5, 0x5d,
+	0x81, 0x69, 0x4a, 0x8f, 0x5b, 0x5c, 0x25, 0x92, 0x12, 0x53, 0x78, 0xca, 0xbc, 0x89, 0x2d, 0xe2,
+	0x3a, 0x42, 0x91, 0x02, 0x4a, 0xaa, 0xb1, 0x5e, 0x3c, 0x19, 0x47, 0x12, 0x93, 0xf1, 0x4d, 0x18,
+	0xdf, 0x6c, 0x5d, 0xe3, 0x41, 0x25, 0x77, 0xcb, 0xc2, 0x86, 0xa6, 0x14, 0x22, 0xf5, 0x12, 0x90,
+	0xf1, 0x3e, 0xcc, 0xa6, 0xc4, 0x2d, 0x65, 0x80, 0xd7, 0x60, 0x32, 0xe2, 0x42, 0xb2, 0xc1, 0xb4,
+	0x08, 0x02, 0x51, 0x9b, 0xa9, 0x92, 0x19, 0x3d, 0x16, 0x05, 0xe8, 0x42, 0x81, 0x5b, 0x8c, 0x8b,
+	0x30, 0x0a, 0xa8, 0x21, 0x58, 0x4b, 0x85, 0xe0, 0x55, 0x98, 0x70, 0xd3, 0x11, 0xcc, 0x1d, 0x30,
+	0x82, 0x7d, 0x83, 0x4f, 0x95, 0xd4, 0xb8, 0x43, 0x65, 0x61, 0x03, 0x67, 0x22, 0x31, 0xb9, 0xf1,
+	0x89, 0x06, 0x73, 0x7c, 0xed, 0xa5, 0x9c, 0x3d, 0x74, 0xa3, 0xd8, 0xdd, 0x3f, 0x42, 0xe7, 0x2f,
+	0x5f, 0xb1, 0xa3, 0xd5, 0x14, 0x47, 0x7b, 0x19, 0x66, 0xf8, 0x58, 0xb2, 0xb7, 0xd6, 0x99, 0xb7,
+	0xa6, 0x1b, 0x0a, 0x9d, 0xee, 0x03, 0x0d, 0xe6, 0x33, 0xd8, 0x7e, 0xae, 0xae, 0xf3, 0xbb, 0x2c,
+	0x1e, 0xc8, 0x60, 0xdb, 0x82, 0x55, 0x98, 0x68, 0x4b, 0x89, 0x2e, 0x9f, 0xb1, 0x32, 0x2a, 0x77,
+	0xb2, 0x5e, 0x84, 0x49, 0x5b, 0x56, 0x95, 0x50, 0xb1, 0x8a, 0x2c, 0xdc, 0x9c, 0xde, 0x85, 0x85,
+	0x2c, 0xb6, 0x4b, 0x9d, 0xc1, 0x7c, 0xa4, 0xc1, 0x5c, 0x94, 0x61, 0x75, 0x3a, 0x83, 0x44, 0xac,
+	0xa1, 0x17, 0xd1, 0x9d, 0xfd, 0x7d, 0x82, 0x83, 0x70, 0x11, 0xe5, 0x10, 0x9a, 0x83, 0xfa, 0xba,
+	0xdb, 0x73, 0x02, 0xb1, 0x84, 0x72, 0xc0, 0xf8, 0x9e, 0xb4, 0xc8, 0x4b, 0xec, 0x7d, 0xae, 0x21,
+	0xfe, 0x67, 0x1a, 0x8c, 0xad, 0x6f, 0xed, 0x32, 0x32, 0xf5, 0x00, 0x45, 0x7b, 0xba, 0x13, 0xa2,
+	0x35, 0x40, 0x71, 0x72, 0x41, 0x15, 0xb8, 0x6d, 0x75, 0xc3, 0x4d, 0x7f, 0x46, 0x0b, 0x5d, 0x2a,
+	0x54, 0x6c, 0xa4, 0xe1, 0x14, 0xde, 0xf8, 0x8d, 0x06, 0x67, 0xa2, 0x83, 0x12, 0x6a, 0xcf, 0x9b,
+	0x00, 0x5f, 0xb2, 0xda, 0xb6, 0xc3, 0xec, 0x20, 0x38, 0xbd, 0x98, 0xc1, 0xa9, 0xc8, 0xe3, 0x62,
+	0x5a, 0x53, 0xfa, 0x8e, 0x1e, 0xc2, 0xb1, 0x2e, 0x25, 0x4e, 0x63, 0x44, 0x41, 0x40, 0xe9, 0xbb,
+	0xd0, 0x1b, 0x7f, 0xd3, 0x60, 0x52, 0x62, 0x98, 0x78, 0xe8, 0x15, 0x18, 0x0f, 0xd5, 0x4c, 0xc4,
+	0xd1, 0xdd, 0x54, 0xb8, 0x25, 0x14, 0x78, 0x33, 0xa6, 0x40, 0x1b, 0x8a, 0x80, 0xfc, 0xb0, 0xee,
+	0xbf, 0x32, 0x05, 0xe4, 0x7b, 0xe4, 0x1c, 0x09, 0x75, 0x18, 0xe3, 0x02, 0xf5, 0xba, 0x4c, 0x88,
+	0xba, 0x19, 0xc1, 0x74, 0x97, 0xda, 0x8c, 0x77, 0xa9, 0xb5, 0xdc, 0x5d, 0x6a, 0x4c, 0x64, 0xec,
+	0xc4, 0xe7, 0x55, 0x83, 0xcc, 0xad, 0xbe, 0x41, 0x9b, 0x05, 0x2d, 0xb5, 0x47, 0xb2, 0xbe, 0xb5,
+	0xdb, 0x77, 0xc6, 0x26, 0xdc, 0x2b, 0x82, 0x13, 0x7e, 0x51, 0x2d, 0xe9, 0x17, 0xfd, 0xed, 0xfb,
+	0xaf, 0xf4, 0xde, 0x92, 0xf1, 0x4d, 0x3c, 0xf4, 0x16, 0x8c, 0xf2, 0xe9, 0x15, 0x9a, 0x79, 0xd0,
+	0x59, 0x19, 0x7e, 0xf6, 0xac, 0x6c, 0xbf, 0x02, 0xc0, 0x47, 0xd8, 0xee, 0x75, 0x89, 0xb0, 0xbe,
+	0x84, 0x29, 0x63, 0x7f, 0x1b, 0xa6, 0x6e, 0xda, 0xa4, 0x6b, 0x13, 0x12, 0x2d, 0xcc, 0x3a, 0x8c,
+	0xb9, 0x89, 0x23, 0x33, 0xd7, 0x1b, 0x78, 0x53, 0xd2, 0x80, 0xd1, 0xb6, 0x3a, 0xc7, 0x04, 0x48,
+	0xcf, 0xfb, 0xd4, 0xa1, 0x78, 0x5e, 0xd5, 0x1c, 0x24, 0xaf, 0x92, 0x38, 0xfe, 0x85, 0x06, 0x68,
+	0xab, 0x27, 0x8e, 0x95, 0x63, 0x9f, 0x3d, 0x25, 0xae, 0x69, 0xb4, 0xee, 0xc9, 0xeb, 0xa0, 0x80,
+	0x68, 0x06, 0xdc, 0xed, 0x05, 0xb8, 0xb5, 0x8b, 0x9b, 0xae, 0xd3, 0x22, 0x6c, 0x59, 0x98, 0x34,
+	0x15, 0x9c, 0x71, 0x07, 0x66, 0x53, 0x9c, 0x96, 0x13, 0xfa, 0xdb, 0x1a, 0x34, 0xd6, 0x2d, 0xa7,
+	0x89, 0x3b, 0x9f, 0xbf, 0xe8, 0xc6, 0x36, 0x9c, 0xcb, 0xe1, 0xa5, 0x9c, 0x70, 0xfb, 0x70, 0x26,
+	0xea, 0xe9, 0x34, 0x1d, 0xf0, 0x06, 0x4c, 0x4a, 0xe3, 0x94, 0xe3, 0xb5, 0x03, 0x28, 0x21, 0xfb,
+	0x69, 0x72, 0x7c, 0x07, 0x66, 0x53, 0xa3, 0x95, 0xe3, 0xfb, 0xe7, 0x1a, 0x9c, 0xdb, 0x55, 0xc2,
+	0xdb, 0xb6, 0xdd, 0x3c, 0x70, 0xac, 0x2e, 0x16, 0xa1, 0xb9, 0xad, 0x86, 0xe6, 0x76, 0x1c, 0x9a,
+	0x1d, 0x41, 0x18, 0x86, 0xe6, 0x10, 0x56, 0xa4, 0xae, 0x16, 0x4b, 0x5d, 0x4b, 0x4b, 0x1d, 0x7b,
+	0x57, 0x5d, 0xf1, 0xae, 0x1d, 0xd0, 0xf3, 0x18, 0x2d, 0x77, 0x10, 0xe3, 0x83, 0x1e, 0x65, 0x42,
+	0xbb, 0x3d, 0x4f, 0x9c, 0x98, 0x86, 0x69, 0x58, 0x82, 0x51, 0xad, 0x88, 0xd1, 0x8a, 0x12, 0x01,
+	0x0a, 0xc4, 0x37, 0xbe, 0xc3, 0x2f, 0x06, 0xb2, 0x07, 0x2d, 0x65, 0xc1, 0xa1, 0x92, 0xb0, 0x63,
+	0xb6, 0x26, 0xc7, 0x7c, 0x3c, 0xb7, 0xfb, 0xb0, 0xef, 0xf2, 0x55, 0x35, 0x35, 0x72, 0x39, 0x15,
+	0x3c, 0x8b, 0x5b, 0xb1, 0xbf, 0x57, 0x60, 0x5e, 0xf5, 0x2f, 0xe9, 0x08, 0x29, 0x67, 0x12, 0x94,
+	0xf0, 0x80, 0x01, 0x26, 0xc0, 0xeb, 0xd2, 0xd4, 0xaa, 0x8b, 0x9d, 0x79, 0xdb, 0x75, 0xdb, 0x1d,
+	0xcc, 0xef, 0xaf, 0xf7, 0x7a, 0xfb, 0x6b, 0xbb, 0x81, 0x6f, 0x3b, 0xed, 0x2f, 0x5b, 0x9d, 0x1e,
+	0x96, 0x26, 0xde, 0x6b, 0x30, 0xba, 0x6f, 0x35, 0xf1, 0xdb, 0xe6, 0xfd, 0xc6, 0xc8, 0x00, 0x1f,
+	0x86, 0xc4, 0xe8, 0x7f, 0x61, 0xdc, 0x8f, 0xae, 0xa8, 0x47, 0xd9, 0x97, 0xe7, 0x53, 0x5f, 0x6e,
+	0x3a, 0xc1, 0xab, 0xd7, 0xf8, 0x87, 0x31, 0x35, 0x7a, 0x19, 0x2a, 0xf8, 0x49, 0x63, 0x6c, 0x80,
+	0xd1, 0x2a, 0xf8, 0x09, 0xbd, 0x9e, 0xc8, 0xd2, 0x71, 0xb9, 0xf9, 0x7b, 0x18, 0x9f, 0xa3, 0x5d,
+	0xdf, 0x23, 0x81, 0x6f, 0x35, 0x83, 0xfe, 0x26, 0x93, 0x4d, 0x53, 0x29, 0x36, 0x4d, 0x35, 0x65,
+	0x1a, 0xe3, 0xd7, 0x1a, 0x34, 0xb2, 0xc7, 0x2c, 0x25, 0x02, 0x3d, 0x97, 0x68, 0x4b, 0x01, 0xad,
+	0x47, 0xff, 0x8a, 0x83, 0xaa, 0x74, 0x03, 0xfa, 0x6f, 0x98, 0x6d, 0xab, 0x27, 0xb2, 0x77, 0x2c,
+	0xf2, 0x2e, 0xe3, 0xb3, 0x66, 0x66, 0x35, 0x19, 0x87, 0x30, 0xc5, 0xbd, 0x9c, 0x6c, 0x3c, 0x89,
+	0xe3, 0x5a, 0x3b, 0x3d, 0xb3, 0x25, 0xd4, 0x90, 0x2a, 0xfa, 0x8b, 0x06, 0xd3, 0xea, 0x98, 0xe5,
+	0x54, 0x73, 0x1b, 0x40, 0xf4, 0xb0, 0x65, 0x79, 0xe2, 0x4a, 0xef, 0x25, 0xb9, 0x02, 0x42, 0xea,
+	0x7f, 0x2d, 0xa6, 0xdc, 0x70, 0x02, 0xff, 0xc4, 0x94, 0x3e, 0xd5, 0xff, 0x1f, 0xa6, 0x12, 0xcd,
+	0x68, 0x1a, 0xaa, 0x07, 0xf8, 0x44, 0xb8, 0x06, 0xfd, 0x49, 0xb3, 0xf8, 0x23, 0xea, 0xa5, 0x4c,
+	0xe0, 0x31, 0x93, 0x03, 0x6f, 0x54, 0x5e, 0xd7, 0x0c, 0x07, 0xa6, 0x99, 0xec, 0x64, 0x53, 0xb9,
+	0x07, 0xcb, 0x71, 0xaf, 0x15, 0x80, 0x5e, 0xf2, 0x3c, 0x54, 0xc2, 0x0c, 0xa0, 0xbf, 0xbf, 0x6a,
+	0x30, 0x93, 0x18, 0xb0, 0x9c, 0x02, 0xef, 0x64, 0x28, 0xf0, 0xb2, 0xf8, 0x24, 0x35, 0xc0, 0x69,
+	0x6a, 0xf0, 0x47, 0x95, 0xd0, 0x0b, 0xd9, 0xe1, 0xcf, 0x7d, 0xdb, 0x39, 0xa0, 0xd4, 0x01, 0xbb,
+	0x85, 0xe3, 0x3d, 0x70, 0x40, 0xd6, 0x6b, 0x45, 0xd5, 0xeb, 0x45, 0x98, 0x6c, 0xfa, 0xd8, 0x0a,
+	0x5c, 0xf5, 0xfc, 0x40, 0x45, 0x52, 0xed, 0xe3, 0x27, 0x9e, 0xed, 0xe3, 0x87, 0x76, 0x17, 0xb3,
+	0xd0, 0x5a, 0x35, 0x25, 0x0c, 0xed, 0xbf, 0x6b, 0x3d, 0x79, 0x9b, 0x60, 0x12, 0x5e, 0x78, 0x08,
+	0x90, 0xfa, 0x7c, 0x8f, 0x60, 0x7e, 0x90, 0xc3, 0xef, 0xac, 0x22, 0x98, 0xda, 0xcc, 0xea, 0x05,
+	0xee, 0x75, 0xcf, 0xf3, 0xdd, 0x23, 0xcc, 0xe2, 0xe3, 0x98, 0x29, 0xa3, 0xe8, 0x3a, 0x40, 0x02,
+	0x2b, 0xe8, 0x11, 0x16, 0x08, 0xeb, 0xa6, 0x80, 0x28, 0x3f, 0x8c, 0x41, 0xce, 0xcf, 0x38, 0xe7,
+	0x27, 0xc6, 0xd0, 0xb3, 0x83, 0x86, 0x54, 0x0b, 0x13, 0xeb, 0xa7, 0xd8, 0xc9, 0x2e, 0xc2, 0x24,
+	0x17, 0x2a, 0xcc, 0x31, 0x2a, 0xac, 0x67, 0x15, 0x29, 0x0b, 0x5b, 0x55, 0x85, 0x4d, 0x08, 0x54,
+	0x4b, 0x0b, 0x24, 0x87, 0x80, 0x7a, 0x71, 0x08, 0x18, 0x49, 0xbb, 0xf0, 0x7b, 0x70, 0x2e, 0x47,
+	0xaa, 0x72, 0x9e, 0x7c, 0x05, 0x6a, 0x1d, 0xdb, 0x39, 0x10, 0xf9, 0xf1, 0x82, 0x12, 0x04, 0xe2,
+	0xce, 0x19, 0x8d, 0xe1, 0xc5, 0xd9, 0x7a, 0xdc, 0x46, 0x4e, 0x73, 0x4d, 0x78, 0x0f, 0x16, 0x33,
+	0x47, 0x2c, 0xbb, 0x22, 0xd4, 0xa9, 0x1c, 0x44, 0x4c, 0xd8, 0x3c, 0x61, 0x39, 0x91, 0xf1, 0x4d,
+	0x0d, 0x1a, 0x26, 0x3e, 0x72, 0x0f, 0x9e, 0xce, 0x81, 0xa2, 0xd9, 0x57, 0x91, 0x67, 0xdf, 0x50,
+	0xbb, 0x16, 0x9a, 0xfc, 0xe5, 0x70, 0x52, 0x6e, 0x75, 0xff, 0x58, 0x13, 0xfb, 0x5a, 0x5a, 0x2b,
+	0x57, 0xbc, 0x07, 0xa3, 0xbb, 0x91, 0x78, 0x0f, 0xc6, 0x21, 0x84, 0xa0, 0xc6, 0x76, 0x50, 0x5c,
+	0x12, 0xf6, 0x9b, 0xe6, 0xe6, 0x4d, 0xcb, 0xb3, 0xf6, 0xec, 0x8e, 0x1d, 0xd8, 0x98, 0x88, 0x08,
+	0xa1, 0xe0, 0xe8, 0x48, 0x7b, 0x3d, 0xbb, 0x13, 0xd8, 0x4e, 0x78, 0x71, 0x27, 0x40, 0x16, 0xdb,
+	0xbd, 0x56, 0x38, 0x9b, 0x47, 0xf8, 0x6c, 0x8e, 0x31, 0xc6, 0x1f, 0xa4, 0xba, 0x2a, 0xca, 0x74,
+	0xdf, 0xbd, 0xe3, 0x33, 0xe5, 0x7b, 0xb8, 0x29, 0x2b, 0xd5, 0x5c, 0x71, 0xd6, 0xcb, 0x19, 0xed,
+	0xeb, 0x1a, 0xa0, 0x9b, 0xb8, 0x83, 0x03, 0x2c, 0x75, 0x55, 0x46, 0x0b, 0xc3, 0xf9, 0xe2, 0x1d,
+	0x98, 0x4d, 0x71, 0x51, 0x4e, 0xa0, 0xc7, 0x71, 0xad, 0x20, 0xed, 0xe6, 0x54, 0x03, 0x89, 0x03,
+	0x33, 0x89, 0xb1, 0xca, 0x85, 0x90, 0x4b, 0x50, 0xa7, 0x5a, 0x0c, 0x43, 0xc8, 0xb4, 0x1c, 0x42,
+	0x98, 0x2e, 0x78, 0x33, 0x4d, 0xfd, 0x13, 0x19, 0x4f, 0x7f, 0x7b, 0xf5, 0xdb, 0xdf, 0xc4, 0xf6,
+	0xac, 0xe6, 0xda, 0xb3, 0x56, 0xac, 0x99, 0x7a, 0x5a, 0x33, 0x1f, 0x6a, 0xb0, 0x90, 0xc5, 0x69,
+	0x39, 0xfd, 0xa4, 0xae, 0xdd, 0x2a, 0x83, 0x5d, 0xbb, 0xb5, 0x61, 0x26, 0xaa, 0xfb, 0x79, 0x40,
+	0xcf, 0x94, 0xc5, 0xd1, 0xeb, 0xa1, 0xf8, 0x1d, 0xdf, 0xd6, 0xc6, 0x18, 0x2a, 0x78, 0x08, 0x85,
+	0x2e, 0x11, 0xc2, 0x54, 0x59, 0x16, 0xab, 0x19, 0x0a, 0x95, 0xc5, 0x21, 0xe3, 0x10, 0x26, 0xa3,
+	0x81, 0xcc, 0x5e, 0x87, 0xed, 0x2f, 0xfc, 0x5e, 0x07, 0x47, 0x03, 0x08, 0x88, 0x76, 0x4e, 0x7f,
+	0x3d, 0x3c, 0xf1, 0xb0, 0xc8, 0x1a, 0x22, 0x98, 0x75, 0xde, 0x8c, 0xce, 0xc6, 0xeb, 0xa6, 0x80,
+	0xe2, 0x7d, 0x1a, 0x37, 0x03, 0x07, 0x8c, 0xcf, 0x34, 0x58, 0x0a, 0x35, 0x2c, 0xcb, 0xe7, 0x58,
+	0xb6, 0xdf, 0xc7, 0x25, 0x5e, 0x83, 0xf1, 0x50, 0xa2, 0xd0, 0xe5, 0x1a, 0xb2, 0xcb, 0xc9, 0xdd,
+	0x99, 0x31, 0x29, 0xba, 0x02, 0x75, 0xca, 0x2c, 0x11, 0xc9, 0xfa, 0x5c, 0xf2, 0x1b, 0x2a, 0xb9,
+	0xc9, 0x49, 0x86, 0x74, 0x1f, 0x13, 0x96, 0x0b, 0x64, 0x2b, 0x17, 0x18, 0x8e, 0x58, 0x09, 0x65,
+	0x19, 0x7d, 0x0d, 0x17, 0x24, 0x7e, 0xcf, 0x8b, 0x28, 0x9f, 0xa9, 0x30, 0xcf, 0xc3, 0x84, 0xb4,
+	0x98, 0x70, 0xee, 0x41, 0x0f, 0xfb, 0x27, 0xf2, 0x4d, 0x4a, 0xb1, 0xa6, 0x1a, 0x30, 0x7a, 0x80,
+	0x4f, 0x8e, 0x5d, 0xbf, 0x15, 0xa6, 0x03, 0x02, 0xa4, 0x4e, 0xec, 0xbb, 0xe1, 0xc0, 0xe3, 0x22,
+	0xa0, 0xb1, 0x8a, 0x9e, 0x5e, 0x80, 0x77, 0xf9, 0x56, 0x9c, 0x5f, 0xd3, 0x4a, 0x18, 0xba, 0x6f,
+	0x7e, 0xec, 0xda, 0x0e, 0x5d, 0xac, 0x6f, 0xe0, 0xb6, 0x58, 0xe0, 0xab, 0xa6, 0x8a, 0xa4, 0x36,
+	0x08, 0x11, 0x1b, 0x4e, 0x4b, 0xac, 0xf3, 0x32, 0x8a, 0x4e, 0xad, 0x66, 0xcf, 0x27, 0xae, 0x2f,
+	0x6a, 0x08, 0x05, 0x44, 0xb9, 0x6a, 0xb2, 0x0c, 0x82, 0x67, 0x01, 0x1c, 0x50, 0xec, 0x3d, 0x5e,
+	0x6c, 0x6f, 0x48, 0xdb, 0xfb, 0x2d, 0x98, 0x8b, 0x02, 0x37, 0x57, 0x1a, 0x4f, 0x4a, 0x10, 0xd4,
+	0xa8, 0xd0, 0x42, 0x65, 0xec, 0x77, 0x3c, 0x3e, 0xcf, 0x07, 0x38, 0x60, 0xfc, 0x43, 0x83, 0xf9,
+	0x0c, 0xc5, 0x97, 0xf3, 0x14, 0xe9, 0xca, 0xab, 0x52, 0xee, 0xca, 0x6b, 0x05, 0x80, 0x96, 0x6d,
+	0xad, 0x73, 0x05, 0x8a, 0xd2, 0xe9, 0x18, 0x83, 0xde, 0x04, 0xa0, 0xc2, 0x30, 0x29, 0xa9, 0x11,
+	0xab, 0xfc, 0x30, 0x4a, 0x5d, 0xc2, 0x24, 0x4d, 0x98, 0x12, 0xb9, 0xf1, 0x69, 0x98, 0x6a, 0xae,
+	0xbb, 0xce, 0x11, 0xf6, 0x09, 0x0d, 0x78, 0x85, 0x79, 0xd4, 0xbe, 0xef, 0x76, 0xd9, 0x07, 0x52,
+	0x0c, 0x55, 0x91, 0xd4, 0x46, 0x81, 0x1b, 0xd3, 0xf0, 0x68, 0x2a, 0xa3, 0xa4, 0xf4, 0xaf, 0xa6,
+	0xa4, 0x7f, 0x08, 0x6a, 0x24, 0xc0, 0x9e, 0xc8, 0x35, 0xd9, 0x6f, 0xba, 0x95, 0x7b, 0xd7, 0x26,
+	0x81, 0xeb, 0x9f, 0xc8, 0xc9, 0xa6, 0x82, 0xa3, 0x23, 0x36, 0x5d, 0xcf, 0xc6, 0x2d, 0x4e, 0x32,
+	0xca, 0x47, 0x94, 0x50, 0x8a, 0x4f, 0x8d, 0x25, 0x7c, 0x6a, 0x01, 0x46, 0x30, 0x2f, 0x23, 0xe0,
+	0xde, 0x26, 0xa0, 0x44, 0x32, 0x0a, 0xc9, 0x64, 0x34, 0xb1, 0xbd, 0x9d, 0x48, 0x6d, 0x6f, 0x3f,
+	0xd6, 0x60, 0x96, 0xab, 0x35, 0x88, 0x44, 0x2f, 0x9e, 0xbf, 0x4b, 0x30, 0xde, 0x4e, 0xe8, 0x36,
+	0x46, 0xa4, 0x34, 0x51, 0xcd, 0xd0, 0xc4, 0x70, 0x71, 0xff, 0x03, 0x0d, 0xe6, 0xd2, 0x1c, 0x97,
+	0x0d, 0x91, 0xd0, 0x8c, 0x7c, 0x2a, 0x2b, 0x13, 0x8d, 0x3d, 0xce, 0x94, 0x28, 0x0d, 0x37, 0xbe,
+	0xf5, 0x96, 0x28, 0x4e, 0x71, 0x81, 0xf8, 0x50, 0xba, 0xaf, 0x96, 0x47, 0x7c, 0xbe, 0x62, 0xff,
+	0x50, 0x83, 0xb9, 0xdd, 0x5e, 0xb3, 0x89, 0x71, 0x2b, 0x2e, 0xf1, 0x28, 0x16, 0xfb, 0x12, 0x9c,
+	0x75, 0x33, 0x0b, 0xe9, 0x55, 0xec, 0x90, 0xa9, 0x81, 0x0f, 0xf3, 0x19, 0x7c, 0x95, 0xdd, 0x68,
+	0x9f, 0x75, 0xf0, 0x71, 0x06, 0xc7, 0x2a, 0xd6, 0xb0, 0x60, 0x66, 0xb3, 0xeb, 0xb9, 0xbe, 0xbc,
+	0x81, 0x95, 0xee, 0x0e, 0x34, 0xe5, 0xee, 0x60, 0x49, 0x3e, 0x8b, 0x17, 0x93, 0x26, 0x42, 0xe4,
+	0xed, 0xaf, 0x8d, 0x3f, 0x6b, 0x30, 0x21, 0x8d, 0x41, 0x2f, 0x85, 0xda, 0x4f, 0x57, 0xde, 0xd3,
+	0x96, 0x1f, 0x80, 0xb9, 0x29, 0x99, 0x64, 0x14, 0xba, 0x16, 0xaf, 0x02, 0x55, 0x65, 0xb7, 0x90,
+	0x12, 0x33, 0x8e, 0xfb, 0x51, 0x56, 0x52, 0x2b, 0xce, 0x4a, 0x1e, 0x29, 0xca, 0x12, 0xd5, 0x9d,
+	0x85, 0x7b, 0x04, 0x2c, 0xca, 0xa9, 0xb8, 0xb2, 0x42, 0x50, 0x8a, 0x83, 0x55, 0x39, 0x0e, 0x1a,
+	0x9f, 0x55, 0xe0, 0x2c, 0x97, 0x9b, 0x0d, 0x73, 0xd7, 0xdd, 0xa3, 0x0b, 0xe7, 0x63, 0x77, 0x2f,
+	0xea, 0x9c, 0x03, 0xd4, 0xbc, 0x76, 0x0b, 0x77, 0x3d, 0x37, 0xc0, 0x4e, 0xf3, 0xe4, 0x1e, 0x3e,
+	0x09, 0xcd, 0xab, 0x62, 0xa5, 0xf0, 0x5f, 0x55, 0xc2, 0x3f, 0xc5, 0xdb, 0x1d, 0xec, 0x04, 0xe2,
+	0x84, 0x4d, 0x40, 0xfc, 0xf4, 0x25, 0xb0, 0x3a, 0x61, 0xc5, 0x18, 0x03, 0xa8, 0xdd, 0x09, 0x77,
+	0x4c, 0xdc, 0x12, 0xab, 0x42, 0x8c, 0xa0, 0x7d, 0xed, 0x5b, 0x76, 0x07, 0xb7, 0xc4, 0x6a, 0x20,
+	0x20, 0x6a, 0x09, 0x9f, 0xa9, 0x28, 0x7c, 0xad, 0x90, 0x61, 0x09, 0x91, 0xcd, 0x84, 0x84, 0x85,
+	0x1b, 0x92, 0x61, 0x17, 0x89, 0x3f, 0x6a, 0x30, 0x25, 0x0d, 0xcd, 0x36, 0x78, 0x57, 0x60, 0xa4,
+	0x2d, 0x17, 0x43, 0xa1, 0x0c, 0x16, 0x05, 0x85, 0xa4, 0xb3, 0x8a, 0xa2, 0xb3, 0xb4, 0x2d, 0xaa,
+	0x99, 0xb6, 0x18, 0x6e, 0xc1, 0x70, 0x60, 0x5a, 0x65, 0xbe, 0x5c, 0x5c, 0x78, 0x09, 0xaa, 0x8f,
+	0xdd, 0x3d, 0x11, 0x2d, 0xe7, 0x95, 0x13, 0xbc, 0xd0, 0xe5, 0x4c, 0x4a, 0x61, 0x7c, 0x5f, 0x2a,
+	0x62, 0x8c, 0x9b, 0xf0, 0xe1, 0x90, 0x0e, 0x39, 0x5c, 0x84, 0x24, 0x30, 0x9f, 0xc1, 0xd3, 0xe9,
+	0x6a, 0xe2, 0xda, 0xbf, 0xcf, 0x03, 0x7f, 0xf6, 0x8b, 0xfe, 0x0f, 0x26, 0x9a, 0xf1, 0xe1, 0x31,
+	0x0a, 0x3f, 0x52, 0xdf, 0xc8, 0xea, 0x0b, 0x59, 0x68, 0x9e, 0xc9, 0x3c, 0x0e, 0xdf, 0xde, 0xa0,
+	0x59, 0x41, 0x24, 0xbf, 0x61, 0xd2, 0xe7, 0xd2, 0xc8, 0x30, 0x03, 0xb2, 0x03, 0xf5, 0x3b, 0xf9,
+	0x49, 0x89, 0x3e, 0x97, 0x46, 0xf2, 0x9b, 0xe7, 0xb6, 0xfc, 0xac, 0x13, 0x2d, 0x86, 0x42, 0x26,
+	0x5e, 0x98, 0xea, 0x8d, 0xec, 0x06, 0xe2, 0xa1, 0x2f, 0xc2, 0x19, 0x22, 0xbd, 0x77, 0x44, 0xa1,
+	0x6c, 0x89, 0x37, 0x9a, 0xfa, 0x62, 0x26, 0x9e, 0x78, 0xe8, 0x6b, 0xb0, 0xd8, 0xce, 0x7e, 0x6c,
+	0x88, 0x5e, 0x48, 0x8c, 0x9a, 0x7e, 0xec, 0xa7, 0x1b, 0xfd, 0x48, 0x88, 0x87, 0xf6, 0xe1, 0x5c,
+	0x3b, 0xef, 0xe5, 0x1e, 0x7a, 0x31, 0xee, 0x20, 0xf7, 0x49, 0xa1, 0x7e, 0xb1, 0x3f, 0x11, 0xf1,
+	0xd0, 0x03, 0x40, 0x41, 0xea, 0xf9, 0x1a, 0x5a, 0x12, 0xdf, 0x66, 0x3e, 0xcd, 0xd3, 0x97, 0x0b,
+	0x5a, 0x89, 0x87, 0x9a, 0xd0, 0x68, 0xe7, 0xbc, 0x36, 0x42, 0x86, 0xf2, 0xa2, 0x3a, 0xf3, 0xa5,
+	0x95, 0xfe, 0x62, 0x5f, 0x1a, 0xce, 0x77, 0x3b, 0xf5, 0x5c, 0x06, 0x2d, 0x25, 0x34, 0xab, 0xbc,
+	0xf6, 0xd1, 0x97, 0x0b, 0x5a, 0x89, 0x87, 0x1e, 0xc2, 0x6c, 0x3b, 0xfd, 0x4a, 0x04, 0x65, 0x7f,
+	0x15, 0x79, 0xd9, 0x4a, 0x51, 0x33, 0xbb, 0x14, 0x9c, 0x3a, 0x50, 0x9f, 0x3d, 0xa0, 0xf0, 0x59,
+	0x79, 0xfa, 0xf5, 0x87, 0xae, 0xe7, 0x35, 0x45, 0x22, 0x27, 0xde, 0x11, 0xc8, 0x22, 0xa7, 0x9f,
+	0x36, 0xe8, 0xcb, 0x05, 0xad, 0xc4, 0x43, 0xdb, 0x30, 0x63, 0x27, 0xeb, 0xc3, 0x51, 0x98, 0xfb,
+	0x65, 0xbd, 0x15, 0xd0, 0x97, 0xf2, 0x1b, 0x39, 0x8b, 0xa9, 0xfe, 0x08, 0xca, 0xfd, 0x86, 0xc8,
+	0x2c, 0xe6, 0x14, 0xaa, 0x6f, 0xc3, 0x4c, 0x3b, 0x59, 0xd6, 0x8d, 0xce, 0x27, 0x94, 0x2e, 0xd7,
+	0xa3, 0xeb, 0x4b, 0xf9, 0x8d, 0x3c, 0xee, 0x84, 0x0d, 0x24, 0x8a, 0x3b, 0x72, 0xfd, 0xb3, 0x3e,
+	0x97, 0x46, 0x72, 0xd1, 0xd2, 0x45, 0xa9, 0x39, 0x0e, 0x27, 0xea, 0x6c, 0xf5, 0xe5, 0x82, 0x56,
+	0x1e, 0x86, 0xe4, 0x32, 0xcc, 0x28, 0x0c, 0x25, 0xca, 0x40, 0xf5, 0xc5, 0x4c, 0x3c, 0xf7, 0xad,
+	0x44, 0xe1, 0x5f, 0xe4, 0x5b, 0xe9, 0xe2, 0x44, 0x5d, 0xcf, 0x6b, 0x22, 0x1e, 0x7a, 0x07, 0xe6,
+	0x33, 0x0b, 0x09, 0xd1, 0x85, 0x30, 0xec, 0xe7, 0x94, 0x3c, 0xea, 0xab, 0xc5, 0x04, 0x5c, 0xe3,
+	0x11, 0x3a, 0xd2, 0xb8, 0x5c, 0xb4, 0xa7, 0xcf, 0xa5, 0x91, 0x5c, 0xba, 0x44, 0xa7, 0x91, 0x74,
+	0xe9, 0xc2, 0x3f, 0x5d, 0xcf, 0x6b, 0x22, 0x1e, 0xfa, 0x2a, 0x2c, 0x64, 0x17, 0xb2, 0xa1, 0xd5,
+	0x44, 0x84, 0x4f, 0x15, 0xe4, 0xe9, 0x2f, 0xf4, 0xa1, 0xe0, 0xab, 0x41, 0x4e, 0x85, 0x99, 0xbc,
+	0x1a, 0xe4, 0x94, 0xbd, 0xe9, 0x46, 0x3f, 0x92, 0xc8, 0xf9, 0x12, 0xb5, 0x5b, 0xb2, 0xf3, 0xa5,
+	0x0b, 0xca, 0xf4, 0xe5, 0x82, 0x56, 0xde, 0x65, 0xba, 0x30, 0x28, 0xea, 0x32, 0xb3, 0x2e, 0x4b,
+	0x5f, 0x2e, 0x68, 0x25, 0x1e, 0xfa, 0x0a, 0xcc, 0x65, 0x95, 0xea, 0xa0, 0x64, 0x88, 0x4c, 0xd4,
+	0x0e, 0xe9, 0x17, 0x0a, 0xdb, 0xf9, 0x44, 0x91, 0x0b, 0x50, 0xd0, 0x42, 0x66, 0x55, 0x4a, 0x3c,
+	0x51, 0x52, 0xd5, 0x30, 0x37, 0x60, 0x52, 0x29, 0xc0, 0x88, 0x36, 0x0d, 0xc9, 0x42, 0x13, 0xbd,
+	0x91, 0xdd, 0x20, 0xa6, 0x48, 0xd6, 0x1d, 0x7b, 0x3c, 0x45, 0x72, 0xea, 0x0a, 0xf4, 0xd5, 0x62,
+	0x02, 0xbe, 0xf4, 0x64, 0xdc, 0x68, 0xa7, 0x96, 0x1e, 0xf5, 0x7e, 0x5d, 0x5f, 0x29, 0x6a, 0xe6,
+	0x1c, 0x67, 0x5e, 0x10, 0x47, 0x1c, 0xe7, 0x5d, 0x64, 0xeb, 0xab, 0xc5, 0x04, 0xdc, 0x24, 0xf2,
+	0xf5, 0x65, 0x6a, 0x0b, 0x25, 0x2e, 0xb6, 0xf4, 0xc5, 0x4c, 0x3c, 0x9f, 0xdd, 0x89, 0x1b, 0xc3,
+	0x68, 0x76, 0xa7, 0xef, 0x33, 0x75, 0x3d, 0xaf, 0x89, 0x1b, 0x57, 0xb9, 0xc5, 0x4b, 0xed, 0x08,
+	0xc3, 0x7b, 0x44, 0xbd, 0x91, 0xdd, 0x90, 0x35, 0x1b, 0x18, 0x43, 0xd9, 0xb3, 0x21, 0xe4, 0x69,
+	0xb9, 0xa0, 0x95, 0xef, 0xe0, 0x72, 0xef, 0x40, 0xa2, 0x1d, 0x5c, 0xd1, 0x0d, 0x90, 0x7e, 0xb1,
+	0x3f, 0x11, 0x1f, 0xe7, 0x76, 0xdf, 0x71, 0x6e, 0x0f, 0x32, 0x4e, 0xf1, 0x2d, 0xc7, 0x36, 0xcc,
+	0xa4, 0x0e, 0xb5, 0xa3, 0x85, 0x38, 0xeb, 0x9e, 0x41, 0x5f, 0xca, 0x6f, 0xe4, 0xff, 0x47, 0x22,
+	0x79, 0x54, 0x88, 0xa2, 0x20, 0x9e, 0x3e, 0xf5, 0xd4, 0xcf, 0xe7, 0xb6, 0xa9, 0xab, 0xb3, 0x74,
+	0x10, 0x9d, 0x5c, 0x9d, 0x95, 0xf3, 0x40, 0x7d, 0xb9, 0xa0, 0x95, 0xcb, 0x9b, 0x3a, 0xb7, 0x8a,
+	0xe4, 0xcd, 0x3a, 0x69, 0xd3, 0x97, 0xf2, 0x1b, 0xf9, 0x8c, 0x91, 0x53, 0xdd, 0x68, 0xc6, 0x24,
+	0x92, 0x77, 0x7d, 0x31, 0x13, 0xcf, 0x19, 0x4a, 0xa5, 0x89, 0xa9, 0x9d, 0x90, 0x9c, 0xd4, 0xea,
+	0x4b, 0xf9, 0x8d, 0xc4, 0xbb, 0x71, 0xe1, 0x9d, 0xe5, 0x1d, 0x0f, 0x3b, 0x8f, 0x36, 0xb7, 0xa4,
+	0xff, 0xdf, 0xc4, 0x3e, 0x78, 0x93, 0xfd, 0xdd, 0x1b, 0x61, 0xa8, 0x57, 0xff, 0x33, 0x00, 0xc9,
+	0x17, 0x1a, 0xa5, 0x32, 0x4a, 0x00, 0x00,
 }
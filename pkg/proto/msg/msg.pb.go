@@ -38,7 +38,7 @@ func (m *MsgDataToMQ) Reset()         { *m = MsgDataToMQ{} }
 func (m *MsgDataToMQ) String() string { return proto.CompactTextString(m) }
 func (*MsgDataToMQ) ProtoMessage()    {}
 func (*MsgDataToMQ) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{0}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{0}
 }
 func (m *MsgDataToMQ) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgDataToMQ.Unmarshal(m, b)
@@ -91,7 +91,7 @@ func (m *MsgDataToDB) Reset()         { *m = MsgDataToDB{} }
 func (m *MsgDataToDB) String() string { return proto.CompactTextString(m) }
 func (*MsgDataToDB) ProtoMessage()    {}
 func (*MsgDataToDB) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{1}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{1}
 }
 func (m *MsgDataToDB) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgDataToDB.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *PushMsgDataToMQ) Reset()         { *m = PushMsgDataToMQ{} }
 func (m *PushMsgDataToMQ) String() string { return proto.CompactTextString(m) }
 func (*PushMsgDataToMQ) ProtoMessage()    {}
 func (*PushMsgDataToMQ) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{2}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{2}
 }
 func (m *PushMsgDataToMQ) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PushMsgDataToMQ.Unmarshal(m, b)
@@ -193,7 +193,7 @@ func (m *MsgDataToMongoByMQ) Reset()         { *m = MsgDataToMongoByMQ{} }
 func (m *MsgDataToMongoByMQ) String() string { return proto.CompactTextString(m) }
 func (*MsgDataToMongoByMQ) ProtoMessage()    {}
 func (*MsgDataToMongoByMQ) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{3}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{3}
 }
 func (m *MsgDataToMongoByMQ) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgDataToMongoByMQ.Unmarshal(m, b)
@@ -273,7 +273,7 @@ func (m *GetMaxAndMinSeqReq) Reset()         { *m = GetMaxAndMinSeqReq{} }
 func (m *GetMaxAndMinSeqReq) String() string { return proto.CompactTextString(m) }
 func (*GetMaxAndMinSeqReq) ProtoMessage()    {}
 func (*GetMaxAndMinSeqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{4}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{4}
 }
 func (m *GetMaxAndMinSeqReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaxAndMinSeqReq.Unmarshal(m, b)
@@ -321,7 +321,7 @@ func (m *GetMaxAndMinSeqResp) Reset()         { *m = GetMaxAndMinSeqResp{} }
 func (m *GetMaxAndMinSeqResp) String() string { return proto.CompactTextString(m) }
 func (*GetMaxAndMinSeqResp) ProtoMessage()    {}
 func (*GetMaxAndMinSeqResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{5}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{5}
 }
 func (m *GetMaxAndMinSeqResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaxAndMinSeqResp.Unmarshal(m, b)
@@ -382,7 +382,7 @@ func (m *SendMsgReq) Reset()         { *m = SendMsgReq{} }
 func (m *SendMsgReq) String() string { return proto.CompactTextString(m) }
 func (*SendMsgReq) ProtoMessage()    {}
 func (*SendMsgReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{6}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{6}
 }
 func (m *SendMsgReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendMsgReq.Unmarshal(m, b)
@@ -439,7 +439,7 @@ func (m *SendMsgResp) Reset()         { *m = SendMsgResp{} }
 func (m *SendMsgResp) String() string { return proto.CompactTextString(m) }
 func (*SendMsgResp) ProtoMessage()    {}
 func (*SendMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{7}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{7}
 }
 func (m *SendMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendMsgResp.Unmarshal(m, b)
@@ -514,7 +514,7 @@ func (m *ClearMsgReq) Reset()         { *m = ClearMsgReq{} }
 func (m *ClearMsgReq) String() string { return proto.CompactTextString(m) }
 func (*ClearMsgReq) ProtoMessage()    {}
 func (*ClearMsgReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{8}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{8}
 }
 func (m *ClearMsgReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClearMsgReq.Unmarshal(m, b)
@@ -567,7 +567,7 @@ func (m *ClearMsgResp) Reset()         { *m = ClearMsgResp{} }
 func (m *ClearMsgResp) String() string { return proto.CompactTextString(m) }
 func (*ClearMsgResp) ProtoMessage()    {}
 func (*ClearMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{9}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{9}
 }
 func (m *ClearMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClearMsgResp.Unmarshal(m, b)
@@ -616,7 +616,7 @@ func (m *SetMsgMinSeqReq) Reset()         { *m = SetMsgMinSeqReq{} }
 func (m *SetMsgMinSeqReq) String() string { return proto.CompactTextString(m) }
 func (*SetMsgMinSeqReq) ProtoMessage()    {}
 func (*SetMsgMinSeqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{10}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{10}
 }
 func (m *SetMsgMinSeqReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMsgMinSeqReq.Unmarshal(m, b)
@@ -683,7 +683,7 @@ func (m *SetMsgMinSeqResp) Reset()         { *m = SetMsgMinSeqResp{} }
 func (m *SetMsgMinSeqResp) String() string { return proto.CompactTextString(m) }
 func (*SetMsgMinSeqResp) ProtoMessage()    {}
 func (*SetMsgMinSeqResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{11}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{11}
 }
 func (m *SetMsgMinSeqResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMsgMinSeqResp.Unmarshal(m, b)
@@ -729,7 +729,7 @@ func (m *SetSendMsgStatusReq) Reset()         { *m = SetSendMsgStatusReq{} }
 func (m *SetSendMsgStatusReq) String() string { return proto.CompactTextString(m) }
 func (*SetSendMsgStatusReq) ProtoMessage()    {}
 func (*SetSendMsgStatusReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{12}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{12}
 }
 func (m *SetSendMsgStatusReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetSendMsgStatusReq.Unmarshal(m, b)
@@ -775,7 +775,7 @@ func (m *SetSendMsgStatusResp) Reset()         { *m = SetSendMsgStatusResp{} }
 func (m *SetSendMsgStatusResp) String() string { return proto.CompactTextString(m) }
 func (*SetSendMsgStatusResp) ProtoMessage()    {}
 func (*SetSendMsgStatusResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{13}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{13}
 }
 func (m *SetSendMsgStatusResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetSendMsgStatusResp.Unmarshal(m, b)
@@ -820,7 +820,7 @@ func (m *GetSendMsgStatusReq) Reset()         { *m = GetSendMsgStatusReq{} }
 func (m *GetSendMsgStatusReq) String() string { return proto.CompactTextString(m) }
 func (*GetSendMsgStatusReq) ProtoMessage()    {}
 func (*GetSendMsgStatusReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{14}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{14}
 }
 func (m *GetSendMsgStatusReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSendMsgStatusReq.Unmarshal(m, b)
@@ -860,7 +860,7 @@ func (m *GetSendMsgStatusResp) Reset()         { *m = GetSendMsgStatusResp{} }
 func (m *GetSendMsgStatusResp) String() string { return proto.CompactTextString(m) }
 func (*GetSendMsgStatusResp) ProtoMessage()    {}
 func (*GetSendMsgStatusResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{15}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{15}
 }
 func (m *GetSendMsgStatusResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSendMsgStatusResp.Unmarshal(m, b)
@@ -915,7 +915,7 @@ func (m *DelSuperGroupMsgReq) Reset()         { *m = DelSuperGroupMsgReq{} }
 func (m *DelSuperGroupMsgReq) String() string { return proto.CompactTextString(m) }
 func (*DelSuperGroupMsgReq) ProtoMessage()    {}
 func (*DelSuperGroupMsgReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{16}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{16}
 }
 func (m *DelSuperGroupMsgReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelSuperGroupMsgReq.Unmarshal(m, b)
@@ -975,7 +975,7 @@ func (m *DelSuperGroupMsgResp) Reset()         { *m = DelSuperGroupMsgResp{} }
 func (m *DelSuperGroupMsgResp) String() string { return proto.CompactTextString(m) }
 func (*DelSuperGroupMsgResp) ProtoMessage()    {}
 func (*DelSuperGroupMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{17}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{17}
 }
 func (m *DelSuperGroupMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelSuperGroupMsgResp.Unmarshal(m, b)
@@ -1022,7 +1022,7 @@ func (m *GetSuperGroupMsgReq) Reset()         { *m = GetSuperGroupMsgReq{} }
 func (m *GetSuperGroupMsgReq) String() string { return proto.CompactTextString(m) }
 func (*GetSuperGroupMsgReq) ProtoMessage()    {}
 func (*GetSuperGroupMsgReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{18}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{18}
 }
 func (m *GetSuperGroupMsgReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSuperGroupMsgReq.Unmarshal(m, b)
@@ -1076,7 +1076,7 @@ func (m *GetSuperGroupMsgResp) Reset()         { *m = GetSuperGroupMsgResp{} }
 func (m *GetSuperGroupMsgResp) String() string { return proto.CompactTextString(m) }
 func (*GetSuperGroupMsgResp) ProtoMessage()    {}
 func (*GetSuperGroupMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{19}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{19}
 }
 func (m *GetSuperGroupMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetSuperGroupMsgResp.Unmarshal(m, b)
@@ -1129,7 +1129,7 @@ func (m *GetWriteDiffMsgReq) Reset()         { *m = GetWriteDiffMsgReq{} }
 func (m *GetWriteDiffMsgReq) String() string { return proto.CompactTextString(m) }
 func (*GetWriteDiffMsgReq) ProtoMessage()    {}
 func (*GetWriteDiffMsgReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{20}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{20}
 }
 func (m *GetWriteDiffMsgReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetWriteDiffMsgReq.Unmarshal(m, b)
@@ -1176,7 +1176,7 @@ func (m *GetWriteDiffMsgResp) Reset()         { *m = GetWriteDiffMsgResp{} }
 func (m *GetWriteDiffMsgResp) String() string { return proto.CompactTextString(m) }
 func (*GetWriteDiffMsgResp) ProtoMessage()    {}
 func (*GetWriteDiffMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{21}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{21}
 }
 func (m *GetWriteDiffMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetWriteDiffMsgResp.Unmarshal(m, b)
@@ -1238,7 +1238,7 @@ func (m *ModifyMessageReactionExtensionsReq) Reset()         { *m = ModifyMessag
 func (m *ModifyMessageReactionExtensionsReq) String() string { return proto.CompactTextString(m) }
 func (*ModifyMessageReactionExtensionsReq) ProtoMessage()    {}
 func (*ModifyMessageReactionExtensionsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{22}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{22}
 }
 func (m *ModifyMessageReactionExtensionsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyMessageReactionExtensionsReq.Unmarshal(m, b)
@@ -1357,7 +1357,7 @@ func (m *SetMessageReactionExtensionsReq) Reset()         { *m = SetMessageReact
 func (m *SetMessageReactionExtensionsReq) String() string { return proto.CompactTextString(m) }
 func (*SetMessageReactionExtensionsReq) ProtoMessage()    {}
 func (*SetMessageReactionExtensionsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{23}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{23}
 }
 func (m *SetMessageReactionExtensionsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMessageReactionExtensionsReq.Unmarshal(m, b)
@@ -1477,7 +1477,7 @@ func (m *SetMessageReactionExtensionsResp) Reset()         { *m = SetMessageReac
 func (m *SetMessageReactionExtensionsResp) String() string { return proto.CompactTextString(m) }
 func (*SetMessageReactionExtensionsResp) ProtoMessage()    {}
 func (*SetMessageReactionExtensionsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{24}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{24}
 }
 func (m *SetMessageReactionExtensionsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetMessageReactionExtensionsResp.Unmarshal(m, b)
@@ -1562,7 +1562,7 @@ func (m *AddMessageReactionExtensionsReq) Reset()         { *m = AddMessageReact
 func (m *AddMessageReactionExtensionsReq) String() string { return proto.CompactTextString(m) }
 func (*AddMessageReactionExtensionsReq) ProtoMessage()    {}
 func (*AddMessageReactionExtensionsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{25}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{25}
 }
 func (m *AddMessageReactionExtensionsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddMessageReactionExtensionsReq.Unmarshal(m, b)
@@ -1689,7 +1689,7 @@ func (m *AddMessageReactionExtensionsResp) Reset()         { *m = AddMessageReac
 func (m *AddMessageReactionExtensionsResp) String() string { return proto.CompactTextString(m) }
 func (*AddMessageReactionExtensionsResp) ProtoMessage()    {}
 func (*AddMessageReactionExtensionsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{26}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{26}
 }
 func (m *AddMessageReactionExtensionsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddMessageReactionExtensionsResp.Unmarshal(m, b)
@@ -1768,7 +1768,7 @@ func (m *GetMessageListReactionExtensionsReq) Reset()         { *m = GetMessageL
 func (m *GetMessageListReactionExtensionsReq) String() string { return proto.CompactTextString(m) }
 func (*GetMessageListReactionExtensionsReq) ProtoMessage()    {}
 func (*GetMessageListReactionExtensionsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{27}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{27}
 }
 func (m *GetMessageListReactionExtensionsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMessageListReactionExtensionsReq.Unmarshal(m, b)
@@ -1853,7 +1853,7 @@ func (m *GetMessageListReactionExtensionsReq_MessageReactionKey) String() string
 }
 func (*GetMessageListReactionExtensionsReq_MessageReactionKey) ProtoMessage() {}
 func (*GetMessageListReactionExtensionsReq_MessageReactionKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{27, 0}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{27, 0}
 }
 func (m *GetMessageListReactionExtensionsReq_MessageReactionKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMessageListReactionExtensionsReq_MessageReactionKey.Unmarshal(m, b)
@@ -1900,7 +1900,7 @@ func (m *GetMessageListReactionExtensionsResp) Reset()         { *m = GetMessage
 func (m *GetMessageListReactionExtensionsResp) String() string { return proto.CompactTextString(m) }
 func (*GetMessageListReactionExtensionsResp) ProtoMessage()    {}
 func (*GetMessageListReactionExtensionsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{28}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{28}
 }
 func (m *GetMessageListReactionExtensionsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMessageListReactionExtensionsResp.Unmarshal(m, b)
@@ -1955,7 +1955,7 @@ func (m *SingleMessageExtensionResult) Reset()         { *m = SingleMessageExten
 func (m *SingleMessageExtensionResult) String() string { return proto.CompactTextString(m) }
 func (*SingleMessageExtensionResult) ProtoMessage()    {}
 func (*SingleMessageExtensionResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{29}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{29}
 }
 func (m *SingleMessageExtensionResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SingleMessageExtensionResult.Unmarshal(m, b)
@@ -2017,7 +2017,7 @@ func (m *ModifyMessageReactionExtensionsResp) Reset()         { *m = ModifyMessa
 func (m *ModifyMessageReactionExtensionsResp) String() string { return proto.CompactTextString(m) }
 func (*ModifyMessageReactionExtensionsResp) ProtoMessage()    {}
 func (*ModifyMessageReactionExtensionsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{30}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{30}
 }
 func (m *ModifyMessageReactionExtensionsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ModifyMessageReactionExtensionsResp.Unmarshal(m, b)
@@ -2086,7 +2086,7 @@ func (m *DeleteMessageListReactionExtensionsReq) Reset() {
 func (m *DeleteMessageListReactionExtensionsReq) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessageListReactionExtensionsReq) ProtoMessage()    {}
 func (*DeleteMessageListReactionExtensionsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{31}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{31}
 }
 func (m *DeleteMessageListReactionExtensionsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMessageListReactionExtensionsReq.Unmarshal(m, b)
@@ -2184,7 +2184,7 @@ func (m *DeleteMessageListReactionExtensionsResp) Reset() {
 func (m *DeleteMessageListReactionExtensionsResp) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessageListReactionExtensionsResp) ProtoMessage()    {}
 func (*DeleteMessageListReactionExtensionsResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{32}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{32}
 }
 func (m *DeleteMessageListReactionExtensionsResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMessageListReactionExtensionsResp.Unmarshal(m, b)
@@ -2238,7 +2238,7 @@ func (m *ExtendMsgResp) Reset()         { *m = ExtendMsgResp{} }
 func (m *ExtendMsgResp) String() string { return proto.CompactTextString(m) }
 func (*ExtendMsgResp) ProtoMessage()    {}
 func (*ExtendMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{33}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{33}
 }
 func (m *ExtendMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtendMsgResp.Unmarshal(m, b)
@@ -2294,7 +2294,7 @@ func (m *ExtendMsg) Reset()         { *m = ExtendMsg{} }
 func (m *ExtendMsg) String() string { return proto.CompactTextString(m) }
 func (*ExtendMsg) ProtoMessage()    {}
 func (*ExtendMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{34}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{34}
 }
 func (m *ExtendMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtendMsg.Unmarshal(m, b)
@@ -2362,7 +2362,7 @@ func (m *KeyValueResp) Reset()         { *m = KeyValueResp{} }
 func (m *KeyValueResp) String() string { return proto.CompactTextString(m) }
 func (*KeyValueResp) ProtoMessage()    {}
 func (*KeyValueResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{35}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{35}
 }
 func (m *KeyValueResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyValueResp.Unmarshal(m, b)
@@ -2416,7 +2416,7 @@ func (m *MsgDataToModifyByMQ) Reset()         { *m = MsgDataToModifyByMQ{} }
 func (m *MsgDataToModifyByMQ) String() string { return proto.CompactTextString(m) }
 func (*MsgDataToModifyByMQ) ProtoMessage()    {}
 func (*MsgDataToModifyByMQ) Descriptor() ([]byte, []int) {
-	return fileDescriptor_msg_53ecdffb017d9c40, []int{36}
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{36}
 }
 func (m *MsgDataToModifyByMQ) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgDataToModifyByMQ.Unmarshal(m, b)
@@ -2457,6 +2457,116 @@ func (m *MsgDataToModifyByMQ) GetTriggerID() string {
 	return ""
 }
 
+type DeadLetterMsgToMQ struct {
+	Topic                string   `protobuf:"bytes,1,opt,name=topic" json:"topic,omitempty"`
+	Partition            int32    `protobuf:"varint,2,opt,name=partition" json:"partition,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset" json:"offset,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,5,opt,name=value" json:"value,omitempty"`
+	Handler              string   `protobuf:"bytes,6,opt,name=handler" json:"handler,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,7,opt,name=errMsg" json:"errMsg,omitempty"`
+	Attempt              int32    `protobuf:"varint,8,opt,name=attempt" json:"attempt,omitempty"`
+	CreateTime           int64    `protobuf:"varint,9,opt,name=createTime" json:"createTime,omitempty"`
+	OperationID          string   `protobuf:"bytes,10,opt,name=operationID" json:"operationID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeadLetterMsgToMQ) Reset()         { *m = DeadLetterMsgToMQ{} }
+func (m *DeadLetterMsgToMQ) String() string { return proto.CompactTextString(m) }
+func (*DeadLetterMsgToMQ) ProtoMessage()    {}
+func (*DeadLetterMsgToMQ) Descriptor() ([]byte, []int) {
+	return fileDescriptor_msg_85e8b90e26cbc7d3, []int{37}
+}
+func (m *DeadLetterMsgToMQ) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetterMsgToMQ.Unmarshal(m, b)
+}
+func (m *DeadLetterMsgToMQ) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetterMsgToMQ.Marshal(b, m, deterministic)
+}
+func (dst *DeadLetterMsgToMQ) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetterMsgToMQ.Merge(dst, src)
+}
+func (m *DeadLetterMsgToMQ) XXX_Size() int {
+	return xxx_messageInfo_DeadLetterMsgToMQ.Size(m)
+}
+func (m *DeadLetterMsgToMQ) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetterMsgToMQ.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetterMsgToMQ proto.InternalMessageInfo
+
+func (m *DeadLetterMsgToMQ) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *DeadLetterMsgToMQ) GetPartition() int32 {
+	if m != nil {
+		return m.Partition
+	}
+	return 0
+}
+
+func (m *DeadLetterMsgToMQ) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *DeadLetterMsgToMQ) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DeadLetterMsgToMQ) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *DeadLetterMsgToMQ) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *DeadLetterMsgToMQ) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *DeadLetterMsgToMQ) GetAttempt() int32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *DeadLetterMsgToMQ) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *DeadLetterMsgToMQ) GetOperationID() string {
+	if m != nil {
+		return m.OperationID
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgDataToMQ)(nil), "msg.MsgDataToMQ")
 	proto.RegisterType((*MsgDataToDB)(nil), "msg.MsgDataToDB")
@@ -2501,6 +2611,7 @@ func init() {
 	proto.RegisterMapType((map[string]*KeyValueResp)(nil), "msg.ExtendMsg.ReactionExtensionListEntry")
 	proto.RegisterType((*KeyValueResp)(nil), "msg.KeyValueResp")
 	proto.RegisterType((*MsgDataToModifyByMQ)(nil), "msg.MsgDataToModifyByMQ")
+	proto.RegisterType((*DeadLetterMsgToMQ)(nil), "msg.DeadLetterMsgToMQ")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3039,120 +3150,127 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 	Metadata: "msg/msg.proto",
 }
 
-func init() { proto.RegisterFile("msg/msg.proto", fileDescriptor_msg_53ecdffb017d9c40) }
-
-var fileDescriptor_msg_53ecdffb017d9c40 = []byte{
-	// 1785 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6f, 0xdb, 0xc8,
-	0x15, 0x07, 0x45, 0x53, 0xb2, 0x9e, 0xec, 0xb5, 0x77, 0xec, 0x75, 0xb5, 0x5c, 0x03, 0xd1, 0x72,
-	0xb3, 0x1b, 0xa7, 0x49, 0x64, 0xd4, 0x2d, 0x90, 0xa2, 0x29, 0xd0, 0xc4, 0x91, 0xab, 0x18, 0xa9,
-	0xea, 0x98, 0x72, 0x5b, 0xa0, 0x3d, 0x38, 0x8c, 0x34, 0x62, 0x08, 0x4b, 0x24, 0xcd, 0xa1, 0x62,
-	0xab, 0xff, 0x0e, 0x05, 0xda, 0x5b, 0x0e, 0x3d, 0xf6, 0x0b, 0xf4, 0x16, 0xf4, 0x03, 0xf4, 0xd4,
-	0x0f, 0x10, 0xf4, 0xd2, 0x6f, 0xd1, 0x2f, 0x51, 0xcc, 0x0c, 0x29, 0x0d, 0xff, 0x89, 0xb4, 0x1c,
-	0x38, 0x40, 0xdb, 0x9b, 0x66, 0xe6, 0xcd, 0x9b, 0xf7, 0x7b, 0xef, 0xf7, 0x66, 0x86, 0xf3, 0x04,
-	0xab, 0x23, 0x62, 0xee, 0x8e, 0x88, 0xd9, 0x74, 0x3d, 0xc7, 0x77, 0x90, 0x3c, 0x22, 0xa6, 0xba,
-	0x73, 0xe4, 0x62, 0xfb, 0xc1, 0x61, 0xe7, 0x41, 0x17, 0x7b, 0x6f, 0xb0, 0xb7, 0xeb, 0x9e, 0x99,
-	0xbb, 0x6c, 0x78, 0x97, 0xf4, 0xcf, 0x4e, 0x2f, 0xc8, 0xee, 0x05, 0xe1, 0xe2, 0x6a, 0x33, 0x57,
-	0xd2, 0x33, 0x5c, 0x17, 0x7b, 0x81, 0xbc, 0xf6, 0x1b, 0xa8, 0x75, 0x88, 0xd9, 0x32, 0x7c, 0xe3,
-	0xc4, 0xe9, 0x1c, 0xa3, 0x4d, 0x50, 0x7c, 0xe7, 0x0c, 0xdb, 0x75, 0xa9, 0x21, 0xed, 0x54, 0x75,
-	0xde, 0x40, 0x0d, 0xa8, 0x39, 0x2e, 0xf6, 0x0c, 0xdf, 0x72, 0xec, 0xc3, 0x56, 0xbd, 0xc4, 0xc6,
-	0xc4, 0x2e, 0xf4, 0x3d, 0xa8, 0x8c, 0xb8, 0x9a, 0xba, 0xdc, 0x90, 0x76, 0x6a, 0x7b, 0x6a, 0x93,
-	0x30, 0x03, 0x4e, 0x0d, 0xd7, 0x3a, 0x75, 0x0d, 0xcf, 0x18, 0x91, 0x66, 0xb0, 0x90, 0x1e, 0x8a,
-	0x6a, 0x58, 0x58, 0xbc, 0xb5, 0x2f, 0x2a, 0x91, 0x0a, 0x2b, 0xc9, 0x37, 0x4e, 0x7b, 0x2b, 0xc1,
-	0xda, 0x8b, 0x31, 0x79, 0x2d, 0x02, 0x6d, 0x40, 0xed, 0x48, 0x98, 0xc5, 0xe1, 0x8a, 0x5d, 0xa2,
-	0x35, 0xa5, 0xe2, 0xd6, 0x68, 0xb0, 0xe2, 0x8e, 0xc9, 0xeb, 0x13, 0xe7, 0x67, 0x04, 0x7b, 0x87,
-	0x2d, 0xe6, 0x8d, 0xaa, 0x1e, 0xe9, 0xd3, 0xfe, 0x2a, 0x01, 0x9a, 0xd9, 0xe2, 0xd8, 0xa6, 0xb3,
-	0x3f, 0xe9, 0x1c, 0xa3, 0x3a, 0x54, 0x86, 0x06, 0xf1, 0xbb, 0xf8, 0x9c, 0x99, 0xb3, 0xa4, 0x87,
-	0x4d, 0x74, 0x1b, 0x56, 0x0d, 0xd3, 0xf4, 0xb0, 0x19, 0x05, 0x19, 0xed, 0x44, 0x7b, 0x50, 0x1b,
-	0x61, 0x42, 0x0c, 0x13, 0xff, 0xc4, 0x22, 0x7e, 0x5d, 0x6e, 0xc8, 0x3b, 0xb5, 0xbd, 0xf5, 0x26,
-	0xa5, 0x92, 0x80, 0x5c, 0x17, 0x85, 0xd0, 0x36, 0x54, 0x7d, 0xcf, 0x32, 0x4d, 0x66, 0xeb, 0x12,
-	0xd3, 0x3a, 0xeb, 0xd0, 0x7e, 0x0a, 0xa8, 0x8d, 0xfd, 0x8e, 0x71, 0xf9, 0xc4, 0xee, 0x77, 0x2c,
-	0xbb, 0x8b, 0xcf, 0x75, 0x7c, 0x8e, 0xb6, 0xa0, 0x1c, 0x80, 0xe3, 0x5e, 0x0b, 0x5a, 0x71, 0x97,
-	0x96, 0x12, 0x2e, 0xd5, 0x2e, 0x60, 0x23, 0xa1, 0x8f, 0xb8, 0x14, 0xf8, 0x81, 0xe7, 0x3d, 0x75,
-	0xfa, 0x98, 0x69, 0x54, 0xf4, 0xb0, 0x49, 0x97, 0x3a, 0xf0, 0xbc, 0x0e, 0x31, 0x03, 0x6d, 0x41,
-	0x8b, 0xf6, 0x77, 0x8c, 0x4b, 0xea, 0x29, 0xea, 0xdf, 0x55, 0x3d, 0x68, 0xb1, 0x7e, 0xa6, 0x97,
-	0x61, 0xa1, 0xfd, 0xac, 0xa5, 0xfd, 0x1a, 0xa0, 0x8b, 0xed, 0x7e, 0x87, 0x98, 0x14, 0xc0, 0xcd,
-	0x92, 0xfc, 0x6f, 0x12, 0xd4, 0xa6, 0x8b, 0x73, 0xb4, 0x38, 0x8a, 0x16, 0xcf, 0xd0, 0xe2, 0x08,
-	0x5a, 0xde, 0xa2, 0x96, 0xf1, 0x75, 0x3a, 0xc4, 0x9c, 0x86, 0x49, 0xec, 0xa2, 0x12, 0xbd, 0xa1,
-	0x85, 0x6d, 0x9f, 0x4b, 0x28, 0x5c, 0x42, 0xe8, 0x42, 0x2a, 0x2c, 0x13, 0x6c, 0xf7, 0x4f, 0xac,
-	0x11, 0xae, 0x97, 0x1b, 0xd2, 0x8e, 0xac, 0x4f, 0xdb, 0xe8, 0x13, 0x28, 0xe1, 0xcb, 0x7a, 0x85,
-	0x4d, 0x2a, 0xe1, 0x4b, 0xad, 0x07, 0xb5, 0xa7, 0x43, 0x6c, 0x78, 0x81, 0xbb, 0xb6, 0xa0, 0x3c,
-	0x8e, 0xc4, 0x9b, 0xb7, 0xa8, 0x4a, 0xc7, 0x0d, 0x98, 0xc0, 0x0d, 0x9e, 0xb6, 0xe3, 0xce, 0x94,
-	0x93, 0x49, 0xf9, 0x18, 0x56, 0x66, 0x8b, 0x2c, 0xe2, 0x16, 0xed, 0x2f, 0x12, 0xac, 0x75, 0x31,
-	0xc5, 0x17, 0xe1, 0x66, 0xaa, 0xad, 0x75, 0xa8, 0x98, 0x9e, 0x33, 0x76, 0xa7, 0xa6, 0x86, 0x4d,
-	0x3a, 0x63, 0xc4, 0x29, 0x13, 0x50, 0x89, 0xb7, 0xe2, 0x08, 0x96, 0x92, 0x74, 0x10, 0xf1, 0x2b,
-	0x51, 0xfc, 0x5a, 0x0b, 0xd6, 0xa3, 0xa6, 0x2d, 0x84, 0xf0, 0x08, 0x36, 0xba, 0xd8, 0x0f, 0xc8,
-	0xd3, 0xf5, 0x0d, 0x7f, 0x4c, 0xf4, 0xa4, 0x69, 0x52, 0xd2, 0xb4, 0x2d, 0x28, 0x13, 0x26, 0xce,
-	0x14, 0x2a, 0x7a, 0xd0, 0xd2, 0x9e, 0xc1, 0x66, 0x52, 0xe1, 0x42, 0xa6, 0x3d, 0x64, 0xa9, 0x7c,
-	0x75, 0xd3, 0xb4, 0x97, 0xb0, 0xd9, 0xfe, 0x20, 0x26, 0x08, 0x20, 0xe5, 0x08, 0xc8, 0x3f, 0x4a,
-	0xb0, 0xd1, 0xc2, 0xc3, 0xee, 0xd8, 0xc5, 0x5e, 0x9b, 0x46, 0x39, 0xe0, 0xb1, 0x18, 0x2f, 0x29,
-	0xc6, 0xd7, 0x19, 0x6f, 0x4a, 0x59, 0xbc, 0x91, 0xa3, 0xbc, 0xc9, 0xe5, 0x07, 0x75, 0x76, 0xd2,
-	0x8c, 0x85, 0x9c, 0xdd, 0xe3, 0xce, 0x8e, 0x03, 0xca, 0xe7, 0xc1, 0x3a, 0xc8, 0x94, 0xd9, 0x25,
-	0xc6, 0x6c, 0xfa, 0x33, 0x1b, 0x90, 0xf6, 0x7b, 0x1e, 0x98, 0xeb, 0x9b, 0xbb, 0xe0, 0x3e, 0xf9,
-	0x8c, 0x1d, 0x36, 0xbf, 0xf0, 0x2c, 0x1f, 0xb7, 0xac, 0xc1, 0x60, 0x71, 0x8c, 0xda, 0xef, 0x98,
-	0xbb, 0xa2, 0x9a, 0x6e, 0x10, 0xc8, 0x9f, 0x15, 0xd0, 0x3a, 0x4e, 0xdf, 0x1a, 0x4c, 0x3a, 0xfc,
-	0xa4, 0xd5, 0xb1, 0xd1, 0xa3, 0xc6, 0x1e, 0x5c, 0xfa, 0xd8, 0x26, 0x96, 0x63, 0x17, 0xcc, 0x62,
-	0xba, 0x67, 0x3b, 0x63, 0xaf, 0x87, 0x67, 0x1b, 0x6c, 0xd8, 0x8e, 0x90, 0x59, 0x4e, 0x6e, 0xbe,
-	0x04, 0x13, 0xba, 0xd0, 0xc9, 0xc4, 0xc5, 0x8c, 0x9a, 0x8a, 0x2e, 0x76, 0xa1, 0x4b, 0xf8, 0xcc,
-	0x8b, 0x1b, 0xc5, 0x2e, 0x0d, 0x0a, 0xbb, 0x34, 0xec, 0xf3, 0x4b, 0x43, 0x2e, 0x86, 0xa6, 0x9e,
-	0xa6, 0xe4, 0xc0, 0xf6, 0xbd, 0x89, 0x9e, 0xbe, 0x40, 0xfc, 0xa4, 0x2a, 0x27, 0x4f, 0xaa, 0xfb,
-	0xd3, 0xd3, 0xa8, 0xb6, 0xb7, 0xdd, 0x34, 0x1d, 0xc7, 0x1c, 0x62, 0x7e, 0x59, 0x7d, 0x35, 0x1e,
-	0x34, 0xbb, 0xbe, 0x67, 0xd9, 0xe6, 0xcf, 0x8d, 0xe1, 0x18, 0xd3, 0xb3, 0x0a, 0x3d, 0x86, 0x15,
-	0xc3, 0xf7, 0x8d, 0xde, 0x6b, 0xdc, 0x3f, 0xb4, 0x07, 0x4e, 0x7d, 0xb9, 0xc0, 0xbc, 0xc8, 0x0c,
-	0x4a, 0x0b, 0x8b, 0x30, 0x20, 0xf5, 0x6a, 0x43, 0xda, 0x59, 0xd6, 0xc3, 0x26, 0xda, 0x83, 0x4d,
-	0x8b, 0x50, 0xf3, 0x3d, 0xdb, 0x18, 0xce, 0x80, 0xd7, 0x81, 0x89, 0xa5, 0x8e, 0xa1, 0x26, 0xa0,
-	0x11, 0x31, 0x7f, 0x6c, 0x79, 0xc4, 0xe7, 0xfe, 0x63, 0x27, 0x6e, 0x8d, 0x9d, 0xb8, 0x29, 0x23,
-	0x2a, 0x06, 0x35, 0xdb, 0x89, 0x94, 0xdb, 0x67, 0x78, 0x12, 0x70, 0x83, 0xfe, 0x44, 0xdf, 0x01,
-	0xe5, 0x0d, 0x05, 0x11, 0xdc, 0x49, 0xbf, 0x48, 0x21, 0xe4, 0x73, 0x3c, 0xe1, 0x38, 0xb9, 0xe4,
-	0x0f, 0x4a, 0xdf, 0x97, 0xb4, 0xbf, 0x2b, 0x70, 0x8b, 0x1e, 0x48, 0x1f, 0x87, 0x90, 0x4d, 0x40,
-	0xe1, 0xef, 0x17, 0x43, 0xc3, 0x1f, 0x38, 0xde, 0x28, 0xd8, 0x32, 0x15, 0x3d, 0x65, 0x24, 0x4e,
-	0x60, 0x25, 0x49, 0xe0, 0x71, 0x16, 0x81, 0xcb, 0x8c, 0xc0, 0x3f, 0x62, 0x04, 0xce, 0x01, 0x7c,
-	0x7d, 0xf6, 0x56, 0xb2, 0xd8, 0xbb, 0xbc, 0x20, 0x7b, 0xab, 0xd7, 0x61, 0x2f, 0x14, 0x63, 0x6f,
-	0xed, 0xca, 0xec, 0x5d, 0xf9, 0xd8, 0xec, 0xfd, 0xb7, 0x04, 0x8d, 0xf9, 0xc1, 0x5c, 0xf4, 0x5e,
-	0x2d, 0x46, 0x53, 0x4e, 0x46, 0x33, 0xdd, 0x1f, 0x4b, 0x59, 0xfe, 0x10, 0xa3, 0xa1, 0x44, 0xa3,
-	0x71, 0x17, 0xca, 0x1e, 0x26, 0xe3, 0x61, 0xc8, 0xd0, 0x4f, 0x19, 0x43, 0xa7, 0x60, 0x31, 0x71,
-	0xf5, 0x40, 0x40, 0x7b, 0xaf, 0xc0, 0xad, 0x27, 0xfd, 0xfe, 0xff, 0x56, 0xae, 0xe6, 0x00, 0xfe,
-	0x7f, 0xae, 0x5e, 0x37, 0x57, 0x69, 0x36, 0x12, 0x7c, 0x5e, 0x5f, 0xe5, 0xf7, 0x24, 0x82, 0xcf,
-	0x6f, 0x32, 0x7b, 0xe7, 0x87, 0xf7, 0xbf, 0x29, 0x7b, 0xff, 0x25, 0xc3, 0x57, 0xed, 0xe9, 0x5e,
-	0x45, 0xdd, 0x79, 0x8d, 0x0c, 0xce, 0xfc, 0xbe, 0x16, 0xb3, 0x5b, 0x8e, 0x65, 0x77, 0xfe, 0xf5,
-	0x2f, 0x8b, 0x6e, 0xca, 0x1c, 0xba, 0x35, 0xa0, 0xe6, 0x4f, 0x5c, 0xfc, 0x1c, 0x4f, 0xa6, 0xb9,
-	0x5b, 0xd5, 0xc5, 0x2e, 0x44, 0x60, 0x6b, 0x14, 0x8d, 0x71, 0x28, 0x5c, 0x61, 0x4e, 0x7b, 0xc4,
-	0x9c, 0x56, 0xc0, 0x37, 0xcd, 0x4e, 0x42, 0x8d, 0x9e, 0xa1, 0x5a, 0x1d, 0x00, 0x4a, 0x4a, 0xc7,
-	0xb9, 0x21, 0x15, 0xe5, 0x46, 0x29, 0x8b, 0x1b, 0xda, 0x3b, 0x09, 0x6e, 0xe7, 0x9b, 0xbe, 0x10,
-	0x91, 0xbb, 0xb0, 0x41, 0x2c, 0xdb, 0x1c, 0xe2, 0x29, 0x10, 0xc6, 0x34, 0xfe, 0x7e, 0xf7, 0x25,
-	0xbf, 0xc9, 0x88, 0xe3, 0xd3, 0x05, 0xb9, 0xa0, 0x9e, 0x36, 0x5b, 0x7b, 0x5f, 0x82, 0xed, 0x79,
-	0xb3, 0x16, 0xb0, 0xd3, 0xcb, 0xda, 0xc7, 0xb9, 0xa5, 0x3f, 0xcc, 0xb5, 0xf4, 0xfa, 0x9b, 0xf8,
-	0x52, 0x22, 0x90, 0x37, 0xb5, 0x89, 0xfd, 0x43, 0x82, 0xaf, 0x72, 0x3f, 0x88, 0x16, 0xfc, 0xc8,
-	0xac, 0x91, 0x71, 0xaf, 0x87, 0x09, 0x11, 0x9c, 0x89, 0x98, 0x33, 0x99, 0xee, 0xf0, 0xe1, 0x50,
-	0x17, 0xc5, 0xd0, 0x1e, 0xc0, 0xc0, 0xb0, 0x86, 0xb8, 0xcf, 0x26, 0x2d, 0x65, 0x4e, 0x12, 0xa4,
-	0xb4, 0x77, 0x32, 0x7c, 0xd3, 0xc2, 0x43, 0xec, 0xe3, 0x8f, 0xb8, 0x3b, 0x7d, 0xf8, 0xfb, 0x45,
-	0xfe, 0x27, 0x65, 0xd6, 0x7e, 0x57, 0xb9, 0xf2, 0xf1, 0xba, 0x9c, 0x79, 0x78, 0x1c, 0x67, 0x65,
-	0x47, 0x95, 0xc5, 0x66, 0x2e, 0xcf, 0xd2, 0x67, 0x6a, 0x7f, 0x92, 0xe0, 0x4e, 0xa1, 0x78, 0x2d,
-	0xc4, 0xbb, 0x2b, 0x9c, 0x69, 0x0e, 0xac, 0x46, 0x58, 0x85, 0xee, 0x43, 0x15, 0x87, 0x1d, 0x41,
-	0xad, 0xe6, 0x93, 0x18, 0xf9, 0x66, 0x02, 0xa2, 0x6d, 0xa5, 0x2c, 0xdb, 0xe4, 0xc8, 0x83, 0xd7,
-	0x3f, 0x4b, 0x50, 0x9d, 0xaa, 0x42, 0xa7, 0x59, 0xae, 0x95, 0x98, 0xe1, 0x77, 0xa3, 0x2b, 0x5f,
-	0x7f, 0x97, 0x29, 0x15, 0x3d, 0x2e, 0xe4, 0x4c, 0x36, 0x68, 0xb1, 0xcb, 0x22, 0xdf, 0xb8, 0xa2,
-	0xd7, 0x41, 0xfe, 0xec, 0xae, 0x84, 0xcf, 0xee, 0xea, 0xaf, 0xae, 0xb8, 0x93, 0xdd, 0x89, 0xee,
-	0x64, 0x29, 0xf1, 0x13, 0xf6, 0xaf, 0x09, 0xac, 0x88, 0x43, 0xe8, 0x21, 0x2c, 0x9f, 0x05, 0xed,
-	0x20, 0x80, 0x73, 0x19, 0x3a, 0x15, 0x5e, 0x20, 0x98, 0x6f, 0x25, 0xd8, 0x10, 0xca, 0x5d, 0xd4,
-	0x47, 0xac, 0xde, 0x95, 0xa8, 0x6a, 0x49, 0x05, 0xaa, 0x5a, 0xa5, 0x2b, 0x57, 0xb5, 0xe4, 0x58,
-	0x55, 0x6b, 0xef, 0x0f, 0x00, 0xf2, 0x88, 0x98, 0xe8, 0x25, 0xac, 0xc5, 0xaa, 0x51, 0xe8, 0xeb,
-	0x14, 0x1f, 0x24, 0x2b, 0x60, 0xea, 0x37, 0x45, 0xc4, 0x88, 0x8b, 0x1c, 0xd8, 0x7c, 0x31, 0x1e,
-	0x0e, 0x83, 0xec, 0xdd, 0x9f, 0x74, 0xf1, 0x39, 0xb3, 0xef, 0xdb, 0x29, 0xf3, 0xd3, 0x04, 0xe9,
-	0x5a, 0xf7, 0x0a, 0xcb, 0xb2, 0xbc, 0xac, 0x04, 0x2f, 0xeb, 0x68, 0x2d, 0x78, 0x02, 0x09, 0xab,
-	0x5e, 0xea, 0x7a, 0xb4, 0x83, 0xb8, 0xe8, 0x18, 0xa0, 0x85, 0x87, 0x1d, 0x62, 0xf2, 0x24, 0x48,
-	0x59, 0x68, 0x36, 0x4c, 0x35, 0x7c, 0x99, 0x23, 0x41, 0x5c, 0xd4, 0x86, 0xf5, 0xf8, 0x9b, 0x37,
-	0xaa, 0xb3, 0x85, 0x53, 0x5e, 0xe4, 0xd5, 0xcf, 0x33, 0x46, 0x88, 0x8b, 0x76, 0x61, 0x39, 0x2c,
-	0x0f, 0x21, 0x6e, 0xb9, 0x50, 0x92, 0x52, 0x3f, 0x8d, 0xf5, 0x10, 0x17, 0x3d, 0x82, 0x15, 0xb1,
-	0xe2, 0x82, 0x36, 0xa7, 0x4f, 0x40, 0x42, 0x7d, 0x48, 0xfd, 0x2c, 0xa5, 0x97, 0x9b, 0x1d, 0xaf,
-	0x8b, 0x04, 0x66, 0xa7, 0xd4, 0x5f, 0x02, 0xb3, 0x53, 0x0b, 0x29, 0x6d, 0x58, 0x6f, 0xa7, 0x2b,
-	0x6a, 0x67, 0x2a, 0x6a, 0xcf, 0x51, 0x94, 0xe2, 0xc8, 0x94, 0x4a, 0x80, 0xa0, 0x28, 0xe1, 0xc8,
-	0x16, 0x63, 0xb9, 0xf8, 0x18, 0x8e, 0xbe, 0x15, 0x4a, 0xc7, 0x1e, 0xdb, 0xd5, 0x7a, 0xfa, 0x00,
-	0x71, 0xd1, 0x19, 0x6c, 0xcf, 0x7b, 0x80, 0x41, 0xb7, 0x8b, 0x3c, 0xb8, 0xa9, 0x5f, 0x17, 0x90,
-	0x22, 0x2e, 0xba, 0x80, 0x46, 0xde, 0x55, 0x1b, 0xed, 0x14, 0xfd, 0x98, 0x50, 0xef, 0x16, 0x94,
-	0xe4, 0x28, 0xe7, 0x7d, 0xa8, 0x06, 0x28, 0x73, 0x9e, 0x2a, 0x02, 0x94, 0xb9, 0x5f, 0xbc, 0xbf,
-	0x85, 0x5b, 0x91, 0xc3, 0x3d, 0x65, 0xbd, 0x7b, 0x61, 0x7e, 0x14, 0xb8, 0xb2, 0xa9, 0xf7, 0x8b,
-	0x0b, 0x13, 0x77, 0xff, 0x8b, 0x5f, 0x7e, 0x7e, 0xe4, 0x62, 0xfb, 0xf4, 0xb0, 0x23, 0xfc, 0x45,
-	0x64, 0x44, 0xcc, 0x47, 0x23, 0x62, 0xbe, 0x2a, 0xb3, 0xe6, 0x77, 0xff, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x4c, 0x17, 0xe7, 0xd8, 0x8b, 0x22, 0x00, 0x00,
+func init() { proto.RegisterFile("msg/msg.proto", fileDescriptor_msg_85e8b90e26cbc7d3) }
+
+var fileDescriptor_msg_85e8b90e26cbc7d3 = []byte{
+	// 1892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6f, 0xe3, 0xc6,
+	0x15, 0x07, 0x45, 0xd3, 0xb2, 0x9e, 0xec, 0xd8, 0x3b, 0x76, 0xb6, 0x5a, 0x66, 0xd1, 0x55, 0x98,
+	0xdd, 0xc4, 0xdb, 0x6c, 0x64, 0xd4, 0x2d, 0x90, 0xa2, 0x5b, 0xa0, 0x89, 0xa3, 0xad, 0x63, 0x24,
+	0xea, 0xee, 0x52, 0xdb, 0x16, 0x68, 0x0f, 0x0e, 0x23, 0x3d, 0x71, 0x09, 0x4b, 0x24, 0xcd, 0xa1,
+	0x76, 0xad, 0xfe, 0x3b, 0x14, 0x68, 0x6f, 0x39, 0xf4, 0xd0, 0x43, 0xbf, 0x40, 0x6f, 0x41, 0x3f,
+	0x40, 0x4f, 0xfd, 0x00, 0x41, 0x2f, 0xfd, 0x16, 0xfd, 0x12, 0xc5, 0xcc, 0x90, 0xd2, 0xf0, 0x9f,
+	0x48, 0xcb, 0x81, 0x17, 0x68, 0x73, 0xd3, 0x7b, 0xf3, 0xe6, 0xcd, 0xfb, 0xf3, 0x9b, 0x37, 0xc3,
+	0x79, 0x82, 0xad, 0x09, 0xb5, 0x0f, 0x26, 0xd4, 0xee, 0xf8, 0x81, 0x17, 0x7a, 0x44, 0x9d, 0x50,
+	0x5b, 0xdf, 0x7f, 0xec, 0xa3, 0xfb, 0xde, 0x49, 0xef, 0xbd, 0x3e, 0x06, 0x2f, 0x30, 0x38, 0xf0,
+	0xcf, 0xec, 0x03, 0x3e, 0x7c, 0x40, 0x87, 0x67, 0xa7, 0x2f, 0xe9, 0xc1, 0x4b, 0x2a, 0xc4, 0xf5,
+	0x4e, 0xa9, 0x64, 0x60, 0xf9, 0x3e, 0x06, 0x91, 0xbc, 0xf1, 0x1b, 0x68, 0xf6, 0xa8, 0xdd, 0xb5,
+	0x42, 0xeb, 0x99, 0xd7, 0x7b, 0x4a, 0xf6, 0x40, 0x0b, 0xbd, 0x33, 0x74, 0x5b, 0x4a, 0x5b, 0xd9,
+	0x6f, 0x98, 0x82, 0x20, 0x6d, 0x68, 0x7a, 0x3e, 0x06, 0x56, 0xe8, 0x78, 0xee, 0x49, 0xb7, 0x55,
+	0xe3, 0x63, 0x32, 0x8b, 0x7c, 0x1f, 0xea, 0x13, 0xa1, 0xa6, 0xa5, 0xb6, 0x95, 0xfd, 0xe6, 0xa1,
+	0xde, 0xa1, 0xdc, 0x80, 0x53, 0xcb, 0x77, 0x4e, 0x7d, 0x2b, 0xb0, 0x26, 0xb4, 0x13, 0x2d, 0x64,
+	0xc6, 0xa2, 0x06, 0x4a, 0x8b, 0x77, 0x8f, 0x64, 0x25, 0x4a, 0x65, 0x25, 0xe5, 0xc6, 0x19, 0x5f,
+	0x28, 0xb0, 0xfd, 0x64, 0x4a, 0x9f, 0xcb, 0x8e, 0xb6, 0xa1, 0xf9, 0x58, 0x9a, 0x25, 0xdc, 0x95,
+	0x59, 0xb2, 0x35, 0xb5, 0xea, 0xd6, 0x18, 0xb0, 0xe9, 0x4f, 0xe9, 0xf3, 0x67, 0xde, 0xcf, 0x28,
+	0x06, 0x27, 0x5d, 0x1e, 0x8d, 0x86, 0x99, 0xe0, 0x19, 0x7f, 0x53, 0x80, 0x2c, 0x6c, 0xf1, 0x5c,
+	0xdb, 0x3b, 0x9a, 0xf5, 0x9e, 0x92, 0x16, 0xd4, 0xc7, 0x16, 0x0d, 0xfb, 0x78, 0xce, 0xcd, 0x59,
+	0x33, 0x63, 0x92, 0xdc, 0x85, 0x2d, 0xcb, 0xb6, 0x03, 0xb4, 0x93, 0x4e, 0x26, 0x99, 0xe4, 0x10,
+	0x9a, 0x13, 0xa4, 0xd4, 0xb2, 0xf1, 0x53, 0x87, 0x86, 0x2d, 0xb5, 0xad, 0xee, 0x37, 0x0f, 0x77,
+	0x3a, 0x0c, 0x4a, 0x92, 0xe7, 0xa6, 0x2c, 0x44, 0x6e, 0x43, 0x23, 0x0c, 0x1c, 0xdb, 0xe6, 0xb6,
+	0xae, 0x71, 0xad, 0x0b, 0x86, 0xf1, 0x53, 0x20, 0xc7, 0x18, 0xf6, 0xac, 0x8b, 0x0f, 0xdd, 0x61,
+	0xcf, 0x71, 0xfb, 0x78, 0x6e, 0xe2, 0x39, 0xb9, 0x09, 0xeb, 0x91, 0x73, 0x22, 0x6a, 0x11, 0x95,
+	0x0e, 0x69, 0x2d, 0x13, 0x52, 0xe3, 0x25, 0xec, 0x66, 0xf4, 0x51, 0x9f, 0x39, 0xfe, 0x28, 0x08,
+	0x3e, 0xf2, 0x86, 0xc8, 0x35, 0x6a, 0x66, 0x4c, 0xb2, 0xa5, 0x1e, 0x05, 0x41, 0x8f, 0xda, 0x91,
+	0xb6, 0x88, 0x62, 0xfc, 0x9e, 0x75, 0xc1, 0x22, 0xc5, 0xe2, 0xbb, 0x65, 0x46, 0x14, 0xe7, 0x73,
+	0xbd, 0xad, 0xb5, 0x88, 0xcf, 0x29, 0xe3, 0xd7, 0x00, 0x7d, 0x74, 0x87, 0x3d, 0x6a, 0x33, 0x07,
+	0xae, 0x17, 0xe4, 0x7f, 0x57, 0xa0, 0x39, 0x5f, 0x5c, 0x78, 0x8b, 0x49, 0x6f, 0x71, 0xe1, 0x2d,
+	0x26, 0xbc, 0x15, 0x14, 0xb3, 0x4c, 0xac, 0xd3, 0xa3, 0xf6, 0x3c, 0x4d, 0x32, 0x8b, 0x49, 0x0c,
+	0xc6, 0x0e, 0xba, 0xa1, 0x90, 0xd0, 0x84, 0x84, 0xc4, 0x22, 0x3a, 0x6c, 0x50, 0x74, 0x87, 0xcf,
+	0x9c, 0x09, 0xb6, 0xd6, 0xdb, 0xca, 0xbe, 0x6a, 0xce, 0x69, 0xf2, 0x1a, 0xd4, 0xf0, 0xa2, 0x55,
+	0xe7, 0x93, 0x6a, 0x78, 0x61, 0x0c, 0xa0, 0xf9, 0xd1, 0x18, 0xad, 0x20, 0x0a, 0xd7, 0x4d, 0x58,
+	0x9f, 0x26, 0xf2, 0x2d, 0x28, 0xa6, 0xd2, 0xf3, 0x23, 0x24, 0x08, 0x83, 0xe7, 0x74, 0x3a, 0x98,
+	0x6a, 0x76, 0x53, 0x7e, 0x00, 0x9b, 0x8b, 0x45, 0x56, 0x09, 0x8b, 0xf1, 0x57, 0x05, 0xb6, 0xfb,
+	0xc8, 0xfc, 0x4b, 0x60, 0x33, 0xd7, 0xd6, 0x16, 0xd4, 0xed, 0xc0, 0x9b, 0xfa, 0x73, 0x53, 0x63,
+	0x92, 0xcd, 0x98, 0x08, 0xc8, 0x44, 0x50, 0x12, 0x54, 0xda, 0x83, 0xb5, 0x2c, 0x1c, 0x64, 0xff,
+	0xb5, 0xa4, 0xff, 0x46, 0x17, 0x76, 0x92, 0xa6, 0xad, 0xe4, 0xe1, 0x63, 0xd8, 0xed, 0x63, 0x18,
+	0x81, 0xa7, 0x1f, 0x5a, 0xe1, 0x94, 0x9a, 0x59, 0xd3, 0x94, 0xac, 0x69, 0x37, 0x61, 0x9d, 0x72,
+	0x71, 0xae, 0x50, 0x33, 0x23, 0xca, 0xf8, 0x18, 0xf6, 0xb2, 0x0a, 0x57, 0x32, 0xed, 0x7d, 0xbe,
+	0x95, 0x2f, 0x6f, 0x9a, 0xf1, 0x19, 0xec, 0x1d, 0x7f, 0x2d, 0x26, 0x48, 0x4e, 0xaa, 0x09, 0x27,
+	0xff, 0xa8, 0xc0, 0x6e, 0x17, 0xc7, 0xfd, 0xa9, 0x8f, 0xc1, 0x31, 0xcb, 0x72, 0x84, 0x63, 0x39,
+	0x5f, 0x4a, 0x0a, 0xaf, 0x0b, 0xdc, 0xd4, 0x8a, 0x70, 0xa3, 0x26, 0x71, 0x53, 0x8a, 0x0f, 0x16,
+	0xec, 0xac, 0x19, 0x2b, 0x05, 0x7b, 0x20, 0x82, 0x9d, 0x76, 0xa8, 0x1c, 0x07, 0x3b, 0xa0, 0x32,
+	0x64, 0xd7, 0x38, 0xb2, 0xd9, 0xcf, 0x62, 0x87, 0x8c, 0xdf, 0xc3, 0x5e, 0x76, 0x91, 0x95, 0x12,
+	0xb3, 0x5a, 0x9d, 0xfc, 0x98, 0x1f, 0x36, 0xbf, 0x08, 0x9c, 0x10, 0xbb, 0xce, 0x68, 0xb4, 0xba,
+	0x8f, 0xc6, 0xef, 0x60, 0x37, 0xa3, 0xe9, 0x1a, 0x1d, 0xf9, 0xb3, 0x06, 0x46, 0xcf, 0x1b, 0x3a,
+	0xa3, 0x59, 0x4f, 0x9c, 0xb4, 0x26, 0x5a, 0x03, 0x66, 0xec, 0xa3, 0x8b, 0x10, 0x5d, 0xea, 0x78,
+	0x6e, 0xc5, 0x5d, 0xcc, 0x6a, 0xb6, 0x37, 0x0d, 0x06, 0xb8, 0x28, 0xb0, 0x31, 0x9d, 0x00, 0xb3,
+	0x9a, 0x2d, 0xbe, 0x14, 0x29, 0x5b, 0xe8, 0xd9, 0xcc, 0x47, 0x0e, 0x4d, 0xcd, 0x94, 0x59, 0xe4,
+	0x02, 0x5e, 0x0f, 0xd2, 0x46, 0xf1, 0x4b, 0x83, 0xc6, 0x2f, 0x0d, 0x47, 0xe2, 0xd2, 0x50, 0xea,
+	0x43, 0xc7, 0xcc, 0x53, 0xf2, 0xc8, 0x0d, 0x83, 0x99, 0x99, 0xbf, 0x40, 0xfa, 0xa4, 0x5a, 0xcf,
+	0x9e, 0x54, 0x0f, 0xe6, 0xa7, 0x51, 0xf3, 0xf0, 0x76, 0xc7, 0xf6, 0x3c, 0x7b, 0x8c, 0xe2, 0xb2,
+	0xfa, 0xf9, 0x74, 0xd4, 0xe9, 0x87, 0x81, 0xe3, 0xda, 0x3f, 0xb7, 0xc6, 0x53, 0x64, 0x67, 0x15,
+	0xf9, 0x00, 0x36, 0xad, 0x30, 0xb4, 0x06, 0xcf, 0x71, 0x78, 0xe2, 0x8e, 0xbc, 0xd6, 0x46, 0x85,
+	0x79, 0x89, 0x19, 0x0c, 0x16, 0x0e, 0xe5, 0x8e, 0xb4, 0x1a, 0x6d, 0x65, 0x7f, 0xc3, 0x8c, 0x49,
+	0x72, 0x08, 0x7b, 0x0e, 0x65, 0xe6, 0x07, 0xae, 0x35, 0x5e, 0x38, 0xde, 0x02, 0x2e, 0x96, 0x3b,
+	0x46, 0x3a, 0x40, 0x26, 0xd4, 0xfe, 0x89, 0x13, 0xd0, 0x50, 0xc4, 0x8f, 0x9f, 0xb8, 0x4d, 0x7e,
+	0xe2, 0xe6, 0x8c, 0xe8, 0x08, 0x7a, 0x71, 0x10, 0x19, 0xb6, 0xcf, 0x70, 0x16, 0x61, 0x83, 0xfd,
+	0x24, 0xdf, 0x05, 0xed, 0x05, 0x73, 0x22, 0xba, 0x93, 0xbe, 0x91, 0x03, 0xc8, 0x4f, 0x70, 0x26,
+	0xfc, 0x14, 0x92, 0x3f, 0xac, 0xfd, 0x40, 0x31, 0xfe, 0xa1, 0xc1, 0x1d, 0x76, 0x20, 0xbd, 0x1a,
+	0x40, 0x76, 0x80, 0xc4, 0xbf, 0x9f, 0x8c, 0xad, 0x70, 0xe4, 0x05, 0x93, 0xa8, 0x64, 0x6a, 0x66,
+	0xce, 0x48, 0x1a, 0xc0, 0x5a, 0x16, 0xc0, 0xd3, 0x22, 0x00, 0xaf, 0x73, 0x00, 0xff, 0x98, 0x03,
+	0xb8, 0xc4, 0xe1, 0xab, 0xa3, 0xb7, 0x5e, 0x84, 0xde, 0x8d, 0x15, 0xd1, 0xdb, 0xb8, 0x0a, 0x7a,
+	0xa1, 0x1a, 0x7a, 0x9b, 0x97, 0x46, 0xef, 0xe6, 0xab, 0x46, 0xef, 0x7f, 0x14, 0x68, 0x2f, 0x4f,
+	0xe6, 0xaa, 0xf7, 0x6a, 0x39, 0x9b, 0x6a, 0x36, 0x9b, 0xf9, 0xf1, 0x58, 0x2b, 0x8a, 0x87, 0x9c,
+	0x0d, 0x2d, 0x99, 0x8d, 0xfb, 0xb0, 0x1e, 0x20, 0x9d, 0x8e, 0x63, 0x84, 0xde, 0xe0, 0x08, 0x9d,
+	0x3b, 0x8b, 0xd4, 0x37, 0x23, 0x01, 0xe3, 0x2b, 0x0d, 0xee, 0x7c, 0x38, 0x1c, 0xfe, 0x7f, 0xed,
+	0xd5, 0x12, 0x87, 0xbf, 0xd9, 0xab, 0x57, 0xdd, 0xab, 0x6c, 0x37, 0x52, 0x3c, 0x6f, 0x6d, 0x89,
+	0x7b, 0x12, 0xc5, 0xf3, 0xeb, 0xdc, 0xbd, 0xcb, 0xd3, 0xfb, 0xbf, 0xb4, 0x7b, 0xff, 0xad, 0xc2,
+	0x5b, 0xc7, 0xf3, 0x5a, 0xc5, 0xc2, 0x79, 0x85, 0x1d, 0x5c, 0xf8, 0x7d, 0x2d, 0xef, 0x6e, 0x35,
+	0xb5, 0xbb, 0xcb, 0xaf, 0x7f, 0x45, 0x70, 0xd3, 0x96, 0xc0, 0xad, 0x0d, 0xcd, 0x70, 0xe6, 0xe3,
+	0x27, 0x38, 0x9b, 0xef, 0xdd, 0x86, 0x29, 0xb3, 0x08, 0x85, 0x9b, 0x93, 0x64, 0x8e, 0x63, 0xe1,
+	0x3a, 0x0f, 0xda, 0x43, 0x1e, 0xb4, 0x0a, 0xb1, 0xe9, 0xf4, 0x32, 0x6a, 0xcc, 0x02, 0xd5, 0xfa,
+	0x08, 0x48, 0x56, 0x3a, 0x8d, 0x0d, 0xa5, 0x2a, 0x36, 0x6a, 0x45, 0xd8, 0x30, 0xbe, 0x54, 0xe0,
+	0x6e, 0xb9, 0xe9, 0x2b, 0x01, 0xb9, 0x0f, 0xbb, 0xd4, 0x71, 0xed, 0x31, 0xce, 0x1d, 0xe1, 0x48,
+	0x13, 0xef, 0x77, 0x6f, 0x8a, 0x9b, 0x8c, 0x3c, 0x3e, 0x5f, 0x50, 0x08, 0x9a, 0x79, 0xb3, 0x8d,
+	0xaf, 0x6a, 0x70, 0x7b, 0xd9, 0xac, 0x15, 0xec, 0x0c, 0x8a, 0xea, 0xb8, 0xb0, 0xf4, 0x47, 0xa5,
+	0x96, 0x5e, 0xbd, 0x88, 0xaf, 0x65, 0x12, 0x79, 0x5d, 0x45, 0xec, 0x9f, 0x0a, 0xbc, 0x55, 0xfa,
+	0x41, 0xb4, 0xe2, 0x47, 0x66, 0x93, 0x4e, 0x07, 0x03, 0xa4, 0x54, 0x0a, 0x26, 0xe1, 0xc1, 0xe4,
+	0xba, 0xe3, 0x87, 0x43, 0x53, 0x16, 0x23, 0x87, 0x00, 0x23, 0xcb, 0x19, 0xe3, 0x90, 0x4f, 0x5a,
+	0x2b, 0x9c, 0x24, 0x49, 0x19, 0x5f, 0xaa, 0xf0, 0x76, 0x17, 0xc7, 0x18, 0xe2, 0x2b, 0xac, 0x4e,
+	0x5f, 0xff, 0xfd, 0xa2, 0xfc, 0x93, 0xb2, 0xa8, 0xde, 0xd5, 0x2f, 0x7d, 0xbc, 0x6e, 0x14, 0x1e,
+	0x1e, 0x4f, 0x8b, 0x76, 0x47, 0xa3, 0xad, 0x96, 0xe1, 0x2c, 0x7f, 0xa6, 0xf1, 0x27, 0x05, 0xde,
+	0xa9, 0x94, 0xaf, 0x95, 0x70, 0x77, 0x89, 0x33, 0xcd, 0x83, 0xad, 0x04, 0xaa, 0xc8, 0x03, 0x68,
+	0x60, 0xcc, 0x88, 0x7a, 0x35, 0xaf, 0xa5, 0xc0, 0xb7, 0x10, 0x90, 0x6d, 0xab, 0x15, 0xd9, 0xa6,
+	0x26, 0x1e, 0xbc, 0xfe, 0x55, 0x83, 0xc6, 0x5c, 0x15, 0x39, 0x2d, 0x0a, 0xad, 0xc2, 0x0d, 0xbf,
+	0x9f, 0x5c, 0xf9, 0xea, 0x55, 0xa6, 0x56, 0xf5, 0xb8, 0x50, 0x0b, 0xd1, 0x60, 0xa4, 0x2e, 0x8b,
+	0xa2, 0x70, 0x25, 0x78, 0xd1, 0xb3, 0xbb, 0x16, 0x3f, 0xbb, 0xeb, 0xbf, 0xba, 0x64, 0x25, 0x7b,
+	0x27, 0x59, 0xc9, 0x72, 0xf2, 0x27, 0xd5, 0xaf, 0x19, 0x6c, 0xca, 0x43, 0xe4, 0x7d, 0xd8, 0x38,
+	0x8b, 0xe8, 0x28, 0x81, 0x4b, 0x11, 0x3a, 0x17, 0x5e, 0x21, 0x99, 0x5f, 0x28, 0xb0, 0x2b, 0xb5,
+	0xbb, 0x58, 0x8c, 0x78, 0xbf, 0x2b, 0xd3, 0xd5, 0x52, 0x2a, 0x74, 0xb5, 0x6a, 0x97, 0xee, 0x6a,
+	0xa9, 0xe9, 0xae, 0xd6, 0x5f, 0x6a, 0x70, 0xa3, 0x8b, 0xd6, 0xf0, 0x53, 0x0c, 0x43, 0xde, 0x40,
+	0x59, 0x74, 0x3e, 0x7d, 0x67, 0xb0, 0x68, 0x0a, 0xf9, 0xce, 0x80, 0x69, 0xf2, 0xad, 0x20, 0x74,
+	0x98, 0x31, 0x91, 0xbf, 0x0b, 0x06, 0xf3, 0xd8, 0x1b, 0x8d, 0x28, 0x86, 0x11, 0x12, 0x22, 0x2a,
+	0xce, 0x15, 0x4b, 0xfa, 0xa6, 0xc8, 0xd5, 0x5e, 0x9c, 0x2b, 0x8d, 0xf3, 0xb4, 0x17, 0x71, 0x2c,
+	0x9f, 0x5b, 0xee, 0x70, 0x8c, 0x41, 0x54, 0xb5, 0x62, 0x52, 0x8a, 0x65, 0x3d, 0xb1, 0x69, 0x5b,
+	0x50, 0xb7, 0xc2, 0x10, 0x27, 0x7e, 0xc8, 0x4b, 0x91, 0x66, 0xc6, 0x24, 0xf9, 0x36, 0xc0, 0x20,
+	0x40, 0x2b, 0x44, 0x8e, 0xcc, 0x06, 0xb7, 0x47, 0xe2, 0xa4, 0x2b, 0x3a, 0x64, 0x2a, 0xfa, 0xe1,
+	0x1f, 0x00, 0x58, 0xb3, 0x99, 0x7c, 0x06, 0xdb, 0xa9, 0x2e, 0x1d, 0xb9, 0x97, 0x83, 0x8d, 0x6c,
+	0x67, 0x50, 0x7f, 0xbb, 0x8a, 0x18, 0xf5, 0x89, 0x07, 0x7b, 0x4f, 0xa6, 0xe3, 0x71, 0x54, 0xd5,
+	0x8e, 0x66, 0x7d, 0x3c, 0xe7, 0x79, 0xfb, 0x4e, 0xce, 0xfc, 0x3c, 0x41, 0xb6, 0xd6, 0xbb, 0x95,
+	0x65, 0x79, 0xbd, 0xaa, 0x47, 0x1d, 0x07, 0xb2, 0x1d, 0x3d, 0x0d, 0xc5, 0xdd, 0x40, 0x7d, 0x27,
+	0xc9, 0xa0, 0x3e, 0x79, 0x0a, 0xd0, 0xc5, 0x71, 0x8f, 0xda, 0xa2, 0x38, 0xe4, 0x2c, 0xb4, 0x18,
+	0x66, 0x1a, 0xde, 0x2c, 0x91, 0xa0, 0x3e, 0x39, 0x86, 0x9d, 0x74, 0x2f, 0x80, 0xb4, 0xf8, 0xc2,
+	0x39, 0x9d, 0x0a, 0xfd, 0x56, 0xc1, 0x08, 0xf5, 0xc9, 0x01, 0x6c, 0xc4, 0x6d, 0x33, 0x22, 0x2c,
+	0x97, 0x5a, 0x75, 0xfa, 0x8d, 0x14, 0x87, 0xfa, 0xe4, 0x21, 0x6c, 0xca, 0x9d, 0x28, 0xb2, 0x37,
+	0x7f, 0x1a, 0x93, 0xfa, 0x66, 0xfa, 0xeb, 0x39, 0x5c, 0x61, 0x76, 0xba, 0x5f, 0x14, 0x99, 0x9d,
+	0xd3, 0x97, 0xd2, 0x6f, 0x15, 0x8c, 0x08, 0x45, 0xc7, 0xf9, 0x8a, 0x8e, 0x0b, 0x15, 0x1d, 0x2f,
+	0x51, 0x94, 0x13, 0xc8, 0x9c, 0x0e, 0x89, 0x7e, 0xab, 0x60, 0x84, 0xfa, 0xa4, 0x0b, 0xdb, 0xa9,
+	0x26, 0x01, 0xf9, 0x56, 0x2c, 0x9d, 0x6a, 0x42, 0xe8, 0xad, 0xfc, 0x01, 0xea, 0x93, 0x33, 0xb8,
+	0xbd, 0xec, 0x61, 0x8a, 0xdc, 0xad, 0xf2, 0x10, 0xa9, 0xdf, 0xab, 0x20, 0x45, 0x7d, 0xf2, 0x12,
+	0xda, 0x65, 0x9f, 0x20, 0x64, 0xbf, 0xea, 0x47, 0x96, 0x7e, 0xbf, 0xa2, 0xa4, 0xf0, 0x72, 0xd9,
+	0x07, 0x7c, 0xe4, 0x65, 0xc9, 0x13, 0x8e, 0x7e, 0xaf, 0x82, 0x14, 0xf5, 0xc9, 0x6f, 0xe1, 0x4e,
+	0xe2, 0xd2, 0x93, 0xb3, 0xde, 0xbb, 0xf1, 0xfe, 0xa8, 0x70, 0x95, 0xd5, 0x1f, 0x54, 0x17, 0xa6,
+	0xfe, 0xd1, 0x1b, 0xbf, 0xbc, 0xc5, 0xfe, 0x41, 0x73, 0x7a, 0xd2, 0x93, 0xfe, 0x3a, 0x33, 0xa1,
+	0xf6, 0xc3, 0x09, 0xb5, 0x3f, 0x5f, 0xe7, 0xe4, 0xf7, 0xfe, 0x3b, 0x00, 0x1c, 0xb3, 0x39, 0xad,
+	0xa3, 0x23, 0x00, 0x00,
 }
@@ -37,6 +37,8 @@ type GroupInfo struct {
 	ApplyMemberFriend      int32    `protobuf:"varint,15,opt,name=applyMemberFriend" json:"applyMemberFriend,omitempty"`
 	NotificationUpdateTime uint32   `protobuf:"varint,16,opt,name=notificationUpdateTime" json:"notificationUpdateTime,omitempty"`
 	NotificationUserID     string   `protobuf:"bytes,17,opt,name=notificationUserID" json:"notificationUserID,omitempty"`
+	SlowModeSeconds        int32    `protobuf:"varint,18,opt,name=slowModeSeconds" json:"slowModeSeconds,omitempty"`
+	DailyMsgQuota          int32    `protobuf:"varint,19,opt,name=dailyMsgQuota" json:"dailyMsgQuota,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
 	XXX_unrecognized       []byte   `json:"-"`
 	XXX_sizecache          int32    `json:"-"`
@@ -46,7 +48,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{0}
+	return fileDescriptor_ws_755136b32532d37d, []int{0}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupInfo.Unmarshal(m, b)
@@ -185,6 +187,20 @@ func (m *GroupInfo) GetNotificationUserID() string {
 	return ""
 }
 
+func (m *GroupInfo) GetSlowModeSeconds() int32 {
+	if m != nil {
+		return m.SlowModeSeconds
+	}
+	return 0
+}
+
+func (m *GroupInfo) GetDailyMsgQuota() int32 {
+	if m != nil {
+		return m.DailyMsgQuota
+	}
+	return 0
+}
+
 type GroupInfoForSet struct {
 	GroupID              string                 `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
 	GroupName            string                 `protobuf:"bytes,2,opt,name=groupName" json:"groupName,omitempty"`
@@ -195,6 +211,8 @@ type GroupInfoForSet struct {
 	NeedVerification     *wrapperspb.Int32Value `protobuf:"bytes,7,opt,name=needVerification" json:"needVerification,omitempty"`
 	LookMemberInfo       *wrapperspb.Int32Value `protobuf:"bytes,8,opt,name=lookMemberInfo" json:"lookMemberInfo,omitempty"`
 	ApplyMemberFriend    *wrapperspb.Int32Value `protobuf:"bytes,9,opt,name=applyMemberFriend" json:"applyMemberFriend,omitempty"`
+	SlowModeSeconds      *wrapperspb.Int32Value `protobuf:"bytes,10,opt,name=slowModeSeconds" json:"slowModeSeconds,omitempty"`
+	DailyMsgQuota        *wrapperspb.Int32Value `protobuf:"bytes,11,opt,name=dailyMsgQuota" json:"dailyMsgQuota,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -204,7 +222,7 @@ func (m *GroupInfoForSet) Reset()         { *m = GroupInfoForSet{} }
 func (m *GroupInfoForSet) String() string { return proto.CompactTextString(m) }
 func (*GroupInfoForSet) ProtoMessage()    {}
 func (*GroupInfoForSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{1}
+	return fileDescriptor_ws_755136b32532d37d, []int{1}
 }
 func (m *GroupInfoForSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupInfoForSet.Unmarshal(m, b)
@@ -287,6 +305,20 @@ func (m *GroupInfoForSet) GetApplyMemberFriend() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (m *GroupInfoForSet) GetSlowModeSeconds() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.SlowModeSeconds
+	}
+	return nil
+}
+
+func (m *GroupInfoForSet) GetDailyMsgQuota() *wrapperspb.Int32Value {
+	if m != nil {
+		return m.DailyMsgQuota
+	}
+	return nil
+}
+
 type GroupMemberFullInfo struct {
 	GroupID              string   `protobuf:"bytes,1,opt,name=groupID" json:"groupID,omitempty"`
 	UserID               string   `protobuf:"bytes,2,opt,name=userID" json:"userID,omitempty"`
@@ -309,7 +341,7 @@ func (m *GroupMemberFullInfo) Reset()         { *m = GroupMemberFullInfo{} }
 func (m *GroupMemberFullInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupMemberFullInfo) ProtoMessage()    {}
 func (*GroupMemberFullInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{2}
+	return fileDescriptor_ws_755136b32532d37d, []int{2}
 }
 func (m *GroupMemberFullInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupMemberFullInfo.Unmarshal(m, b)
@@ -428,7 +460,7 @@ func (m *PublicUserInfo) Reset()         { *m = PublicUserInfo{} }
 func (m *PublicUserInfo) String() string { return proto.CompactTextString(m) }
 func (*PublicUserInfo) ProtoMessage()    {}
 func (*PublicUserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{3}
+	return fileDescriptor_ws_755136b32532d37d, []int{3}
 }
 func (m *PublicUserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublicUserInfo.Unmarshal(m, b)
@@ -505,7 +537,7 @@ func (m *UserInfo) Reset()         { *m = UserInfo{} }
 func (m *UserInfo) String() string { return proto.CompactTextString(m) }
 func (*UserInfo) ProtoMessage()    {}
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{4}
+	return fileDescriptor_ws_755136b32532d37d, []int{4}
 }
 func (m *UserInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfo.Unmarshal(m, b)
@@ -626,7 +658,7 @@ func (m *FriendInfo) Reset()         { *m = FriendInfo{} }
 func (m *FriendInfo) String() string { return proto.CompactTextString(m) }
 func (*FriendInfo) ProtoMessage()    {}
 func (*FriendInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{5}
+	return fileDescriptor_ws_755136b32532d37d, []int{5}
 }
 func (m *FriendInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendInfo.Unmarshal(m, b)
@@ -711,7 +743,7 @@ func (m *BlackInfo) Reset()         { *m = BlackInfo{} }
 func (m *BlackInfo) String() string { return proto.CompactTextString(m) }
 func (*BlackInfo) ProtoMessage()    {}
 func (*BlackInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{6}
+	return fileDescriptor_ws_755136b32532d37d, []int{6}
 }
 func (m *BlackInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlackInfo.Unmarshal(m, b)
@@ -774,27 +806,28 @@ func (m *BlackInfo) GetEx() string {
 }
 
 type GroupRequest struct {
-	UserInfo             *PublicUserInfo `protobuf:"bytes,1,opt,name=userInfo" json:"userInfo,omitempty"`
-	GroupInfo            *GroupInfo      `protobuf:"bytes,2,opt,name=groupInfo" json:"groupInfo,omitempty"`
-	HandleResult         int32           `protobuf:"varint,3,opt,name=handleResult" json:"handleResult,omitempty"`
-	ReqMsg               string          `protobuf:"bytes,4,opt,name=reqMsg" json:"reqMsg,omitempty"`
-	HandleMsg            string          `protobuf:"bytes,5,opt,name=handleMsg" json:"handleMsg,omitempty"`
-	ReqTime              uint32          `protobuf:"varint,6,opt,name=reqTime" json:"reqTime,omitempty"`
-	HandleUserID         string          `protobuf:"bytes,7,opt,name=handleUserID" json:"handleUserID,omitempty"`
-	HandleTime           uint32          `protobuf:"varint,8,opt,name=handleTime" json:"handleTime,omitempty"`
-	Ex                   string          `protobuf:"bytes,9,opt,name=ex" json:"ex,omitempty"`
-	JoinSource           int32           `protobuf:"varint,10,opt,name=joinSource" json:"joinSource,omitempty"`
-	InviterUserID        string          `protobuf:"bytes,11,opt,name=inviterUserID" json:"inviterUserID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	UserInfo             *PublicUserInfo    `protobuf:"bytes,1,opt,name=userInfo" json:"userInfo,omitempty"`
+	GroupInfo            *GroupInfo         `protobuf:"bytes,2,opt,name=groupInfo" json:"groupInfo,omitempty"`
+	HandleResult         int32              `protobuf:"varint,3,opt,name=handleResult" json:"handleResult,omitempty"`
+	ReqMsg               string             `protobuf:"bytes,4,opt,name=reqMsg" json:"reqMsg,omitempty"`
+	HandleMsg            string             `protobuf:"bytes,5,opt,name=handleMsg" json:"handleMsg,omitempty"`
+	ReqTime              uint32             `protobuf:"varint,6,opt,name=reqTime" json:"reqTime,omitempty"`
+	HandleUserID         string             `protobuf:"bytes,7,opt,name=handleUserID" json:"handleUserID,omitempty"`
+	HandleTime           uint32             `protobuf:"varint,8,opt,name=handleTime" json:"handleTime,omitempty"`
+	Ex                   string             `protobuf:"bytes,9,opt,name=ex" json:"ex,omitempty"`
+	JoinSource           int32              `protobuf:"varint,10,opt,name=joinSource" json:"joinSource,omitempty"`
+	InviterUserID        string             `protobuf:"bytes,11,opt,name=inviterUserID" json:"inviterUserID,omitempty"`
+	JoinAnswers          []*GroupJoinAnswer `protobuf:"bytes,12,rep,name=joinAnswers" json:"joinAnswers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *GroupRequest) Reset()         { *m = GroupRequest{} }
 func (m *GroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GroupRequest) ProtoMessage()    {}
 func (*GroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{7}
+	return fileDescriptor_ws_755136b32532d37d, []int{7}
 }
 func (m *GroupRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupRequest.Unmarshal(m, b)
@@ -891,6 +924,13 @@ func (m *GroupRequest) GetInviterUserID() string {
 	return ""
 }
 
+func (m *GroupRequest) GetJoinAnswers() []*GroupJoinAnswer {
+	if m != nil {
+		return m.JoinAnswers
+	}
+	return nil
+}
+
 type FriendRequest struct {
 	FromUserID           string   `protobuf:"bytes,1,opt,name=fromUserID" json:"fromUserID,omitempty"`
 	FromNickname         string   `protobuf:"bytes,2,opt,name=fromNickname" json:"fromNickname,omitempty"`
@@ -916,7 +956,7 @@ func (m *FriendRequest) Reset()         { *m = FriendRequest{} }
 func (m *FriendRequest) String() string { return proto.CompactTextString(m) }
 func (*FriendRequest) ProtoMessage()    {}
 func (*FriendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{8}
+	return fileDescriptor_ws_755136b32532d37d, []int{8}
 }
 func (m *FriendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendRequest.Unmarshal(m, b)
@@ -1061,7 +1101,7 @@ func (m *Department) Reset()         { *m = Department{} }
 func (m *Department) String() string { return proto.CompactTextString(m) }
 func (*Department) ProtoMessage()    {}
 func (*Department) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{9}
+	return fileDescriptor_ws_755136b32532d37d, []int{9}
 }
 func (m *Department) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Department.Unmarshal(m, b)
@@ -1173,7 +1213,7 @@ func (m *OrganizationUser) Reset()         { *m = OrganizationUser{} }
 func (m *OrganizationUser) String() string { return proto.CompactTextString(m) }
 func (*OrganizationUser) ProtoMessage()    {}
 func (*OrganizationUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{10}
+	return fileDescriptor_ws_755136b32532d37d, []int{10}
 }
 func (m *OrganizationUser) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationUser.Unmarshal(m, b)
@@ -1294,7 +1334,7 @@ func (m *DepartmentMember) Reset()         { *m = DepartmentMember{} }
 func (m *DepartmentMember) String() string { return proto.CompactTextString(m) }
 func (*DepartmentMember) ProtoMessage()    {}
 func (*DepartmentMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{11}
+	return fileDescriptor_ws_755136b32532d37d, []int{11}
 }
 func (m *DepartmentMember) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DepartmentMember.Unmarshal(m, b)
@@ -1375,7 +1415,7 @@ func (m *UserDepartmentMember) Reset()         { *m = UserDepartmentMember{} }
 func (m *UserDepartmentMember) String() string { return proto.CompactTextString(m) }
 func (*UserDepartmentMember) ProtoMessage()    {}
 func (*UserDepartmentMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{12}
+	return fileDescriptor_ws_755136b32532d37d, []int{12}
 }
 func (m *UserDepartmentMember) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserDepartmentMember.Unmarshal(m, b)
@@ -1421,7 +1461,7 @@ func (m *UserInDepartment) Reset()         { *m = UserInDepartment{} }
 func (m *UserInDepartment) String() string { return proto.CompactTextString(m) }
 func (*UserInDepartment) ProtoMessage()    {}
 func (*UserInDepartment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{13}
+	return fileDescriptor_ws_755136b32532d37d, []int{13}
 }
 func (m *UserInDepartment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInDepartment.Unmarshal(m, b)
@@ -1470,7 +1510,7 @@ func (m *PullMessageBySeqListReq) Reset()         { *m = PullMessageBySeqListReq
 func (m *PullMessageBySeqListReq) String() string { return proto.CompactTextString(m) }
 func (*PullMessageBySeqListReq) ProtoMessage()    {}
 func (*PullMessageBySeqListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{14}
+	return fileDescriptor_ws_755136b32532d37d, []int{14}
 }
 func (m *PullMessageBySeqListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PullMessageBySeqListReq.Unmarshal(m, b)
@@ -1529,7 +1569,7 @@ func (m *SeqList) Reset()         { *m = SeqList{} }
 func (m *SeqList) String() string { return proto.CompactTextString(m) }
 func (*SeqList) ProtoMessage()    {}
 func (*SeqList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{15}
+	return fileDescriptor_ws_755136b32532d37d, []int{15}
 }
 func (m *SeqList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SeqList.Unmarshal(m, b)
@@ -1567,7 +1607,7 @@ func (m *MsgDataList) Reset()         { *m = MsgDataList{} }
 func (m *MsgDataList) String() string { return proto.CompactTextString(m) }
 func (*MsgDataList) ProtoMessage()    {}
 func (*MsgDataList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{16}
+	return fileDescriptor_ws_755136b32532d37d, []int{16}
 }
 func (m *MsgDataList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgDataList.Unmarshal(m, b)
@@ -1608,7 +1648,7 @@ func (m *PullMessageBySeqListResp) Reset()         { *m = PullMessageBySeqListRe
 func (m *PullMessageBySeqListResp) String() string { return proto.CompactTextString(m) }
 func (*PullMessageBySeqListResp) ProtoMessage()    {}
 func (*PullMessageBySeqListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{17}
+	return fileDescriptor_ws_755136b32532d37d, []int{17}
 }
 func (m *PullMessageBySeqListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PullMessageBySeqListResp.Unmarshal(m, b)
@@ -1669,7 +1709,7 @@ func (m *GetMaxAndMinSeqReq) Reset()         { *m = GetMaxAndMinSeqReq{} }
 func (m *GetMaxAndMinSeqReq) String() string { return proto.CompactTextString(m) }
 func (*GetMaxAndMinSeqReq) ProtoMessage()    {}
 func (*GetMaxAndMinSeqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{18}
+	return fileDescriptor_ws_755136b32532d37d, []int{18}
 }
 func (m *GetMaxAndMinSeqReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaxAndMinSeqReq.Unmarshal(m, b)
@@ -1722,7 +1762,7 @@ func (m *MaxAndMinSeq) Reset()         { *m = MaxAndMinSeq{} }
 func (m *MaxAndMinSeq) String() string { return proto.CompactTextString(m) }
 func (*MaxAndMinSeq) ProtoMessage()    {}
 func (*MaxAndMinSeq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{19}
+	return fileDescriptor_ws_755136b32532d37d, []int{19}
 }
 func (m *MaxAndMinSeq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MaxAndMinSeq.Unmarshal(m, b)
@@ -1771,7 +1811,7 @@ func (m *GetMaxAndMinSeqResp) Reset()         { *m = GetMaxAndMinSeqResp{} }
 func (m *GetMaxAndMinSeqResp) String() string { return proto.CompactTextString(m) }
 func (*GetMaxAndMinSeqResp) ProtoMessage()    {}
 func (*GetMaxAndMinSeqResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{20}
+	return fileDescriptor_ws_755136b32532d37d, []int{20}
 }
 func (m *GetMaxAndMinSeqResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetMaxAndMinSeqResp.Unmarshal(m, b)
@@ -1840,7 +1880,7 @@ func (m *UserSendMsgResp) Reset()         { *m = UserSendMsgResp{} }
 func (m *UserSendMsgResp) String() string { return proto.CompactTextString(m) }
 func (*UserSendMsgResp) ProtoMessage()    {}
 func (*UserSendMsgResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{21}
+	return fileDescriptor_ws_755136b32532d37d, []int{21}
 }
 func (m *UserSendMsgResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserSendMsgResp.Unmarshal(m, b)
@@ -1900,7 +1940,7 @@ type MsgData struct {
 	SessionType          int32            `protobuf:"varint,9,opt,name=sessionType" json:"sessionType,omitempty"`
 	MsgFrom              int32            `protobuf:"varint,10,opt,name=msgFrom" json:"msgFrom,omitempty"`
 	ContentType          int32            `protobuf:"varint,11,opt,name=contentType" json:"contentType,omitempty"`
-	Content              []byte           `protobuf:"bytes,12,opt,name=content,proto3" json:"content,omitempty"`
+	Content              []byte           `protobuf:"bytes,12,opt,name=content" json:"content,omitempty"`
 	Seq                  uint32           `protobuf:"varint,14,opt,name=seq" json:"seq,omitempty"`
 	SendTime             int64            `protobuf:"varint,15,opt,name=sendTime" json:"sendTime,omitempty"`
 	CreateTime           int64            `protobuf:"varint,16,opt,name=createTime" json:"createTime,omitempty"`
@@ -1908,7 +1948,7 @@ type MsgData struct {
 	Options              map[string]bool  `protobuf:"bytes,18,rep,name=options" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
 	OfflinePushInfo      *OfflinePushInfo `protobuf:"bytes,19,opt,name=offlinePushInfo" json:"offlinePushInfo,omitempty"`
 	AtUserIDList         []string         `protobuf:"bytes,20,rep,name=atUserIDList" json:"atUserIDList,omitempty"`
-	MsgDataList          []byte           `protobuf:"bytes,21,opt,name=msgDataList,proto3" json:"msgDataList,omitempty"`
+	MsgDataList          []byte           `protobuf:"bytes,21,opt,name=msgDataList" json:"msgDataList,omitempty"`
 	AttachedInfo         string           `protobuf:"bytes,22,opt,name=attachedInfo" json:"attachedInfo,omitempty"`
 	Ex                   string           `protobuf:"bytes,23,opt,name=ex" json:"ex,omitempty"`
 	IsReact              bool             `protobuf:"varint,40,opt,name=isReact" json:"isReact,omitempty"`
@@ -1923,7 +1963,7 @@ func (m *MsgData) Reset()         { *m = MsgData{} }
 func (m *MsgData) String() string { return proto.CompactTextString(m) }
 func (*MsgData) ProtoMessage()    {}
 func (*MsgData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{22}
+	return fileDescriptor_ws_755136b32532d37d, []int{22}
 }
 func (m *MsgData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MsgData.Unmarshal(m, b)
@@ -2133,7 +2173,7 @@ func (m *OfflinePushInfo) Reset()         { *m = OfflinePushInfo{} }
 func (m *OfflinePushInfo) String() string { return proto.CompactTextString(m) }
 func (*OfflinePushInfo) ProtoMessage()    {}
 func (*OfflinePushInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{23}
+	return fileDescriptor_ws_755136b32532d37d, []int{23}
 }
 func (m *OfflinePushInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OfflinePushInfo.Unmarshal(m, b)
@@ -2189,7 +2229,7 @@ func (m *OfflinePushInfo) GetIOSBadgeCount() bool {
 }
 
 type TipsComm struct {
-	Detail               []byte   `protobuf:"bytes,1,opt,name=detail,proto3" json:"detail,omitempty"`
+	Detail               []byte   `protobuf:"bytes,1,opt,name=detail" json:"detail,omitempty"`
 	DefaultTips          string   `protobuf:"bytes,2,opt,name=defaultTips" json:"defaultTips,omitempty"`
 	JsonDetail           string   `protobuf:"bytes,3,opt,name=jsonDetail" json:"jsonDetail,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2201,7 +2241,7 @@ func (m *TipsComm) Reset()         { *m = TipsComm{} }
 func (m *TipsComm) String() string { return proto.CompactTextString(m) }
 func (*TipsComm) ProtoMessage()    {}
 func (*TipsComm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{24}
+	return fileDescriptor_ws_755136b32532d37d, []int{24}
 }
 func (m *TipsComm) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TipsComm.Unmarshal(m, b)
@@ -2242,7 +2282,7 @@ func (m *TipsComm) GetJsonDetail() string {
 	return ""
 }
 
-// 	OnGroupCreated()
+// OnGroupCreated()
 type GroupCreatedTips struct {
 	Group                *GroupInfo             `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo   `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2258,7 +2298,7 @@ func (m *GroupCreatedTips) Reset()         { *m = GroupCreatedTips{} }
 func (m *GroupCreatedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupCreatedTips) ProtoMessage()    {}
 func (*GroupCreatedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{25}
+	return fileDescriptor_ws_755136b32532d37d, []int{25}
 }
 func (m *GroupCreatedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupCreatedTips.Unmarshal(m, b)
@@ -2313,7 +2353,7 @@ func (m *GroupCreatedTips) GetGroupOwnerUser() *GroupMemberFullInfo {
 	return nil
 }
 
-// 	OnGroupInfoSet()
+// OnGroupInfoSet()
 type GroupInfoSetTips struct {
 	OpUser               *GroupMemberFullInfo `protobuf:"bytes,1,opt,name=opUser" json:"opUser,omitempty"`
 	MuteTime             int64                `protobuf:"varint,2,opt,name=muteTime" json:"muteTime,omitempty"`
@@ -2327,7 +2367,7 @@ func (m *GroupInfoSetTips) Reset()         { *m = GroupInfoSetTips{} }
 func (m *GroupInfoSetTips) String() string { return proto.CompactTextString(m) }
 func (*GroupInfoSetTips) ProtoMessage()    {}
 func (*GroupInfoSetTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{26}
+	return fileDescriptor_ws_755136b32532d37d, []int{26}
 }
 func (m *GroupInfoSetTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupInfoSetTips.Unmarshal(m, b)
@@ -2368,7 +2408,7 @@ func (m *GroupInfoSetTips) GetGroup() *GroupInfo {
 	return nil
 }
 
-// 	OnJoinGroupApplication()
+// OnJoinGroupApplication()
 type JoinGroupApplicationTips struct {
 	Group                *GroupInfo      `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	Applicant            *PublicUserInfo `protobuf:"bytes,2,opt,name=applicant" json:"applicant,omitempty"`
@@ -2382,7 +2422,7 @@ func (m *JoinGroupApplicationTips) Reset()         { *m = JoinGroupApplicationTi
 func (m *JoinGroupApplicationTips) String() string { return proto.CompactTextString(m) }
 func (*JoinGroupApplicationTips) ProtoMessage()    {}
 func (*JoinGroupApplicationTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{27}
+	return fileDescriptor_ws_755136b32532d37d, []int{27}
 }
 func (m *JoinGroupApplicationTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JoinGroupApplicationTips.Unmarshal(m, b)
@@ -2423,7 +2463,8 @@ func (m *JoinGroupApplicationTips) GetReqMsg() string {
 	return ""
 }
 
-// 	OnQuitGroup()
+//	OnQuitGroup()
+//
 // Actively leave the group
 type MemberQuitTips struct {
 	Group                *GroupInfo           `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
@@ -2438,7 +2479,7 @@ func (m *MemberQuitTips) Reset()         { *m = MemberQuitTips{} }
 func (m *MemberQuitTips) String() string { return proto.CompactTextString(m) }
 func (*MemberQuitTips) ProtoMessage()    {}
 func (*MemberQuitTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{28}
+	return fileDescriptor_ws_755136b32532d37d, []int{28}
 }
 func (m *MemberQuitTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MemberQuitTips.Unmarshal(m, b)
@@ -2479,7 +2520,7 @@ func (m *MemberQuitTips) GetOperationTime() int64 {
 	return 0
 }
 
-// 	OnApplicationGroupAccepted()
+// OnApplicationGroupAccepted()
 type GroupApplicationAcceptedTips struct {
 	Group                *GroupInfo           `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2494,7 +2535,7 @@ func (m *GroupApplicationAcceptedTips) Reset()         { *m = GroupApplicationAc
 func (m *GroupApplicationAcceptedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupApplicationAcceptedTips) ProtoMessage()    {}
 func (*GroupApplicationAcceptedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{29}
+	return fileDescriptor_ws_755136b32532d37d, []int{29}
 }
 func (m *GroupApplicationAcceptedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupApplicationAcceptedTips.Unmarshal(m, b)
@@ -2542,7 +2583,7 @@ func (m *GroupApplicationAcceptedTips) GetReceiverAs() int32 {
 	return 0
 }
 
-// 	OnApplicationGroupRejected()
+// OnApplicationGroupRejected()
 type GroupApplicationRejectedTips struct {
 	Group                *GroupInfo           `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2557,7 +2598,7 @@ func (m *GroupApplicationRejectedTips) Reset()         { *m = GroupApplicationRe
 func (m *GroupApplicationRejectedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupApplicationRejectedTips) ProtoMessage()    {}
 func (*GroupApplicationRejectedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{30}
+	return fileDescriptor_ws_755136b32532d37d, []int{30}
 }
 func (m *GroupApplicationRejectedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupApplicationRejectedTips.Unmarshal(m, b)
@@ -2605,7 +2646,7 @@ func (m *GroupApplicationRejectedTips) GetReceiverAs() int32 {
 	return 0
 }
 
-// 	OnTransferGroupOwner()
+// OnTransferGroupOwner()
 type GroupOwnerTransferredTips struct {
 	Group                *GroupInfo           `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2620,7 +2661,7 @@ func (m *GroupOwnerTransferredTips) Reset()         { *m = GroupOwnerTransferred
 func (m *GroupOwnerTransferredTips) String() string { return proto.CompactTextString(m) }
 func (*GroupOwnerTransferredTips) ProtoMessage()    {}
 func (*GroupOwnerTransferredTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{31}
+	return fileDescriptor_ws_755136b32532d37d, []int{31}
 }
 func (m *GroupOwnerTransferredTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupOwnerTransferredTips.Unmarshal(m, b)
@@ -2668,7 +2709,7 @@ func (m *GroupOwnerTransferredTips) GetOperationTime() int64 {
 	return 0
 }
 
-// 	OnMemberKicked()
+// OnMemberKicked()
 type MemberKickedTips struct {
 	Group                *GroupInfo             `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo   `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2683,7 +2724,7 @@ func (m *MemberKickedTips) Reset()         { *m = MemberKickedTips{} }
 func (m *MemberKickedTips) String() string { return proto.CompactTextString(m) }
 func (*MemberKickedTips) ProtoMessage()    {}
 func (*MemberKickedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{32}
+	return fileDescriptor_ws_755136b32532d37d, []int{32}
 }
 func (m *MemberKickedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MemberKickedTips.Unmarshal(m, b)
@@ -2731,7 +2772,7 @@ func (m *MemberKickedTips) GetOperationTime() int64 {
 	return 0
 }
 
-// 	OnMemberInvited()
+// OnMemberInvited()
 type MemberInvitedTips struct {
 	Group                *GroupInfo             `protobuf:"bytes,1,opt,name=group" json:"group,omitempty"`
 	OpUser               *GroupMemberFullInfo   `protobuf:"bytes,2,opt,name=opUser" json:"opUser,omitempty"`
@@ -2746,7 +2787,7 @@ func (m *MemberInvitedTips) Reset()         { *m = MemberInvitedTips{} }
 func (m *MemberInvitedTips) String() string { return proto.CompactTextString(m) }
 func (*MemberInvitedTips) ProtoMessage()    {}
 func (*MemberInvitedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{33}
+	return fileDescriptor_ws_755136b32532d37d, []int{33}
 }
 func (m *MemberInvitedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MemberInvitedTips.Unmarshal(m, b)
@@ -2808,7 +2849,7 @@ func (m *MemberEnterTips) Reset()         { *m = MemberEnterTips{} }
 func (m *MemberEnterTips) String() string { return proto.CompactTextString(m) }
 func (*MemberEnterTips) ProtoMessage()    {}
 func (*MemberEnterTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{34}
+	return fileDescriptor_ws_755136b32532d37d, []int{34}
 }
 func (m *MemberEnterTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MemberEnterTips.Unmarshal(m, b)
@@ -2862,7 +2903,7 @@ func (m *GroupDismissedTips) Reset()         { *m = GroupDismissedTips{} }
 func (m *GroupDismissedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupDismissedTips) ProtoMessage()    {}
 func (*GroupDismissedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{35}
+	return fileDescriptor_ws_755136b32532d37d, []int{35}
 }
 func (m *GroupDismissedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupDismissedTips.Unmarshal(m, b)
@@ -2918,7 +2959,7 @@ func (m *GroupMemberMutedTips) Reset()         { *m = GroupMemberMutedTips{} }
 func (m *GroupMemberMutedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupMemberMutedTips) ProtoMessage()    {}
 func (*GroupMemberMutedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{36}
+	return fileDescriptor_ws_755136b32532d37d, []int{36}
 }
 func (m *GroupMemberMutedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupMemberMutedTips.Unmarshal(m, b)
@@ -2987,7 +3028,7 @@ func (m *GroupMemberCancelMutedTips) Reset()         { *m = GroupMemberCancelMut
 func (m *GroupMemberCancelMutedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupMemberCancelMutedTips) ProtoMessage()    {}
 func (*GroupMemberCancelMutedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{37}
+	return fileDescriptor_ws_755136b32532d37d, []int{37}
 }
 func (m *GroupMemberCancelMutedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupMemberCancelMutedTips.Unmarshal(m, b)
@@ -3048,7 +3089,7 @@ func (m *GroupMutedTips) Reset()         { *m = GroupMutedTips{} }
 func (m *GroupMutedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupMutedTips) ProtoMessage()    {}
 func (*GroupMutedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{38}
+	return fileDescriptor_ws_755136b32532d37d, []int{38}
 }
 func (m *GroupMutedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupMutedTips.Unmarshal(m, b)
@@ -3102,7 +3143,7 @@ func (m *GroupCancelMutedTips) Reset()         { *m = GroupCancelMutedTips{} }
 func (m *GroupCancelMutedTips) String() string { return proto.CompactTextString(m) }
 func (*GroupCancelMutedTips) ProtoMessage()    {}
 func (*GroupCancelMutedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{39}
+	return fileDescriptor_ws_755136b32532d37d, []int{39}
 }
 func (m *GroupCancelMutedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupCancelMutedTips.Unmarshal(m, b)
@@ -3157,7 +3198,7 @@ func (m *GroupMemberInfoSetTips) Reset()         { *m = GroupMemberInfoSetTips{}
 func (m *GroupMemberInfoSetTips) String() string { return proto.CompactTextString(m) }
 func (*GroupMemberInfoSetTips) ProtoMessage()    {}
 func (*GroupMemberInfoSetTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{40}
+	return fileDescriptor_ws_755136b32532d37d, []int{40}
 }
 func (m *GroupMemberInfoSetTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GroupMemberInfoSetTips.Unmarshal(m, b)
@@ -3217,7 +3258,7 @@ func (m *OrganizationChangedTips) Reset()         { *m = OrganizationChangedTips
 func (m *OrganizationChangedTips) String() string { return proto.CompactTextString(m) }
 func (*OrganizationChangedTips) ProtoMessage()    {}
 func (*OrganizationChangedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{41}
+	return fileDescriptor_ws_755136b32532d37d, []int{41}
 }
 func (m *OrganizationChangedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OrganizationChangedTips.Unmarshal(m, b)
@@ -3264,7 +3305,7 @@ func (m *FriendApplication) Reset()         { *m = FriendApplication{} }
 func (m *FriendApplication) String() string { return proto.CompactTextString(m) }
 func (*FriendApplication) ProtoMessage()    {}
 func (*FriendApplication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{42}
+	return fileDescriptor_ws_755136b32532d37d, []int{42}
 }
 func (m *FriendApplication) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendApplication.Unmarshal(m, b)
@@ -3317,7 +3358,7 @@ func (m *FromToUserID) Reset()         { *m = FromToUserID{} }
 func (m *FromToUserID) String() string { return proto.CompactTextString(m) }
 func (*FromToUserID) ProtoMessage()    {}
 func (*FromToUserID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{43}
+	return fileDescriptor_ws_755136b32532d37d, []int{43}
 }
 func (m *FromToUserID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FromToUserID.Unmarshal(m, b)
@@ -3363,7 +3404,7 @@ func (m *FriendApplicationTips) Reset()         { *m = FriendApplicationTips{} }
 func (m *FriendApplicationTips) String() string { return proto.CompactTextString(m) }
 func (*FriendApplicationTips) ProtoMessage()    {}
 func (*FriendApplicationTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{44}
+	return fileDescriptor_ws_755136b32532d37d, []int{44}
 }
 func (m *FriendApplicationTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendApplicationTips.Unmarshal(m, b)
@@ -3403,7 +3444,7 @@ func (m *FriendApplicationApprovedTips) Reset()         { *m = FriendApplication
 func (m *FriendApplicationApprovedTips) String() string { return proto.CompactTextString(m) }
 func (*FriendApplicationApprovedTips) ProtoMessage()    {}
 func (*FriendApplicationApprovedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{45}
+	return fileDescriptor_ws_755136b32532d37d, []int{45}
 }
 func (m *FriendApplicationApprovedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendApplicationApprovedTips.Unmarshal(m, b)
@@ -3450,7 +3491,7 @@ func (m *FriendApplicationRejectedTips) Reset()         { *m = FriendApplication
 func (m *FriendApplicationRejectedTips) String() string { return proto.CompactTextString(m) }
 func (*FriendApplicationRejectedTips) ProtoMessage()    {}
 func (*FriendApplicationRejectedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{46}
+	return fileDescriptor_ws_755136b32532d37d, []int{46}
 }
 func (m *FriendApplicationRejectedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendApplicationRejectedTips.Unmarshal(m, b)
@@ -3498,7 +3539,7 @@ func (m *FriendAddedTips) Reset()         { *m = FriendAddedTips{} }
 func (m *FriendAddedTips) String() string { return proto.CompactTextString(m) }
 func (*FriendAddedTips) ProtoMessage()    {}
 func (*FriendAddedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{47}
+	return fileDescriptor_ws_755136b32532d37d, []int{47}
 }
 func (m *FriendAddedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendAddedTips.Unmarshal(m, b)
@@ -3551,7 +3592,7 @@ func (m *FriendDeletedTips) Reset()         { *m = FriendDeletedTips{} }
 func (m *FriendDeletedTips) String() string { return proto.CompactTextString(m) }
 func (*FriendDeletedTips) ProtoMessage()    {}
 func (*FriendDeletedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{48}
+	return fileDescriptor_ws_755136b32532d37d, []int{48}
 }
 func (m *FriendDeletedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendDeletedTips.Unmarshal(m, b)
@@ -3589,7 +3630,7 @@ func (m *BlackAddedTips) Reset()         { *m = BlackAddedTips{} }
 func (m *BlackAddedTips) String() string { return proto.CompactTextString(m) }
 func (*BlackAddedTips) ProtoMessage()    {}
 func (*BlackAddedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{49}
+	return fileDescriptor_ws_755136b32532d37d, []int{49}
 }
 func (m *BlackAddedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlackAddedTips.Unmarshal(m, b)
@@ -3627,7 +3668,7 @@ func (m *BlackDeletedTips) Reset()         { *m = BlackDeletedTips{} }
 func (m *BlackDeletedTips) String() string { return proto.CompactTextString(m) }
 func (*BlackDeletedTips) ProtoMessage()    {}
 func (*BlackDeletedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{50}
+	return fileDescriptor_ws_755136b32532d37d, []int{50}
 }
 func (m *BlackDeletedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlackDeletedTips.Unmarshal(m, b)
@@ -3665,7 +3706,7 @@ func (m *FriendInfoChangedTips) Reset()         { *m = FriendInfoChangedTips{} }
 func (m *FriendInfoChangedTips) String() string { return proto.CompactTextString(m) }
 func (*FriendInfoChangedTips) ProtoMessage()    {}
 func (*FriendInfoChangedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{51}
+	return fileDescriptor_ws_755136b32532d37d, []int{51}
 }
 func (m *FriendInfoChangedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FriendInfoChangedTips.Unmarshal(m, b)
@@ -3692,6 +3733,60 @@ func (m *FriendInfoChangedTips) GetFromToUserID() *FromToUserID {
 	return nil
 }
 
+type FriendCategoryChangedTips struct {
+	OwnerUserID          string   `protobuf:"bytes,1,opt,name=ownerUserID" json:"ownerUserID,omitempty"`
+	CategoryID           string   `protobuf:"bytes,2,opt,name=categoryID" json:"categoryID,omitempty"`
+	FriendUserIDList     []string `protobuf:"bytes,3,rep,name=friendUserIDList" json:"friendUserIDList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FriendCategoryChangedTips) Reset()         { *m = FriendCategoryChangedTips{} }
+func (m *FriendCategoryChangedTips) String() string { return proto.CompactTextString(m) }
+func (*FriendCategoryChangedTips) ProtoMessage()    {}
+func (*FriendCategoryChangedTips) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ws_755136b32532d37d, []int{52}
+}
+func (m *FriendCategoryChangedTips) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FriendCategoryChangedTips.Unmarshal(m, b)
+}
+func (m *FriendCategoryChangedTips) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FriendCategoryChangedTips.Marshal(b, m, deterministic)
+}
+func (dst *FriendCategoryChangedTips) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FriendCategoryChangedTips.Merge(dst, src)
+}
+func (m *FriendCategoryChangedTips) XXX_Size() int {
+	return xxx_messageInfo_FriendCategoryChangedTips.Size(m)
+}
+func (m *FriendCategoryChangedTips) XXX_DiscardUnknown() {
+	xxx_messageInfo_FriendCategoryChangedTips.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FriendCategoryChangedTips proto.InternalMessageInfo
+
+func (m *FriendCategoryChangedTips) GetOwnerUserID() string {
+	if m != nil {
+		return m.OwnerUserID
+	}
+	return ""
+}
+
+func (m *FriendCategoryChangedTips) GetCategoryID() string {
+	if m != nil {
+		return m.CategoryID
+	}
+	return ""
+}
+
+func (m *FriendCategoryChangedTips) GetFriendUserIDList() []string {
+	if m != nil {
+		return m.FriendUserIDList
+	}
+	return nil
+}
+
 // ////////////////////user/////////////////////
 type UserInfoUpdatedTips struct {
 	UserID               string   `protobuf:"bytes,1,opt,name=userID" json:"userID,omitempty"`
@@ -3704,7 +3799,7 @@ func (m *UserInfoUpdatedTips) Reset()         { *m = UserInfoUpdatedTips{} }
 func (m *UserInfoUpdatedTips) String() string { return proto.CompactTextString(m) }
 func (*UserInfoUpdatedTips) ProtoMessage()    {}
 func (*UserInfoUpdatedTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{52}
+	return fileDescriptor_ws_755136b32532d37d, []int{53}
 }
 func (m *UserInfoUpdatedTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UserInfoUpdatedTips.Unmarshal(m, b)
@@ -3745,7 +3840,7 @@ func (m *ConversationUpdateTips) Reset()         { *m = ConversationUpdateTips{}
 func (m *ConversationUpdateTips) String() string { return proto.CompactTextString(m) }
 func (*ConversationUpdateTips) ProtoMessage()    {}
 func (*ConversationUpdateTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{53}
+	return fileDescriptor_ws_755136b32532d37d, []int{54}
 }
 func (m *ConversationUpdateTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConversationUpdateTips.Unmarshal(m, b)
@@ -3799,7 +3894,7 @@ func (m *ConversationSetPrivateTips) Reset()         { *m = ConversationSetPriva
 func (m *ConversationSetPrivateTips) String() string { return proto.CompactTextString(m) }
 func (*ConversationSetPrivateTips) ProtoMessage()    {}
 func (*ConversationSetPrivateTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{54}
+	return fileDescriptor_ws_755136b32532d37d, []int{55}
 }
 func (m *ConversationSetPrivateTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConversationSetPrivateTips.Unmarshal(m, b)
@@ -3854,7 +3949,7 @@ func (m *DeleteMessageTips) Reset()         { *m = DeleteMessageTips{} }
 func (m *DeleteMessageTips) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessageTips) ProtoMessage()    {}
 func (*DeleteMessageTips) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{55}
+	return fileDescriptor_ws_755136b32532d37d, []int{56}
 }
 func (m *DeleteMessageTips) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteMessageTips.Unmarshal(m, b)
@@ -3908,7 +4003,7 @@ func (m *RequestPagination) Reset()         { *m = RequestPagination{} }
 func (m *RequestPagination) String() string { return proto.CompactTextString(m) }
 func (*RequestPagination) ProtoMessage()    {}
 func (*RequestPagination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{56}
+	return fileDescriptor_ws_755136b32532d37d, []int{57}
 }
 func (m *RequestPagination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestPagination.Unmarshal(m, b)
@@ -3954,7 +4049,7 @@ func (m *ResponsePagination) Reset()         { *m = ResponsePagination{} }
 func (m *ResponsePagination) String() string { return proto.CompactTextString(m) }
 func (*ResponsePagination) ProtoMessage()    {}
 func (*ResponsePagination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{57}
+	return fileDescriptor_ws_755136b32532d37d, []int{58}
 }
 func (m *ResponsePagination) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResponsePagination.Unmarshal(m, b)
@@ -4011,7 +4106,7 @@ func (m *SignalReq) Reset()         { *m = SignalReq{} }
 func (m *SignalReq) String() string { return proto.CompactTextString(m) }
 func (*SignalReq) ProtoMessage()    {}
 func (*SignalReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{58}
+	return fileDescriptor_ws_755136b32532d37d, []int{59}
 }
 func (m *SignalReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalReq.Unmarshal(m, b)
@@ -4036,46 +4131,64 @@ type isSignalReq_Payload interface {
 }
 
 type SignalReq_Invite struct {
-	Invite *SignalInviteReq `protobuf:"bytes,1,opt,name=invite,oneof"`
+	Invite *SignalInviteReq `protobuf:"bytes,1,opt,name=invite,proto3,oneof"`
 }
+
 type SignalReq_InviteInGroup struct {
-	InviteInGroup *SignalInviteInGroupReq `protobuf:"bytes,2,opt,name=inviteInGroup,oneof"`
+	InviteInGroup *SignalInviteInGroupReq `protobuf:"bytes,2,opt,name=inviteInGroup,proto3,oneof"`
 }
+
 type SignalReq_Cancel struct {
-	Cancel *SignalCancelReq `protobuf:"bytes,3,opt,name=cancel,oneof"`
+	Cancel *SignalCancelReq `protobuf:"bytes,3,opt,name=cancel,proto3,oneof"`
 }
+
 type SignalReq_Accept struct {
-	Accept *SignalAcceptReq `protobuf:"bytes,4,opt,name=accept,oneof"`
+	Accept *SignalAcceptReq `protobuf:"bytes,4,opt,name=accept,proto3,oneof"`
 }
+
 type SignalReq_HungUp struct {
-	HungUp *SignalHungUpReq `protobuf:"bytes,5,opt,name=hungUp,oneof"`
+	HungUp *SignalHungUpReq `protobuf:"bytes,5,opt,name=hungUp,proto3,oneof"`
 }
+
 type SignalReq_Reject struct {
-	Reject *SignalRejectReq `protobuf:"bytes,6,opt,name=reject,oneof"`
+	Reject *SignalRejectReq `protobuf:"bytes,6,opt,name=reject,proto3,oneof"`
 }
+
 type SignalReq_GetRoomByGroupID struct {
-	GetRoomByGroupID *SignalGetRoomByGroupIDReq `protobuf:"bytes,7,opt,name=getRoomByGroupID,oneof"`
+	GetRoomByGroupID *SignalGetRoomByGroupIDReq `protobuf:"bytes,7,opt,name=getRoomByGroupID,proto3,oneof"`
 }
+
 type SignalReq_OnRoomParticipantConnectedReq struct {
-	OnRoomParticipantConnectedReq *SignalOnRoomParticipantConnectedReq `protobuf:"bytes,8,opt,name=onRoomParticipantConnectedReq,oneof"`
+	OnRoomParticipantConnectedReq *SignalOnRoomParticipantConnectedReq `protobuf:"bytes,8,opt,name=onRoomParticipantConnectedReq,proto3,oneof"`
 }
+
 type SignalReq_OnRoomParticipantDisconnectedReq struct {
-	OnRoomParticipantDisconnectedReq *SignalOnRoomParticipantDisconnectedReq `protobuf:"bytes,9,opt,name=onRoomParticipantDisconnectedReq,oneof"`
+	OnRoomParticipantDisconnectedReq *SignalOnRoomParticipantDisconnectedReq `protobuf:"bytes,9,opt,name=onRoomParticipantDisconnectedReq,proto3,oneof"`
 }
+
 type SignalReq_GetTokenByRoomID struct {
-	GetTokenByRoomID *SignalGetTokenByRoomIDReq `protobuf:"bytes,10,opt,name=getTokenByRoomID,oneof"`
+	GetTokenByRoomID *SignalGetTokenByRoomIDReq `protobuf:"bytes,10,opt,name=getTokenByRoomID,proto3,oneof"`
 }
 
-func (*SignalReq_Invite) isSignalReq_Payload()                           {}
-func (*SignalReq_InviteInGroup) isSignalReq_Payload()                    {}
-func (*SignalReq_Cancel) isSignalReq_Payload()                           {}
-func (*SignalReq_Accept) isSignalReq_Payload()                           {}
-func (*SignalReq_HungUp) isSignalReq_Payload()                           {}
-func (*SignalReq_Reject) isSignalReq_Payload()                           {}
-func (*SignalReq_GetRoomByGroupID) isSignalReq_Payload()                 {}
-func (*SignalReq_OnRoomParticipantConnectedReq) isSignalReq_Payload()    {}
+func (*SignalReq_Invite) isSignalReq_Payload() {}
+
+func (*SignalReq_InviteInGroup) isSignalReq_Payload() {}
+
+func (*SignalReq_Cancel) isSignalReq_Payload() {}
+
+func (*SignalReq_Accept) isSignalReq_Payload() {}
+
+func (*SignalReq_HungUp) isSignalReq_Payload() {}
+
+func (*SignalReq_Reject) isSignalReq_Payload() {}
+
+func (*SignalReq_GetRoomByGroupID) isSignalReq_Payload() {}
+
+func (*SignalReq_OnRoomParticipantConnectedReq) isSignalReq_Payload() {}
+
 func (*SignalReq_OnRoomParticipantDisconnectedReq) isSignalReq_Payload() {}
-func (*SignalReq_GetTokenByRoomID) isSignalReq_Payload()                 {}
+
+func (*SignalReq_GetTokenByRoomID) isSignalReq_Payload() {}
 
 func (m *SignalReq) GetPayload() isSignalReq_Payload {
 	if m != nil {
@@ -4400,7 +4513,7 @@ func (m *SignalResp) Reset()         { *m = SignalResp{} }
 func (m *SignalResp) String() string { return proto.CompactTextString(m) }
 func (*SignalResp) ProtoMessage()    {}
 func (*SignalResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{59}
+	return fileDescriptor_ws_755136b32532d37d, []int{60}
 }
 func (m *SignalResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalResp.Unmarshal(m, b)
@@ -4425,37 +4538,51 @@ type isSignalResp_Payload interface {
 }
 
 type SignalResp_Invite struct {
-	Invite *SignalInviteReply `protobuf:"bytes,1,opt,name=invite,oneof"`
+	Invite *SignalInviteReply `protobuf:"bytes,1,opt,name=invite,proto3,oneof"`
 }
+
 type SignalResp_InviteInGroup struct {
-	InviteInGroup *SignalInviteInGroupReply `protobuf:"bytes,2,opt,name=inviteInGroup,oneof"`
+	InviteInGroup *SignalInviteInGroupReply `protobuf:"bytes,2,opt,name=inviteInGroup,proto3,oneof"`
 }
+
 type SignalResp_Cancel struct {
-	Cancel *SignalCancelReply `protobuf:"bytes,3,opt,name=cancel,oneof"`
+	Cancel *SignalCancelReply `protobuf:"bytes,3,opt,name=cancel,proto3,oneof"`
 }
+
 type SignalResp_Accept struct {
-	Accept *SignalAcceptReply `protobuf:"bytes,4,opt,name=accept,oneof"`
+	Accept *SignalAcceptReply `protobuf:"bytes,4,opt,name=accept,proto3,oneof"`
 }
+
 type SignalResp_HungUp struct {
-	HungUp *SignalHungUpReply `protobuf:"bytes,5,opt,name=hungUp,oneof"`
+	HungUp *SignalHungUpReply `protobuf:"bytes,5,opt,name=hungUp,proto3,oneof"`
 }
+
 type SignalResp_Reject struct {
-	Reject *SignalRejectReply `protobuf:"bytes,6,opt,name=reject,oneof"`
+	Reject *SignalRejectReply `protobuf:"bytes,6,opt,name=reject,proto3,oneof"`
 }
+
 type SignalResp_GetRoomByGroupID struct {
-	GetRoomByGroupID *SignalGetRoomByGroupIDReply `protobuf:"bytes,7,opt,name=getRoomByGroupID,oneof"`
+	GetRoomByGroupID *SignalGetRoomByGroupIDReply `protobuf:"bytes,7,opt,name=getRoomByGroupID,proto3,oneof"`
 }
+
 type SignalResp_GetTokenByRoomID struct {
-	GetTokenByRoomID *SignalGetTokenByRoomIDReply `protobuf:"bytes,8,opt,name=getTokenByRoomID,oneof"`
+	GetTokenByRoomID *SignalGetTokenByRoomIDReply `protobuf:"bytes,8,opt,name=getTokenByRoomID,proto3,oneof"`
 }
 
-func (*SignalResp_Invite) isSignalResp_Payload()           {}
-func (*SignalResp_InviteInGroup) isSignalResp_Payload()    {}
-func (*SignalResp_Cancel) isSignalResp_Payload()           {}
-func (*SignalResp_Accept) isSignalResp_Payload()           {}
-func (*SignalResp_HungUp) isSignalResp_Payload()           {}
-func (*SignalResp_Reject) isSignalResp_Payload()           {}
+func (*SignalResp_Invite) isSignalResp_Payload() {}
+
+func (*SignalResp_InviteInGroup) isSignalResp_Payload() {}
+
+func (*SignalResp_Cancel) isSignalResp_Payload() {}
+
+func (*SignalResp_Accept) isSignalResp_Payload() {}
+
+func (*SignalResp_HungUp) isSignalResp_Payload() {}
+
+func (*SignalResp_Reject) isSignalResp_Payload() {}
+
 func (*SignalResp_GetRoomByGroupID) isSignalResp_Payload() {}
+
 func (*SignalResp_GetTokenByRoomID) isSignalResp_Payload() {}
 
 func (m *SignalResp) GetPayload() isSignalResp_Payload {
@@ -4730,7 +4857,7 @@ func (m *InvitationInfo) Reset()         { *m = InvitationInfo{} }
 func (m *InvitationInfo) String() string { return proto.CompactTextString(m) }
 func (*InvitationInfo) ProtoMessage()    {}
 func (*InvitationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{60}
+	return fileDescriptor_ws_755136b32532d37d, []int{61}
 }
 func (m *InvitationInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InvitationInfo.Unmarshal(m, b)
@@ -4840,7 +4967,7 @@ func (m *ParticipantMetaData) Reset()         { *m = ParticipantMetaData{} }
 func (m *ParticipantMetaData) String() string { return proto.CompactTextString(m) }
 func (*ParticipantMetaData) ProtoMessage()    {}
 func (*ParticipantMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{61}
+	return fileDescriptor_ws_755136b32532d37d, []int{62}
 }
 func (m *ParticipantMetaData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ParticipantMetaData.Unmarshal(m, b)
@@ -4895,7 +5022,7 @@ func (m *SignalInviteReq) Reset()         { *m = SignalInviteReq{} }
 func (m *SignalInviteReq) String() string { return proto.CompactTextString(m) }
 func (*SignalInviteReq) ProtoMessage()    {}
 func (*SignalInviteReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{62}
+	return fileDescriptor_ws_755136b32532d37d, []int{63}
 }
 func (m *SignalInviteReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalInviteReq.Unmarshal(m, b)
@@ -4957,7 +5084,7 @@ func (m *SignalInviteReply) Reset()         { *m = SignalInviteReply{} }
 func (m *SignalInviteReply) String() string { return proto.CompactTextString(m) }
 func (*SignalInviteReply) ProtoMessage()    {}
 func (*SignalInviteReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{63}
+	return fileDescriptor_ws_755136b32532d37d, []int{64}
 }
 func (m *SignalInviteReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalInviteReply.Unmarshal(m, b)
@@ -5019,7 +5146,7 @@ func (m *SignalInviteInGroupReq) Reset()         { *m = SignalInviteInGroupReq{}
 func (m *SignalInviteInGroupReq) String() string { return proto.CompactTextString(m) }
 func (*SignalInviteInGroupReq) ProtoMessage()    {}
 func (*SignalInviteInGroupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{64}
+	return fileDescriptor_ws_755136b32532d37d, []int{65}
 }
 func (m *SignalInviteInGroupReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalInviteInGroupReq.Unmarshal(m, b)
@@ -5081,7 +5208,7 @@ func (m *SignalInviteInGroupReply) Reset()         { *m = SignalInviteInGroupRep
 func (m *SignalInviteInGroupReply) String() string { return proto.CompactTextString(m) }
 func (*SignalInviteInGroupReply) ProtoMessage()    {}
 func (*SignalInviteInGroupReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{65}
+	return fileDescriptor_ws_755136b32532d37d, []int{66}
 }
 func (m *SignalInviteInGroupReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalInviteInGroupReply.Unmarshal(m, b)
@@ -5143,7 +5270,7 @@ func (m *SignalCancelReq) Reset()         { *m = SignalCancelReq{} }
 func (m *SignalCancelReq) String() string { return proto.CompactTextString(m) }
 func (*SignalCancelReq) ProtoMessage()    {}
 func (*SignalCancelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{66}
+	return fileDescriptor_ws_755136b32532d37d, []int{67}
 }
 func (m *SignalCancelReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalCancelReq.Unmarshal(m, b)
@@ -5201,7 +5328,7 @@ func (m *SignalCancelReply) Reset()         { *m = SignalCancelReply{} }
 func (m *SignalCancelReply) String() string { return proto.CompactTextString(m) }
 func (*SignalCancelReply) ProtoMessage()    {}
 func (*SignalCancelReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{67}
+	return fileDescriptor_ws_755136b32532d37d, []int{68}
 }
 func (m *SignalCancelReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalCancelReply.Unmarshal(m, b)
@@ -5236,7 +5363,7 @@ func (m *SignalAcceptReq) Reset()         { *m = SignalAcceptReq{} }
 func (m *SignalAcceptReq) String() string { return proto.CompactTextString(m) }
 func (*SignalAcceptReq) ProtoMessage()    {}
 func (*SignalAcceptReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{68}
+	return fileDescriptor_ws_755136b32532d37d, []int{69}
 }
 func (m *SignalAcceptReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalAcceptReq.Unmarshal(m, b)
@@ -5304,7 +5431,7 @@ func (m *SignalAcceptReply) Reset()         { *m = SignalAcceptReply{} }
 func (m *SignalAcceptReply) String() string { return proto.CompactTextString(m) }
 func (*SignalAcceptReply) ProtoMessage()    {}
 func (*SignalAcceptReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{69}
+	return fileDescriptor_ws_755136b32532d37d, []int{70}
 }
 func (m *SignalAcceptReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalAcceptReply.Unmarshal(m, b)
@@ -5358,7 +5485,7 @@ func (m *SignalHungUpReq) Reset()         { *m = SignalHungUpReq{} }
 func (m *SignalHungUpReq) String() string { return proto.CompactTextString(m) }
 func (*SignalHungUpReq) ProtoMessage()    {}
 func (*SignalHungUpReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{70}
+	return fileDescriptor_ws_755136b32532d37d, []int{71}
 }
 func (m *SignalHungUpReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalHungUpReq.Unmarshal(m, b)
@@ -5409,7 +5536,7 @@ func (m *SignalHungUpReply) Reset()         { *m = SignalHungUpReply{} }
 func (m *SignalHungUpReply) String() string { return proto.CompactTextString(m) }
 func (*SignalHungUpReply) ProtoMessage()    {}
 func (*SignalHungUpReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{71}
+	return fileDescriptor_ws_755136b32532d37d, []int{72}
 }
 func (m *SignalHungUpReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalHungUpReply.Unmarshal(m, b)
@@ -5444,7 +5571,7 @@ func (m *SignalRejectReq) Reset()         { *m = SignalRejectReq{} }
 func (m *SignalRejectReq) String() string { return proto.CompactTextString(m) }
 func (*SignalRejectReq) ProtoMessage()    {}
 func (*SignalRejectReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{72}
+	return fileDescriptor_ws_755136b32532d37d, []int{73}
 }
 func (m *SignalRejectReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalRejectReq.Unmarshal(m, b)
@@ -5509,7 +5636,7 @@ func (m *SignalRejectReply) Reset()         { *m = SignalRejectReply{} }
 func (m *SignalRejectReply) String() string { return proto.CompactTextString(m) }
 func (*SignalRejectReply) ProtoMessage()    {}
 func (*SignalRejectReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{73}
+	return fileDescriptor_ws_755136b32532d37d, []int{74}
 }
 func (m *SignalRejectReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalRejectReply.Unmarshal(m, b)
@@ -5542,7 +5669,7 @@ func (m *SignalGetRoomByGroupIDReq) Reset()         { *m = SignalGetRoomByGroupI
 func (m *SignalGetRoomByGroupIDReq) String() string { return proto.CompactTextString(m) }
 func (*SignalGetRoomByGroupIDReq) ProtoMessage()    {}
 func (*SignalGetRoomByGroupIDReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{74}
+	return fileDescriptor_ws_755136b32532d37d, []int{75}
 }
 func (m *SignalGetRoomByGroupIDReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalGetRoomByGroupIDReq.Unmarshal(m, b)
@@ -5596,7 +5723,7 @@ func (m *SignalGetRoomByGroupIDReply) Reset()         { *m = SignalGetRoomByGrou
 func (m *SignalGetRoomByGroupIDReply) String() string { return proto.CompactTextString(m) }
 func (*SignalGetRoomByGroupIDReply) ProtoMessage()    {}
 func (*SignalGetRoomByGroupIDReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{75}
+	return fileDescriptor_ws_755136b32532d37d, []int{76}
 }
 func (m *SignalGetRoomByGroupIDReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalGetRoomByGroupIDReply.Unmarshal(m, b)
@@ -5650,7 +5777,7 @@ func (m *SignalOnRoomParticipantConnectedReq) Reset()         { *m = SignalOnRoo
 func (m *SignalOnRoomParticipantConnectedReq) String() string { return proto.CompactTextString(m) }
 func (*SignalOnRoomParticipantConnectedReq) ProtoMessage()    {}
 func (*SignalOnRoomParticipantConnectedReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{76}
+	return fileDescriptor_ws_755136b32532d37d, []int{77}
 }
 func (m *SignalOnRoomParticipantConnectedReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalOnRoomParticipantConnectedReq.Unmarshal(m, b)
@@ -5706,7 +5833,7 @@ func (m *SignalOnRoomParticipantDisconnectedReq) Reset() {
 func (m *SignalOnRoomParticipantDisconnectedReq) String() string { return proto.CompactTextString(m) }
 func (*SignalOnRoomParticipantDisconnectedReq) ProtoMessage()    {}
 func (*SignalOnRoomParticipantDisconnectedReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{77}
+	return fileDescriptor_ws_755136b32532d37d, []int{78}
 }
 func (m *SignalOnRoomParticipantDisconnectedReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalOnRoomParticipantDisconnectedReq.Unmarshal(m, b)
@@ -5761,7 +5888,7 @@ func (m *SignalGetTokenByRoomIDReq) Reset()         { *m = SignalGetTokenByRoomI
 func (m *SignalGetTokenByRoomIDReq) String() string { return proto.CompactTextString(m) }
 func (*SignalGetTokenByRoomIDReq) ProtoMessage()    {}
 func (*SignalGetTokenByRoomIDReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{78}
+	return fileDescriptor_ws_755136b32532d37d, []int{79}
 }
 func (m *SignalGetTokenByRoomIDReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalGetTokenByRoomIDReq.Unmarshal(m, b)
@@ -5821,7 +5948,7 @@ func (m *SignalGetTokenByRoomIDReply) Reset()         { *m = SignalGetTokenByRoo
 func (m *SignalGetTokenByRoomIDReply) String() string { return proto.CompactTextString(m) }
 func (*SignalGetTokenByRoomIDReply) ProtoMessage()    {}
 func (*SignalGetTokenByRoomIDReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{79}
+	return fileDescriptor_ws_755136b32532d37d, []int{80}
 }
 func (m *SignalGetTokenByRoomIDReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignalGetTokenByRoomIDReply.Unmarshal(m, b)
@@ -5869,7 +5996,7 @@ func (m *DelMsgListReq) Reset()         { *m = DelMsgListReq{} }
 func (m *DelMsgListReq) String() string { return proto.CompactTextString(m) }
 func (*DelMsgListReq) ProtoMessage()    {}
 func (*DelMsgListReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{80}
+	return fileDescriptor_ws_755136b32532d37d, []int{81}
 }
 func (m *DelMsgListReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelMsgListReq.Unmarshal(m, b)
@@ -5929,7 +6056,7 @@ func (m *DelMsgListResp) Reset()         { *m = DelMsgListResp{} }
 func (m *DelMsgListResp) String() string { return proto.CompactTextString(m) }
 func (*DelMsgListResp) ProtoMessage()    {}
 func (*DelMsgListResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{81}
+	return fileDescriptor_ws_755136b32532d37d, []int{82}
 }
 func (m *DelMsgListResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DelMsgListResp.Unmarshal(m, b)
@@ -5975,7 +6102,7 @@ func (m *SetAppBackgroundStatusReq) Reset()         { *m = SetAppBackgroundStatu
 func (m *SetAppBackgroundStatusReq) String() string { return proto.CompactTextString(m) }
 func (*SetAppBackgroundStatusReq) ProtoMessage()    {}
 func (*SetAppBackgroundStatusReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{82}
+	return fileDescriptor_ws_755136b32532d37d, []int{83}
 }
 func (m *SetAppBackgroundStatusReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAppBackgroundStatusReq.Unmarshal(m, b)
@@ -6021,7 +6148,7 @@ func (m *SetAppBackgroundStatusResp) Reset()         { *m = SetAppBackgroundStat
 func (m *SetAppBackgroundStatusResp) String() string { return proto.CompactTextString(m) }
 func (*SetAppBackgroundStatusResp) ProtoMessage()    {}
 func (*SetAppBackgroundStatusResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{83}
+	return fileDescriptor_ws_755136b32532d37d, []int{84}
 }
 func (m *SetAppBackgroundStatusResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetAppBackgroundStatusResp.Unmarshal(m, b)
@@ -6071,7 +6198,7 @@ func (m *ExtendMsgSet) Reset()         { *m = ExtendMsgSet{} }
 func (m *ExtendMsgSet) String() string { return proto.CompactTextString(m) }
 func (*ExtendMsgSet) ProtoMessage()    {}
 func (*ExtendMsgSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{84}
+	return fileDescriptor_ws_755136b32532d37d, []int{85}
 }
 func (m *ExtendMsgSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtendMsgSet.Unmarshal(m, b)
@@ -6148,7 +6275,7 @@ func (m *ExtendMsg) Reset()         { *m = ExtendMsg{} }
 func (m *ExtendMsg) String() string { return proto.CompactTextString(m) }
 func (*ExtendMsg) ProtoMessage()    {}
 func (*ExtendMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{85}
+	return fileDescriptor_ws_755136b32532d37d, []int{86}
 }
 func (m *ExtendMsg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExtendMsg.Unmarshal(m, b)
@@ -6216,7 +6343,7 @@ func (m *KeyValue) Reset()         { *m = KeyValue{} }
 func (m *KeyValue) String() string { return proto.CompactTextString(m) }
 func (*KeyValue) ProtoMessage()    {}
 func (*KeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ws_bc78a4975e7e5f46, []int{86}
+	return fileDescriptor_ws_755136b32532d37d, []int{87}
 }
 func (m *KeyValue) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyValue.Unmarshal(m, b)
@@ -6257,6 +6384,60 @@ func (m *KeyValue) GetLatestUpdateTime() int64 {
 	return 0
 }
 
+type GroupJoinAnswer struct {
+	QuestionID           string   `protobuf:"bytes,1,opt,name=questionID" json:"questionID,omitempty"`
+	Question             string   `protobuf:"bytes,2,opt,name=question" json:"question,omitempty"`
+	Answer               string   `protobuf:"bytes,3,opt,name=answer" json:"answer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GroupJoinAnswer) Reset()         { *m = GroupJoinAnswer{} }
+func (m *GroupJoinAnswer) String() string { return proto.CompactTextString(m) }
+func (*GroupJoinAnswer) ProtoMessage()    {}
+func (*GroupJoinAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ws_755136b32532d37d, []int{88}
+}
+func (m *GroupJoinAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GroupJoinAnswer.Unmarshal(m, b)
+}
+func (m *GroupJoinAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GroupJoinAnswer.Marshal(b, m, deterministic)
+}
+func (dst *GroupJoinAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupJoinAnswer.Merge(dst, src)
+}
+func (m *GroupJoinAnswer) XXX_Size() int {
+	return xxx_messageInfo_GroupJoinAnswer.Size(m)
+}
+func (m *GroupJoinAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupJoinAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GroupJoinAnswer proto.InternalMessageInfo
+
+func (m *GroupJoinAnswer) GetQuestionID() string {
+	if m != nil {
+		return m.QuestionID
+	}
+	return ""
+}
+
+func (m *GroupJoinAnswer) GetQuestion() string {
+	if m != nil {
+		return m.Question
+	}
+	return ""
+}
+
+func (m *GroupJoinAnswer) GetAnswer() string {
+	if m != nil {
+		return m.Answer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GroupInfo)(nil), "server_api_params.GroupInfo")
 	proto.RegisterType((*GroupInfoForSet)(nil), "server_api_params.GroupInfoForSet")
@@ -6314,6 +6495,7 @@ func init() {
 	proto.RegisterType((*BlackAddedTips)(nil), "server_api_params.BlackAddedTips")
 	proto.RegisterType((*BlackDeletedTips)(nil), "server_api_params.BlackDeletedTips")
 	proto.RegisterType((*FriendInfoChangedTips)(nil), "server_api_params.FriendInfoChangedTips")
+	proto.RegisterType((*FriendCategoryChangedTips)(nil), "server_api_params.FriendCategoryChangedTips")
 	proto.RegisterType((*UserInfoUpdatedTips)(nil), "server_api_params.UserInfoUpdatedTips")
 	proto.RegisterType((*ConversationUpdateTips)(nil), "server_api_params.ConversationUpdateTips")
 	proto.RegisterType((*ConversationSetPrivateTips)(nil), "server_api_params.ConversationSetPrivateTips")
@@ -6351,271 +6533,281 @@ func init() {
 	proto.RegisterType((*ExtendMsg)(nil), "server_api_params.ExtendMsg")
 	proto.RegisterMapType((map[string]*KeyValue)(nil), "server_api_params.ExtendMsg.ReactionExtensionListEntry")
 	proto.RegisterType((*KeyValue)(nil), "server_api_params.KeyValue")
-}
-
-func init() { proto.RegisterFile("sdk_ws/ws.proto", fileDescriptor_ws_bc78a4975e7e5f46) }
-
-var fileDescriptor_ws_bc78a4975e7e5f46 = []byte{
-	// 4162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x59, 0x8f, 0x1c, 0x57,
-	0xb9, 0xa9, 0xea, 0x65, 0xba, 0xbf, 0x9e, 0xa5, 0xa7, 0x6c, 0x4f, 0x3a, 0x13, 0xc7, 0x77, 0x6e,
-	0xc5, 0xf2, 0x75, 0x7c, 0x9d, 0xf1, 0xbd, 0xce, 0x72, 0x6f, 0x36, 0xa3, 0x59, 0xec, 0xf1, 0xc4,
-	0xee, 0x99, 0x49, 0xb5, 0x1d, 0xa3, 0x24, 0x92, 0xa9, 0xe9, 0x3a, 0xd3, 0x53, 0x99, 0xea, 0xaa,
-	0x9a, 0x5a, 0xc6, 0x1e, 0x1e, 0x40, 0x2c, 0x02, 0x24, 0x1e, 0x90, 0x10, 0x8b, 0x04, 0x6f, 0xbc,
-	0x20, 0x10, 0x8a, 0x10, 0x0a, 0x12, 0x12, 0x08, 0x21, 0xc4, 0x03, 0x12, 0x48, 0xe4, 0x1d, 0x09,
-	0x04, 0x2f, 0x20, 0xc4, 0x1f, 0x40, 0x42, 0x0a, 0x3a, 0x4b, 0x55, 0x9d, 0x53, 0x4b, 0x77, 0xbb,
-	0x35, 0x8a, 0x6d, 0x99, 0x27, 0xfb, 0xfb, 0xea, 0x7c, 0xdf, 0xf9, 0xf6, 0xf3, 0x9d, 0xa5, 0x07,
-	0x66, 0x7c, 0x63, 0xef, 0xf6, 0x1d, 0xff, 0xc2, 0x1d, 0x7f, 0xd1, 0xf5, 0x9c, 0xc0, 0x51, 0x66,
-	0x7d, 0xe4, 0x1d, 0x20, 0xef, 0xb6, 0xee, 0x9a, 0xb7, 0x5d, 0xdd, 0xd3, 0xfb, 0xfe, 0xfc, 0xe2,
-	0xa6, 0x8b, 0xec, 0x67, 0xd7, 0xdb, 0xcf, 0x76, 0xc8, 0xa7, 0x0b, 0xee, 0x5e, 0xef, 0x02, 0x19,
-	0x7c, 0x21, 0x22, 0xf6, 0x74, 0xd7, 0x45, 0x1e, 0x63, 0xa1, 0xfe, 0xb1, 0x0c, 0xf5, 0x35, 0xcf,
-	0x09, 0xdd, 0x75, 0x7b, 0xc7, 0x51, 0x5a, 0x30, 0xd1, 0x23, 0xc0, 0x6a, 0x4b, 0x5a, 0x90, 0xce,
-	0xd6, 0xb5, 0x08, 0x54, 0x4e, 0x42, 0x9d, 0xfc, 0x77, 0x43, 0xef, 0xa3, 0x96, 0x4c, 0xbe, 0x25,
-	0x08, 0x45, 0x85, 0x49, 0xdb, 0x09, 0xcc, 0x1d, 0xb3, 0xab, 0x07, 0xa6, 0x63, 0xb7, 0x4a, 0x64,
-	0x80, 0x80, 0xc3, 0x63, 0x4c, 0x3b, 0xf0, 0x1c, 0x23, 0xec, 0x92, 0x31, 0x65, 0x3a, 0x86, 0xc7,
-	0xe1, 0xf9, 0x77, 0xf4, 0x2e, 0xba, 0xa9, 0x5d, 0x6f, 0x55, 0xe8, 0xfc, 0x0c, 0x54, 0x16, 0xa0,
-	0xe1, 0xdc, 0xb1, 0x91, 0x77, 0xd3, 0x47, 0xde, 0xfa, 0x6a, 0xab, 0x4a, 0xbe, 0xf2, 0x28, 0xe5,
-	0x14, 0x40, 0xd7, 0x43, 0x7a, 0x80, 0x6e, 0x98, 0x7d, 0xd4, 0x9a, 0x58, 0x90, 0xce, 0x4e, 0x69,
-	0x1c, 0x06, 0x73, 0xe8, 0xa3, 0xfe, 0x36, 0xf2, 0x56, 0x9c, 0xd0, 0x0e, 0x5a, 0x35, 0x32, 0x80,
-	0x47, 0x29, 0xd3, 0x20, 0xa3, 0xbb, 0xad, 0x3a, 0x61, 0x2d, 0xa3, 0xbb, 0xca, 0x1c, 0x54, 0xfd,
-	0x40, 0x0f, 0x42, 0xbf, 0x05, 0x0b, 0xd2, 0xd9, 0x8a, 0xc6, 0x20, 0xe5, 0x34, 0x4c, 0x11, 0xbe,
-	0x4e, 0x24, 0x4d, 0x83, 0x90, 0x88, 0xc8, 0xd8, 0x62, 0x37, 0x0e, 0x5d, 0xd4, 0x9a, 0x24, 0x0c,
-	0x12, 0x84, 0x72, 0x0e, 0x9a, 0x36, 0x42, 0xc6, 0x9b, 0xc8, 0x4b, 0xac, 0x36, 0x45, 0x06, 0x65,
-	0xf0, 0xca, 0x19, 0x98, 0xb6, 0x1c, 0x67, 0xaf, 0x4d, 0x44, 0xc5, 0x7e, 0x6a, 0x4d, 0x93, 0x91,
-	0x29, 0xac, 0x72, 0x1e, 0x66, 0x75, 0xd7, 0xb5, 0x0e, 0x29, 0xea, 0x8a, 0x67, 0x22, 0xdb, 0x68,
-	0xcd, 0x90, 0xa1, 0xd9, 0x0f, 0xca, 0x8b, 0x30, 0xc7, 0xfb, 0xe7, 0xa6, 0x6b, 0x44, 0xb6, 0x6b,
-	0x12, 0xd3, 0x14, 0x7c, 0x55, 0x16, 0x41, 0x11, 0xbe, 0x50, 0x13, 0xcc, 0x12, 0x13, 0xe4, 0x7c,
-	0x51, 0xbf, 0x56, 0x82, 0x99, 0x38, 0xc2, 0xae, 0x38, 0x5e, 0x07, 0x05, 0x0f, 0x70, 0x9c, 0xd1,
-	0x18, 0xa8, 0xc6, 0x31, 0xb0, 0x96, 0xe3, 0x27, 0x1c, 0x5b, 0x8d, 0x8b, 0x4f, 0x2e, 0xf6, 0x1c,
-	0xa7, 0x67, 0x21, 0x9a, 0x48, 0xdb, 0xe1, 0xce, 0xe2, 0xba, 0x1d, 0x3c, 0x77, 0xf1, 0x4d, 0xdd,
-	0x0a, 0x51, 0x8e, 0x13, 0x57, 0x32, 0x4e, 0xac, 0x0d, 0x67, 0x93, 0xf6, 0xf0, 0x7a, 0x9e, 0x87,
-	0xeb, 0xc3, 0xf9, 0x64, 0xa9, 0xd4, 0x0f, 0x65, 0x38, 0x46, 0xdc, 0xc2, 0xb0, 0xa1, 0x65, 0x0d,
-	0x29, 0x01, 0x73, 0x50, 0x0d, 0xa9, 0xb3, 0xa9, 0x5f, 0x18, 0x84, 0x5d, 0xe6, 0x39, 0x16, 0xba,
-	0x8e, 0x0e, 0x90, 0x45, 0x3c, 0x52, 0xd1, 0x12, 0x84, 0x32, 0x0f, 0xb5, 0x77, 0x1d, 0xd3, 0x26,
-	0x81, 0x55, 0x26, 0x1f, 0x63, 0x18, 0x7f, 0xb3, 0xcd, 0xee, 0x9e, 0x8d, 0x7d, 0x4d, 0xfd, 0x10,
-	0xc3, 0xbc, 0x8b, 0xaa, 0xa2, 0x8b, 0xce, 0xc0, 0xb4, 0xee, 0xba, 0x6d, 0xdd, 0xee, 0x21, 0x8f,
-	0x4e, 0x3a, 0x41, 0xd3, 0x41, 0xc4, 0xe2, 0x82, 0x80, 0x67, 0xea, 0x38, 0xa1, 0xd7, 0x45, 0xc4,
-	0xda, 0x15, 0x8d, 0xc3, 0x60, 0x3e, 0x8e, 0x8b, 0x3c, 0x2e, 0x8f, 0x69, 0xea, 0xa7, 0xb0, 0x2c,
-	0x24, 0x20, 0x0e, 0x09, 0x5c, 0x48, 0xc2, 0x00, 0x5d, 0xb6, 0x0d, 0xa2, 0x54, 0x83, 0x15, 0x92,
-	0x04, 0x85, 0x0b, 0x84, 0x69, 0x1f, 0x98, 0x41, 0x5c, 0xae, 0x26, 0x69, 0x81, 0x10, 0x90, 0xea,
-	0x17, 0x24, 0x98, 0xde, 0x0a, 0xb7, 0x2d, 0xb3, 0x4b, 0x10, 0xd8, 0xf8, 0x89, 0x89, 0x25, 0xc1,
-	0xc4, 0xbc, 0xa1, 0xe4, 0x62, 0x43, 0x95, 0x44, 0x43, 0xcd, 0x41, 0xb5, 0x87, 0x6c, 0x03, 0x79,
-	0xcc, 0xf0, 0x0c, 0x62, 0x0a, 0x55, 0x22, 0x85, 0xd4, 0x3f, 0xc8, 0x50, 0xfb, 0x88, 0x45, 0x58,
-	0x80, 0x86, 0xbb, 0xeb, 0xd8, 0x68, 0x23, 0xc4, 0xc1, 0xc7, 0x64, 0xe1, 0x51, 0xca, 0x71, 0xa8,
-	0x6c, 0x9b, 0x5e, 0xb0, 0x4b, 0xbc, 0x3f, 0xa5, 0x51, 0x00, 0x63, 0x51, 0x5f, 0x37, 0xa9, 0xcb,
-	0xeb, 0x1a, 0x05, 0x98, 0x42, 0xb5, 0xd8, 0x43, 0xe2, 0x52, 0x50, 0xcf, 0x2c, 0x05, 0xd9, 0x08,
-	0x82, 0xdc, 0x08, 0x3a, 0x07, 0xcd, 0x9e, 0xe5, 0x6c, 0xeb, 0x96, 0x86, 0xba, 0x07, 0x6d, 0xbf,
-	0xb7, 0xe9, 0x06, 0xc4, 0xdd, 0x15, 0x2d, 0x83, 0xc7, 0xf6, 0x21, 0x22, 0x76, 0x02, 0x8f, 0xb9,
-	0x3b, 0x86, 0xd5, 0x7f, 0x48, 0x00, 0x34, 0xed, 0x88, 0x89, 0x53, 0x6b, 0x99, 0x94, 0x5d, 0xcb,
-	0xe6, 0xa0, 0xea, 0xa1, 0xbe, 0xee, 0xed, 0x45, 0xa9, 0x46, 0xa1, 0x94, 0x62, 0xa5, 0x8c, 0x62,
-	0xaf, 0x00, 0xec, 0x90, 0x79, 0x30, 0x1f, 0x62, 0x72, 0x5c, 0x18, 0x32, 0x5d, 0xc2, 0x62, 0xe4,
-	0x6d, 0x8d, 0x1b, 0x8e, 0xf3, 0x58, 0x37, 0x0c, 0x96, 0x2e, 0x15, 0x9a, 0xc7, 0x31, 0x22, 0x27,
-	0x5b, 0xaa, 0x03, 0xb2, 0x65, 0x22, 0x0e, 0xae, 0xbf, 0x4b, 0x50, 0x5f, 0xb6, 0xf4, 0xee, 0xde,
-	0x88, 0xaa, 0x8b, 0x2a, 0xca, 0x19, 0x15, 0xd7, 0x60, 0x6a, 0x1b, 0xb3, 0x8b, 0x54, 0x20, 0x56,
-	0x68, 0x5c, 0xfc, 0xcf, 0x1c, 0x2d, 0xc5, 0xe4, 0xd2, 0x44, 0x3a, 0x51, 0xdd, 0xf2, 0x70, 0x75,
-	0x2b, 0x03, 0xd4, 0x8d, 0xd7, 0x0b, 0xf5, 0x9b, 0x25, 0x98, 0x24, 0x65, 0x55, 0x43, 0xfb, 0x21,
-	0xf2, 0x03, 0xe5, 0x35, 0xa8, 0x85, 0x91, 0xa8, 0xd2, 0xa8, 0xa2, 0xc6, 0x24, 0xca, 0xcb, 0x6c,
-	0x3d, 0x24, 0xf4, 0x32, 0xa1, 0x3f, 0x99, 0x43, 0x1f, 0x2f, 0xb0, 0x5a, 0x32, 0x1c, 0xaf, 0x84,
-	0xbb, 0xba, 0x6d, 0x58, 0x48, 0x43, 0x7e, 0x68, 0x05, 0xac, 0x36, 0x0b, 0x38, 0x1a, 0x69, 0xfb,
-	0x6d, 0xbf, 0xc7, 0xd6, 0x49, 0x06, 0x61, 0xeb, 0xd0, 0x71, 0xf8, 0x13, 0x55, 0x3d, 0x41, 0xe0,
-	0x84, 0xf7, 0xd0, 0x3e, 0xf1, 0x10, 0x4d, 0xcf, 0x08, 0x4c, 0xe6, 0x64, 0x56, 0xa3, 0x81, 0x20,
-	0xe0, 0xb0, 0x8b, 0x29, 0x4c, 0x18, 0xd0, 0x46, 0x8c, 0xc3, 0x64, 0xfa, 0x30, 0xb1, 0x90, 0x43,
-	0xa6, 0x90, 0x67, 0xca, 0x6d, 0x23, 0xaf, 0xdc, 0xfe, 0xbe, 0x04, 0x53, 0x34, 0x09, 0x23, 0xd7,
-	0x9c, 0xc2, 0xd9, 0xe2, 0xf4, 0x85, 0x58, 0xe4, 0x30, 0x58, 0x17, 0x0c, 0x6d, 0x88, 0x65, 0x4f,
-	0xc0, 0xe1, 0x80, 0xc6, 0xf0, 0x15, 0xa1, 0xfc, 0xf1, 0xa8, 0x68, 0x96, 0x35, 0xbe, 0x0c, 0x72,
-	0x18, 0x5c, 0x38, 0x02, 0x47, 0x88, 0xb1, 0x18, 0xc6, 0xb4, 0x81, 0x13, 0xcf, 0x4f, 0xa3, 0x8c,
-	0xc3, 0x60, 0x2f, 0x05, 0x4e, 0x34, 0x37, 0x35, 0x75, 0x82, 0xa0, 0x9c, 0xd9, 0xbc, 0x74, 0xf9,
-	0x8b, 0xe1, 0x4c, 0x6c, 0xd4, 0x07, 0xc6, 0x06, 0x08, 0xb1, 0x21, 0xa6, 0x68, 0x23, 0x93, 0xa2,
-	0xa7, 0x61, 0x8a, 0xf2, 0x49, 0x2d, 0x7f, 0x02, 0x52, 0x8c, 0xb0, 0xa9, 0x74, 0x84, 0x89, 0x31,
-	0x32, 0x5d, 0x10, 0x23, 0x33, 0x71, 0xde, 0xfd, 0x48, 0x06, 0x58, 0x45, 0xae, 0xee, 0x05, 0x7d,
-	0x64, 0x07, 0x58, 0x3d, 0x23, 0x86, 0x62, 0xe7, 0x0a, 0x38, 0x7e, 0xd5, 0x92, 0xc5, 0x55, 0x4b,
-	0x81, 0x32, 0x31, 0x38, 0xf5, 0x26, 0xf9, 0x3f, 0x36, 0xa6, 0xab, 0x7b, 0x94, 0x1b, 0x4d, 0x95,
-	0x18, 0xc6, 0xab, 0x92, 0xe3, 0x19, 0x6c, 0x1d, 0xab, 0x68, 0x14, 0xc0, 0x25, 0x24, 0x99, 0x8f,
-	0xec, 0x02, 0xaa, 0x74, 0x95, 0x11, 0xb1, 0x43, 0x37, 0x2e, 0xe7, 0xa0, 0xe9, 0x87, 0xdb, 0x89,
-	0x72, 0x1b, 0x61, 0x9f, 0x25, 0x4d, 0x06, 0x8f, 0x8d, 0x4a, 0x77, 0x34, 0x78, 0x10, 0x5d, 0xf8,
-	0x12, 0x44, 0xba, 0x93, 0x51, 0x7f, 0x2d, 0x43, 0x73, 0xd3, 0xeb, 0xe9, 0xb6, 0xf9, 0xc9, 0xb8,
-	0x63, 0x1f, 0xab, 0x01, 0x58, 0x80, 0x06, 0xb2, 0x7b, 0x96, 0xe9, 0xef, 0x6e, 0x24, 0x76, 0xe3,
-	0x51, 0xbc, 0xb1, 0xcb, 0x45, 0x2d, 0x42, 0x45, 0x68, 0x11, 0xe6, 0xa0, 0xda, 0x77, 0xb6, 0x4d,
-	0x2b, 0x8a, 0x7b, 0x06, 0x91, 0x98, 0x47, 0x16, 0x22, 0xbd, 0x42, 0x1c, 0xf3, 0x11, 0x22, 0x69,
-	0x1b, 0x6a, 0xb9, 0x6d, 0x43, 0x9d, 0x6f, 0x1b, 0x44, 0xc3, 0x43, 0xc6, 0xf0, 0xd4, 0x5c, 0x8d,
-	0xb8, 0x0e, 0x0d, 0x5a, 0xe2, 0x7f, 0x21, 0x41, 0x33, 0x71, 0x05, 0xed, 0xa9, 0x0b, 0x4d, 0x99,
-	0x8e, 0x4e, 0x39, 0x27, 0x3a, 0xe3, 0x98, 0x2a, 0xf1, 0x31, 0x85, 0xa3, 0xd0, 0xf1, 0x4d, 0x6e,
-	0x63, 0x13, 0xc3, 0x78, 0x36, 0x0b, 0xe9, 0x9c, 0x21, 0x29, 0xc4, 0x6d, 0x63, 0xab, 0xc2, 0x36,
-	0x36, 0xbd, 0x52, 0xff, 0x44, 0x82, 0xe3, 0x38, 0x02, 0x32, 0x6a, 0x6c, 0x42, 0xd3, 0x49, 0x45,
-	0x09, 0x5b, 0xca, 0x9e, 0xce, 0x59, 0x8a, 0xd2, 0x01, 0xa5, 0x65, 0x88, 0x31, 0x43, 0x23, 0x35,
-	0x09, 0x5b, 0xdb, 0xf2, 0x18, 0xa6, 0xe5, 0xd1, 0x32, 0xc4, 0xea, 0xcf, 0x24, 0x68, 0xd2, 0xc5,
-	0x93, 0xab, 0x01, 0x47, 0x2e, 0xf6, 0x2d, 0x38, 0x9e, 0x9e, 0xf9, 0xba, 0xe9, 0x07, 0x2d, 0x79,
-	0xa1, 0x34, 0xaa, 0xe8, 0xb9, 0x0c, 0xd4, 0x1f, 0xc8, 0xf0, 0xf8, 0x56, 0x68, 0x59, 0x6d, 0xe4,
-	0xfb, 0x7a, 0x0f, 0x2d, 0x1f, 0x76, 0xd0, 0x3e, 0xfe, 0xa0, 0xa1, 0xfd, 0xc2, 0x18, 0xc2, 0x9d,
-	0x14, 0x69, 0x45, 0x4c, 0xc7, 0x8e, 0x43, 0x88, 0x47, 0xe1, 0x94, 0xf3, 0x29, 0x9f, 0x56, 0x69,
-	0xa1, 0x84, 0x17, 0x69, 0x06, 0x2a, 0x9f, 0x80, 0x49, 0xd2, 0x25, 0xb0, 0x69, 0x5a, 0x65, 0xa2,
-	0xc0, 0xab, 0xb9, 0x7d, 0x49, 0xae, 0x54, 0xb4, 0xdf, 0x60, 0xf0, 0x65, 0x3b, 0xf0, 0x0e, 0x35,
-	0x81, 0xe3, 0xfc, 0xdb, 0x30, 0x9b, 0x19, 0xa2, 0x34, 0xa1, 0xb4, 0x87, 0x0e, 0x99, 0x1e, 0xf8,
-	0xbf, 0xca, 0xff, 0x40, 0xe5, 0x00, 0x6f, 0x50, 0x99, 0xf7, 0xe7, 0x73, 0x24, 0x60, 0x32, 0x6b,
-	0x74, 0xe0, 0xcb, 0xf2, 0xff, 0x4b, 0xea, 0xd3, 0xb1, 0x62, 0xbc, 0x8e, 0x92, 0xa0, 0xa3, 0x7a,
-	0x0d, 0x1a, 0x6d, 0xbf, 0xb7, 0xaa, 0x07, 0x3a, 0x19, 0xf8, 0x2a, 0x34, 0xfa, 0x09, 0x48, 0x06,
-	0xe7, 0xcf, 0xc7, 0x88, 0x34, 0x7e, 0xb8, 0xfa, 0x81, 0x0c, 0xad, 0x7c, 0x53, 0xf8, 0x2e, 0x96,
-	0x01, 0x79, 0xde, 0x8a, 0x63, 0x20, 0xa2, 0x5a, 0x45, 0x8b, 0x40, 0xec, 0x3b, 0xe4, 0x79, 0x78,
-	0x7d, 0x63, 0x6d, 0x3c, 0x85, 0x94, 0x45, 0x28, 0x5b, 0x91, 0x5b, 0x06, 0x4b, 0x41, 0xc6, 0x29,
-	0x7d, 0x68, 0x12, 0xeb, 0x72, 0x0a, 0x31, 0x9f, 0x2d, 0x8d, 0xec, 0x33, 0xdf, 0xa5, 0x4e, 0xe3,
-	0x78, 0x50, 0xc7, 0x65, 0x58, 0xcf, 0x77, 0xe1, 0x44, 0xee, 0xd0, 0x1c, 0x07, 0x3e, 0x2f, 0x3a,
-	0xf0, 0x54, 0xb1, 0x2a, 0x69, 0x27, 0xba, 0xa0, 0xac, 0xa1, 0xa0, 0xad, 0xdf, 0x5d, 0xb2, 0x8d,
-	0xb6, 0x69, 0x77, 0xd0, 0x3e, 0x8e, 0xf6, 0x05, 0x68, 0xb0, 0xe3, 0x86, 0xd8, 0x4d, 0x75, 0x8d,
-	0x47, 0x15, 0x9e, 0x42, 0xa4, 0xf2, 0xa1, 0x94, 0xc9, 0x07, 0xf5, 0x12, 0x4c, 0xf2, 0xd3, 0x91,
-	0x05, 0x46, 0xbf, 0xdb, 0x41, 0xfb, 0x44, 0xa1, 0x29, 0x8d, 0x41, 0x04, 0x4f, 0x46, 0xb0, 0xdd,
-	0x07, 0x83, 0xd4, 0xdf, 0xc8, 0x70, 0x2c, 0x23, 0xb2, 0xef, 0xde, 0x2b, 0x1f, 0x3e, 0x5e, 0x4a,
-	0x45, 0xf1, 0x52, 0x16, 0xe2, 0x65, 0x0f, 0x66, 0xa9, 0x93, 0xb8, 0xa9, 0x5b, 0x15, 0x12, 0x00,
-	0xaf, 0xe5, 0x6d, 0x06, 0xb2, 0x42, 0x32, 0xdf, 0x73, 0x58, 0xea, 0xfc, 0x2c, 0xdf, 0x79, 0x04,
-	0x73, 0xf9, 0x83, 0x73, 0xdc, 0xff, 0x82, 0xe8, 0xfe, 0xff, 0xc8, 0x73, 0x3f, 0x2f, 0x09, 0xe7,
-	0xff, 0xcf, 0x48, 0x30, 0x83, 0xab, 0x6a, 0x07, 0xd9, 0x46, 0xdb, 0xef, 0x11, 0x4b, 0x2e, 0x40,
-	0x83, 0x32, 0x68, 0xfb, 0xbd, 0x64, 0x77, 0xc8, 0xa1, 0xf0, 0x88, 0xae, 0x65, 0xe2, 0xea, 0x49,
-	0x46, 0xb0, 0xaa, 0xc7, 0xa1, 0xf0, 0x0a, 0xe9, 0x23, 0x76, 0x34, 0x83, 0xcd, 0x5b, 0xd2, 0x62,
-	0x98, 0xad, 0x78, 0xe5, 0x78, 0xc5, 0x7b, 0x7f, 0x02, 0x26, 0x58, 0x78, 0x92, 0x55, 0x12, 0x6f,
-	0xd0, 0xe3, 0x3a, 0x4b, 0x21, 0xda, 0x04, 0x77, 0x0f, 0x92, 0x78, 0xa3, 0x10, 0x7f, 0x4e, 0x56,
-	0x12, 0xcf, 0xc9, 0x52, 0x32, 0x96, 0xb3, 0x32, 0xa6, 0xf4, 0xac, 0x64, 0xf5, 0xc4, 0x3d, 0x1f,
-	0x69, 0x83, 0xb6, 0x2c, 0x3d, 0xd8, 0x71, 0xbc, 0x3e, 0xdb, 0x6f, 0x57, 0xb4, 0x0c, 0x1e, 0xf7,
-	0x99, 0x14, 0x17, 0x6f, 0x14, 0xe8, 0x9a, 0x9e, 0xc2, 0xe2, 0xb6, 0x9c, 0x62, 0xa2, 0x0d, 0x03,
-	0x3d, 0x30, 0x11, 0x91, 0x54, 0x36, 0xdf, 0x37, 0x1d, 0x9b, 0xb4, 0xac, 0x74, 0x5f, 0xc0, 0xa3,
-	0xb0, 0xe6, 0x7d, 0xbf, 0x77, 0xc5, 0x73, 0xfa, 0x6c, 0x2f, 0x16, 0x81, 0x44, 0x73, 0xc7, 0x0e,
-	0xa2, 0x76, 0x97, 0x1e, 0x95, 0xf0, 0x28, 0x4c, 0xcb, 0x40, 0xd2, 0x41, 0x4d, 0x6a, 0x11, 0x88,
-	0x83, 0xcb, 0x47, 0xfb, 0xac, 0xd3, 0xc7, 0xff, 0x15, 0x3c, 0x39, 0x93, 0xf2, 0xa4, 0xd8, 0xba,
-	0x35, 0xc9, 0x57, 0xbe, 0x75, 0x4b, 0x7a, 0x9e, 0x59, 0xa1, 0xe7, 0x59, 0x82, 0x09, 0xc7, 0xc5,
-	0xf5, 0xc0, 0x6f, 0x29, 0x24, 0x7f, 0xfe, 0xab, 0xb8, 0x62, 0x2d, 0x6e, 0xd2, 0x91, 0x34, 0x53,
-	0x22, 0x3a, 0xe5, 0x3a, 0xcc, 0x38, 0x3b, 0x3b, 0x96, 0x69, 0xa3, 0xad, 0xd0, 0xdf, 0x25, 0xfb,
-	0xf2, 0x63, 0x24, 0xfa, 0xd5, 0xbc, 0xae, 0x42, 0x1c, 0xa9, 0xa5, 0x49, 0x71, 0x2b, 0xa8, 0x07,
-	0x74, 0x47, 0x44, 0x2a, 0xde, 0x71, 0x52, 0xf1, 0x04, 0x1c, 0x39, 0x70, 0xe4, 0x2a, 0xff, 0x09,
-	0x62, 0x38, 0x1e, 0x45, 0xb9, 0x04, 0x7a, 0x77, 0x17, 0x91, 0x13, 0xa6, 0xd6, 0x1c, 0x6d, 0x28,
-	0x79, 0x1c, 0x0b, 0xfe, 0xc7, 0xe3, 0x6e, 0xb6, 0x05, 0x13, 0xa6, 0xaf, 0x21, 0xbd, 0x1b, 0xb4,
-	0xce, 0x2e, 0x48, 0x67, 0x6b, 0x5a, 0x04, 0x2a, 0x17, 0xe1, 0xb8, 0xe9, 0x5f, 0xbe, 0x1b, 0x20,
-	0xcf, 0xd6, 0x2d, 0xfc, 0xaf, 0xed, 0x13, 0x8b, 0x3d, 0x43, 0x86, 0xe5, 0x7e, 0x53, 0x16, 0x41,
-	0xc1, 0x51, 0x60, 0x7a, 0x7e, 0xd0, 0x76, 0x0c, 0x73, 0xe7, 0x90, 0x38, 0xe6, 0x1c, 0x71, 0x4c,
-	0xce, 0x97, 0xf9, 0x97, 0x61, 0x92, 0x37, 0x6f, 0x4e, 0x6d, 0x39, 0xce, 0xd7, 0x96, 0x1a, 0x5f,
-	0x3a, 0xbe, 0x2e, 0xc1, 0x4c, 0xca, 0xb0, 0x78, 0x74, 0x60, 0x06, 0x16, 0x62, 0x1c, 0x28, 0x80,
-	0x37, 0x72, 0x06, 0xf2, 0xbb, 0x2c, 0x75, 0xc9, 0xff, 0x99, 0x1d, 0x4a, 0xb1, 0x1d, 0x54, 0x98,
-	0x34, 0x37, 0x3b, 0x98, 0x51, 0xc7, 0x09, 0x6d, 0x23, 0xbe, 0x2f, 0xe0, 0x70, 0xe4, 0x84, 0x61,
-	0xb3, 0xb3, 0xac, 0x1b, 0x3d, 0x44, 0x6f, 0x8f, 0x2a, 0x44, 0x26, 0x11, 0xa9, 0x1a, 0x50, 0xbb,
-	0x61, 0xba, 0xfe, 0x8a, 0xd3, 0xef, 0xe3, 0x00, 0x34, 0x50, 0x80, 0xb7, 0x1c, 0x12, 0x71, 0x17,
-	0x83, 0xb0, 0x2f, 0x0d, 0xb4, 0xa3, 0x87, 0x56, 0x80, 0x87, 0x46, 0x05, 0x8c, 0x43, 0x91, 0xd3,
-	0x0e, 0xdf, 0xb1, 0x57, 0x29, 0x35, 0x95, 0x93, 0xc3, 0xa8, 0xbf, 0x92, 0xa1, 0x49, 0x0a, 0xf4,
-	0x0a, 0x09, 0x77, 0x83, 0x10, 0x5d, 0x84, 0x0a, 0x29, 0x3f, 0xac, 0xc1, 0x1d, 0x7c, 0x44, 0x44,
-	0x87, 0x2a, 0x97, 0xa0, 0xea, 0xb8, 0xa4, 0x2b, 0xa6, 0xd5, 0xfb, 0x4c, 0x11, 0x91, 0x78, 0x43,
-	0xa0, 0x31, 0x2a, 0xe5, 0x0a, 0x40, 0x3f, 0x69, 0x82, 0x69, 0x2f, 0x33, 0x2a, 0x0f, 0x8e, 0x12,
-	0x1b, 0x37, 0x5e, 0xa6, 0xe3, 0x6b, 0x82, 0x92, 0x26, 0x22, 0x95, 0x0d, 0x98, 0x26, 0x62, 0x6f,
-	0x46, 0x67, 0x85, 0xc4, 0x07, 0xa3, 0xcf, 0x98, 0xa2, 0x56, 0xbf, 0x23, 0x31, 0x33, 0xe2, 0xaf,
-	0x1d, 0x44, 0x6d, 0x9f, 0x98, 0x44, 0x1a, 0xcb, 0x24, 0xf3, 0x50, 0xeb, 0x87, 0xdc, 0xd1, 0x65,
-	0x49, 0x8b, 0xe1, 0xc4, 0x45, 0xa5, 0x91, 0x5d, 0xa4, 0x7e, 0x57, 0x82, 0xd6, 0xeb, 0x8e, 0x69,
-	0x93, 0x0f, 0x4b, 0xae, 0x6b, 0xb1, 0xdb, 0xa4, 0xb1, 0x7d, 0xfe, 0x31, 0xa8, 0xeb, 0x94, 0x8d,
-	0x1d, 0x30, 0xb7, 0x8f, 0x70, 0x1c, 0x99, 0xd0, 0x70, 0x67, 0x42, 0x25, 0xfe, 0x4c, 0x48, 0x7d,
-	0x4f, 0x82, 0x69, 0x6a, 0x94, 0x37, 0x42, 0x33, 0x18, 0x5b, 0xbe, 0x65, 0xa8, 0xed, 0x87, 0x66,
-	0x30, 0x46, 0x54, 0xc6, 0x74, 0xd9, 0x78, 0x2a, 0xe5, 0xc4, 0x93, 0xfa, 0x81, 0x04, 0x27, 0xd3,
-	0x66, 0x5d, 0xea, 0x76, 0x91, 0x7b, 0x3f, 0x53, 0x4a, 0x38, 0x13, 0x2b, 0xe7, 0x9c, 0x89, 0x79,
-	0xa8, 0x8b, 0xcc, 0x03, 0xe4, 0x2d, 0xf9, 0x6c, 0x93, 0xcf, 0x61, 0x72, 0x55, 0xd2, 0xd0, 0xbb,
-	0xa8, 0xfb, 0xf0, 0xaa, 0xf4, 0x39, 0x19, 0x9e, 0x58, 0x8b, 0x13, 0xf7, 0x86, 0xa7, 0xdb, 0xfe,
-	0x0e, 0xf2, 0xbc, 0xfb, 0xa8, 0xcf, 0x75, 0x98, 0xb2, 0xd1, 0x9d, 0x44, 0x26, 0x96, 0xce, 0xa3,
-	0xb2, 0x11, 0x89, 0x47, 0xab, 0x7d, 0xea, 0x3f, 0x25, 0x68, 0x52, 0x3e, 0xd7, 0xcc, 0xee, 0xde,
-	0x7d, 0x54, 0x7e, 0x03, 0xa6, 0xf7, 0x88, 0x04, 0x18, 0x1a, 0xa3, 0xec, 0xa7, 0xa8, 0x47, 0x54,
-	0xff, 0x43, 0x09, 0x66, 0xa3, 0x4b, 0xf0, 0x03, 0xf3, 0x7e, 0x06, 0xf3, 0x16, 0xcc, 0xd0, 0x4b,
-	0x85, 0x71, 0x0d, 0x90, 0x26, 0x1f, 0xd1, 0x02, 0x3f, 0x96, 0x60, 0x86, 0x72, 0xba, 0x6c, 0x07,
-	0xc8, 0x1b, 0x5b, 0xff, 0xab, 0xd0, 0x40, 0x76, 0xe0, 0xe9, 0xf6, 0x38, 0x15, 0x96, 0x27, 0x1d,
-	0xb1, 0xc8, 0xbe, 0x27, 0x81, 0x42, 0x58, 0xad, 0x9a, 0x7e, 0xdf, 0xf4, 0xfd, 0xfb, 0xe8, 0xba,
-	0xd1, 0x04, 0xfe, 0x96, 0x0c, 0xc7, 0x39, 0x2e, 0xed, 0x30, 0x78, 0xd0, 0x45, 0x56, 0x56, 0xa1,
-	0x8e, 0x7b, 0x0c, 0xfe, 0xca, 0x77, 0xd4, 0x89, 0x12, 0x42, 0xdc, 0x05, 0x13, 0xa0, 0x83, 0xba,
-	0x8e, 0x6d, 0xd0, 0x52, 0x3c, 0xa5, 0x09, 0x38, 0x5c, 0x86, 0xe6, 0x39, 0x36, 0x2b, 0xba, 0xdd,
-	0x45, 0xd6, 0x23, 0x63, 0x22, 0xf5, 0xfb, 0x12, 0x4c, 0xd3, 0x21, 0x0f, 0xbe, 0xca, 0xea, 0x0f,
-	0x25, 0x16, 0xc8, 0x0f, 0x8d, 0x97, 0x70, 0x78, 0xcd, 0x71, 0x5c, 0xf8, 0xbe, 0xfc, 0xc1, 0x0d,
-	0xad, 0xab, 0xd0, 0xe8, 0xee, 0xea, 0x76, 0x6f, 0xac, 0xe0, 0xe2, 0x49, 0xd5, 0x00, 0x1e, 0xe7,
-	0xef, 0x20, 0x56, 0xe8, 0x27, 0xa2, 0xfe, 0x73, 0x29, 0x55, 0x06, 0x3e, 0xe9, 0xb8, 0x37, 0xa3,
-	0xef, 0xc1, 0x2c, 0xbd, 0x14, 0xe7, 0x7a, 0x46, 0xa5, 0x05, 0x13, 0xba, 0x41, 0x0f, 0x5e, 0x24,
-	0x42, 0x14, 0x81, 0xe2, 0xa3, 0x09, 0xf6, 0x3c, 0x2f, 0x79, 0x34, 0x71, 0x0a, 0x40, 0x37, 0x8c,
-	0x5b, 0x8e, 0x67, 0x98, 0x76, 0xb4, 0x41, 0xe0, 0x30, 0xea, 0xeb, 0x30, 0x79, 0xc5, 0x73, 0xfa,
-	0x37, 0xb8, 0xeb, 0xed, 0x81, 0x17, 0xf0, 0xfc, 0xd5, 0xb8, 0x2c, 0x5e, 0x8d, 0xab, 0xef, 0xc0,
-	0x89, 0x8c, 0xe0, 0xc4, 0x58, 0x2b, 0xf4, 0xd6, 0x3e, 0x9a, 0x84, 0x85, 0x4c, 0xde, 0xd1, 0x24,
-	0x2f, 0x8b, 0x26, 0x10, 0xa9, 0x9f, 0x95, 0xe0, 0xa9, 0x0c, 0xfb, 0x25, 0xd7, 0xf5, 0x9c, 0x03,
-	0xe6, 0x93, 0xa3, 0x98, 0x46, 0x6c, 0x8e, 0xe5, 0x54, 0x73, 0x9c, 0x2f, 0x84, 0xd0, 0xd0, 0x7f,
-	0x04, 0x42, 0x7c, 0x4f, 0x82, 0x19, 0x26, 0x84, 0x61, 0xb0, 0x69, 0x5f, 0x80, 0x2a, 0x7d, 0x37,
-	0xc4, 0x26, 0x7c, 0x2a, 0x77, 0xc2, 0xe8, 0xbd, 0x93, 0xc6, 0x06, 0x67, 0x23, 0x52, 0xce, 0xcb,
-	0xa8, 0x97, 0xe2, 0x60, 0x1f, 0xf9, 0x65, 0x0f, 0x23, 0x50, 0x3f, 0x1e, 0x05, 0xf3, 0x2a, 0xb2,
-	0xd0, 0x51, 0xda, 0x48, 0xbd, 0x09, 0xd3, 0xe4, 0x11, 0x53, 0x62, 0x83, 0x23, 0x61, 0x7b, 0x0b,
-	0x9a, 0x84, 0xed, 0x91, 0xcb, 0x1b, 0x67, 0x07, 0xb6, 0x0f, 0x5f, 0x4a, 0x8e, 0x84, 0xfb, 0xb3,
-	0x70, 0x2c, 0xb2, 0x3d, 0x7d, 0x18, 0x4c, 0x79, 0x17, 0x5c, 0x55, 0xaa, 0xdf, 0x90, 0x60, 0x6e,
-	0xc5, 0xb1, 0x0f, 0x90, 0xe7, 0x0b, 0x8f, 0x89, 0x29, 0x89, 0x90, 0xfd, 0x0c, 0x52, 0x16, 0x41,
-	0xe9, 0x72, 0x14, 0xec, 0x70, 0x54, 0x26, 0x87, 0xa3, 0x39, 0x5f, 0x94, 0xe7, 0xe1, 0x44, 0x48,
-	0xb8, 0xde, 0xb4, 0x3d, 0xa4, 0x1b, 0xe4, 0x3c, 0x8e, 0x2b, 0x7a, 0xf9, 0x1f, 0xd5, 0x77, 0x61,
-	0x9e, 0x97, 0xab, 0x83, 0x82, 0x2d, 0xcf, 0x3c, 0xe0, 0x64, 0x63, 0x27, 0xff, 0x92, 0x70, 0xf2,
-	0x9f, 0xdc, 0x14, 0xc8, 0xc2, 0x4d, 0xc1, 0x49, 0xa8, 0x9b, 0x3e, 0x63, 0x40, 0xe6, 0xad, 0x69,
-	0x09, 0x42, 0xd5, 0x61, 0x96, 0x7a, 0x99, 0x5d, 0xcd, 0x91, 0x29, 0xe6, 0xa1, 0x46, 0x43, 0x37,
-	0x9e, 0x24, 0x86, 0x0b, 0x2f, 0xba, 0x0a, 0xaf, 0x75, 0xd5, 0x0e, 0xcc, 0xb2, 0xa7, 0x4d, 0x5b,
-	0x7a, 0xcf, 0xb4, 0x69, 0x2d, 0x3f, 0x05, 0xe0, 0xea, 0xbd, 0xe8, 0xa1, 0x25, 0xbd, 0xa0, 0xe4,
-	0x30, 0xf8, 0xbb, 0xbf, 0xeb, 0xdc, 0x61, 0xdf, 0x65, 0xfa, 0x3d, 0xc1, 0xa8, 0x6f, 0x82, 0xa2,
-	0x21, 0xdf, 0x75, 0x6c, 0x1f, 0x71, 0x5c, 0x17, 0xa0, 0xb1, 0x12, 0x7a, 0x1e, 0xb2, 0xf1, 0x54,
-	0xd1, 0x6b, 0x41, 0x1e, 0x85, 0xf9, 0x76, 0x12, 0xbe, 0xf4, 0xee, 0x82, 0xc3, 0xa8, 0x7f, 0xaa,
-	0x42, 0xbd, 0x63, 0xf6, 0x6c, 0xdd, 0xd2, 0xd0, 0xbe, 0xf2, 0x2a, 0x54, 0xe9, 0xce, 0x88, 0x05,
-	0x64, 0xde, 0x59, 0x3a, 0x1d, 0x4d, 0xb7, 0x80, 0x1a, 0xda, 0xbf, 0xfa, 0x98, 0xc6, 0x68, 0x94,
-	0x37, 0xa2, 0x07, 0x60, 0xeb, 0xf4, 0xa4, 0x8c, 0x2d, 0x93, 0xcf, 0x0c, 0x61, 0xc2, 0x46, 0x53,
-	0x5e, 0x22, 0x07, 0x2c, 0x50, 0x97, 0x74, 0x4e, 0xac, 0x0a, 0x15, 0x0b, 0x44, 0x1b, 0x2c, 0x26,
-	0x10, 0xa5, 0xc1, 0xd4, 0x3a, 0x39, 0x4b, 0x62, 0x0d, 0x41, 0x31, 0x35, 0x3d, 0x72, 0x62, 0xd4,
-	0x94, 0x06, 0x53, 0xef, 0x86, 0x76, 0xef, 0xa6, 0xcb, 0x8e, 0x38, 0x8b, 0xa9, 0xaf, 0x92, 0x61,
-	0x8c, 0x9a, 0xd2, 0x60, 0x6a, 0x8f, 0xac, 0x11, 0xc4, 0xe8, 0x83, 0xa8, 0xe9, 0x52, 0xc2, 0xa8,
-	0x29, 0x8d, 0xf2, 0x16, 0x34, 0x7b, 0x28, 0xd0, 0x1c, 0xa7, 0xbf, 0x7c, 0xb8, 0xc6, 0xee, 0xb7,
-	0xe8, 0x7b, 0xf7, 0xf3, 0x85, 0x7c, 0xd6, 0x52, 0x04, 0x94, 0x63, 0x86, 0x8f, 0xf2, 0x29, 0x78,
-	0xca, 0xb1, 0x31, 0x6a, 0x4b, 0xf7, 0x02, 0xb3, 0x6b, 0xba, 0xba, 0x1d, 0xac, 0x38, 0xb6, 0x4d,
-	0xd6, 0x33, 0x0d, 0xed, 0xb3, 0x17, 0xf1, 0x2f, 0x16, 0x4e, 0xb4, 0x39, 0x88, 0xfa, 0xea, 0x63,
-	0xda, 0x60, 0xf6, 0xca, 0x17, 0x25, 0x58, 0xc8, 0x8c, 0x58, 0x35, 0xfd, 0x2e, 0x2f, 0x03, 0x7d,
-	0x4d, 0xff, 0xd2, 0xe8, 0x32, 0xa4, 0x18, 0x5c, 0x7d, 0x4c, 0x1b, 0x3a, 0x09, 0xb3, 0xf2, 0x0d,
-	0x67, 0x0f, 0xd9, 0xcb, 0x87, 0x78, 0xec, 0xfa, 0x2a, 0xb9, 0x4b, 0x1b, 0x62, 0x65, 0x81, 0x20,
-	0xb1, 0xb2, 0x80, 0x5e, 0xae, 0xc3, 0x84, 0xab, 0x1f, 0x5a, 0x8e, 0x6e, 0xa8, 0x7f, 0x2d, 0x03,
-	0x44, 0xae, 0xf6, 0x49, 0x47, 0x2c, 0x24, 0xd9, 0xe9, 0xa1, 0x49, 0xe6, 0x5a, 0x87, 0x5c, 0x9a,
-	0x75, 0xf2, 0xd3, 0xec, 0xbf, 0x47, 0x4d, 0x33, 0xca, 0x2d, 0x95, 0x68, 0x97, 0x52, 0x89, 0x76,
-	0x7a, 0x68, 0xa2, 0x31, 0xa1, 0x58, 0xaa, 0x5d, 0x4a, 0xa5, 0xda, 0xe9, 0xa1, 0xa9, 0xc6, 0xe8,
-	0x59, 0xb2, 0x5d, 0x4a, 0x25, 0xdb, 0xe9, 0xa1, 0xc9, 0xc6, 0xe8, 0x59, 0xba, 0x5d, 0x4a, 0xa5,
-	0xdb, 0xe9, 0xa1, 0xe9, 0xc6, 0xe8, 0x59, 0xc2, 0xbd, 0x53, 0x98, 0x70, 0x8b, 0xf7, 0x90, 0x70,
-	0x94, 0x67, 0x36, 0xe5, 0xde, 0xc9, 0x09, 0xb4, 0xda, 0x70, 0xee, 0xa9, 0x40, 0x4b, 0xb8, 0x17,
-	0x86, 0xda, 0xe7, 0x4b, 0x30, 0x4d, 0xdc, 0x4d, 0x57, 0x65, 0x7b, 0xc7, 0xc9, 0x3e, 0xcb, 0x95,
-	0x72, 0x9e, 0xe5, 0x2a, 0xe7, 0x61, 0x96, 0x22, 0x10, 0x77, 0x0b, 0x4a, 0x17, 0xfa, 0xec, 0x07,
-	0x72, 0xef, 0x1b, 0xfa, 0x81, 0xd3, 0x5f, 0xd5, 0x03, 0x3d, 0xda, 0x61, 0x24, 0x18, 0xfe, 0x56,
-	0xbe, 0x9c, 0xf9, 0xf5, 0x8a, 0x47, 0xf5, 0xaf, 0xb0, 0xd5, 0x9c, 0x40, 0x98, 0x22, 0x30, 0xfb,
-	0xc8, 0x09, 0x03, 0xb6, 0x48, 0x45, 0x20, 0x7d, 0x4b, 0x69, 0x98, 0x3a, 0xb9, 0xcb, 0x66, 0x0f,
-	0x0d, 0x63, 0x04, 0x59, 0x57, 0x93, 0xbb, 0x79, 0xf6, 0xeb, 0x92, 0x04, 0x33, 0xc2, 0x3d, 0x3a,
-	0xf9, 0xa1, 0x92, 0x19, 0x98, 0xfc, 0x03, 0xc4, 0x8a, 0x26, 0xe0, 0x70, 0x1f, 0xb4, 0x1d, 0xfa,
-	0x87, 0xd7, 0x4d, 0x9b, 0x37, 0x4f, 0x83, 0xf6, 0x41, 0xd9, 0x2f, 0xea, 0x9f, 0x25, 0x38, 0xc6,
-	0xd5, 0x9d, 0x36, 0x0a, 0x74, 0x62, 0x17, 0xe1, 0x19, 0xb9, 0x74, 0x6f, 0xcf, 0xc8, 0xb7, 0x60,
-	0xa6, 0x27, 0x6e, 0xcb, 0xef, 0x71, 0x47, 0x9d, 0x26, 0x17, 0xde, 0xc4, 0x97, 0xee, 0xf9, 0x4d,
-	0xbc, 0xfa, 0x25, 0x19, 0x66, 0x52, 0xcd, 0xc0, 0xc0, 0x4e, 0x6a, 0x09, 0xc0, 0x8c, 0x43, 0x73,
-	0xc0, 0xad, 0x97, 0x18, 0xbf, 0x1a, 0x47, 0x94, 0x77, 0xe9, 0x5f, 0x1a, 0xff, 0xd2, 0xff, 0x2a,
-	0x34, 0xdc, 0xc4, 0x49, 0x03, 0x0e, 0x0d, 0x72, 0x5c, 0xa9, 0xf1, 0xa4, 0xea, 0x97, 0x25, 0x98,
-	0xcd, 0x94, 0x6c, 0x72, 0x19, 0x8e, 0x13, 0x35, 0xbe, 0x0c, 0xc7, 0x00, 0x97, 0x01, 0x72, 0x3a,
-	0x03, 0x2c, 0xf3, 0x80, 0xff, 0xf5, 0x0e, 0x03, 0x0b, 0xa2, 0xaf, 0x5c, 0x18, 0x7d, 0x5f, 0x91,
-	0x61, 0x2e, 0xbf, 0xc1, 0x7a, 0x54, 0xfd, 0xf3, 0x55, 0x09, 0x5a, 0x45, 0x6b, 0xe1, 0x7d, 0x73,
-	0x53, 0x92, 0x3f, 0x71, 0xef, 0xfa, 0xa8, 0xfa, 0xe7, 0x58, 0x94, 0x3e, 0x5c, 0x73, 0xa1, 0xbe,
-	0x1f, 0xdb, 0x27, 0xee, 0xce, 0x1f, 0x51, 0xfb, 0x28, 0xe7, 0xa0, 0x49, 0xd5, 0xe4, 0xde, 0xa1,
-	0xd1, 0xcd, 0x5e, 0x06, 0xaf, 0xbe, 0x1d, 0xd9, 0x92, 0x6b, 0xb4, 0x8e, 0x2a, 0xc6, 0xd5, 0x9f,
-	0x4b, 0x91, 0x4f, 0xe2, 0x3d, 0xcf, 0x43, 0xe5, 0x93, 0x24, 0xd2, 0xb8, 0x36, 0x92, 0x8b, 0xb4,
-	0x78, 0x2f, 0xf6, 0xef, 0x48, 0x1b, 0x1e, 0x69, 0xb1, 0x2d, 0xb9, 0x96, 0x5a, 0xfd, 0xb6, 0x04,
-	0x4f, 0x14, 0xee, 0x47, 0x07, 0x5a, 0x95, 0x6b, 0x1a, 0x65, 0xb1, 0x69, 0x4c, 0xa9, 0x57, 0x1a,
-	0xbf, 0xd0, 0xfc, 0x52, 0x82, 0x27, 0x07, 0x34, 0xef, 0x29, 0xcf, 0x4a, 0xe3, 0x78, 0x36, 0x25,
-	0xac, 0x5c, 0x78, 0x31, 0x3d, 0xd4, 0x17, 0x49, 0x7a, 0x96, 0xf8, 0xf4, 0x54, 0x7f, 0x2b, 0xc1,
-	0xd3, 0x23, 0xec, 0xc4, 0x1f, 0x2c, 0x65, 0x0a, 0x1f, 0xea, 0xaa, 0xbf, 0x93, 0xe0, 0xcc, 0x68,
-	0x9b, 0xfa, 0x87, 0x45, 0xa3, 0x9f, 0xf2, 0x39, 0x90, 0x3e, 0x2d, 0xe0, 0xdc, 0x2a, 0x09, 0x55,
-	0x97, 0xcf, 0x0d, 0x39, 0x95, 0x1b, 0x47, 0x96, 0x01, 0xe9, 0x07, 0xfa, 0xe5, 0xec, 0x03, 0xfd,
-	0x36, 0x97, 0x22, 0xd9, 0x1d, 0x68, 0xc1, 0x52, 0xc2, 0x2d, 0x19, 0xb2, 0xb8, 0x64, 0x7c, 0x1a,
-	0xa6, 0x56, 0x91, 0xd5, 0xf6, 0x7b, 0xd1, 0x4f, 0x69, 0x8e, 0xf4, 0xb4, 0x75, 0x04, 0x7d, 0x96,
-	0x61, 0x9a, 0x17, 0x60, 0x9c, 0x9f, 0x8a, 0xa8, 0xb7, 0xe0, 0x89, 0x0e, 0x0a, 0x96, 0x5c, 0x77,
-	0x59, 0xef, 0xee, 0x61, 0x37, 0xdb, 0x46, 0x87, 0x3c, 0x65, 0x1e, 0xf4, 0xdb, 0x20, 0xbc, 0xb3,
-	0xf4, 0x13, 0x02, 0xf6, 0x82, 0x56, 0xc0, 0xa9, 0x1b, 0x30, 0x5f, 0xc4, 0x78, 0x2c, 0x41, 0xff,
-	0x26, 0xc3, 0x24, 0x79, 0x0f, 0x6c, 0xb4, 0xfd, 0x5e, 0x07, 0x91, 0x1f, 0xc4, 0xfb, 0xe4, 0x5a,
-	0x30, 0xb1, 0x76, 0x04, 0xa7, 0x37, 0xc7, 0x72, 0x76, 0x73, 0xbc, 0x09, 0x80, 0x22, 0x6e, 0x3e,
-	0x7b, 0x64, 0x73, 0x21, 0x27, 0xec, 0xf8, 0x29, 0x13, 0x80, 0xbd, 0xd9, 0xe6, 0x58, 0xe0, 0xf5,
-	0xa5, 0xad, 0xdf, 0x6d, 0xfb, 0x3d, 0xee, 0x0f, 0x9d, 0xd0, 0xb7, 0x36, 0x19, 0x3c, 0xb6, 0x5f,
-	0x4c, 0xb9, 0x11, 0xf6, 0xd9, 0x3a, 0x24, 0xe0, 0x52, 0x2f, 0xd0, 0xab, 0xe9, 0x17, 0xe8, 0xf3,
-	0x6f, 0xc3, 0x4c, 0x4a, 0x9c, 0x9c, 0x37, 0xce, 0x17, 0xc5, 0xdf, 0x4f, 0x9c, 0x1c, 0xa4, 0x20,
-	0xff, 0x02, 0xfa, 0x2f, 0x32, 0xd4, 0xe3, 0x0f, 0x4a, 0x1f, 0x4e, 0x78, 0x48, 0x27, 0x7f, 0xd9,
-	0x24, 0x7e, 0x91, 0xcd, 0xfd, 0xca, 0xe9, 0xff, 0x06, 0x71, 0x5d, 0xd4, 0xf2, 0x28, 0xa9, 0xf9,
-	0xf2, 0xb9, 0x8e, 0xf0, 0x1b, 0x8c, 0xfc, 0xc7, 0xe0, 0xa5, 0xa2, 0xc7, 0xe0, 0x99, 0xe7, 0xeb,
-	0xe5, 0xc2, 0xe7, 0xeb, 0xf1, 0x1f, 0xad, 0x98, 0x47, 0x30, 0x5f, 0x2c, 0x7a, 0x8e, 0xa9, 0xff,
-	0x57, 0x34, 0x75, 0xde, 0x15, 0xfa, 0x35, 0x74, 0x48, 0xff, 0x5c, 0x0a, 0x67, 0xe9, 0x1d, 0xa8,
-	0x45, 0x68, 0x72, 0x54, 0x74, 0xe8, 0xa2, 0x6b, 0x31, 0xe3, 0x08, 0x14, 0xdf, 0xaa, 0xd7, 0x19,
-	0x3d, 0x0e, 0x39, 0x4b, 0x0f, 0x90, 0x1f, 0x70, 0x21, 0x47, 0x8d, 0x90, 0xc1, 0x2f, 0x9f, 0x7f,
-	0xeb, 0xdc, 0xa6, 0x8b, 0xec, 0xdb, 0xeb, 0xed, 0xcc, 0x9f, 0x6c, 0x7a, 0x25, 0x23, 0xe9, 0x76,
-	0x95, 0x7c, 0x7f, 0xee, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x71, 0x3a, 0xae, 0x9b, 0x12, 0x4a,
-	0x00, 0x00,
+	proto.RegisterType((*GroupJoinAnswer)(nil), "server_api_params.GroupJoinAnswer")
+}
+
+func init() { proto.RegisterFile("sdk_ws/ws.proto", fileDescriptor_ws_755136b32532d37d) }
+
+var fileDescriptor_ws_755136b32532d37d = []byte{
+	// 4308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5b, 0x6f, 0x24, 0x57,
+	0x5a, 0xa9, 0xea, 0x8b, 0xdd, 0x5f, 0xfb, 0x5a, 0x33, 0xe3, 0x54, 0x9c, 0x49, 0x30, 0x95, 0x51,
+	0x98, 0x1d, 0x12, 0x0f, 0x4c, 0x76, 0x17, 0x36, 0x9b, 0x1d, 0xe4, 0xcb, 0x8c, 0xc7, 0x9b, 0xe9,
+	0xb1, 0x53, 0x3d, 0x93, 0xa0, 0x4d, 0xa4, 0x50, 0xee, 0x3a, 0x6e, 0x57, 0x5c, 0x5d, 0x55, 0xae,
+	0x8b, 0x67, 0xcc, 0x03, 0x88, 0x8b, 0xb8, 0x88, 0x07, 0x24, 0x24, 0x78, 0x00, 0x89, 0x07, 0x5e,
+	0x10, 0x08, 0x2d, 0x08, 0x2d, 0x12, 0x12, 0x08, 0x21, 0xc4, 0x03, 0x12, 0x48, 0xec, 0x3b, 0x02,
+	0x09, 0x5e, 0x40, 0x88, 0x3f, 0x80, 0x84, 0xb4, 0xe8, 0x3b, 0xe7, 0x54, 0xd5, 0x39, 0x75, 0xe9,
+	0x6e, 0x5b, 0xd6, 0xce, 0x44, 0xd9, 0x27, 0xfb, 0xfb, 0xce, 0xf9, 0xbe, 0xf3, 0x9d, 0xef, 0x76,
+	0xbe, 0x73, 0xa9, 0x86, 0xc5, 0xc8, 0x3e, 0xfe, 0xf4, 0x69, 0x74, 0xfb, 0x69, 0xb4, 0x1e, 0x84,
+	0x7e, 0xec, 0x6b, 0xcb, 0x11, 0x09, 0x4f, 0x49, 0xf8, 0xa9, 0x15, 0x38, 0x9f, 0x06, 0x56, 0x68,
+	0x8d, 0xa2, 0xd5, 0xf5, 0xbd, 0x80, 0x78, 0x6f, 0xef, 0xf6, 0xde, 0xee, 0xd3, 0xa6, 0xdb, 0xc1,
+	0xf1, 0xf0, 0x36, 0xed, 0x7c, 0x3b, 0x25, 0x0e, 0xad, 0x20, 0x20, 0x21, 0x67, 0x61, 0xfc, 0x69,
+	0x0b, 0x3a, 0x3b, 0xa1, 0x9f, 0x04, 0xbb, 0xde, 0xa1, 0xaf, 0xe9, 0x30, 0x33, 0xa4, 0xc0, 0xb6,
+	0xae, 0xac, 0x29, 0x37, 0x3b, 0x66, 0x0a, 0x6a, 0xd7, 0xa1, 0x43, 0xff, 0x7d, 0x64, 0x8d, 0x88,
+	0xae, 0xd2, 0xb6, 0x1c, 0xa1, 0x19, 0x30, 0xe7, 0xf9, 0xb1, 0x73, 0xe8, 0x0c, 0xac, 0xd8, 0xf1,
+	0x3d, 0xbd, 0x41, 0x3b, 0x48, 0x38, 0xec, 0xe3, 0x78, 0x71, 0xe8, 0xdb, 0xc9, 0x80, 0xf6, 0x69,
+	0xb2, 0x3e, 0x22, 0x0e, 0xc7, 0x3f, 0xb4, 0x06, 0xe4, 0x89, 0xf9, 0x50, 0x6f, 0xb1, 0xf1, 0x39,
+	0xa8, 0xad, 0x41, 0xd7, 0x7f, 0xea, 0x91, 0xf0, 0x49, 0x44, 0xc2, 0xdd, 0x6d, 0xbd, 0x4d, 0x5b,
+	0x45, 0x94, 0xf6, 0x3a, 0xc0, 0x20, 0x24, 0x56, 0x4c, 0x1e, 0x3b, 0x23, 0xa2, 0xcf, 0xac, 0x29,
+	0x37, 0xe7, 0x4d, 0x01, 0x83, 0x1c, 0x46, 0x64, 0x74, 0x40, 0xc2, 0x2d, 0x3f, 0xf1, 0x62, 0x7d,
+	0x96, 0x76, 0x10, 0x51, 0xda, 0x02, 0xa8, 0xe4, 0x99, 0xde, 0xa1, 0xac, 0x55, 0xf2, 0x4c, 0x5b,
+	0x81, 0x76, 0x14, 0x5b, 0x71, 0x12, 0xe9, 0xb0, 0xa6, 0xdc, 0x6c, 0x99, 0x1c, 0xd2, 0x6e, 0xc0,
+	0x3c, 0xe5, 0xeb, 0xa7, 0xd2, 0x74, 0x29, 0x89, 0x8c, 0xcc, 0x34, 0xf6, 0xf8, 0x2c, 0x20, 0xfa,
+	0x1c, 0x65, 0x90, 0x23, 0xb4, 0x5b, 0xb0, 0xe4, 0x11, 0x62, 0x7f, 0x48, 0xc2, 0x5c, 0x6b, 0xf3,
+	0xb4, 0x53, 0x09, 0xaf, 0xbd, 0x09, 0x0b, 0xae, 0xef, 0x1f, 0xf7, 0xa8, 0xa8, 0x68, 0x27, 0x7d,
+	0x81, 0xf6, 0x2c, 0x60, 0xb5, 0xb7, 0x60, 0xd9, 0x0a, 0x02, 0xf7, 0x8c, 0xa1, 0xee, 0x87, 0x0e,
+	0xf1, 0x6c, 0x7d, 0x91, 0x76, 0x2d, 0x37, 0x68, 0x5f, 0x85, 0x15, 0xd1, 0x3e, 0x4f, 0x02, 0x3b,
+	0xd5, 0xdd, 0x12, 0x55, 0x4d, 0x4d, 0xab, 0xb6, 0x0e, 0x9a, 0xd4, 0xc2, 0x54, 0xb0, 0x4c, 0x55,
+	0x50, 0xd1, 0xa2, 0xdd, 0x84, 0xc5, 0xc8, 0xf5, 0x9f, 0xf6, 0x7c, 0x9b, 0xf4, 0xc9, 0xc0, 0xf7,
+	0xec, 0x48, 0xd7, 0xa8, 0x4c, 0x45, 0x34, 0xea, 0xd5, 0xb6, 0x1c, 0xf7, 0xac, 0x17, 0x0d, 0x3f,
+	0x48, 0xfc, 0xd8, 0xd2, 0xaf, 0xd0, 0x7e, 0x32, 0xd2, 0xf8, 0xfd, 0x26, 0x2c, 0x66, 0x1e, 0x7b,
+	0xdf, 0x0f, 0xfb, 0x24, 0x7e, 0x81, 0xfd, 0x96, 0xf9, 0x54, 0x3b, 0xf3, 0xa9, 0x9d, 0x0a, 0xbb,
+	0xa3, 0xaf, 0x76, 0xef, 0xbc, 0xba, 0x3e, 0xf4, 0xfd, 0xa1, 0x4b, 0x58, 0x60, 0x1e, 0x24, 0x87,
+	0xeb, 0xbb, 0x5e, 0xfc, 0xce, 0x9d, 0x0f, 0x2d, 0x37, 0x21, 0x15, 0x4e, 0xb1, 0x55, 0x72, 0x8a,
+	0xd9, 0xc9, 0x6c, 0x8a, 0x1e, 0xb3, 0x5b, 0xe5, 0x31, 0x9d, 0xc9, 0x7c, 0xca, 0x54, 0xda, 0xbd,
+	0xb2, 0x99, 0x61, 0x32, 0xa3, 0x92, 0x0f, 0x6c, 0x14, 0x7d, 0xa0, 0x3b, 0x99, 0x49, 0xc1, 0x41,
+	0xbe, 0xa7, 0xc2, 0x15, 0xea, 0x20, 0x5c, 0xbe, 0xc4, 0x75, 0x27, 0x24, 0xb7, 0x15, 0x68, 0x27,
+	0xcc, 0x8d, 0x99, 0x87, 0x70, 0x08, 0x9d, 0x27, 0xf4, 0x5d, 0xf2, 0x90, 0x9c, 0x12, 0x97, 0xfa,
+	0x46, 0xcb, 0xcc, 0x11, 0xda, 0x2a, 0xcc, 0x7e, 0xe6, 0x3b, 0x1e, 0x0d, 0x99, 0x26, 0x6d, 0xcc,
+	0x60, 0x6c, 0xf3, 0x9c, 0xc1, 0xb1, 0x87, 0x5e, 0xc7, 0x3c, 0x22, 0x83, 0x45, 0x67, 0x69, 0xcb,
+	0xce, 0xf2, 0x26, 0x2c, 0x58, 0x41, 0xd0, 0xb3, 0xbc, 0x21, 0x09, 0xd9, 0xa0, 0x33, 0x2c, 0xd0,
+	0x65, 0x2c, 0xa6, 0x3a, 0x1c, 0xa9, 0xef, 0x27, 0xe1, 0x80, 0x50, 0xbb, 0xb7, 0x4c, 0x01, 0x83,
+	0x7c, 0xfc, 0x80, 0x84, 0x42, 0x86, 0x62, 0x49, 0xad, 0x80, 0xe5, 0xce, 0x09, 0x99, 0x73, 0x62,
+	0x8a, 0x4c, 0x62, 0x72, 0xcf, 0xb3, 0xe9, 0xa4, 0xba, 0x3c, 0x45, 0xe6, 0x28, 0x0c, 0x51, 0xc7,
+	0x3b, 0x75, 0xe2, 0x2c, 0x11, 0xcf, 0xb1, 0xd4, 0x27, 0x21, 0x8d, 0x5f, 0x51, 0x60, 0x61, 0x3f,
+	0x39, 0x70, 0x9d, 0x01, 0x45, 0xa0, 0xf2, 0x73, 0x15, 0x2b, 0x92, 0x8a, 0x45, 0x45, 0xa9, 0xf5,
+	0x8a, 0x6a, 0xc8, 0x8a, 0x5a, 0x81, 0xf6, 0x90, 0x78, 0x36, 0x09, 0xb9, 0xe2, 0x39, 0xc4, 0x27,
+	0xd4, 0x4a, 0x27, 0x64, 0xfc, 0x9b, 0x0a, 0xb3, 0xdf, 0x67, 0x11, 0xd6, 0xa0, 0x1b, 0x1c, 0xf9,
+	0x1e, 0x79, 0x94, 0xa0, 0xf3, 0x71, 0x59, 0x44, 0x94, 0x76, 0x15, 0x5a, 0x07, 0x4e, 0x18, 0x1f,
+	0x51, 0xeb, 0xcf, 0x9b, 0x0c, 0x40, 0x2c, 0x19, 0x59, 0x0e, 0x33, 0x79, 0xc7, 0x64, 0x00, 0x9f,
+	0xd0, 0x6c, 0x66, 0x21, 0x79, 0x91, 0xeb, 0x94, 0x16, 0xb9, 0xb2, 0x07, 0x41, 0xa5, 0x07, 0xdd,
+	0x82, 0xa5, 0xa1, 0xeb, 0x1f, 0x58, 0xae, 0x49, 0x06, 0xa7, 0xbd, 0x68, 0xb8, 0x17, 0xc4, 0xd4,
+	0xdc, 0x2d, 0xb3, 0x84, 0x47, 0xfd, 0x50, 0x11, 0xfb, 0x71, 0xc8, 0xcd, 0x9d, 0xc1, 0xc6, 0xff,
+	0x2a, 0x00, 0x2c, 0x01, 0x50, 0x15, 0x17, 0x56, 0x69, 0xa5, 0xbc, 0x4a, 0xaf, 0x40, 0x3b, 0x24,
+	0x23, 0x2b, 0x3c, 0x4e, 0x43, 0x8d, 0x41, 0x85, 0x89, 0x35, 0x4a, 0x13, 0xfb, 0x3a, 0xc0, 0x21,
+	0x1d, 0xe7, 0x49, 0xc4, 0x55, 0x8e, 0x49, 0xa1, 0x54, 0xff, 0xac, 0xa7, 0xd6, 0x36, 0x85, 0xee,
+	0x18, 0xc7, 0x96, 0x6d, 0xf3, 0x70, 0x69, 0xb1, 0x38, 0xce, 0x10, 0x15, 0xd1, 0xd2, 0x1e, 0x13,
+	0x2d, 0x33, 0x99, 0x73, 0xfd, 0x8f, 0x02, 0x9d, 0x4d, 0xd7, 0x1a, 0x1c, 0x4f, 0x39, 0x75, 0x79,
+	0x8a, 0x6a, 0x69, 0x8a, 0x3b, 0x30, 0x7f, 0x80, 0xec, 0xd2, 0x29, 0x50, 0x2d, 0x74, 0xef, 0xfc,
+	0x70, 0xc5, 0x2c, 0xe5, 0xe0, 0x32, 0x65, 0x3a, 0x79, 0xba, 0xcd, 0xc9, 0xd3, 0x6d, 0x8d, 0x99,
+	0x6e, 0xb6, 0x72, 0x19, 0xff, 0xda, 0x80, 0x39, 0x9a, 0x56, 0x4d, 0x72, 0x92, 0x90, 0x28, 0xd6,
+	0xbe, 0x01, 0xb3, 0x49, 0x2a, 0xaa, 0x32, 0xad, 0xa8, 0x19, 0x89, 0xf6, 0x2e, 0x5f, 0x99, 0x29,
+	0xbd, 0x4a, 0xe9, 0xaf, 0x57, 0xd0, 0x67, 0x4b, 0xbd, 0x99, 0x77, 0xc7, 0x35, 0xf9, 0xc8, 0xf2,
+	0x6c, 0x97, 0x98, 0x24, 0x4a, 0xdc, 0x98, 0xe7, 0x66, 0x09, 0xc7, 0x3c, 0xed, 0xa4, 0x17, 0x0d,
+	0xf9, 0x8a, 0xcd, 0x21, 0xd4, 0x0e, 0xeb, 0x87, 0x4d, 0x6c, 0xea, 0x39, 0x02, 0x03, 0x3e, 0x24,
+	0x27, 0xd4, 0x42, 0x2c, 0x3c, 0x53, 0x30, 0x1f, 0x93, 0x6b, 0x8d, 0x39, 0x82, 0x84, 0x43, 0x13,
+	0x33, 0x98, 0x32, 0x60, 0x25, 0xa6, 0x80, 0x29, 0x55, 0x98, 0x72, 0x22, 0x87, 0x52, 0x22, 0x2f,
+	0xa5, 0xdb, 0x6e, 0x45, 0xba, 0xd5, 0xb6, 0xa1, 0x8b, 0x34, 0x1b, 0x5e, 0xf4, 0x94, 0x84, 0x91,
+	0x3e, 0xb7, 0xd6, 0xb8, 0xd9, 0xbd, 0x63, 0xd4, 0xe9, 0xf2, 0x9b, 0x59, 0x57, 0x53, 0x24, 0x33,
+	0xfe, 0xa5, 0x01, 0xf3, 0x2c, 0x94, 0x53, 0x03, 0xbf, 0x8e, 0x31, 0xe7, 0x8f, 0x24, 0x8f, 0x16,
+	0x30, 0xa8, 0x11, 0x84, 0x1e, 0xc9, 0xc9, 0x53, 0xc2, 0x61, 0x58, 0x20, 0x7c, 0x5f, 0x4a, 0xa2,
+	0x22, 0x2a, 0x1d, 0x65, 0x47, 0x4c, 0xa6, 0x02, 0x06, 0xd3, 0x4f, 0xec, 0x4b, 0x9e, 0x9a, 0xc1,
+	0x48, 0x1b, 0xfb, 0xd9, 0xf8, 0xcc, 0x57, 0x05, 0x0c, 0xda, 0x3a, 0xf6, 0xd3, 0xb1, 0x99, 0xc1,
+	0x72, 0x04, 0xe3, 0xcc, 0xc7, 0x65, 0x8b, 0x68, 0x06, 0x97, 0x3c, 0xac, 0x33, 0xd6, 0xc3, 0x40,
+	0xf2, 0x30, 0x39, 0xd0, 0xbb, 0xa5, 0x40, 0xbf, 0x01, 0xf3, 0x8c, 0x4f, 0x61, 0x11, 0x95, 0x90,
+	0xb2, 0x9f, 0xce, 0x17, 0xfd, 0x54, 0xf6, 0xb4, 0x85, 0x1a, 0x4f, 0x5b, 0xcc, 0xa2, 0xf7, 0xcf,
+	0x55, 0x80, 0x6d, 0x12, 0x58, 0x61, 0x3c, 0x22, 0x5e, 0x8c, 0xd3, 0xb3, 0x33, 0x28, 0x33, 0xae,
+	0x84, 0x13, 0xd7, 0x3e, 0x55, 0x5e, 0xfb, 0x34, 0x68, 0x52, 0x85, 0x33, 0x6b, 0xd2, 0xff, 0x51,
+	0x99, 0x81, 0x15, 0x32, 0x6e, 0x2c, 0xe0, 0x32, 0x18, 0xd7, 0x36, 0x3f, 0xb4, 0xf9, 0x6a, 0xd8,
+	0x32, 0x19, 0x80, 0x89, 0x28, 0x1f, 0x8f, 0xee, 0x92, 0xda, 0x6c, 0xad, 0x92, 0xb1, 0x13, 0x37,
+	0x76, 0xb7, 0x60, 0x29, 0x4a, 0x0e, 0xf2, 0xc9, 0x3d, 0x4a, 0x46, 0x3c, 0xf4, 0x4a, 0x78, 0x54,
+	0x2a, 0xdb, 0xf1, 0x61, 0x27, 0xb6, 0x7c, 0xe6, 0x88, 0x62, 0x3d, 0x64, 0xfc, 0x83, 0x0a, 0x4b,
+	0x7b, 0xe1, 0xd0, 0xf2, 0x9c, 0x9f, 0xcd, 0x76, 0x34, 0x17, 0x2a, 0x23, 0xd6, 0xa0, 0x4b, 0xbc,
+	0xa1, 0xeb, 0x44, 0x47, 0x8f, 0x72, 0xbd, 0x89, 0x28, 0x51, 0xd9, 0xcd, 0xba, 0x42, 0xa3, 0x25,
+	0x15, 0x1a, 0x2b, 0xd0, 0x1e, 0xf9, 0x07, 0x8e, 0x9b, 0xfa, 0x3d, 0x87, 0xa8, 0xcf, 0x13, 0x97,
+	0xd0, 0x8a, 0x23, 0xf3, 0xf9, 0x14, 0x91, 0x17, 0x1f, 0xb3, 0x95, 0xc5, 0x47, 0x47, 0x2c, 0x3e,
+	0x64, 0xc5, 0x43, 0x49, 0xf1, 0x4c, 0x5d, 0xdd, 0x2c, 0x9b, 0x8d, 0x2b, 0x14, 0xfe, 0x56, 0x81,
+	0xa5, 0xdc, 0x14, 0xac, 0x32, 0xaf, 0x55, 0x65, 0xd1, 0x3b, 0xd5, 0x0a, 0xef, 0xcc, 0x7c, 0xaa,
+	0x21, 0xfa, 0x14, 0x7a, 0xa1, 0x1f, 0x39, 0xc2, 0x46, 0x2d, 0x83, 0x71, 0x34, 0x97, 0x58, 0x82,
+	0x22, 0x19, 0x24, 0x6c, 0xf3, 0xdb, 0xd2, 0x36, 0xbf, 0xb8, 0xde, 0xff, 0xa5, 0x02, 0x57, 0xd1,
+	0x03, 0x4a, 0xd3, 0xd8, 0x83, 0x25, 0xbf, 0xe0, 0x25, 0x7c, 0x41, 0x7c, 0xa3, 0x22, 0x09, 0x17,
+	0x1d, 0xca, 0x2c, 0x11, 0x23, 0x43, 0xbb, 0x30, 0x88, 0xae, 0xd6, 0x32, 0x2c, 0xca, 0x63, 0x96,
+	0x88, 0x8d, 0xbf, 0x56, 0x60, 0x89, 0x2d, 0xc1, 0x79, 0xe7, 0xcb, 0x17, 0xfb, 0x23, 0xb8, 0x5a,
+	0x1c, 0xf9, 0xa1, 0x13, 0xc5, 0xba, 0xba, 0xd6, 0xa8, 0x61, 0x5a, 0x12, 0xbd, 0x92, 0x81, 0xf1,
+	0x27, 0x2a, 0xbc, 0xbc, 0x9f, 0xb8, 0x6e, 0x8f, 0x44, 0x91, 0x35, 0x24, 0x9b, 0x67, 0x7d, 0x72,
+	0x82, 0x0d, 0x26, 0x39, 0xa9, 0xf5, 0x21, 0xac, 0xc7, 0x68, 0x41, 0xe3, 0xf8, 0x5e, 0xe6, 0x42,
+	0x22, 0x0a, 0x43, 0x2e, 0x62, 0x7c, 0xf4, 0xc6, 0x5a, 0x03, 0x97, 0x7a, 0x0e, 0x6a, 0x3f, 0x03,
+	0x73, 0xb4, 0xd6, 0xe0, 0xc3, 0xe8, 0x4d, 0x3a, 0x81, 0xf7, 0x2a, 0xab, 0x9b, 0x4a, 0xa9, 0xd8,
+	0x4a, 0xcb, 0xe1, 0x7b, 0x5e, 0x1c, 0x9e, 0x99, 0x12, 0xc7, 0xd5, 0x8f, 0x61, 0xb9, 0xd4, 0x45,
+	0x5b, 0x82, 0xc6, 0x31, 0x39, 0xe3, 0xf3, 0xc0, 0x7f, 0xb5, 0x1f, 0x83, 0xd6, 0x29, 0x6e, 0x71,
+	0xb9, 0xf5, 0x57, 0x2b, 0x24, 0xe0, 0x32, 0x9b, 0xac, 0xe3, 0xbb, 0xea, 0x4f, 0x2a, 0xc6, 0x1b,
+	0xd9, 0xc4, 0xc4, 0x39, 0x2a, 0xd2, 0x1c, 0x8d, 0xf7, 0xa1, 0xdb, 0x8b, 0x86, 0xdb, 0x56, 0x6c,
+	0xd1, 0x8e, 0xef, 0x41, 0x77, 0x94, 0x83, 0xb4, 0x73, 0xf5, 0x78, 0x9c, 0xc8, 0x14, 0xbb, 0x1b,
+	0xdf, 0x55, 0x41, 0xaf, 0x56, 0x45, 0x14, 0xa0, 0x0c, 0x24, 0x0c, 0xb7, 0x7c, 0x9b, 0xd0, 0xa9,
+	0xb5, 0xcc, 0x14, 0x44, 0xdb, 0x91, 0x30, 0xc4, 0xf5, 0x8d, 0x6f, 0x06, 0x18, 0xa4, 0xad, 0x43,
+	0xd3, 0x4d, 0xcd, 0x32, 0x5e, 0x0a, 0xda, 0x4f, 0x1b, 0xc1, 0x12, 0xd5, 0xae, 0x30, 0x21, 0x6e,
+	0xb3, 0x8d, 0xa9, 0x6d, 0x16, 0x05, 0xeb, 0x3b, 0x05, 0x1e, 0xcc, 0x70, 0x25, 0xd6, 0xab, 0x03,
+	0xb8, 0x56, 0xd9, 0xb5, 0xc2, 0x80, 0x5f, 0x96, 0x0d, 0xf8, 0x7a, 0xfd, 0x54, 0x8a, 0x46, 0x0c,
+	0x40, 0xdb, 0x21, 0x71, 0xcf, 0x7a, 0xb6, 0xe1, 0xd9, 0x3d, 0xc7, 0xeb, 0x93, 0x13, 0xf4, 0xf6,
+	0x35, 0xe8, 0xf2, 0x43, 0x8b, 0xcc, 0x4c, 0x1d, 0x53, 0x44, 0xd5, 0x9e, 0x65, 0x14, 0xe2, 0xa1,
+	0x51, 0x8a, 0x07, 0xe3, 0x2e, 0xcc, 0x89, 0xc3, 0xd1, 0x05, 0xc6, 0x7a, 0xd6, 0x27, 0x27, 0x74,
+	0x42, 0xf3, 0x26, 0x87, 0x28, 0x9e, 0xf6, 0xe0, 0x7b, 0x18, 0x0e, 0x19, 0xff, 0x88, 0xe7, 0x2e,
+	0x45, 0x91, 0xa3, 0xe0, 0xbc, 0x7c, 0x44, 0x7f, 0x69, 0xd4, 0xf9, 0x4b, 0x53, 0xf2, 0x97, 0x63,
+	0x58, 0x66, 0x46, 0x12, 0x86, 0xd6, 0x5b, 0xd4, 0x01, 0xbe, 0x51, 0x55, 0x06, 0x97, 0x85, 0xe4,
+	0xb6, 0x17, 0xb0, 0xcc, 0xf8, 0x65, 0xbe, 0xab, 0x04, 0x56, 0xaa, 0x3b, 0x57, 0x98, 0xff, 0x2b,
+	0xb2, 0xf9, 0x7f, 0xa8, 0xca, 0xfc, 0xa2, 0x24, 0x82, 0xfd, 0x7f, 0x41, 0x81, 0x45, 0xcc, 0xaa,
+	0x7d, 0xe2, 0xd9, 0xbd, 0x68, 0x48, 0x35, 0xb9, 0x06, 0x5d, 0xc6, 0xa0, 0x17, 0x0d, 0xf3, 0x3d,
+	0xa6, 0x80, 0xc2, 0x1e, 0x03, 0xd7, 0xc1, 0xec, 0x49, 0x7b, 0xf0, 0xac, 0x27, 0xa0, 0x70, 0x85,
+	0x8c, 0x08, 0x3f, 0xe0, 0x41, 0xf5, 0x36, 0xcc, 0x0c, 0xe6, 0x2b, 0x5e, 0x33, 0x5b, 0xf1, 0xbe,
+	0x33, 0x03, 0x33, 0xdc, 0x3d, 0xe9, 0x2a, 0x89, 0xdb, 0xfc, 0x2c, 0xcf, 0x32, 0x88, 0x15, 0xc1,
+	0x83, 0xd3, 0xdc, 0xdf, 0x18, 0x24, 0x9e, 0xb6, 0x35, 0xe4, 0xd3, 0xb6, 0x82, 0x8c, 0xcd, 0xb2,
+	0x8c, 0x85, 0x79, 0xb6, 0xca, 0xf3, 0xc4, 0x9a, 0x8f, 0x96, 0x41, 0xfb, 0xae, 0x15, 0x1f, 0xfa,
+	0xe1, 0x88, 0xef, 0xda, 0x5b, 0x66, 0x09, 0x8f, 0x75, 0x26, 0xc3, 0x65, 0x1b, 0x05, 0xb6, 0xa6,
+	0x17, 0xb0, 0x58, 0x96, 0x33, 0x4c, 0xba, 0x61, 0x60, 0xc7, 0x2e, 0x32, 0x92, 0xc9, 0x16, 0x45,
+	0x8e, 0xef, 0xd1, 0x92, 0x95, 0xed, 0x0b, 0x44, 0x14, 0xce, 0x7c, 0x14, 0x0d, 0xef, 0x87, 0xfe,
+	0x88, 0xef, 0xe8, 0x52, 0x90, 0xce, 0xdc, 0xf7, 0xe2, 0xb4, 0xdc, 0x65, 0x07, 0x2e, 0x22, 0x0a,
+	0x69, 0x39, 0x48, 0x2b, 0xa8, 0x39, 0x33, 0x05, 0xd1, 0xb9, 0x22, 0x72, 0xc2, 0x2b, 0x7d, 0xfc,
+	0x57, 0xb2, 0xe4, 0x62, 0xc1, 0x92, 0x72, 0xe9, 0xb6, 0x44, 0x5b, 0x05, 0x8c, 0x50, 0xf3, 0x2c,
+	0x4b, 0x35, 0xcf, 0x06, 0xcc, 0xf8, 0x01, 0xe6, 0x03, 0x3c, 0xa4, 0xc7, 0xf8, 0xf9, 0x91, 0xfa,
+	0x8c, 0xb5, 0xbe, 0xc7, 0x7a, 0xb2, 0x48, 0x49, 0xe9, 0xb4, 0x87, 0xb0, 0xe8, 0x1f, 0x1e, 0xba,
+	0x8e, 0x47, 0xf6, 0x93, 0xe8, 0x88, 0xee, 0xee, 0xaf, 0xac, 0x29, 0x35, 0x3b, 0xd2, 0x3d, 0xb9,
+	0xa7, 0x59, 0x24, 0xc5, 0x52, 0xd0, 0x8a, 0xd9, 0x8e, 0x88, 0x66, 0xbc, 0xab, 0x34, 0xe3, 0x49,
+	0x38, 0x7a, 0x6c, 0x29, 0x64, 0xfe, 0x6b, 0x54, 0x71, 0x22, 0x8a, 0x71, 0x89, 0xad, 0xc1, 0x11,
+	0xa1, 0xe7, 0x54, 0xfa, 0x0a, 0x2b, 0x28, 0x45, 0x1c, 0x77, 0xfe, 0x97, 0xb3, 0x6a, 0x56, 0x87,
+	0x19, 0x27, 0x32, 0x89, 0x35, 0x88, 0xf5, 0x9b, 0x6b, 0xca, 0xcd, 0x59, 0x33, 0x05, 0xb5, 0x3b,
+	0x70, 0xd5, 0x89, 0xee, 0x3d, 0x8b, 0x49, 0xe8, 0x59, 0x2e, 0xfe, 0xf5, 0x22, 0xaa, 0xb1, 0x2f,
+	0xd1, 0x6e, 0x95, 0x6d, 0x78, 0x6b, 0x82, 0x5e, 0xe0, 0x84, 0x51, 0xdc, 0xf3, 0x6d, 0xe7, 0xf0,
+	0x8c, 0x1a, 0xe6, 0x16, 0x35, 0x4c, 0x45, 0xcb, 0xea, 0xbb, 0x30, 0x27, 0xaa, 0xb7, 0x22, 0xb7,
+	0x5c, 0x15, 0x73, 0xcb, 0xac, 0x98, 0x3a, 0x7e, 0x5b, 0x81, 0xc5, 0x82, 0x62, 0xb1, 0x77, 0xec,
+	0xc4, 0x2e, 0xe1, 0x1c, 0x18, 0x80, 0x1b, 0x39, 0x9b, 0x44, 0x03, 0x1e, 0xba, 0xf4, 0x7f, 0xae,
+	0x87, 0x46, 0xa6, 0x07, 0xbc, 0xff, 0xd8, 0xeb, 0x23, 0xa3, 0xbe, 0x9f, 0x78, 0x76, 0x76, 0xff,
+	0x21, 0xe0, 0xe8, 0x39, 0xc5, 0x5e, 0x7f, 0xd3, 0xb2, 0x87, 0x84, 0xdd, 0xae, 0xb5, 0xa8, 0x4c,
+	0x32, 0xd2, 0xb0, 0x61, 0xf6, 0xb1, 0x13, 0x44, 0x5b, 0xfe, 0x68, 0x84, 0x0e, 0x68, 0x93, 0x18,
+	0xb7, 0x1c, 0x0a, 0x35, 0x17, 0x87, 0xd0, 0x96, 0x36, 0x39, 0xb4, 0x12, 0x37, 0xc6, 0xae, 0x69,
+	0x02, 0x13, 0x50, 0xf4, 0xcc, 0x24, 0xf2, 0xbd, 0x6d, 0x46, 0xcd, 0xe4, 0x14, 0x30, 0xc6, 0xdf,
+	0xab, 0xb0, 0x44, 0x13, 0xf4, 0x16, 0x75, 0x77, 0x9b, 0x12, 0xdd, 0x81, 0x16, 0x4d, 0x3f, 0xba,
+	0x32, 0xc5, 0x41, 0x13, 0xeb, 0xaa, 0xdd, 0x85, 0xb6, 0x1f, 0xd0, 0xaa, 0x98, 0x65, 0xef, 0x37,
+	0xeb, 0x88, 0xe4, 0x7b, 0x06, 0x93, 0x53, 0x69, 0xf7, 0x01, 0x46, 0x79, 0x11, 0xcc, 0x6a, 0x99,
+	0x69, 0x79, 0x08, 0x94, 0xa8, 0xdc, 0x6c, 0x99, 0xce, 0x2e, 0x1b, 0x1a, 0xa6, 0x8c, 0xd4, 0x1e,
+	0xc1, 0x02, 0x15, 0x7b, 0x2f, 0x3d, 0x71, 0xa4, 0x36, 0x98, 0x7e, 0xc4, 0x02, 0xb5, 0xf1, 0x07,
+	0x0a, 0x57, 0x23, 0xb6, 0xf6, 0x09, 0xd3, 0x7d, 0xae, 0x12, 0xe5, 0x42, 0x2a, 0x59, 0x85, 0x59,
+	0xbc, 0x4d, 0xc8, 0x0e, 0x40, 0x1b, 0x66, 0x06, 0xe7, 0x26, 0x6a, 0x4c, 0x6d, 0x22, 0xe3, 0x0f,
+	0x15, 0xd0, 0xf1, 0x3c, 0x8b, 0x36, 0x6c, 0x04, 0x81, 0xcb, 0x6f, 0xc7, 0x2e, 0x6c, 0xf3, 0x9f,
+	0x82, 0x8e, 0xc5, 0xd8, 0x78, 0xb1, 0xae, 0x4e, 0x7b, 0xa8, 0x99, 0xd3, 0x08, 0x67, 0x42, 0x0d,
+	0xf1, 0x4c, 0xc8, 0xf8, 0xb6, 0x02, 0x0b, 0x4c, 0x29, 0x1f, 0x24, 0x4e, 0x7c, 0x61, 0xf9, 0x36,
+	0x61, 0xf6, 0x24, 0x71, 0xe2, 0x0b, 0x78, 0x65, 0x46, 0x57, 0xf6, 0xa7, 0x46, 0x85, 0x3f, 0x19,
+	0xdf, 0x55, 0xe0, 0x7a, 0x51, 0xad, 0x1b, 0x83, 0x01, 0x09, 0x9e, 0x67, 0x48, 0x49, 0x67, 0x62,
+	0xcd, 0x8a, 0x33, 0xb1, 0x90, 0x0c, 0x88, 0x73, 0x4a, 0xc2, 0x8d, 0x88, 0x6f, 0xf2, 0x05, 0x4c,
+	0xe5, 0x94, 0x4c, 0xf2, 0x19, 0x19, 0x7c, 0x7e, 0xa7, 0xf4, 0x4b, 0x2a, 0xbc, 0xb2, 0x93, 0x05,
+	0xee, 0xe3, 0xd0, 0xf2, 0xa2, 0x43, 0x12, 0x86, 0xcf, 0x71, 0x3e, 0x0f, 0x61, 0xde, 0x23, 0x4f,
+	0x73, 0x99, 0xf4, 0xc6, 0xb9, 0xd8, 0xc8, 0xc4, 0xd3, 0xe5, 0x3e, 0xe3, 0xff, 0x14, 0x58, 0x62,
+	0x7c, 0xde, 0x77, 0x06, 0xc7, 0xcf, 0x71, 0xf2, 0x8f, 0x60, 0xe1, 0x98, 0x4a, 0xf0, 0x24, 0x62,
+	0xc9, 0xfb, 0x9c, 0x69, 0xbf, 0x40, 0x3d, 0xe5, 0xf4, 0xbf, 0xa7, 0xc0, 0x72, 0x7a, 0xa9, 0x8f,
+	0xf7, 0x02, 0xcf, 0x6f, 0xfe, 0xfb, 0xb0, 0xc8, 0xae, 0x26, 0x2e, 0xaa, 0x80, 0x22, 0xf9, 0x94,
+	0x1a, 0xf8, 0x0b, 0x05, 0x16, 0x19, 0xa7, 0x7b, 0x5e, 0x4c, 0xc2, 0x0b, 0xcf, 0xff, 0x01, 0x9e,
+	0xd3, 0xc6, 0xa1, 0xe5, 0x5d, 0x24, 0xc3, 0x8a, 0xa4, 0x53, 0x26, 0xd9, 0x6f, 0x2b, 0xa0, 0x51,
+	0x56, 0xdb, 0x4e, 0x34, 0x72, 0xa2, 0xe8, 0x39, 0x9a, 0x6e, 0x3a, 0x81, 0x7f, 0x57, 0x85, 0xab,
+	0x02, 0x97, 0x5e, 0x12, 0xbf, 0xe8, 0x22, 0x6b, 0xdb, 0xd0, 0x19, 0x25, 0xdc, 0xa5, 0xf4, 0xe6,
+	0xb9, 0x06, 0xca, 0x09, 0xb1, 0x0a, 0xa6, 0x40, 0xfa, 0xb6, 0xa5, 0x45, 0xf7, 0x61, 0x12, 0x0e,
+	0xd3, 0xd0, 0xaa, 0xc0, 0x66, 0xcb, 0xf2, 0x06, 0xc4, 0xfd, 0xc2, 0xa8, 0xc8, 0xf8, 0x63, 0x05,
+	0x16, 0x58, 0x97, 0x17, 0x7f, 0xca, 0xc6, 0x9f, 0x29, 0xdc, 0x91, 0x3f, 0x37, 0x56, 0x42, 0xf7,
+	0x5a, 0x11, 0xb8, 0x88, 0x75, 0xf9, 0x8b, 0xeb, 0x5a, 0x0f, 0xa0, 0x3b, 0x38, 0xb2, 0xbc, 0xe1,
+	0x85, 0x9c, 0x4b, 0x24, 0x35, 0x62, 0x78, 0x59, 0xbc, 0x83, 0xd8, 0x62, 0x4d, 0x74, 0xfa, 0xef,
+	0x14, 0xa6, 0x32, 0xf6, 0x61, 0xc8, 0xf9, 0x94, 0x7e, 0x0c, 0xcb, 0xec, 0x52, 0x5c, 0xa8, 0x19,
+	0xf1, 0x68, 0xc0, 0xb2, 0xd9, 0xc1, 0x8b, 0x42, 0x89, 0x52, 0x50, 0x7e, 0x7a, 0xc1, 0x9f, 0x1b,
+	0x66, 0x08, 0xac, 0xe6, 0x2c, 0xdb, 0xfe, 0xc8, 0x0f, 0x6d, 0xc7, 0x4b, 0x37, 0x08, 0x02, 0xc6,
+	0xf8, 0x26, 0xcc, 0xe1, 0x39, 0xd1, 0x63, 0xe1, 0x7a, 0x7b, 0xec, 0x05, 0xbc, 0x78, 0x35, 0xae,
+	0xca, 0x57, 0xe3, 0xc6, 0x27, 0x70, 0xad, 0x24, 0x38, 0x55, 0xd6, 0x16, 0xbb, 0xb5, 0x7f, 0xec,
+	0x0b, 0x6c, 0xab, 0x8f, 0x26, 0x45, 0x59, 0x4c, 0x89, 0xc8, 0xf8, 0x45, 0x05, 0x5e, 0x2b, 0xb1,
+	0xdf, 0x08, 0x82, 0xd0, 0x3f, 0x25, 0xf6, 0xa5, 0x0d, 0x23, 0x17, 0xc7, 0x6a, 0xa1, 0x38, 0xae,
+	0x16, 0x42, 0x2a, 0xe8, 0xbf, 0x0f, 0x42, 0xfc, 0x91, 0x02, 0x8b, 0x5c, 0x08, 0xdb, 0xe6, 0xc3,
+	0x7e, 0x05, 0xda, 0xec, 0xf5, 0x11, 0x1f, 0xf0, 0xb5, 0xca, 0x01, 0xd3, 0x57, 0x53, 0x26, 0xef,
+	0x5c, 0xf6, 0x48, 0xb5, 0x2a, 0xa2, 0xbe, 0x96, 0x39, 0xfb, 0xd4, 0xef, 0x83, 0x38, 0x81, 0xf1,
+	0xd3, 0xa9, 0x33, 0x6f, 0x13, 0x97, 0x5c, 0xa6, 0x8e, 0x8c, 0x27, 0xb0, 0x40, 0x9f, 0x42, 0xe5,
+	0x3a, 0xb8, 0x14, 0xb6, 0x1f, 0xc1, 0x12, 0x65, 0x7b, 0xe9, 0xf2, 0x66, 0xd1, 0x81, 0xfa, 0x11,
+	0x53, 0xc9, 0xa5, 0x70, 0xff, 0x75, 0x05, 0x5e, 0x61, 0xec, 0xb7, 0xac, 0x98, 0x0c, 0xfd, 0xf0,
+	0x4c, 0x1c, 0x62, 0xba, 0x97, 0x62, 0x9c, 0x30, 0x8b, 0x6c, 0x01, 0x83, 0xa7, 0xdf, 0xf9, 0xeb,
+	0xb6, 0xdd, 0xed, 0xac, 0xce, 0xee, 0x98, 0x25, 0xbc, 0xf1, 0x36, 0x5c, 0x49, 0xfd, 0x80, 0x3d,
+	0xe2, 0x66, 0x42, 0xd4, 0x5c, 0x9b, 0x1a, 0xbf, 0xa3, 0xc0, 0xca, 0x96, 0xef, 0x9d, 0x92, 0x30,
+	0x92, 0x1e, 0x7e, 0x33, 0x12, 0x49, 0x64, 0x0e, 0xe1, 0xd1, 0xe6, 0x40, 0xa0, 0xd8, 0xdd, 0xce,
+	0x2e, 0x7d, 0x3b, 0x66, 0x45, 0x8b, 0xf6, 0x65, 0xb8, 0x96, 0x50, 0xae, 0x4f, 0xbc, 0x90, 0x58,
+	0x36, 0x3d, 0x1b, 0x14, 0x12, 0x70, 0x75, 0xa3, 0xf1, 0x19, 0xac, 0x8a, 0x72, 0xf5, 0x49, 0xbc,
+	0x1f, 0x3a, 0xa7, 0x82, 0x6c, 0xfc, 0x16, 0x42, 0x91, 0x6e, 0x21, 0xf2, 0x5b, 0x0b, 0x55, 0xba,
+	0xb5, 0xb8, 0x0e, 0x1d, 0x27, 0xe2, 0x0c, 0xe8, 0xb8, 0xb3, 0x66, 0x8e, 0x30, 0x2c, 0x58, 0x66,
+	0x1e, 0xc7, 0xaf, 0x09, 0xe9, 0x10, 0xab, 0x30, 0xcb, 0xc2, 0x28, 0x1b, 0x24, 0x83, 0x6b, 0x2f,
+	0xdd, 0x6a, 0xaf, 0x98, 0x8d, 0x3e, 0x2c, 0xf3, 0x67, 0x56, 0xfb, 0xd6, 0xd0, 0xf1, 0xd8, 0xba,
+	0xf2, 0x3a, 0x40, 0x60, 0x0d, 0xd3, 0xa7, 0xa3, 0xec, 0xb2, 0x54, 0xc0, 0x60, 0x7b, 0x74, 0xe4,
+	0x3f, 0xe5, 0xed, 0x2a, 0x6b, 0xcf, 0x31, 0xc6, 0x87, 0xa0, 0xe1, 0x3d, 0x91, 0xef, 0x45, 0x44,
+	0xe0, 0xba, 0x06, 0xdd, 0xad, 0x24, 0x0c, 0x89, 0x87, 0x43, 0xa5, 0xef, 0x1f, 0x45, 0x14, 0xf2,
+	0xed, 0xe7, 0x7c, 0xd9, 0x3d, 0x8a, 0x80, 0x31, 0xfe, 0xbd, 0x0d, 0x9d, 0xbe, 0x33, 0xf4, 0x2c,
+	0x17, 0xef, 0x20, 0xdf, 0x83, 0x36, 0xdb, 0xa5, 0xe9, 0x4a, 0xed, 0xb9, 0x3e, 0xeb, 0xcd, 0xb6,
+	0xa3, 0x26, 0x39, 0x79, 0xf0, 0x92, 0xc9, 0x69, 0xb4, 0x0f, 0xd2, 0x27, 0x6d, 0xbb, 0xec, 0xd4,
+	0x8e, 0x2f, 0xd9, 0x5f, 0x9a, 0xc0, 0x84, 0xf7, 0x66, 0xbc, 0x64, 0x0e, 0x28, 0xd0, 0x80, 0x56,
+	0x71, 0x7a, 0x63, 0x82, 0x40, 0xac, 0xd8, 0xe3, 0x02, 0x31, 0x1a, 0xa4, 0xb6, 0xe8, 0xb9, 0x96,
+	0xde, 0x9c, 0x40, 0xcd, 0x8e, 0xbf, 0x38, 0x35, 0xa3, 0x41, 0xea, 0xa3, 0xc4, 0x1b, 0x3e, 0x09,
+	0xf4, 0xd6, 0x04, 0xea, 0x07, 0xb4, 0x1b, 0xa7, 0x66, 0x34, 0x48, 0x1d, 0xd2, 0xf5, 0x4a, 0x6f,
+	0x4f, 0xa0, 0x66, 0xcb, 0x1a, 0xa7, 0x66, 0x34, 0xda, 0xb7, 0x60, 0x69, 0x48, 0x62, 0xd3, 0xf7,
+	0x47, 0x9b, 0x67, 0x3b, 0xfc, 0xae, 0x8d, 0x7d, 0x4b, 0xf0, 0x56, 0x2d, 0x9f, 0x9d, 0x02, 0x01,
+	0xe3, 0x58, 0xe2, 0xa3, 0xfd, 0x1c, 0xbc, 0xe6, 0x7b, 0x88, 0xda, 0xb7, 0xc2, 0xd8, 0x19, 0x38,
+	0x81, 0xe5, 0xc5, 0x5b, 0xbe, 0xe7, 0xd1, 0xb5, 0xd5, 0x24, 0x27, 0xfc, 0x6b, 0x83, 0xaf, 0xd6,
+	0x0e, 0xb4, 0x37, 0x8e, 0xfa, 0xc1, 0x4b, 0xe6, 0x78, 0xf6, 0xda, 0xaf, 0x2a, 0xb0, 0x56, 0xea,
+	0xb1, 0xed, 0x44, 0x03, 0x51, 0x06, 0xf6, 0xa5, 0xc2, 0xd7, 0xa6, 0x97, 0xa1, 0xc0, 0xe0, 0xc1,
+	0x4b, 0xe6, 0xc4, 0x41, 0xb8, 0x96, 0x1f, 0xfb, 0xc7, 0xc4, 0xdb, 0x3c, 0xc3, 0xbe, 0xbb, 0xdb,
+	0x3a, 0x4c, 0xd6, 0xb2, 0x44, 0x90, 0x6b, 0x59, 0x42, 0x6f, 0x76, 0x60, 0x26, 0xb0, 0xce, 0x5c,
+	0xdf, 0xb2, 0x8d, 0xff, 0x6a, 0x02, 0xa4, 0xa6, 0x8e, 0x68, 0x75, 0x2e, 0x05, 0xd9, 0x8d, 0x89,
+	0x41, 0x16, 0xb8, 0x67, 0x42, 0x98, 0xf5, 0xab, 0xc3, 0xec, 0x47, 0xa7, 0x0d, 0x33, 0xc6, 0xad,
+	0x10, 0x68, 0x77, 0x0b, 0x81, 0x76, 0x63, 0x62, 0xa0, 0x71, 0xa1, 0x78, 0xa8, 0xdd, 0x2d, 0x84,
+	0xda, 0x8d, 0x89, 0xa1, 0xc6, 0xe9, 0x79, 0xb0, 0xdd, 0x2d, 0x04, 0xdb, 0x8d, 0x89, 0xc1, 0xc6,
+	0xe9, 0x79, 0xb8, 0xdd, 0x2d, 0x84, 0xdb, 0x8d, 0x89, 0xe1, 0xc6, 0xe9, 0x79, 0xc0, 0x7d, 0x52,
+	0x1b, 0x70, 0xeb, 0xe7, 0x08, 0x38, 0xc6, 0xb3, 0x1c, 0x72, 0x9f, 0x54, 0x38, 0xda, 0xec, 0x64,
+	0xee, 0x05, 0x47, 0xcb, 0xb9, 0xd7, 0xba, 0xda, 0x2f, 0x37, 0x60, 0x81, 0x9a, 0x9b, 0xad, 0xca,
+	0x78, 0x3d, 0x58, 0x7a, 0x68, 0xac, 0x54, 0x3d, 0x34, 0x7e, 0x0b, 0x96, 0x19, 0x82, 0x08, 0x85,
+	0x07, 0x5b, 0xe8, 0xcb, 0x0d, 0xb4, 0x8a, 0x49, 0xa2, 0xd8, 0x1f, 0xe1, 0x35, 0x6c, 0xba, 0xdb,
+	0xc9, 0x31, 0xe2, 0x0b, 0x81, 0x66, 0xe9, 0x7b, 0x9c, 0x90, 0xcd, 0xbf, 0xc5, 0x57, 0x73, 0x0a,
+	0x21, 0x45, 0xec, 0x8c, 0x88, 0x9f, 0xc4, 0x7c, 0x91, 0x4a, 0x41, 0xf6, 0xae, 0xd3, 0x76, 0x2c,
+	0x7a, 0xaf, 0xce, 0x1f, 0x3d, 0x66, 0x08, 0xba, 0xae, 0xe6, 0xef, 0x04, 0xf8, 0xf7, 0x32, 0x39,
+	0x66, 0x8a, 0x3b, 0x7d, 0xfa, 0x11, 0x98, 0x13, 0x3b, 0xe2, 0x63, 0xc8, 0x96, 0x29, 0xe1, 0xb0,
+	0x0e, 0x3a, 0x48, 0xa2, 0xb3, 0x87, 0x8e, 0x27, 0xaa, 0xa7, 0xcb, 0xea, 0xa0, 0x72, 0x8b, 0xf1,
+	0x1f, 0x0a, 0x5c, 0x11, 0xf2, 0x4e, 0x8f, 0xc4, 0x16, 0xd5, 0x8b, 0xf4, 0x30, 0x5e, 0x39, 0xdf,
+	0xc3, 0xf8, 0x7d, 0x58, 0x1c, 0xca, 0x47, 0x04, 0xe7, 0xdc, 0xdd, 0x17, 0xc9, 0xa5, 0x57, 0xfe,
+	0x8d, 0x73, 0xbf, 0xf2, 0x37, 0x7e, 0x4d, 0x85, 0xc5, 0x42, 0x31, 0x30, 0xb6, 0x92, 0xda, 0x00,
+	0x70, 0x32, 0xd7, 0x1c, 0x73, 0x03, 0x27, 0xfb, 0xaf, 0x29, 0x10, 0x55, 0x3d, 0x40, 0x68, 0x5c,
+	0xfc, 0x01, 0xc2, 0x03, 0xe8, 0x06, 0xb9, 0x91, 0xc6, 0x1c, 0x60, 0x54, 0x98, 0xd2, 0x14, 0x49,
+	0x8d, 0xdf, 0x50, 0x60, 0xb9, 0x94, 0xb2, 0xe9, 0xc5, 0x3c, 0x06, 0x6a, 0x76, 0x31, 0x8f, 0x80,
+	0x10, 0x01, 0x6a, 0x31, 0x02, 0x5c, 0xe7, 0x54, 0xfc, 0x1e, 0x89, 0x83, 0x35, 0xde, 0xd7, 0xac,
+	0xf5, 0xbe, 0xdf, 0x54, 0x61, 0xa5, 0xba, 0xc0, 0xfa, 0xa2, 0xda, 0xe7, 0xb7, 0x14, 0xd0, 0xeb,
+	0xd6, 0xc2, 0xe7, 0x66, 0xa6, 0x3c, 0x7e, 0xb2, 0xda, 0xf5, 0x8b, 0x6a, 0x9f, 0x2b, 0xb0, 0x2c,
+	0x6b, 0x22, 0x70, 0xcf, 0x8c, 0xef, 0x64, 0xfa, 0xc9, 0xaa, 0xf3, 0x2f, 0xa8, 0x7e, 0xf0, 0x54,
+	0x80, 0x4d, 0x53, 0x78, 0x13, 0xc7, 0x36, 0x7b, 0x25, 0xbc, 0xf1, 0x31, 0x2c, 0xcb, 0x5a, 0xbb,
+	0x44, 0x1f, 0x37, 0xfe, 0x46, 0x81, 0x45, 0xb9, 0x0c, 0xfb, 0x7c, 0xd9, 0x24, 0xf7, 0x34, 0xa1,
+	0x8c, 0x14, 0x3c, 0x2d, 0xdb, 0x8b, 0xfd, 0xc0, 0xd3, 0x26, 0x7b, 0x5a, 0xa6, 0x4b, 0xa1, 0xa4,
+	0x36, 0x7e, 0x4f, 0x81, 0x57, 0x6a, 0xf7, 0xa3, 0x63, 0xb5, 0x2a, 0x14, 0x8d, 0xaa, 0x5c, 0x34,
+	0x16, 0xa6, 0xd7, 0xb8, 0x78, 0xa2, 0xf9, 0x3b, 0x05, 0x5e, 0x1d, 0x53, 0xbc, 0x17, 0x2c, 0xab,
+	0x5c, 0xc4, 0xb2, 0x05, 0x61, 0xd5, 0xb5, 0xc6, 0x05, 0x85, 0x15, 0xc2, 0xb3, 0x21, 0x86, 0xa7,
+	0xf1, 0x4f, 0x0a, 0xbc, 0x31, 0xc5, 0x4e, 0xfc, 0xc5, 0x9a, 0x4c, 0xed, 0xa3, 0x61, 0xe3, 0x9f,
+	0x15, 0x78, 0x73, 0xba, 0x4d, 0xfd, 0xe7, 0x65, 0x46, 0x7f, 0x25, 0xc6, 0x40, 0xf1, 0xb4, 0x40,
+	0x30, 0xab, 0x22, 0x65, 0x5d, 0x31, 0x36, 0xd4, 0x42, 0x6c, 0x5c, 0x5a, 0x04, 0x14, 0x3f, 0x16,
+	0x68, 0x96, 0x3f, 0x16, 0xe8, 0xc1, 0xab, 0x75, 0xc2, 0xd7, 0x2f, 0x25, 0xc2, 0x92, 0xa1, 0xca,
+	0x4b, 0xc6, 0xcf, 0xc3, 0xfc, 0x36, 0x71, 0x7b, 0xd1, 0x30, 0xfd, 0xac, 0xe7, 0x52, 0x4f, 0x5b,
+	0xa7, 0x98, 0xcf, 0x26, 0x2c, 0x88, 0x02, 0x5c, 0xe4, 0xb3, 0x15, 0xe3, 0x23, 0x78, 0xa5, 0x4f,
+	0xe2, 0x8d, 0x20, 0xd8, 0xb4, 0x06, 0xc7, 0x68, 0x66, 0xcf, 0xee, 0xd3, 0x67, 0xd5, 0xe3, 0xbe,
+	0x53, 0xc2, 0x9d, 0x65, 0x94, 0x13, 0xf0, 0xd7, 0xbc, 0x12, 0xce, 0x78, 0x04, 0xab, 0x75, 0x8c,
+	0x2f, 0x24, 0xe8, 0x7f, 0xab, 0x30, 0x47, 0xdf, 0x26, 0xe3, 0x97, 0x05, 0xf8, 0xfb, 0x29, 0xf8,
+	0x94, 0x9c, 0x5e, 0x51, 0xe6, 0xda, 0x4e, 0xe1, 0xe2, 0xe6, 0x58, 0x2d, 0x6f, 0x8e, 0xf7, 0x00,
+	0x48, 0xca, 0x2d, 0xe2, 0x0f, 0x7e, 0x6e, 0x57, 0xb8, 0x9d, 0x38, 0x64, 0x0e, 0xf0, 0xf7, 0xe3,
+	0x02, 0x0b, 0x5c, 0x5f, 0x7a, 0xd6, 0xb3, 0x5e, 0x34, 0x14, 0x7e, 0x94, 0x86, 0xbd, 0xfb, 0x29,
+	0xe1, 0x51, 0x7f, 0x19, 0x25, 0x7e, 0xd4, 0xc9, 0xd6, 0x21, 0x09, 0x57, 0x78, 0x0d, 0xdf, 0x2e,
+	0xbe, 0x86, 0x5f, 0xfd, 0x18, 0x16, 0x0b, 0xe2, 0x54, 0xbc, 0xb7, 0xbe, 0x23, 0x7f, 0xcb, 0x71,
+	0x7d, 0xdc, 0x04, 0xc5, 0xd7, 0xd8, 0xff, 0xa9, 0x42, 0x27, 0x6b, 0xd0, 0x46, 0x70, 0x2d, 0x24,
+	0x16, 0xfd, 0xd5, 0x98, 0xec, 0x75, 0xb8, 0xf0, 0xc5, 0xd5, 0x4f, 0x8c, 0xe3, 0xba, 0x6e, 0x56,
+	0x51, 0x32, 0xf5, 0x55, 0x73, 0x9d, 0xe2, 0x7b, 0x90, 0xea, 0x87, 0xe9, 0x8d, 0xba, 0x87, 0xe9,
+	0xa5, 0xa7, 0xf4, 0xcd, 0xda, 0xa7, 0xf4, 0xd9, 0xcf, 0x70, 0xac, 0x12, 0x58, 0xad, 0x17, 0xbd,
+	0x42, 0xd5, 0x3f, 0x2e, 0xab, 0xba, 0xea, 0x3a, 0xff, 0x7d, 0x72, 0xc6, 0x7e, 0xfc, 0x45, 0xd0,
+	0xf4, 0x21, 0xcc, 0xa6, 0x68, 0x7a, 0x54, 0x74, 0x16, 0x90, 0xf7, 0x33, 0xc6, 0x29, 0x28, 0xbf,
+	0x9b, 0xef, 0x70, 0x7a, 0x74, 0x39, 0xd7, 0x8a, 0x49, 0x14, 0x0b, 0x2e, 0xc7, 0x94, 0x50, 0xc2,
+	0x1b, 0x84, 0xff, 0x00, 0x51, 0xfe, 0x25, 0x3d, 0x7a, 0x18, 0xbd, 0xcc, 0x61, 0xf9, 0x85, 0x8d,
+	0x28, 0x60, 0x30, 0xc0, 0x52, 0x28, 0x4d, 0xdb, 0x29, 0x8c, 0x51, 0x6a, 0x51, 0x2e, 0xe9, 0x0a,
+	0xce, 0xa0, 0xcd, 0xb7, 0xbe, 0x75, 0x0b, 0x7f, 0xcc, 0xeb, 0xd3, 0xdd, 0x5e, 0xe9, 0x57, 0xbc,
+	0xbe, 0x5e, 0x52, 0xc8, 0x41, 0x9b, 0xb6, 0xbf, 0xf3, 0xff, 0x03, 0x00, 0xaa, 0xfa, 0xdf, 0x10,
+	0x25, 0x4c, 0x00, 0x00,
 }
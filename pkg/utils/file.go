@@ -81,3 +81,34 @@ func ByteSize(bytes uint64) string {
 	result = strings.TrimSuffix(result, ".0")
 	return result + unit
 }
+
+// GetPartNum returns how many parts a file of size is split into
+func GetPartNum(size, partSize int64) int {
+	if size <= 0 || partSize <= 0 {
+		return 0
+	}
+	return int((size + partSize - 1) / partSize)
+}
+
+// IsSha256Hex reports whether hash is a lowercase hex encoded sha256 digest
+func IsSha256Hex(hash string) bool {
+	if len(hash) != 64 {
+		return false
+	}
+	for _, c := range hash {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// GetHashObjectName addresses a file by its content hash, keeping the extension of fileName
+func GetHashObjectName(hash, fileName string) string {
+	return "hash/" + hash + strings.ToLower(path.Ext(fileName))
+}
+
+// GetStagingObjectName names the object a multipart upload is assembled in before its hash is verified
+func GetStagingObjectName() string {
+	return fmt.Sprintf("multipart/%d-%d", time.Now().UnixNano(), rand.Int())
+}
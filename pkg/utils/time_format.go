@@ -7,6 +7,7 @@
 package utils
 
 import (
+	"errors"
 	"strconv"
 	"time"
 )
@@ -87,3 +88,39 @@ func TimeStringToTime(timeString string) (time.Time, error) {
 func TimeToString(t time.Time) string {
 	return t.Format("2006-01-02")
 }
+
+const DailyClockLayout = "15:04"
+
+//Check whether now falls in the daily period [start, end) given as "15:04" clock times in timeZone,
+//a period whose end is not after its start spans midnight
+func IsInDailyPeriod(start, end, timeZone string, now time.Time) (bool, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return false, err
+	}
+	startClock, err := time.Parse(DailyClockLayout, start)
+	if err != nil {
+		return false, err
+	}
+	endClock, err := time.Parse(DailyClockLayout, end)
+	if err != nil {
+		return false, err
+	}
+	if startClock.Equal(endClock) {
+		return false, errors.New("start equals end")
+	}
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := startClock.Hour()*60 + startClock.Minute()
+	endMinute := endClock.Hour()*60 + endClock.Minute()
+	if startMinute < endMinute {
+		return minute >= startMinute && minute < endMinute, nil
+	}
+	return minute >= startMinute || minute < endMinute, nil
+}
+
+//Get the time left until the next midnight in the location of now
+func UntilNextDay(now time.Time) time.Duration {
+	year, month, day := now.Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, now.Location()).Sub(now)
+}